@@ -98,15 +98,48 @@ func (gtid filePosGTID) ContainsGTID(other GTID) bool {
 	if !ok {
 		return false
 	}
-	if filePosOther.file < gtid.file {
+	switch compareBinlogFiles(filePosOther.file, gtid.file) {
+	case -1:
 		return true
-	}
-	if filePosOther.file > gtid.file {
+	case 1:
 		return false
 	}
 	return filePosOther.pos <= gtid.pos
 }
 
+// compareBinlogFiles compares two binlog file names and returns -1, 0 or 1.
+// The numeric extensions of files that share the same base name are
+// compared as integers because mysql stops zero-padding them once
+// they grow past six digits (mysql-bin.999999 -> mysql-bin.1000000).
+func compareBinlogFiles(a, b string) int {
+	aBase, aExt := splitBinlogFile(a)
+	bBase, bExt := splitBinlogFile(b)
+	if aBase == bBase && aExt >= 0 && bExt >= 0 {
+		switch {
+		case aExt < bExt:
+			return -1
+		case aExt > bExt:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+// splitBinlogFile splits a binlog file name into its base name and
+// numeric extension. The extension is -1 if it's not a number.
+func splitBinlogFile(file string) (string, int) {
+	i := strings.LastIndexByte(file, '.')
+	if i < 0 {
+		return file, -1
+	}
+	ext, err := strconv.Atoi(file[i+1:])
+	if err != nil {
+		return file, -1
+	}
+	return file[:i], ext
+}
+
 // Contains implements GTIDSet.Contains().
 func (gtid filePosGTID) Contains(other GTIDSet) bool {
 	if other == nil {
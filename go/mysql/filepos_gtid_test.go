@@ -87,6 +87,24 @@ func Test_filePosGTID_ContainsGTID(t *testing.T) {
 			args{other: filePosGTID{file: "testfile", pos: 103939867}},
 			false,
 		},
+		{
+			"returns true when the file is older",
+			fields{file: "mysql-bin.000002", pos: 4},
+			args{other: filePosGTID{file: "mysql-bin.000001", pos: 1234}},
+			true,
+		},
+		{
+			"returns false when the file is newer",
+			fields{file: "mysql-bin.000001", pos: 1234},
+			args{other: filePosGTID{file: "mysql-bin.000002", pos: 4}},
+			false,
+		},
+		{
+			"it uses integer value for file extensions (it is not lexicographical order)",
+			fields{file: "mysql-bin.1000000", pos: 4},
+			args{other: filePosGTID{file: "mysql-bin.999999", pos: 1234}},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -144,6 +144,11 @@ func (flv *filePosFlavor) readBinlogEvent(c *Conn) (BinlogEvent, error) {
 			eUpdateRowsEventV0, eUpdateRowsEventV1, eUpdateRowsEventV2:
 			flv.savedEvent = event
 			return newFilePosGTIDEvent(flv.file, event.nextPosition(flv.format), event.Timestamp()), nil
+		case eIntVarEvent, eRandEvent, eUserVarEvent, eRowsQueryEvent:
+			// These events are always part of a transaction. Sending a
+			// "repair" event for them would break the transaction up,
+			// and the position after them is not a valid resume point.
+			return event, nil
 		case eQueryEvent:
 			q, err := event.Query(flv.format)
 			if err == nil && strings.HasPrefix(q.SQL, "#") {
@@ -172,9 +172,8 @@ const (
 	//eDeleteFileEvent        = 11
 	// Unused
 	//eNewLoadEvent           = 12
-	eRandEvent = 13
-	// Unused
-	//eUserVarEvent           = 14
+	eRandEvent              = 13
+	eUserVarEvent           = 14
 	eFormatDescriptionEvent = 15
 	eXIDEvent               = 16
 	//Unused
@@ -193,8 +192,7 @@ const (
 	//eHeartbeatEvent         = 27
 	// Unused
 	//eIgnorableEvent         = 28
-	// Unused
-	//eRowsQueryEvent         = 29
+	eRowsQueryEvent     = 29
 	eWriteRowsEventV2   = 30
 	eUpdateRowsEventV2  = 31
 	eDeleteRowsEventV2  = 32
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pools
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/timer"
+)
+
+// Reasons reported by a CapacityController when it resizes a pool.
+const (
+	ResizeReasonWaitTime = "WaitTime"
+	ResizeReasonWaiters  = "Waiters"
+	ResizeReasonLoad     = "Load"
+	ResizeReasonIdle     = "Idle"
+)
+
+// LoadFunc returns a load signal of the backend behind a pool, for
+// example the number of running MySQL threads. ok is false if the
+// signal is currently unknown.
+type LoadFunc func() (load float64, ok bool)
+
+// ResizeFunc is called by a CapacityController after every resize.
+type ResizeFunc func(oldCapacity, newCapacity int, reason string)
+
+// CapacityControllerConfig configures a CapacityController.
+type CapacityControllerConfig struct {
+	// MinCapacity and MaxCapacity bound the capacity of the pool.
+	MinCapacity int
+	MaxCapacity int
+	// Interval is how often the pool is evaluated.
+	Interval time.Duration
+	// Step is the number of resources added or removed by a resize.
+	Step int
+	// Hysteresis is the number of consecutive evaluations that must
+	// agree before the pool is resized.
+	Hysteresis int
+	// WaitThreshold is the average wait for a resource during an
+	// interval above which the pool grows.
+	WaitThreshold time.Duration
+	// WaitersThreshold is the number of callers waiting for a resource
+	// at which the pool grows. Zero disables the check.
+	WaitersThreshold int
+	// MaxLoad is the load at which the pool shrinks instead of growing,
+	// because more resources would only overload the backend.
+	// Zero disables the check.
+	MaxLoad float64
+}
+
+// CapacityController grows and shrinks a ResourcePool between a
+// minimum and a maximum capacity. The pool grows while callers wait
+// for resources, and shrinks while resources sit idle or the backend
+// reports a load above MaxLoad.
+type CapacityController struct {
+	pool     *ResourcePool
+	config   CapacityControllerConfig
+	load     LoadFunc
+	onResize ResizeFunc
+	timer    *timer.Timer
+
+	// mu protects the following fields.
+	mu            sync.Mutex
+	lastWaitCount int64
+	lastWaitTime  time.Duration
+	growVotes     int
+	shrinkVotes   int
+}
+
+// NewCapacityController creates a CapacityController for the pool.
+// The pool must have been created with a max capacity of at least
+// config.MaxCapacity. load and onResize can be nil.
+func NewCapacityController(pool *ResourcePool, config CapacityControllerConfig, load LoadFunc, onResize ResizeFunc) (*CapacityController, error) {
+	if config.MinCapacity <= 0 || config.MinCapacity > config.MaxCapacity {
+		return nil, errors.New("invalid/out of range min and max capacity")
+	}
+	if int64(config.MaxCapacity) > pool.MaxCap() {
+		return nil, errors.New("max capacity exceeds the max capacity of the pool")
+	}
+	if config.Step <= 0 {
+		config.Step = 1
+	}
+	if config.Hysteresis <= 0 {
+		config.Hysteresis = 1
+	}
+	return &CapacityController{
+		pool:     pool,
+		config:   config,
+		load:     load,
+		onResize: onResize,
+		timer:    timer.NewTimer(config.Interval),
+	}, nil
+}
+
+// Open starts evaluating the pool.
+func (cc *CapacityController) Open() {
+	cc.mu.Lock()
+	cc.lastWaitCount = cc.pool.WaitCount()
+	cc.lastWaitTime = cc.pool.WaitTime()
+	cc.mu.Unlock()
+	cc.timer.Start(cc.evaluate)
+}
+
+// Close stops evaluating the pool. The capacity is left as is.
+func (cc *CapacityController) Close() {
+	cc.timer.Stop()
+}
+
+// evaluate decides whether the pool needs to be resized, and resizes it.
+// The resize happens without holding mu, because shrinking the pool
+// blocks until enough resources are returned to it.
+func (cc *CapacityController) evaluate() {
+	oldCapacity, newCapacity, reason := cc.decide()
+	if newCapacity == oldCapacity {
+		return
+	}
+	if err := cc.pool.SetCapacity(newCapacity); err != nil {
+		return
+	}
+	if cc.onResize != nil {
+		cc.onResize(oldCapacity, newCapacity, reason)
+	}
+}
+
+// decide returns the current and the new capacity of the pool, and
+// the reason for the change. Both capacities are equal if the pool
+// must not be resized.
+func (cc *CapacityController) decide() (oldCapacity, newCapacity int, reason string) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	waitCount, waitTime := cc.pool.WaitCount(), cc.pool.WaitTime()
+	waits := waitCount - cc.lastWaitCount
+	var avgWait time.Duration
+	if waits > 0 {
+		avgWait = (waitTime - cc.lastWaitTime) / time.Duration(waits)
+	}
+	cc.lastWaitCount, cc.lastWaitTime = waitCount, waitTime
+
+	grow, shrink := false, false
+	overloaded := false
+	if cc.load != nil && cc.config.MaxLoad > 0 {
+		if load, ok := cc.load(); ok && load >= cc.config.MaxLoad {
+			overloaded = true
+		}
+	}
+	switch {
+	case overloaded:
+		shrink, reason = true, ResizeReasonLoad
+	case cc.config.WaitersThreshold > 0 && cc.pool.Waiters() >= int64(cc.config.WaitersThreshold):
+		grow, reason = true, ResizeReasonWaiters
+	case avgWait > cc.config.WaitThreshold:
+		grow, reason = true, ResizeReasonWaitTime
+	case waits == 0 && cc.pool.Available() >= int64(cc.config.Step):
+		shrink, reason = true, ResizeReasonIdle
+	}
+
+	switch {
+	case grow:
+		cc.growVotes++
+		cc.shrinkVotes = 0
+	case shrink:
+		cc.shrinkVotes++
+		cc.growVotes = 0
+	default:
+		cc.growVotes, cc.shrinkVotes = 0, 0
+		return 0, 0, ""
+	}
+
+	oldCapacity = int(cc.pool.Capacity())
+	newCapacity = oldCapacity
+	switch {
+	case cc.growVotes >= cc.config.Hysteresis:
+		newCapacity = oldCapacity + cc.config.Step
+		if newCapacity > cc.config.MaxCapacity {
+			newCapacity = cc.config.MaxCapacity
+		}
+	case cc.shrinkVotes >= cc.config.Hysteresis:
+		newCapacity = oldCapacity - cc.config.Step
+		if newCapacity < cc.config.MinCapacity {
+			newCapacity = cc.config.MinCapacity
+		}
+	}
+	if newCapacity != oldCapacity {
+		cc.growVotes, cc.shrinkVotes = 0, 0
+	}
+	return oldCapacity, newCapacity, reason
+}
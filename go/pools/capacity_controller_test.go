@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+type resize struct {
+	oldCapacity, newCapacity int
+	reason                   string
+}
+
+func TestCapacityControllerInvalidConfig(t *testing.T) {
+	p := NewResourcePool(PoolFactory, 2, 4, 0, 0, nil)
+	defer p.Close()
+
+	_, err := NewCapacityController(p, CapacityControllerConfig{MinCapacity: 3, MaxCapacity: 2}, nil, nil)
+	assert.Error(t, err)
+	_, err = NewCapacityController(p, CapacityControllerConfig{MinCapacity: 1, MaxCapacity: 5}, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestCapacityControllerGrowAndShrink(t *testing.T) {
+	ctx := context.Background()
+	p := NewResourcePool(PoolFactory, 1, 3, 0, 0, nil)
+	defer p.Close()
+
+	var resizes []resize
+	cc, err := NewCapacityController(p, CapacityControllerConfig{
+		MinCapacity:      1,
+		MaxCapacity:      3,
+		Hysteresis:       2,
+		WaitThreshold:    time.Hour,
+		WaitersThreshold: 1,
+	}, nil, func(oldCapacity, newCapacity int, reason string) {
+		resizes = append(resizes, resize{oldCapacity, newCapacity, reason})
+	})
+	require.NoError(t, err)
+
+	r, err := p.Get(ctx)
+	require.NoError(t, err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r, err := p.Get(ctx)
+		if err == nil {
+			p.Put(r)
+		}
+	}()
+	for p.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// A single evaluation is not enough to resize.
+	cc.evaluate()
+	assert.EqualValues(t, 1, p.Capacity())
+	cc.evaluate()
+	<-done
+	assert.EqualValues(t, 2, p.Capacity())
+	assert.Equal(t, []resize{{1, 2, ResizeReasonWaiters}}, resizes)
+
+	// The first evaluation still sees the wait of the second Get.
+	p.Put(r)
+	cc.evaluate()
+	cc.evaluate()
+	assert.EqualValues(t, 2, p.Capacity())
+	cc.evaluate()
+	assert.EqualValues(t, 1, p.Capacity())
+	assert.Equal(t, resize{2, 1, ResizeReasonIdle}, resizes[1])
+
+	// The pool never shrinks below the min capacity.
+	cc.evaluate()
+	cc.evaluate()
+	assert.EqualValues(t, 1, p.Capacity())
+	assert.Len(t, resizes, 2)
+}
+
+func TestCapacityControllerLoad(t *testing.T) {
+	ctx := context.Background()
+	p := NewResourcePool(PoolFactory, 3, 3, 0, 0, nil)
+	defer p.Close()
+
+	load := 10.0
+	var resizes []resize
+	cc, err := NewCapacityController(p, CapacityControllerConfig{
+		MinCapacity:      1,
+		MaxCapacity:      3,
+		WaitThreshold:    time.Hour,
+		WaitersThreshold: 1,
+		MaxLoad:          5,
+	}, func() (float64, bool) {
+		return load, true
+	}, func(oldCapacity, newCapacity int, reason string) {
+		resizes = append(resizes, resize{oldCapacity, newCapacity, reason})
+	})
+	require.NoError(t, err)
+
+	// The pool shrinks under load even if all resources are in use.
+	var rs []Resource
+	for i := 0; i < 2; i++ {
+		r, err := p.Get(ctx)
+		require.NoError(t, err)
+		rs = append(rs, r)
+	}
+	cc.evaluate()
+	assert.EqualValues(t, 2, p.Capacity())
+	assert.Equal(t, []resize{{3, 2, ResizeReasonLoad}}, resizes)
+
+	// Without load, waiters make the pool grow again.
+	load = 0
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r, err := p.Get(ctx)
+		if err == nil {
+			p.Put(r)
+		}
+	}()
+	for p.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cc.evaluate()
+	<-done
+	assert.EqualValues(t, 3, p.Capacity())
+	for _, r := range rs {
+		p.Put(r)
+	}
+}
+
+func TestCapacityControllerShrinkDoesNotHoldLock(t *testing.T) {
+	ctx := context.Background()
+	p := NewResourcePool(PoolFactory, 2, 2, 0, 0, nil)
+	defer p.Close()
+
+	cc, err := NewCapacityController(p, CapacityControllerConfig{
+		MinCapacity:   1,
+		MaxCapacity:   2,
+		WaitThreshold: time.Hour,
+		MaxLoad:       5,
+	}, func() (float64, bool) {
+		return 10, true
+	}, nil)
+	require.NoError(t, err)
+
+	var rs []Resource
+	for i := 0; i < 2; i++ {
+		r, err := p.Get(ctx)
+		require.NoError(t, err)
+		rs = append(rs, r)
+	}
+
+	// The shrink blocks until a resource is returned to the pool.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cc.evaluate()
+	}()
+	for p.Capacity() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Other callers of the controller are not blocked meanwhile.
+	locked := make(chan struct{})
+	go func() {
+		defer close(locked)
+		cc.mu.Lock()
+		cc.mu.Unlock()
+	}()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("controller lock is held during the resize")
+	}
+	select {
+	case <-done:
+		t.Fatal("shrink completed while all resources are in use")
+	default:
+	}
+
+	for _, r := range rs {
+		p.Put(r)
+	}
+	<-done
+}
@@ -67,6 +67,7 @@ type ResourcePool struct {
 	waitTime   sync2.AtomicDuration
 	idleClosed sync2.AtomicInt64
 	exhausted  sync2.AtomicInt64
+	waiters    sync2.AtomicInt64
 
 	capacity    sync2.AtomicInt64
 	idleTimeout sync2.AtomicDuration
@@ -217,11 +218,14 @@ func (rp *ResourcePool) get(ctx context.Context) (resource Resource, err error)
 	case wrapper, ok = <-rp.resources:
 	default:
 		startTime := time.Now()
+		rp.waiters.Add(1)
 		select {
 		case wrapper, ok = <-rp.resources:
 		case <-ctx.Done():
+			rp.waiters.Add(-1)
 			return nil, ErrTimeout
 		}
+		rp.waiters.Add(-1)
 		rp.recordWait(startTime)
 	}
 	if !ok {
@@ -393,6 +397,11 @@ func (rp *ResourcePool) WaitCount() int64 {
 	return rp.waitCount.Get()
 }
 
+// Waiters returns the number of callers currently waiting for a resource.
+func (rp *ResourcePool) Waiters() int64 {
+	return rp.waiters.Get()
+}
+
 // WaitTime returns the total wait time.
 func (rp *ResourcePool) WaitTime() time.Duration {
 	return rp.waitTime.Get()
@@ -93,6 +93,23 @@ type Stats struct {
 	CopyRowCount  *stats.Counter
 	CopyLoopCount *stats.Counter
 	ErrorCounts   *stats.CountersWithMultiLabels
+
+	// ThrottledTimings records the time spent throttled, per component.
+	ThrottledTimings *stats.Timings
+
+	// ParallelApplyTimings records the time spent applying transactions
+	// by each worker of the parallel applier.
+	ParallelApplyTimings *stats.Timings
+	// ParallelApplyFallbacks counts the batches of transactions that
+	// the parallel applier had to finish applying one at a time.
+	ParallelApplyFallbacks *stats.Counter
+
+	parallelApplyMutex   sync.Mutex
+	parallelApplyWorkers int64
+	parallelApplyStart   time.Time
+	// parallelApplyBusy is the time spent by the workers before
+	// they were last started.
+	parallelApplyBusy int64
 }
 
 // RecordHeartbeat updates the time the last heartbeat from vstreamer was seen
@@ -123,6 +140,39 @@ func (bps *Stats) LastPosition() mysql.Position {
 	return bps.lastPosition
 }
 
+// StartParallelApply records that transactions are now applied by the
+// specified number of workers. Zero workers means that transactions
+// are applied one at a time.
+func (bps *Stats) StartParallelApply(workers int) {
+	bps.parallelApplyMutex.Lock()
+	defer bps.parallelApplyMutex.Unlock()
+	bps.parallelApplyWorkers = int64(workers)
+	bps.parallelApplyStart = time.Now()
+	bps.parallelApplyBusy = bps.ParallelApplyTimings.Time()
+}
+
+// ParallelApplyWorkers returns the number of workers of the parallel applier.
+func (bps *Stats) ParallelApplyWorkers() int64 {
+	bps.parallelApplyMutex.Lock()
+	defer bps.parallelApplyMutex.Unlock()
+	return bps.parallelApplyWorkers
+}
+
+// ParallelApplyUtilization returns the percentage of time the workers
+// of the parallel applier spent applying transactions since they started.
+func (bps *Stats) ParallelApplyUtilization() int64 {
+	bps.parallelApplyMutex.Lock()
+	defer bps.parallelApplyMutex.Unlock()
+	if bps.parallelApplyWorkers == 0 {
+		return 0
+	}
+	elapsed := time.Since(bps.parallelApplyStart).Nanoseconds() * bps.parallelApplyWorkers
+	if elapsed <= 0 {
+		return 0
+	}
+	return (bps.ParallelApplyTimings.Time() - bps.parallelApplyBusy) * 100 / elapsed
+}
+
 // MessageHistory gets all the messages, we store 3 at a time
 func (bps *Stats) MessageHistory() []string {
 	strs := make([]string, 0, 3)
@@ -148,6 +198,9 @@ func NewStats() *Stats {
 	bps.CopyRowCount = stats.NewCounter("", "")
 	bps.CopyLoopCount = stats.NewCounter("", "")
 	bps.ErrorCounts = stats.NewCountersWithMultiLabels("", "", []string{"type"})
+	bps.ThrottledTimings = stats.NewTimings("", "", "Component")
+	bps.ParallelApplyTimings = stats.NewTimings("", "", "Worker")
+	bps.ParallelApplyFallbacks = stats.NewCounter("", "")
 	return bps
 }
 
@@ -522,6 +575,8 @@ func (blp *BinlogPlayer) setVReplicationState(state, message string) error {
 // transaction_timestamp: timestamp of the transaction (from the master).
 // state: Running, Error or Stopped.
 // message: Reason for current state.
+// time_throttled: last time the stream was throttled.
+// component_throttled: the component (vcopier or vplayer) that was last throttled.
 func CreateVReplicationTable() []string {
 	return []string{
 		"CREATE DATABASE IF NOT EXISTS _vt",
@@ -541,6 +596,8 @@ func CreateVReplicationTable() []string {
   state VARBINARY(100) NOT NULL,
   message VARBINARY(1000) DEFAULT NULL,
   db_name VARBINARY(255) NOT NULL,
+  time_throttled BIGINT NOT NULL DEFAULT 0,
+  component_throttled VARCHAR(255) NOT NULL DEFAULT '',
   PRIMARY KEY (id)
 ) ENGINE=InnoDB`,
 	}
@@ -550,6 +607,11 @@ func CreateVReplicationTable() []string {
 var AlterVReplicationTable = []string{
 	"ALTER TABLE _vt.vreplication ADD COLUMN db_name VARBINARY(255) NOT NULL",
 	"ALTER TABLE _vt.vreplication MODIFY source BLOB NOT NULL",
+	"ALTER TABLE _vt.vreplication ADD COLUMN time_throttled BIGINT NOT NULL DEFAULT 0",
+	"ALTER TABLE _vt.vreplication ADD COLUMN component_throttled VARCHAR(255) NOT NULL DEFAULT ''",
+	"ALTER TABLE _vt.vreplication ADD COLUMN sink VARBINARY(1000) NOT NULL DEFAULT ''",
+	"ALTER TABLE _vt.vreplication ADD COLUMN on_conflict VARBINARY(32) NOT NULL DEFAULT ''",
+	"ALTER TABLE _vt.vreplication ADD COLUMN on_schema_change VARBINARY(32) NOT NULL DEFAULT ''",
 }
 
 // VRSettings contains the settings of a vreplication table.
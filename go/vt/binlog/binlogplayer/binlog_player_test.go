@@ -393,3 +393,33 @@ func TestReadVReplicationStatus(t *testing.T) {
 		t.Errorf("ReadVReplicationStatus(482821) = %#v, want %#v", got, want)
 	}
 }
+
+func TestParallelApplyStats(t *testing.T) {
+	bps := NewStats()
+	if got := bps.ParallelApplyUtilization(); got != 0 {
+		t.Errorf("ParallelApplyUtilization() = %d, want 0", got)
+	}
+
+	// Time spent before the workers are started doesn't count.
+	bps.ParallelApplyTimings.Add("0", time.Hour)
+	bps.StartParallelApply(2)
+	if got := bps.ParallelApplyWorkers(); got != 2 {
+		t.Errorf("ParallelApplyWorkers() = %d, want 2", got)
+	}
+	if got := bps.ParallelApplyUtilization(); got != 0 {
+		t.Errorf("ParallelApplyUtilization() = %d, want 0", got)
+	}
+	time.Sleep(10 * time.Millisecond)
+	bps.ParallelApplyTimings.Add("0", 10*time.Millisecond)
+	if got := bps.ParallelApplyUtilization(); got <= 0 || got > 50 {
+		t.Errorf("ParallelApplyUtilization() = %d, want between 1 and 50", got)
+	}
+
+	bps.StartParallelApply(0)
+	if got := bps.ParallelApplyWorkers(); got != 0 {
+		t.Errorf("ParallelApplyWorkers() = %d, want 0", got)
+	}
+	if got := bps.ParallelApplyUtilization(); got != 0 {
+		t.Errorf("ParallelApplyUtilization() = %d, want 0", got)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmutils
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// This file contains helper methods to deal with the schema versions
+// recorded by the schema tracker.
+
+// NewSchemaVersion returns a SchemaVersion for a schema recorded by the
+// schema tracker.
+func NewSchemaVersion(id int64, position, ddl string, timeUpdated int64, schema *binlogdatapb.MinimalSchema) *tabletmanagerdatapb.SchemaVersion {
+	version := &tabletmanagerdatapb.SchemaVersion{
+		Id:          id,
+		Position:    position,
+		Ddl:         ddl,
+		TimeUpdated: timeUpdated,
+	}
+	for _, table := range schema.Tables {
+		td := &tabletmanagerdatapb.TableDefinition{
+			Name:   table.Name,
+			Type:   TableBaseTable,
+			Fields: table.Fields,
+		}
+		for _, field := range table.Fields {
+			td.Columns = append(td.Columns, field.Name)
+		}
+		for _, pkColumn := range table.PKColumns {
+			if pkColumn >= 0 && pkColumn < int64(len(table.Fields)) {
+				td.PrimaryKeyColumns = append(td.PrimaryKeyColumns, table.Fields[pkColumn].Name)
+			}
+		}
+		version.TableDefinitions = append(version.TableDefinitions, td)
+	}
+	return version
+}
+
+// FilterSchemaVersions restricts schema versions, oldest first, to the given
+// tables, and drops the versions in which none of these tables changed.
+// If tables is empty, the versions are returned as is.
+func FilterSchemaVersions(versions []*tabletmanagerdatapb.SchemaVersion, tables []string) []*tabletmanagerdatapb.SchemaVersion {
+	if len(tables) == 0 {
+		return versions
+	}
+	includes := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		includes[table] = true
+	}
+	var filtered []*tabletmanagerdatapb.SchemaVersion
+	var previous *tabletmanagerdatapb.SchemaVersion
+	for _, version := range versions {
+		version = proto.Clone(version).(*tabletmanagerdatapb.SchemaVersion)
+		var tds []*tabletmanagerdatapb.TableDefinition
+		for _, td := range version.TableDefinitions {
+			if includes[td.Name] {
+				tds = append(tds, td)
+			}
+		}
+		version.TableDefinitions = tds
+		if previous != nil && schemaVersionTablesEqual(previous, version) {
+			continue
+		}
+		filtered = append(filtered, version)
+		previous = version
+	}
+	return filtered
+}
+
+func schemaVersionTablesEqual(left, right *tabletmanagerdatapb.SchemaVersion) bool {
+	if len(left.TableDefinitions) != len(right.TableDefinitions) {
+		return false
+	}
+	for i := range left.TableDefinitions {
+		if !proto.Equal(left.TableDefinitions[i], right.TableDefinitions[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// SchemaVersionAtPosition returns the last of the schema versions, oldest
+// first, whose DDL is included in the given position, or nil if there is none.
+func SchemaVersionAtPosition(versions []*tabletmanagerdatapb.SchemaVersion, pos mysql.Position) (*tabletmanagerdatapb.SchemaVersion, error) {
+	var found *tabletmanagerdatapb.SchemaVersion
+	for _, version := range versions {
+		versionPos, err := mysql.DecodePosition(version.Position)
+		if err != nil {
+			return nil, err
+		}
+		if pos.AtLeast(versionPos) {
+			found = version
+		}
+	}
+	return found, nil
+}
+
+// SchemaVersionAtTime returns the last of the schema versions, oldest first,
+// whose DDL ran at or before the given time, or nil if there is none.
+func SchemaVersionAtTime(versions []*tabletmanagerdatapb.SchemaVersion, t time.Time) *tabletmanagerdatapb.SchemaVersion {
+	var found *tabletmanagerdatapb.SchemaVersion
+	for _, version := range versions {
+		if version.TimeUpdated <= t.Unix() {
+			found = version
+		}
+	}
+	return found
+}
+
+// SchemaVersionToSchemaDefinition returns the schema of a schema version.
+func SchemaVersionToSchemaDefinition(version *tabletmanagerdatapb.SchemaVersion) *tabletmanagerdatapb.SchemaDefinition {
+	sd := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: version.TableDefinitions,
+	}
+	GenerateSchemaVersion(sd)
+	return sd
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tmutils
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func minimalTable(name string, columns ...string) *binlogdatapb.MinimalTable {
+	table := &binlogdatapb.MinimalTable{Name: name, PKColumns: []int64{0}}
+	for _, column := range columns {
+		table.Fields = append(table.Fields, &querypb.Field{Name: column, Type: querypb.Type_INT32})
+	}
+	return table
+}
+
+// testSchemaVersions returns a history in which t1 is created, t2 is
+// created, t1 gets a column, and t2 is dropped.
+func testSchemaVersions() []*tabletmanagerdatapb.SchemaVersion {
+	return []*tabletmanagerdatapb.SchemaVersion{
+		NewSchemaVersion(1, "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5", "create table t1", 100,
+			&binlogdatapb.MinimalSchema{Tables: []*binlogdatapb.MinimalTable{minimalTable("t1", "id")}}),
+		NewSchemaVersion(2, "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8", "create table t2", 200,
+			&binlogdatapb.MinimalSchema{Tables: []*binlogdatapb.MinimalTable{minimalTable("t1", "id"), minimalTable("t2", "id")}}),
+		NewSchemaVersion(3, "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-12", "alter table t1", 300,
+			&binlogdatapb.MinimalSchema{Tables: []*binlogdatapb.MinimalTable{minimalTable("t1", "id", "val"), minimalTable("t2", "id")}}),
+		NewSchemaVersion(4, "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-15", "drop table t2", 400,
+			&binlogdatapb.MinimalSchema{Tables: []*binlogdatapb.MinimalTable{minimalTable("t1", "id", "val")}}),
+	}
+}
+
+func TestNewSchemaVersion(t *testing.T) {
+	version := testSchemaVersions()[2]
+	want := &tabletmanagerdatapb.TableDefinition{
+		Name:              "t1",
+		Columns:           []string{"id", "val"},
+		PrimaryKeyColumns: []string{"id"},
+		Type:              TableBaseTable,
+		Fields: []*querypb.Field{
+			{Name: "id", Type: querypb.Type_INT32},
+			{Name: "val", Type: querypb.Type_INT32},
+		},
+	}
+	if !proto.Equal(version.TableDefinitions[0], want) {
+		t.Errorf("NewSchemaVersion: got %v, want %v", version.TableDefinitions[0], want)
+	}
+}
+
+func TestFilterSchemaVersions(t *testing.T) {
+	versions := testSchemaVersions()
+	if got := FilterSchemaVersions(versions, nil); len(got) != 4 {
+		t.Errorf("FilterSchemaVersions without tables: got %d versions, want 4", len(got))
+	}
+
+	testcases := []struct {
+		tables []string
+		ids    []int64
+	}{{
+		tables: []string{"t1"},
+		ids:    []int64{1, 3},
+	}, {
+		tables: []string{"t2"},
+		ids:    []int64{1, 2, 4},
+	}, {
+		tables: []string{"t1", "t2"},
+		ids:    []int64{1, 2, 3, 4},
+	}}
+	for _, tc := range testcases {
+		got := FilterSchemaVersions(versions, tc.tables)
+		var ids []int64
+		for _, version := range got {
+			ids = append(ids, version.Id)
+			for _, td := range version.TableDefinitions {
+				if td.Name != tc.tables[0] && (len(tc.tables) == 1 || td.Name != tc.tables[1]) {
+					t.Errorf("FilterSchemaVersions(%v): version %d has table %s", tc.tables, version.Id, td.Name)
+				}
+			}
+		}
+		if !reflect.DeepEqual(ids, tc.ids) {
+			t.Errorf("FilterSchemaVersions(%v): got versions %v, want %v", tc.tables, ids, tc.ids)
+		}
+	}
+	if len(versions[1].TableDefinitions) != 2 {
+		t.Errorf("FilterSchemaVersions modified its input")
+	}
+}
+
+func TestSchemaVersionAt(t *testing.T) {
+	versions := testSchemaVersions()
+
+	testcases := []struct {
+		pos string
+		id  int64
+	}{{
+		pos: "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-4",
+		id:  0,
+	}, {
+		pos: "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8",
+		id:  2,
+	}, {
+		pos: "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-14",
+		id:  3,
+	}}
+	for _, tc := range testcases {
+		pos, err := mysql.DecodePosition(tc.pos)
+		if err != nil {
+			t.Fatal(err)
+		}
+		version, err := SchemaVersionAtPosition(versions, pos)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version.GetId() != tc.id {
+			t.Errorf("SchemaVersionAtPosition(%v): got version %d, want %d", tc.pos, version.GetId(), tc.id)
+		}
+	}
+
+	if version := SchemaVersionAtTime(versions, time.Unix(99, 0)); version != nil {
+		t.Errorf("SchemaVersionAtTime(99): got version %d, want none", version.Id)
+	}
+	if version := SchemaVersionAtTime(versions, time.Unix(399, 0)); version.GetId() != 3 {
+		t.Errorf("SchemaVersionAtTime(399): got version %d, want 3", version.GetId())
+	}
+	sd := SchemaVersionToSchemaDefinition(versions[1])
+	if len(sd.TableDefinitions) != 2 || sd.Version == "" {
+		t.Errorf("SchemaVersionToSchemaDefinition: got %v", sd)
+	}
+}
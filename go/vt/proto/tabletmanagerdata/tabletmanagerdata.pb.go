@@ -233,6 +233,84 @@ func (m *SchemaChangeResult) GetAfterSchema() *SchemaDefinition {
 	return nil
 }
 
+// SchemaVersion is the schema of the database as recorded by the schema
+// tracker in _vt.schema_version upon a DDL.
+type SchemaVersion struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the replication position of the DDL
+	Position string `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	// the DDL
+	Ddl string `protobuf:"bytes,3,opt,name=ddl,proto3" json:"ddl,omitempty"`
+	// the time of the DDL, in seconds since the epoch
+	TimeUpdated int64 `protobuf:"varint,4,opt,name=time_updated,json=timeUpdated,proto3" json:"time_updated,omitempty"`
+	// the tables as of the DDL. Only their name, columns, primary key columns
+	// and fields are recorded.
+	TableDefinitions     []*TableDefinition `protobuf:"bytes,5,rep,name=table_definitions,json=tableDefinitions,proto3" json:"table_definitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SchemaVersion) Reset()         { *m = SchemaVersion{} }
+func (m *SchemaVersion) String() string { return proto.CompactTextString(m) }
+func (*SchemaVersion) ProtoMessage()    {}
+func (*SchemaVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{3}
+}
+
+func (m *SchemaVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchemaVersion.Unmarshal(m, b)
+}
+func (m *SchemaVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchemaVersion.Marshal(b, m, deterministic)
+}
+func (m *SchemaVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaVersion.Merge(m, src)
+}
+func (m *SchemaVersion) XXX_Size() int {
+	return xxx_messageInfo_SchemaVersion.Size(m)
+}
+func (m *SchemaVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaVersion proto.InternalMessageInfo
+
+func (m *SchemaVersion) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SchemaVersion) GetPosition() string {
+	if m != nil {
+		return m.Position
+	}
+	return ""
+}
+
+func (m *SchemaVersion) GetDdl() string {
+	if m != nil {
+		return m.Ddl
+	}
+	return ""
+}
+
+func (m *SchemaVersion) GetTimeUpdated() int64 {
+	if m != nil {
+		return m.TimeUpdated
+	}
+	return 0
+}
+
+func (m *SchemaVersion) GetTableDefinitions() []*TableDefinition {
+	if m != nil {
+		return m.TableDefinitions
+	}
+	return nil
+}
+
 // UserPermission describes a single row in the mysql.user table
 // Primary key is Host+User
 // PasswordChecksum is the crc64 of the password, for security reasons
@@ -250,7 +328,7 @@ func (m *UserPermission) Reset()         { *m = UserPermission{} }
 func (m *UserPermission) String() string { return proto.CompactTextString(m) }
 func (*UserPermission) ProtoMessage()    {}
 func (*UserPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{3}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{4}
 }
 
 func (m *UserPermission) XXX_Unmarshal(b []byte) error {
@@ -315,7 +393,7 @@ func (m *DbPermission) Reset()         { *m = DbPermission{} }
 func (m *DbPermission) String() string { return proto.CompactTextString(m) }
 func (*DbPermission) ProtoMessage()    {}
 func (*DbPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{4}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{5}
 }
 
 func (m *DbPermission) XXX_Unmarshal(b []byte) error {
@@ -378,7 +456,7 @@ func (m *Permissions) Reset()         { *m = Permissions{} }
 func (m *Permissions) String() string { return proto.CompactTextString(m) }
 func (*Permissions) ProtoMessage()    {}
 func (*Permissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{5}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{6}
 }
 
 func (m *Permissions) XXX_Unmarshal(b []byte) error {
@@ -424,7 +502,7 @@ func (m *PingRequest) Reset()         { *m = PingRequest{} }
 func (m *PingRequest) String() string { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()    {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{6}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{7}
 }
 
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
@@ -463,7 +541,7 @@ func (m *PingResponse) Reset()         { *m = PingResponse{} }
 func (m *PingResponse) String() string { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()    {}
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{7}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{8}
 }
 
 func (m *PingResponse) XXX_Unmarshal(b []byte) error {
@@ -503,7 +581,7 @@ func (m *SleepRequest) Reset()         { *m = SleepRequest{} }
 func (m *SleepRequest) String() string { return proto.CompactTextString(m) }
 func (*SleepRequest) ProtoMessage()    {}
 func (*SleepRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{8}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{9}
 }
 
 func (m *SleepRequest) XXX_Unmarshal(b []byte) error {
@@ -541,7 +619,7 @@ func (m *SleepResponse) Reset()         { *m = SleepResponse{} }
 func (m *SleepResponse) String() string { return proto.CompactTextString(m) }
 func (*SleepResponse) ProtoMessage()    {}
 func (*SleepResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{9}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{10}
 }
 
 func (m *SleepResponse) XXX_Unmarshal(b []byte) error {
@@ -575,7 +653,7 @@ func (m *ExecuteHookRequest) Reset()         { *m = ExecuteHookRequest{} }
 func (m *ExecuteHookRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteHookRequest) ProtoMessage()    {}
 func (*ExecuteHookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{10}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{11}
 }
 
 func (m *ExecuteHookRequest) XXX_Unmarshal(b []byte) error {
@@ -630,7 +708,7 @@ func (m *ExecuteHookResponse) Reset()         { *m = ExecuteHookResponse{} }
 func (m *ExecuteHookResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteHookResponse) ProtoMessage()    {}
 func (*ExecuteHookResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{11}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{12}
 }
 
 func (m *ExecuteHookResponse) XXX_Unmarshal(b []byte) error {
@@ -685,7 +763,7 @@ func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
 func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSchemaRequest) ProtoMessage()    {}
 func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{12}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{13}
 }
 
 func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -738,7 +816,7 @@ func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
 func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSchemaResponse) ProtoMessage()    {}
 func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{13}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{14}
 }
 
 func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -766,6 +844,133 @@ func (m *GetSchemaResponse) GetSchemaDefinition() *SchemaDefinition {
 	return nil
 }
 
+type GetSchemaHistoryRequest struct {
+	// if not empty, only these tables are returned, and only the versions in
+	// which any of them changed
+	Tables []string `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	// if not zero, only the versions with an id greater than or equal to min_id
+	MinId int64 `protobuf:"varint,2,opt,name=min_id,json=minId,proto3" json:"min_id,omitempty"`
+	// if not zero, only the versions with an id less than or equal to max_id
+	MaxId int64 `protobuf:"varint,3,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	// if not zero, only the versions of the DDLs that ran at or before this
+	// time, in seconds since the epoch
+	MaxTimeUpdated int64 `protobuf:"varint,4,opt,name=max_time_updated,json=maxTimeUpdated,proto3" json:"max_time_updated,omitempty"`
+	// if not zero, only the most recent versions, up to limit
+	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// if set, the versions are returned without their tables
+	ExcludeTableDefinitions bool     `protobuf:"varint,6,opt,name=exclude_table_definitions,json=excludeTableDefinitions,proto3" json:"exclude_table_definitions,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *GetSchemaHistoryRequest) Reset()         { *m = GetSchemaHistoryRequest{} }
+func (m *GetSchemaHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaHistoryRequest) ProtoMessage()    {}
+func (*GetSchemaHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{15}
+}
+
+func (m *GetSchemaHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetSchemaHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaHistoryRequest.Merge(m, src)
+}
+func (m *GetSchemaHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaHistoryRequest.Size(m)
+}
+func (m *GetSchemaHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaHistoryRequest proto.InternalMessageInfo
+
+func (m *GetSchemaHistoryRequest) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *GetSchemaHistoryRequest) GetMinId() int64 {
+	if m != nil {
+		return m.MinId
+	}
+	return 0
+}
+
+func (m *GetSchemaHistoryRequest) GetMaxId() int64 {
+	if m != nil {
+		return m.MaxId
+	}
+	return 0
+}
+
+func (m *GetSchemaHistoryRequest) GetMaxTimeUpdated() int64 {
+	if m != nil {
+		return m.MaxTimeUpdated
+	}
+	return 0
+}
+
+func (m *GetSchemaHistoryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetSchemaHistoryRequest) GetExcludeTableDefinitions() bool {
+	if m != nil {
+		return m.ExcludeTableDefinitions
+	}
+	return false
+}
+
+type GetSchemaHistoryResponse struct {
+	// oldest first
+	SchemaVersions       []*SchemaVersion `protobuf:"bytes,1,rep,name=schema_versions,json=schemaVersions,proto3" json:"schema_versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetSchemaHistoryResponse) Reset()         { *m = GetSchemaHistoryResponse{} }
+func (m *GetSchemaHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaHistoryResponse) ProtoMessage()    {}
+func (*GetSchemaHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{16}
+}
+
+func (m *GetSchemaHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchemaHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetSchemaHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchemaHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSchemaHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaHistoryResponse.Merge(m, src)
+}
+func (m *GetSchemaHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSchemaHistoryResponse.Size(m)
+}
+func (m *GetSchemaHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaHistoryResponse proto.InternalMessageInfo
+
+func (m *GetSchemaHistoryResponse) GetSchemaVersions() []*SchemaVersion {
+	if m != nil {
+		return m.SchemaVersions
+	}
+	return nil
+}
+
 type GetPermissionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -776,7 +981,7 @@ func (m *GetPermissionsRequest) Reset()         { *m = GetPermissionsRequest{} }
 func (m *GetPermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsRequest) ProtoMessage()    {}
 func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{14}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{17}
 }
 
 func (m *GetPermissionsRequest) XXX_Unmarshal(b []byte) error {
@@ -808,7 +1013,7 @@ func (m *GetPermissionsResponse) Reset()         { *m = GetPermissionsResponse{}
 func (m *GetPermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPermissionsResponse) ProtoMessage()    {}
 func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{15}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{18}
 }
 
 func (m *GetPermissionsResponse) XXX_Unmarshal(b []byte) error {
@@ -846,7 +1051,7 @@ func (m *SetReadOnlyRequest) Reset()         { *m = SetReadOnlyRequest{} }
 func (m *SetReadOnlyRequest) String() string { return proto.CompactTextString(m) }
 func (*SetReadOnlyRequest) ProtoMessage()    {}
 func (*SetReadOnlyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{16}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{19}
 }
 
 func (m *SetReadOnlyRequest) XXX_Unmarshal(b []byte) error {
@@ -877,7 +1082,7 @@ func (m *SetReadOnlyResponse) Reset()         { *m = SetReadOnlyResponse{} }
 func (m *SetReadOnlyResponse) String() string { return proto.CompactTextString(m) }
 func (*SetReadOnlyResponse) ProtoMessage()    {}
 func (*SetReadOnlyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{17}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{20}
 }
 
 func (m *SetReadOnlyResponse) XXX_Unmarshal(b []byte) error {
@@ -908,7 +1113,7 @@ func (m *SetReadWriteRequest) Reset()         { *m = SetReadWriteRequest{} }
 func (m *SetReadWriteRequest) String() string { return proto.CompactTextString(m) }
 func (*SetReadWriteRequest) ProtoMessage()    {}
 func (*SetReadWriteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{18}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{21}
 }
 
 func (m *SetReadWriteRequest) XXX_Unmarshal(b []byte) error {
@@ -939,7 +1144,7 @@ func (m *SetReadWriteResponse) Reset()         { *m = SetReadWriteResponse{} }
 func (m *SetReadWriteResponse) String() string { return proto.CompactTextString(m) }
 func (*SetReadWriteResponse) ProtoMessage()    {}
 func (*SetReadWriteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{19}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{22}
 }
 
 func (m *SetReadWriteResponse) XXX_Unmarshal(b []byte) error {
@@ -971,7 +1176,7 @@ func (m *ChangeTypeRequest) Reset()         { *m = ChangeTypeRequest{} }
 func (m *ChangeTypeRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangeTypeRequest) ProtoMessage()    {}
 func (*ChangeTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{20}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{23}
 }
 
 func (m *ChangeTypeRequest) XXX_Unmarshal(b []byte) error {
@@ -1009,7 +1214,7 @@ func (m *ChangeTypeResponse) Reset()         { *m = ChangeTypeResponse{} }
 func (m *ChangeTypeResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeTypeResponse) ProtoMessage()    {}
 func (*ChangeTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{21}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{24}
 }
 
 func (m *ChangeTypeResponse) XXX_Unmarshal(b []byte) error {
@@ -1040,7 +1245,7 @@ func (m *RefreshStateRequest) Reset()         { *m = RefreshStateRequest{} }
 func (m *RefreshStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshStateRequest) ProtoMessage()    {}
 func (*RefreshStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{22}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{25}
 }
 
 func (m *RefreshStateRequest) XXX_Unmarshal(b []byte) error {
@@ -1071,7 +1276,7 @@ func (m *RefreshStateResponse) Reset()         { *m = RefreshStateResponse{} }
 func (m *RefreshStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshStateResponse) ProtoMessage()    {}
 func (*RefreshStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{23}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{26}
 }
 
 func (m *RefreshStateResponse) XXX_Unmarshal(b []byte) error {
@@ -1102,7 +1307,7 @@ func (m *RunHealthCheckRequest) Reset()         { *m = RunHealthCheckRequest{} }
 func (m *RunHealthCheckRequest) String() string { return proto.CompactTextString(m) }
 func (*RunHealthCheckRequest) ProtoMessage()    {}
 func (*RunHealthCheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{24}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{27}
 }
 
 func (m *RunHealthCheckRequest) XXX_Unmarshal(b []byte) error {
@@ -1133,7 +1338,7 @@ func (m *RunHealthCheckResponse) Reset()         { *m = RunHealthCheckResponse{}
 func (m *RunHealthCheckResponse) String() string { return proto.CompactTextString(m) }
 func (*RunHealthCheckResponse) ProtoMessage()    {}
 func (*RunHealthCheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{25}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{28}
 }
 
 func (m *RunHealthCheckResponse) XXX_Unmarshal(b []byte) error {
@@ -1165,7 +1370,7 @@ func (m *IgnoreHealthErrorRequest) Reset()         { *m = IgnoreHealthErrorReque
 func (m *IgnoreHealthErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*IgnoreHealthErrorRequest) ProtoMessage()    {}
 func (*IgnoreHealthErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{26}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{29}
 }
 
 func (m *IgnoreHealthErrorRequest) XXX_Unmarshal(b []byte) error {
@@ -1203,7 +1408,7 @@ func (m *IgnoreHealthErrorResponse) Reset()         { *m = IgnoreHealthErrorResp
 func (m *IgnoreHealthErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*IgnoreHealthErrorResponse) ProtoMessage()    {}
 func (*IgnoreHealthErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{27}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{30}
 }
 
 func (m *IgnoreHealthErrorResponse) XXX_Unmarshal(b []byte) error {
@@ -1238,7 +1443,7 @@ func (m *ReloadSchemaRequest) Reset()         { *m = ReloadSchemaRequest{} }
 func (m *ReloadSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*ReloadSchemaRequest) ProtoMessage()    {}
 func (*ReloadSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{28}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{31}
 }
 
 func (m *ReloadSchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -1276,7 +1481,7 @@ func (m *ReloadSchemaResponse) Reset()         { *m = ReloadSchemaResponse{} }
 func (m *ReloadSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*ReloadSchemaResponse) ProtoMessage()    {}
 func (*ReloadSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{29}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{32}
 }
 
 func (m *ReloadSchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -1308,7 +1513,7 @@ func (m *PreflightSchemaRequest) Reset()         { *m = PreflightSchemaRequest{}
 func (m *PreflightSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*PreflightSchemaRequest) ProtoMessage()    {}
 func (*PreflightSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{30}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{33}
 }
 
 func (m *PreflightSchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -1349,7 +1554,7 @@ func (m *PreflightSchemaResponse) Reset()         { *m = PreflightSchemaResponse
 func (m *PreflightSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*PreflightSchemaResponse) ProtoMessage()    {}
 func (*PreflightSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{31}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{34}
 }
 
 func (m *PreflightSchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -1392,7 +1597,7 @@ func (m *ApplySchemaRequest) Reset()         { *m = ApplySchemaRequest{} }
 func (m *ApplySchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplySchemaRequest) ProtoMessage()    {}
 func (*ApplySchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{32}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{35}
 }
 
 func (m *ApplySchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -1460,7 +1665,7 @@ func (m *ApplySchemaResponse) Reset()         { *m = ApplySchemaResponse{} }
 func (m *ApplySchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplySchemaResponse) ProtoMessage()    {}
 func (*ApplySchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{33}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{36}
 }
 
 func (m *ApplySchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -1505,7 +1710,7 @@ func (m *LockTablesRequest) Reset()         { *m = LockTablesRequest{} }
 func (m *LockTablesRequest) String() string { return proto.CompactTextString(m) }
 func (*LockTablesRequest) ProtoMessage()    {}
 func (*LockTablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{34}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{37}
 }
 
 func (m *LockTablesRequest) XXX_Unmarshal(b []byte) error {
@@ -1536,7 +1741,7 @@ func (m *LockTablesResponse) Reset()         { *m = LockTablesResponse{} }
 func (m *LockTablesResponse) String() string { return proto.CompactTextString(m) }
 func (*LockTablesResponse) ProtoMessage()    {}
 func (*LockTablesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{35}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{38}
 }
 
 func (m *LockTablesResponse) XXX_Unmarshal(b []byte) error {
@@ -1567,7 +1772,7 @@ func (m *UnlockTablesRequest) Reset()         { *m = UnlockTablesRequest{} }
 func (m *UnlockTablesRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockTablesRequest) ProtoMessage()    {}
 func (*UnlockTablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{36}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{39}
 }
 
 func (m *UnlockTablesRequest) XXX_Unmarshal(b []byte) error {
@@ -1598,7 +1803,7 @@ func (m *UnlockTablesResponse) Reset()         { *m = UnlockTablesResponse{} }
 func (m *UnlockTablesResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockTablesResponse) ProtoMessage()    {}
 func (*UnlockTablesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{37}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{40}
 }
 
 func (m *UnlockTablesResponse) XXX_Unmarshal(b []byte) error {
@@ -1634,7 +1839,7 @@ func (m *ExecuteFetchAsDbaRequest) Reset()         { *m = ExecuteFetchAsDbaReque
 func (m *ExecuteFetchAsDbaRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsDbaRequest) ProtoMessage()    {}
 func (*ExecuteFetchAsDbaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{38}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{41}
 }
 
 func (m *ExecuteFetchAsDbaRequest) XXX_Unmarshal(b []byte) error {
@@ -1701,7 +1906,7 @@ func (m *ExecuteFetchAsDbaResponse) Reset()         { *m = ExecuteFetchAsDbaResp
 func (m *ExecuteFetchAsDbaResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsDbaResponse) ProtoMessage()    {}
 func (*ExecuteFetchAsDbaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{39}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{42}
 }
 
 func (m *ExecuteFetchAsDbaResponse) XXX_Unmarshal(b []byte) error {
@@ -1743,7 +1948,7 @@ func (m *ExecuteFetchAsAllPrivsRequest) Reset()         { *m = ExecuteFetchAsAll
 func (m *ExecuteFetchAsAllPrivsRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAllPrivsRequest) ProtoMessage()    {}
 func (*ExecuteFetchAsAllPrivsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{40}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{43}
 }
 
 func (m *ExecuteFetchAsAllPrivsRequest) XXX_Unmarshal(b []byte) error {
@@ -1803,7 +2008,7 @@ func (m *ExecuteFetchAsAllPrivsResponse) Reset()         { *m = ExecuteFetchAsAl
 func (m *ExecuteFetchAsAllPrivsResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAllPrivsResponse) ProtoMessage()    {}
 func (*ExecuteFetchAsAllPrivsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{41}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{44}
 }
 
 func (m *ExecuteFetchAsAllPrivsResponse) XXX_Unmarshal(b []byte) error {
@@ -1843,7 +2048,7 @@ func (m *ExecuteFetchAsAppRequest) Reset()         { *m = ExecuteFetchAsAppReque
 func (m *ExecuteFetchAsAppRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAppRequest) ProtoMessage()    {}
 func (*ExecuteFetchAsAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{42}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{45}
 }
 
 func (m *ExecuteFetchAsAppRequest) XXX_Unmarshal(b []byte) error {
@@ -1889,7 +2094,7 @@ func (m *ExecuteFetchAsAppResponse) Reset()         { *m = ExecuteFetchAsAppResp
 func (m *ExecuteFetchAsAppResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecuteFetchAsAppResponse) ProtoMessage()    {}
 func (*ExecuteFetchAsAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{43}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{46}
 }
 
 func (m *ExecuteFetchAsAppResponse) XXX_Unmarshal(b []byte) error {
@@ -1927,7 +2132,7 @@ func (m *ReplicationStatusRequest) Reset()         { *m = ReplicationStatusReque
 func (m *ReplicationStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*ReplicationStatusRequest) ProtoMessage()    {}
 func (*ReplicationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{44}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{47}
 }
 
 func (m *ReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -1959,7 +2164,7 @@ func (m *ReplicationStatusResponse) Reset()         { *m = ReplicationStatusResp
 func (m *ReplicationStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ReplicationStatusResponse) ProtoMessage()    {}
 func (*ReplicationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{45}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{48}
 }
 
 func (m *ReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -1997,7 +2202,7 @@ func (m *MasterStatusRequest) Reset()         { *m = MasterStatusRequest{} }
 func (m *MasterStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*MasterStatusRequest) ProtoMessage()    {}
 func (*MasterStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{46}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{49}
 }
 
 func (m *MasterStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -2029,7 +2234,7 @@ func (m *MasterStatusResponse) Reset()         { *m = MasterStatusResponse{} }
 func (m *MasterStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*MasterStatusResponse) ProtoMessage()    {}
 func (*MasterStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{47}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{50}
 }
 
 func (m *MasterStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -2067,7 +2272,7 @@ func (m *MasterPositionRequest) Reset()         { *m = MasterPositionRequest{} }
 func (m *MasterPositionRequest) String() string { return proto.CompactTextString(m) }
 func (*MasterPositionRequest) ProtoMessage()    {}
 func (*MasterPositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{48}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{51}
 }
 
 func (m *MasterPositionRequest) XXX_Unmarshal(b []byte) error {
@@ -2099,7 +2304,7 @@ func (m *MasterPositionResponse) Reset()         { *m = MasterPositionResponse{}
 func (m *MasterPositionResponse) String() string { return proto.CompactTextString(m) }
 func (*MasterPositionResponse) ProtoMessage()    {}
 func (*MasterPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{49}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{52}
 }
 
 func (m *MasterPositionResponse) XXX_Unmarshal(b []byte) error {
@@ -2138,7 +2343,7 @@ func (m *WaitForPositionRequest) Reset()         { *m = WaitForPositionRequest{}
 func (m *WaitForPositionRequest) String() string { return proto.CompactTextString(m) }
 func (*WaitForPositionRequest) ProtoMessage()    {}
 func (*WaitForPositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{50}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{53}
 }
 
 func (m *WaitForPositionRequest) XXX_Unmarshal(b []byte) error {
@@ -2176,7 +2381,7 @@ func (m *WaitForPositionResponse) Reset()         { *m = WaitForPositionResponse
 func (m *WaitForPositionResponse) String() string { return proto.CompactTextString(m) }
 func (*WaitForPositionResponse) ProtoMessage()    {}
 func (*WaitForPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{51}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{54}
 }
 
 func (m *WaitForPositionResponse) XXX_Unmarshal(b []byte) error {
@@ -2207,7 +2412,7 @@ func (m *StopReplicationRequest) Reset()         { *m = StopReplicationRequest{}
 func (m *StopReplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationRequest) ProtoMessage()    {}
 func (*StopReplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{52}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{55}
 }
 
 func (m *StopReplicationRequest) XXX_Unmarshal(b []byte) error {
@@ -2238,7 +2443,7 @@ func (m *StopReplicationResponse) Reset()         { *m = StopReplicationResponse
 func (m *StopReplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationResponse) ProtoMessage()    {}
 func (*StopReplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{53}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{56}
 }
 
 func (m *StopReplicationResponse) XXX_Unmarshal(b []byte) error {
@@ -2271,7 +2476,7 @@ func (m *StopReplicationMinimumRequest) Reset()         { *m = StopReplicationMi
 func (m *StopReplicationMinimumRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationMinimumRequest) ProtoMessage()    {}
 func (*StopReplicationMinimumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{54}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{57}
 }
 
 func (m *StopReplicationMinimumRequest) XXX_Unmarshal(b []byte) error {
@@ -2317,7 +2522,7 @@ func (m *StopReplicationMinimumResponse) Reset()         { *m = StopReplicationM
 func (m *StopReplicationMinimumResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationMinimumResponse) ProtoMessage()    {}
 func (*StopReplicationMinimumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{55}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{58}
 }
 
 func (m *StopReplicationMinimumResponse) XXX_Unmarshal(b []byte) error {
@@ -2355,7 +2560,7 @@ func (m *StartReplicationRequest) Reset()         { *m = StartReplicationRequest
 func (m *StartReplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*StartReplicationRequest) ProtoMessage()    {}
 func (*StartReplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{56}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{59}
 }
 
 func (m *StartReplicationRequest) XXX_Unmarshal(b []byte) error {
@@ -2386,7 +2591,7 @@ func (m *StartReplicationResponse) Reset()         { *m = StartReplicationRespon
 func (m *StartReplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*StartReplicationResponse) ProtoMessage()    {}
 func (*StartReplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{57}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{60}
 }
 
 func (m *StartReplicationResponse) XXX_Unmarshal(b []byte) error {
@@ -2419,7 +2624,7 @@ func (m *StartReplicationUntilAfterRequest) Reset()         { *m = StartReplicat
 func (m *StartReplicationUntilAfterRequest) String() string { return proto.CompactTextString(m) }
 func (*StartReplicationUntilAfterRequest) ProtoMessage()    {}
 func (*StartReplicationUntilAfterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{58}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{61}
 }
 
 func (m *StartReplicationUntilAfterRequest) XXX_Unmarshal(b []byte) error {
@@ -2464,7 +2669,7 @@ func (m *StartReplicationUntilAfterResponse) Reset()         { *m = StartReplica
 func (m *StartReplicationUntilAfterResponse) String() string { return proto.CompactTextString(m) }
 func (*StartReplicationUntilAfterResponse) ProtoMessage()    {}
 func (*StartReplicationUntilAfterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{59}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{62}
 }
 
 func (m *StartReplicationUntilAfterResponse) XXX_Unmarshal(b []byte) error {
@@ -2495,7 +2700,7 @@ func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
 func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicasRequest) ProtoMessage()    {}
 func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{60}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{63}
 }
 
 func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
@@ -2527,7 +2732,7 @@ func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
 func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicasResponse) ProtoMessage()    {}
 func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{61}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{64}
 }
 
 func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
@@ -2565,7 +2770,7 @@ func (m *ResetReplicationRequest) Reset()         { *m = ResetReplicationRequest
 func (m *ResetReplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetReplicationRequest) ProtoMessage()    {}
 func (*ResetReplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{62}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{65}
 }
 
 func (m *ResetReplicationRequest) XXX_Unmarshal(b []byte) error {
@@ -2596,7 +2801,7 @@ func (m *ResetReplicationResponse) Reset()         { *m = ResetReplicationRespon
 func (m *ResetReplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetReplicationResponse) ProtoMessage()    {}
 func (*ResetReplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{63}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{66}
 }
 
 func (m *ResetReplicationResponse) XXX_Unmarshal(b []byte) error {
@@ -2628,7 +2833,7 @@ func (m *VReplicationExecRequest) Reset()         { *m = VReplicationExecRequest
 func (m *VReplicationExecRequest) String() string { return proto.CompactTextString(m) }
 func (*VReplicationExecRequest) ProtoMessage()    {}
 func (*VReplicationExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{64}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{67}
 }
 
 func (m *VReplicationExecRequest) XXX_Unmarshal(b []byte) error {
@@ -2667,7 +2872,7 @@ func (m *VReplicationExecResponse) Reset()         { *m = VReplicationExecRespon
 func (m *VReplicationExecResponse) String() string { return proto.CompactTextString(m) }
 func (*VReplicationExecResponse) ProtoMessage()    {}
 func (*VReplicationExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{65}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{68}
 }
 
 func (m *VReplicationExecResponse) XXX_Unmarshal(b []byte) error {
@@ -2707,7 +2912,7 @@ func (m *VReplicationWaitForPosRequest) Reset()         { *m = VReplicationWaitF
 func (m *VReplicationWaitForPosRequest) String() string { return proto.CompactTextString(m) }
 func (*VReplicationWaitForPosRequest) ProtoMessage()    {}
 func (*VReplicationWaitForPosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{66}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{69}
 }
 
 func (m *VReplicationWaitForPosRequest) XXX_Unmarshal(b []byte) error {
@@ -2752,7 +2957,7 @@ func (m *VReplicationWaitForPosResponse) Reset()         { *m = VReplicationWait
 func (m *VReplicationWaitForPosResponse) String() string { return proto.CompactTextString(m) }
 func (*VReplicationWaitForPosResponse) ProtoMessage()    {}
 func (*VReplicationWaitForPosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{67}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{70}
 }
 
 func (m *VReplicationWaitForPosResponse) XXX_Unmarshal(b []byte) error {
@@ -2783,7 +2988,7 @@ func (m *InitMasterRequest) Reset()         { *m = InitMasterRequest{} }
 func (m *InitMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*InitMasterRequest) ProtoMessage()    {}
 func (*InitMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{68}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{71}
 }
 
 func (m *InitMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -2815,7 +3020,7 @@ func (m *InitMasterResponse) Reset()         { *m = InitMasterResponse{} }
 func (m *InitMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*InitMasterResponse) ProtoMessage()    {}
 func (*InitMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{69}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{72}
 }
 
 func (m *InitMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -2857,7 +3062,7 @@ func (m *PopulateReparentJournalRequest) Reset()         { *m = PopulateReparent
 func (m *PopulateReparentJournalRequest) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalRequest) ProtoMessage()    {}
 func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{70}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{73}
 }
 
 func (m *PopulateReparentJournalRequest) XXX_Unmarshal(b []byte) error {
@@ -2916,7 +3121,7 @@ func (m *PopulateReparentJournalResponse) Reset()         { *m = PopulateReparen
 func (m *PopulateReparentJournalResponse) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalResponse) ProtoMessage()    {}
 func (*PopulateReparentJournalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{71}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{74}
 }
 
 func (m *PopulateReparentJournalResponse) XXX_Unmarshal(b []byte) error {
@@ -2950,7 +3155,7 @@ func (m *InitReplicaRequest) Reset()         { *m = InitReplicaRequest{} }
 func (m *InitReplicaRequest) String() string { return proto.CompactTextString(m) }
 func (*InitReplicaRequest) ProtoMessage()    {}
 func (*InitReplicaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{72}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{75}
 }
 
 func (m *InitReplicaRequest) XXX_Unmarshal(b []byte) error {
@@ -3002,7 +3207,7 @@ func (m *InitReplicaResponse) Reset()         { *m = InitReplicaResponse{} }
 func (m *InitReplicaResponse) String() string { return proto.CompactTextString(m) }
 func (*InitReplicaResponse) ProtoMessage()    {}
 func (*InitReplicaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{73}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{76}
 }
 
 func (m *InitReplicaResponse) XXX_Unmarshal(b []byte) error {
@@ -3033,7 +3238,7 @@ func (m *DemoteMasterRequest) Reset()         { *m = DemoteMasterRequest{} }
 func (m *DemoteMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*DemoteMasterRequest) ProtoMessage()    {}
 func (*DemoteMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{74}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{77}
 }
 
 func (m *DemoteMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -3068,7 +3273,7 @@ func (m *DemoteMasterResponse) Reset()         { *m = DemoteMasterResponse{} }
 func (m *DemoteMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*DemoteMasterResponse) ProtoMessage()    {}
 func (*DemoteMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{75}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{78}
 }
 
 func (m *DemoteMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -3114,7 +3319,7 @@ func (m *UndoDemoteMasterRequest) Reset()         { *m = UndoDemoteMasterRequest
 func (m *UndoDemoteMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*UndoDemoteMasterRequest) ProtoMessage()    {}
 func (*UndoDemoteMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{76}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{79}
 }
 
 func (m *UndoDemoteMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -3145,7 +3350,7 @@ func (m *UndoDemoteMasterResponse) Reset()         { *m = UndoDemoteMasterRespon
 func (m *UndoDemoteMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*UndoDemoteMasterResponse) ProtoMessage()    {}
 func (*UndoDemoteMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{77}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{80}
 }
 
 func (m *UndoDemoteMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -3176,7 +3381,7 @@ func (m *ReplicaWasPromotedRequest) Reset()         { *m = ReplicaWasPromotedReq
 func (m *ReplicaWasPromotedRequest) String() string { return proto.CompactTextString(m) }
 func (*ReplicaWasPromotedRequest) ProtoMessage()    {}
 func (*ReplicaWasPromotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{78}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{81}
 }
 
 func (m *ReplicaWasPromotedRequest) XXX_Unmarshal(b []byte) error {
@@ -3207,7 +3412,7 @@ func (m *ReplicaWasPromotedResponse) Reset()         { *m = ReplicaWasPromotedRe
 func (m *ReplicaWasPromotedResponse) String() string { return proto.CompactTextString(m) }
 func (*ReplicaWasPromotedResponse) ProtoMessage()    {}
 func (*ReplicaWasPromotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{79}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{82}
 }
 
 func (m *ReplicaWasPromotedResponse) XXX_Unmarshal(b []byte) error {
@@ -3242,7 +3447,7 @@ func (m *SetMasterRequest) Reset()         { *m = SetMasterRequest{} }
 func (m *SetMasterRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMasterRequest) ProtoMessage()    {}
 func (*SetMasterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{80}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{83}
 }
 
 func (m *SetMasterRequest) XXX_Unmarshal(b []byte) error {
@@ -3301,7 +3506,7 @@ func (m *SetMasterResponse) Reset()         { *m = SetMasterResponse{} }
 func (m *SetMasterResponse) String() string { return proto.CompactTextString(m) }
 func (*SetMasterResponse) ProtoMessage()    {}
 func (*SetMasterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{81}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{84}
 }
 
 func (m *SetMasterResponse) XXX_Unmarshal(b []byte) error {
@@ -3334,7 +3539,7 @@ func (m *ReplicaWasRestartedRequest) Reset()         { *m = ReplicaWasRestartedR
 func (m *ReplicaWasRestartedRequest) String() string { return proto.CompactTextString(m) }
 func (*ReplicaWasRestartedRequest) ProtoMessage()    {}
 func (*ReplicaWasRestartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{82}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{85}
 }
 
 func (m *ReplicaWasRestartedRequest) XXX_Unmarshal(b []byte) error {
@@ -3372,7 +3577,7 @@ func (m *ReplicaWasRestartedResponse) Reset()         { *m = ReplicaWasRestarted
 func (m *ReplicaWasRestartedResponse) String() string { return proto.CompactTextString(m) }
 func (*ReplicaWasRestartedResponse) ProtoMessage()    {}
 func (*ReplicaWasRestartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{83}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{86}
 }
 
 func (m *ReplicaWasRestartedResponse) XXX_Unmarshal(b []byte) error {
@@ -3404,7 +3609,7 @@ func (m *StopReplicationAndGetStatusRequest) Reset()         { *m = StopReplicat
 func (m *StopReplicationAndGetStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusRequest) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{84}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{87}
 }
 
 func (m *StopReplicationAndGetStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -3448,7 +3653,7 @@ func (m *StopReplicationAndGetStatusResponse) Reset()         { *m = StopReplica
 func (m *StopReplicationAndGetStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusResponse) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{85}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{88}
 }
 
 func (m *StopReplicationAndGetStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -3494,7 +3699,7 @@ func (m *PromoteReplicaRequest) Reset()         { *m = PromoteReplicaRequest{} }
 func (m *PromoteReplicaRequest) String() string { return proto.CompactTextString(m) }
 func (*PromoteReplicaRequest) ProtoMessage()    {}
 func (*PromoteReplicaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{86}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{89}
 }
 
 func (m *PromoteReplicaRequest) XXX_Unmarshal(b []byte) error {
@@ -3526,7 +3731,7 @@ func (m *PromoteReplicaResponse) Reset()         { *m = PromoteReplicaResponse{}
 func (m *PromoteReplicaResponse) String() string { return proto.CompactTextString(m) }
 func (*PromoteReplicaResponse) ProtoMessage()    {}
 func (*PromoteReplicaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{87}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{90}
 }
 
 func (m *PromoteReplicaResponse) XXX_Unmarshal(b []byte) error {
@@ -3566,7 +3771,7 @@ func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
 func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
 func (*BackupRequest) ProtoMessage()    {}
 func (*BackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{88}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{91}
 }
 
 func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
@@ -3612,7 +3817,7 @@ func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
 func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
 func (*BackupResponse) ProtoMessage()    {}
 func (*BackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{89}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{92}
 }
 
 func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
@@ -3650,7 +3855,7 @@ func (m *RestoreFromBackupRequest) Reset()         { *m = RestoreFromBackupReque
 func (m *RestoreFromBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreFromBackupRequest) ProtoMessage()    {}
 func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{90}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{93}
 }
 
 func (m *RestoreFromBackupRequest) XXX_Unmarshal(b []byte) error {
@@ -3682,7 +3887,7 @@ func (m *RestoreFromBackupResponse) Reset()         { *m = RestoreFromBackupResp
 func (m *RestoreFromBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreFromBackupResponse) ProtoMessage()    {}
 func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{91}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
 }
 
 func (m *RestoreFromBackupResponse) XXX_Unmarshal(b []byte) error {
@@ -3721,7 +3926,7 @@ func (m *SlaveStatusRequest) Reset()         { *m = SlaveStatusRequest{} }
 func (m *SlaveStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SlaveStatusRequest) ProtoMessage()    {}
 func (*SlaveStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{92}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
 }
 
 func (m *SlaveStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -3754,7 +3959,7 @@ func (m *SlaveStatusResponse) Reset()         { *m = SlaveStatusResponse{} }
 func (m *SlaveStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SlaveStatusResponse) ProtoMessage()    {}
 func (*SlaveStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{93}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{96}
 }
 
 func (m *SlaveStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -3793,7 +3998,7 @@ func (m *StopSlaveRequest) Reset()         { *m = StopSlaveRequest{} }
 func (m *StopSlaveRequest) String() string { return proto.CompactTextString(m) }
 func (*StopSlaveRequest) ProtoMessage()    {}
 func (*StopSlaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{94}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{97}
 }
 
 func (m *StopSlaveRequest) XXX_Unmarshal(b []byte) error {
@@ -3825,7 +4030,7 @@ func (m *StopSlaveResponse) Reset()         { *m = StopSlaveResponse{} }
 func (m *StopSlaveResponse) String() string { return proto.CompactTextString(m) }
 func (*StopSlaveResponse) ProtoMessage()    {}
 func (*StopSlaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{95}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{98}
 }
 
 func (m *StopSlaveResponse) XXX_Unmarshal(b []byte) error {
@@ -3859,7 +4064,7 @@ func (m *StopSlaveMinimumRequest) Reset()         { *m = StopSlaveMinimumRequest
 func (m *StopSlaveMinimumRequest) String() string { return proto.CompactTextString(m) }
 func (*StopSlaveMinimumRequest) ProtoMessage()    {}
 func (*StopSlaveMinimumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{96}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{99}
 }
 
 func (m *StopSlaveMinimumRequest) XXX_Unmarshal(b []byte) error {
@@ -3906,7 +4111,7 @@ func (m *StopSlaveMinimumResponse) Reset()         { *m = StopSlaveMinimumRespon
 func (m *StopSlaveMinimumResponse) String() string { return proto.CompactTextString(m) }
 func (*StopSlaveMinimumResponse) ProtoMessage()    {}
 func (*StopSlaveMinimumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{97}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{100}
 }
 
 func (m *StopSlaveMinimumResponse) XXX_Unmarshal(b []byte) error {
@@ -3945,7 +4150,7 @@ func (m *StartSlaveRequest) Reset()         { *m = StartSlaveRequest{} }
 func (m *StartSlaveRequest) String() string { return proto.CompactTextString(m) }
 func (*StartSlaveRequest) ProtoMessage()    {}
 func (*StartSlaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{98}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{101}
 }
 
 func (m *StartSlaveRequest) XXX_Unmarshal(b []byte) error {
@@ -3977,7 +4182,7 @@ func (m *StartSlaveResponse) Reset()         { *m = StartSlaveResponse{} }
 func (m *StartSlaveResponse) String() string { return proto.CompactTextString(m) }
 func (*StartSlaveResponse) ProtoMessage()    {}
 func (*StartSlaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{99}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{102}
 }
 
 func (m *StartSlaveResponse) XXX_Unmarshal(b []byte) error {
@@ -4011,7 +4216,7 @@ func (m *StartSlaveUntilAfterRequest) Reset()         { *m = StartSlaveUntilAfte
 func (m *StartSlaveUntilAfterRequest) String() string { return proto.CompactTextString(m) }
 func (*StartSlaveUntilAfterRequest) ProtoMessage()    {}
 func (*StartSlaveUntilAfterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{100}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{103}
 }
 
 func (m *StartSlaveUntilAfterRequest) XXX_Unmarshal(b []byte) error {
@@ -4057,7 +4262,7 @@ func (m *StartSlaveUntilAfterResponse) Reset()         { *m = StartSlaveUntilAft
 func (m *StartSlaveUntilAfterResponse) String() string { return proto.CompactTextString(m) }
 func (*StartSlaveUntilAfterResponse) ProtoMessage()    {}
 func (*StartSlaveUntilAfterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{101}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{104}
 }
 
 func (m *StartSlaveUntilAfterResponse) XXX_Unmarshal(b []byte) error {
@@ -4089,7 +4294,7 @@ func (m *GetSlavesRequest) Reset()         { *m = GetSlavesRequest{} }
 func (m *GetSlavesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSlavesRequest) ProtoMessage()    {}
 func (*GetSlavesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{102}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{105}
 }
 
 func (m *GetSlavesRequest) XXX_Unmarshal(b []byte) error {
@@ -4122,7 +4327,7 @@ func (m *GetSlavesResponse) Reset()         { *m = GetSlavesResponse{} }
 func (m *GetSlavesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSlavesResponse) ProtoMessage()    {}
 func (*GetSlavesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{103}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{106}
 }
 
 func (m *GetSlavesResponse) XXX_Unmarshal(b []byte) error {
@@ -4164,7 +4369,7 @@ func (m *InitSlaveRequest) Reset()         { *m = InitSlaveRequest{} }
 func (m *InitSlaveRequest) String() string { return proto.CompactTextString(m) }
 func (*InitSlaveRequest) ProtoMessage()    {}
 func (*InitSlaveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{104}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{107}
 }
 
 func (m *InitSlaveRequest) XXX_Unmarshal(b []byte) error {
@@ -4217,7 +4422,7 @@ func (m *InitSlaveResponse) Reset()         { *m = InitSlaveResponse{} }
 func (m *InitSlaveResponse) String() string { return proto.CompactTextString(m) }
 func (*InitSlaveResponse) ProtoMessage()    {}
 func (*InitSlaveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{105}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{108}
 }
 
 func (m *InitSlaveResponse) XXX_Unmarshal(b []byte) error {
@@ -4249,7 +4454,7 @@ func (m *SlaveWasPromotedRequest) Reset()         { *m = SlaveWasPromotedRequest
 func (m *SlaveWasPromotedRequest) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedRequest) ProtoMessage()    {}
 func (*SlaveWasPromotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{106}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{109}
 }
 
 func (m *SlaveWasPromotedRequest) XXX_Unmarshal(b []byte) error {
@@ -4281,7 +4486,7 @@ func (m *SlaveWasPromotedResponse) Reset()         { *m = SlaveWasPromotedRespon
 func (m *SlaveWasPromotedResponse) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedResponse) ProtoMessage()    {}
 func (*SlaveWasPromotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{107}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{110}
 }
 
 func (m *SlaveWasPromotedResponse) XXX_Unmarshal(b []byte) error {
@@ -4315,7 +4520,7 @@ func (m *SlaveWasRestartedRequest) Reset()         { *m = SlaveWasRestartedReque
 func (m *SlaveWasRestartedRequest) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedRequest) ProtoMessage()    {}
 func (*SlaveWasRestartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{108}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{111}
 }
 
 func (m *SlaveWasRestartedRequest) XXX_Unmarshal(b []byte) error {
@@ -4354,7 +4559,7 @@ func (m *SlaveWasRestartedResponse) Reset()         { *m = SlaveWasRestartedResp
 func (m *SlaveWasRestartedResponse) String() string { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedResponse) ProtoMessage()    {}
 func (*SlaveWasRestartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{109}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{112}
 }
 
 func (m *SlaveWasRestartedResponse) XXX_Unmarshal(b []byte) error {
@@ -4388,7 +4593,7 @@ func (m *VExecRequest) Reset()         { *m = VExecRequest{} }
 func (m *VExecRequest) String() string { return proto.CompactTextString(m) }
 func (*VExecRequest) ProtoMessage()    {}
 func (*VExecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{110}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{113}
 }
 
 func (m *VExecRequest) XXX_Unmarshal(b []byte) error {
@@ -4441,7 +4646,7 @@ func (m *VExecResponse) Reset()         { *m = VExecResponse{} }
 func (m *VExecResponse) String() string { return proto.CompactTextString(m) }
 func (*VExecResponse) ProtoMessage()    {}
 func (*VExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff9ac4f89e61ffa4, []int{111}
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{114}
 }
 
 func (m *VExecResponse) XXX_Unmarshal(b []byte) error {
@@ -4473,6 +4678,7 @@ func init() {
 	proto.RegisterType((*TableDefinition)(nil), "tabletmanagerdata.TableDefinition")
 	proto.RegisterType((*SchemaDefinition)(nil), "tabletmanagerdata.SchemaDefinition")
 	proto.RegisterType((*SchemaChangeResult)(nil), "tabletmanagerdata.SchemaChangeResult")
+	proto.RegisterType((*SchemaVersion)(nil), "tabletmanagerdata.SchemaVersion")
 	proto.RegisterType((*UserPermission)(nil), "tabletmanagerdata.UserPermission")
 	proto.RegisterMapType((map[string]string)(nil), "tabletmanagerdata.UserPermission.PrivilegesEntry")
 	proto.RegisterType((*DbPermission)(nil), "tabletmanagerdata.DbPermission")
@@ -4487,6 +4693,8 @@ func init() {
 	proto.RegisterType((*ExecuteHookResponse)(nil), "tabletmanagerdata.ExecuteHookResponse")
 	proto.RegisterType((*GetSchemaRequest)(nil), "tabletmanagerdata.GetSchemaRequest")
 	proto.RegisterType((*GetSchemaResponse)(nil), "tabletmanagerdata.GetSchemaResponse")
+	proto.RegisterType((*GetSchemaHistoryRequest)(nil), "tabletmanagerdata.GetSchemaHistoryRequest")
+	proto.RegisterType((*GetSchemaHistoryResponse)(nil), "tabletmanagerdata.GetSchemaHistoryResponse")
 	proto.RegisterType((*GetPermissionsRequest)(nil), "tabletmanagerdata.GetPermissionsRequest")
 	proto.RegisterType((*GetPermissionsResponse)(nil), "tabletmanagerdata.GetPermissionsResponse")
 	proto.RegisterType((*SetReadOnlyRequest)(nil), "tabletmanagerdata.SetReadOnlyRequest")
@@ -4590,150 +4798,160 @@ func init() {
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdd, 0x72, 0xdb, 0xc6,
-	0xf5, 0x1f, 0x52, 0x1f, 0x96, 0x0e, 0x3f, 0x44, 0x81, 0x94, 0x48, 0x51, 0xb1, 0x2c, 0xc3, 0x4e,
-	0xe2, 0x7f, 0x32, 0x7f, 0x2a, 0x91, 0x13, 0x4f, 0x26, 0x69, 0x3b, 0x95, 0x6d, 0xc9, 0x76, 0x2c,
-	0xc7, 0x0a, 0xe4, 0x8f, 0x4c, 0xa6, 0x53, 0x0c, 0x48, 0xac, 0x28, 0x8c, 0x40, 0x2c, 0xbc, 0xbb,
-	0xa4, 0xc4, 0x9b, 0x3e, 0x42, 0xfb, 0x02, 0x9d, 0xde, 0x74, 0xa6, 0xbd, 0xef, 0x43, 0xf4, 0x11,
-	0xd2, 0x47, 0xe9, 0x45, 0x2f, 0xda, 0xd9, 0xdd, 0x03, 0x10, 0x20, 0x20, 0x59, 0x56, 0xdd, 0x99,
-	0xdc, 0x68, 0xb0, 0xbf, 0xb3, 0xe7, 0x73, 0xcf, 0x9e, 0x73, 0x96, 0x82, 0xa6, 0x70, 0xba, 0x3e,
-	0x11, 0x03, 0x27, 0x70, 0xfa, 0x84, 0xb9, 0x8e, 0x70, 0x3a, 0x21, 0xa3, 0x82, 0x1a, 0xcb, 0x19,
-	0x42, 0xbb, 0xf4, 0x66, 0x48, 0xd8, 0x58, 0xd3, 0xdb, 0x55, 0x41, 0x43, 0x3a, 0xd9, 0xdf, 0x5e,
-	0x61, 0x24, 0xf4, 0xbd, 0x9e, 0x23, 0x3c, 0x1a, 0x24, 0xe0, 0x8a, 0x4f, 0xfb, 0x43, 0xe1, 0xf9,
-	0x7a, 0x69, 0xfe, 0xbb, 0x00, 0x4b, 0x2f, 0xa4, 0xe0, 0x87, 0xe4, 0xc8, 0x0b, 0x3c, 0xb9, 0xd9,
-	0x30, 0x60, 0x36, 0x70, 0x06, 0xa4, 0x55, 0xd8, 0x2c, 0xdc, 0x59, 0xb4, 0xd4, 0xb7, 0xb1, 0x0a,
-	0xf3, 0xbc, 0x77, 0x4c, 0x06, 0x4e, 0xab, 0xa8, 0x50, 0x5c, 0x19, 0x2d, 0xb8, 0xd6, 0xa3, 0xfe,
-	0x70, 0x10, 0xf0, 0xd6, 0xcc, 0xe6, 0xcc, 0x9d, 0x45, 0x2b, 0x5a, 0x1a, 0x1d, 0xa8, 0x87, 0xcc,
-	0x1b, 0x38, 0x6c, 0x6c, 0x9f, 0x90, 0xb1, 0x1d, 0xed, 0x9a, 0x55, 0xbb, 0x96, 0x91, 0xf4, 0x94,
-	0x8c, 0x1f, 0xe0, 0x7e, 0x03, 0x66, 0xc5, 0x38, 0x24, 0xad, 0x39, 0xad, 0x55, 0x7e, 0x1b, 0x37,
-	0xa0, 0x24, 0x4d, 0xb7, 0x7d, 0x12, 0xf4, 0xc5, 0x71, 0x6b, 0x7e, 0xb3, 0x70, 0x67, 0xd6, 0x02,
-	0x09, 0xed, 0x2b, 0xc4, 0x58, 0x87, 0x45, 0x46, 0x4f, 0xed, 0x1e, 0x1d, 0x06, 0xa2, 0x75, 0x4d,
-	0x91, 0x17, 0x18, 0x3d, 0x7d, 0x20, 0xd7, 0xc6, 0x6d, 0x98, 0x3f, 0xf2, 0x88, 0xef, 0xf2, 0xd6,
-	0xc2, 0xe6, 0xcc, 0x9d, 0xd2, 0x76, 0xb9, 0xa3, 0xe3, 0xb5, 0x27, 0x41, 0x0b, 0x69, 0xe6, 0x5f,
-	0x0a, 0x50, 0x3b, 0x54, 0xce, 0x24, 0x42, 0xf0, 0x31, 0x2c, 0x49, 0x2d, 0x5d, 0x87, 0x13, 0x1b,
-	0xfd, 0xd6, 0xd1, 0xa8, 0x46, 0xb0, 0x66, 0x31, 0x9e, 0x83, 0x3e, 0x17, 0xdb, 0x8d, 0x99, 0x79,
-	0xab, 0xa8, 0xd4, 0x99, 0x9d, 0xec, 0x51, 0x4e, 0x85, 0xda, 0xaa, 0x89, 0x34, 0xc0, 0x65, 0x40,
-	0x47, 0x84, 0x71, 0x8f, 0x06, 0xad, 0x19, 0xa5, 0x31, 0x5a, 0x4a, 0x43, 0x0d, 0xad, 0xf5, 0xc1,
-	0xb1, 0x13, 0xf4, 0x89, 0x45, 0xf8, 0xd0, 0x17, 0xc6, 0x63, 0xa8, 0x74, 0xc9, 0x11, 0x65, 0x29,
-	0x43, 0x4b, 0xdb, 0xb7, 0x72, 0xb4, 0x4f, 0xbb, 0x69, 0x95, 0x35, 0x27, 0xfa, 0xb2, 0x07, 0x65,
-	0xe7, 0x48, 0x10, 0x66, 0x27, 0x4e, 0xfa, 0x92, 0x82, 0x4a, 0x8a, 0x51, 0xc3, 0xe6, 0x3f, 0x0b,
-	0x50, 0x7d, 0xc9, 0x09, 0x3b, 0x20, 0x6c, 0xe0, 0x71, 0x8e, 0x29, 0x75, 0x4c, 0xb9, 0x88, 0x52,
-	0x4a, 0x7e, 0x4b, 0x6c, 0xc8, 0x09, 0xc3, 0x84, 0x52, 0xdf, 0xc6, 0xa7, 0xb0, 0x1c, 0x3a, 0x9c,
-	0x9f, 0x52, 0xe6, 0xda, 0xbd, 0x63, 0xd2, 0x3b, 0xe1, 0xc3, 0x81, 0x8a, 0xc3, 0xac, 0x55, 0x8b,
-	0x08, 0x0f, 0x10, 0x37, 0xbe, 0x07, 0x08, 0x99, 0x37, 0xf2, 0x7c, 0xd2, 0x27, 0x3a, 0xb1, 0x4a,
-	0xdb, 0x9f, 0xe7, 0x58, 0x9b, 0xb6, 0xa5, 0x73, 0x10, 0xf3, 0xec, 0x06, 0x82, 0x8d, 0xad, 0x84,
-	0x90, 0xf6, 0x2f, 0x61, 0x69, 0x8a, 0x6c, 0xd4, 0x60, 0xe6, 0x84, 0x8c, 0xd1, 0x72, 0xf9, 0x69,
-	0x34, 0x60, 0x6e, 0xe4, 0xf8, 0x43, 0x82, 0x96, 0xeb, 0xc5, 0xd7, 0xc5, 0xaf, 0x0a, 0xe6, 0x4f,
-	0x05, 0x28, 0x3f, 0xec, 0xbe, 0xc5, 0xef, 0x2a, 0x14, 0xdd, 0x2e, 0xf2, 0x16, 0xdd, 0x6e, 0x1c,
-	0x87, 0x99, 0x44, 0x1c, 0x9e, 0xe7, 0xb8, 0xb6, 0x95, 0xe3, 0x5a, 0x52, 0xd9, 0xff, 0xd2, 0xb1,
-	0x3f, 0x17, 0xa0, 0x34, 0xd1, 0xc4, 0x8d, 0x7d, 0xa8, 0x49, 0x3b, 0xed, 0x70, 0x82, 0xb5, 0x0a,
-	0xca, 0xca, 0x9b, 0x6f, 0x3d, 0x00, 0x6b, 0x69, 0x98, 0x5a, 0x73, 0x63, 0x0f, 0xaa, 0x6e, 0x37,
-	0x25, 0x4b, 0xdf, 0xa0, 0x1b, 0x6f, 0xf1, 0xd8, 0xaa, 0xb8, 0x89, 0x15, 0x37, 0x3f, 0x86, 0xd2,
-	0x81, 0x17, 0xf4, 0x2d, 0xf2, 0x66, 0x48, 0xb8, 0x90, 0x57, 0x29, 0x74, 0xc6, 0x3e, 0x75, 0x5c,
-	0x74, 0x32, 0x5a, 0x9a, 0x77, 0xa0, 0xac, 0x37, 0xf2, 0x90, 0x06, 0x9c, 0x5c, 0xb0, 0xf3, 0x13,
-	0x28, 0x1f, 0xfa, 0x84, 0x84, 0x91, 0xcc, 0x36, 0x2c, 0xb8, 0x43, 0xa6, 0x8a, 0xaa, 0xda, 0x3a,
-	0x63, 0xc5, 0x6b, 0x73, 0x09, 0x2a, 0xb8, 0x57, 0x8b, 0x35, 0xff, 0x51, 0x00, 0x63, 0xf7, 0x8c,
-	0xf4, 0x86, 0x82, 0x3c, 0xa6, 0xf4, 0x24, 0x92, 0x91, 0x57, 0x5f, 0x37, 0x00, 0x42, 0x87, 0x39,
-	0x03, 0x22, 0x08, 0xd3, 0xee, 0x2f, 0x5a, 0x09, 0xc4, 0x38, 0x80, 0x45, 0x72, 0x26, 0x98, 0x63,
-	0x93, 0x60, 0xa4, 0x2a, 0x6d, 0x69, 0xfb, 0x6e, 0x4e, 0x74, 0xb2, 0xda, 0x3a, 0xbb, 0x92, 0x6d,
-	0x37, 0x18, 0xe9, 0x9c, 0x58, 0x20, 0xb8, 0x6c, 0x7f, 0x03, 0x95, 0x14, 0xe9, 0x9d, 0xf2, 0xe1,
-	0x08, 0xea, 0x29, 0x55, 0x18, 0xc7, 0x1b, 0x50, 0x22, 0x67, 0x9e, 0xb0, 0xb9, 0x70, 0xc4, 0x90,
-	0x63, 0x80, 0x40, 0x42, 0x87, 0x0a, 0x51, 0x6d, 0x44, 0xb8, 0x74, 0x28, 0xe2, 0x36, 0xa2, 0x56,
-	0x88, 0x13, 0x16, 0xdd, 0x02, 0x5c, 0x99, 0x23, 0xa8, 0x3d, 0x22, 0x42, 0xd7, 0x95, 0x28, 0x7c,
-	0xab, 0x30, 0xaf, 0x1c, 0xd7, 0x19, 0xb7, 0x68, 0xe1, 0xca, 0xb8, 0x05, 0x15, 0x2f, 0xe8, 0xf9,
-	0x43, 0x97, 0xd8, 0x23, 0x8f, 0x9c, 0x72, 0xa5, 0x62, 0xc1, 0x2a, 0x23, 0xf8, 0x4a, 0x62, 0xc6,
-	0x87, 0x50, 0x25, 0x67, 0x7a, 0x13, 0x0a, 0xd1, 0x6d, 0xab, 0x82, 0xa8, 0x2a, 0xd0, 0xdc, 0x24,
-	0xb0, 0x9c, 0xd0, 0x8b, 0xde, 0x1d, 0xc0, 0xb2, 0xae, 0x8c, 0x89, 0x62, 0xff, 0x2e, 0xd5, 0xb6,
-	0xc6, 0xa7, 0x10, 0xb3, 0x09, 0x2b, 0x8f, 0x88, 0x48, 0xa4, 0x30, 0xfa, 0x68, 0xfe, 0x08, 0xab,
-	0xd3, 0x04, 0x34, 0xe2, 0xd7, 0x50, 0x4a, 0x5f, 0x3a, 0xa9, 0x7e, 0x23, 0x47, 0x7d, 0x92, 0x39,
-	0xc9, 0x62, 0x36, 0xc0, 0x38, 0x24, 0xc2, 0x22, 0x8e, 0xfb, 0x3c, 0xf0, 0xc7, 0x91, 0xc6, 0x15,
-	0xa8, 0xa7, 0x50, 0x4c, 0xe1, 0x09, 0xfc, 0x9a, 0x79, 0x82, 0x44, 0xbb, 0x57, 0xa1, 0x91, 0x86,
-	0x71, 0xfb, 0xb7, 0xb0, 0xac, 0x9b, 0xd3, 0x8b, 0x71, 0x18, 0x6d, 0x36, 0xbe, 0x84, 0x92, 0x36,
-	0xcf, 0x56, 0x0d, 0x5e, 0x9a, 0x5c, 0xdd, 0x6e, 0x74, 0xe2, 0x79, 0x45, 0xc5, 0x5c, 0x28, 0x0e,
-	0x10, 0xf1, 0xb7, 0xb4, 0x33, 0x29, 0x6b, 0x62, 0x90, 0x45, 0x8e, 0x18, 0xe1, 0xc7, 0x32, 0xa5,
-	0x92, 0x06, 0xa5, 0x61, 0xdc, 0xde, 0x84, 0x15, 0x6b, 0x18, 0x3c, 0x26, 0x8e, 0x2f, 0x8e, 0x55,
-	0xe3, 0x88, 0x18, 0x5a, 0xb0, 0x3a, 0x4d, 0x40, 0x96, 0x2f, 0xa0, 0xf5, 0xa4, 0x1f, 0x50, 0x46,
-	0x34, 0x71, 0x97, 0x31, 0xca, 0x52, 0x25, 0x45, 0x08, 0xc2, 0x82, 0x49, 0xa1, 0x50, 0x4b, 0x73,
-	0x1d, 0xd6, 0x72, 0xb8, 0x50, 0xe4, 0xd7, 0xd2, 0x68, 0x59, 0x4f, 0xd2, 0x99, 0x7c, 0x0b, 0x2a,
-	0xa7, 0x8e, 0x27, 0xec, 0x90, 0xf2, 0x49, 0x32, 0x2d, 0x5a, 0x65, 0x09, 0x1e, 0x20, 0xa6, 0x3d,
-	0x4b, 0xf2, 0xa2, 0xcc, 0x6d, 0x58, 0x3d, 0x60, 0xe4, 0xc8, 0xf7, 0xfa, 0xc7, 0x53, 0x17, 0x44,
-	0xce, 0x64, 0x2a, 0x70, 0xd1, 0x0d, 0x89, 0x96, 0x66, 0x1f, 0x9a, 0x19, 0x1e, 0xcc, 0xab, 0x7d,
-	0xa8, 0xea, 0x5d, 0x36, 0x53, 0x73, 0x45, 0x54, 0xcf, 0x3f, 0x3c, 0x37, 0xb3, 0x93, 0x53, 0x88,
-	0x55, 0xe9, 0x25, 0x56, 0xdc, 0xfc, 0x57, 0x01, 0x8c, 0x9d, 0x30, 0xf4, 0xc7, 0x69, 0xcb, 0x6a,
-	0x30, 0xc3, 0xdf, 0xf8, 0x51, 0x89, 0xe1, 0x6f, 0x7c, 0x59, 0x62, 0x8e, 0x28, 0xeb, 0x11, 0xbc,
-	0xac, 0x7a, 0x21, 0xc7, 0x00, 0xc7, 0xf7, 0xe9, 0xa9, 0x9d, 0x98, 0x61, 0x55, 0x65, 0x58, 0xb0,
-	0x6a, 0x8a, 0x60, 0x4d, 0xf0, 0xec, 0x00, 0x34, 0xfb, 0xbe, 0x06, 0xa0, 0xb9, 0x2b, 0x0e, 0x40,
-	0x7f, 0x2d, 0x40, 0x3d, 0xe5, 0x3d, 0xc6, 0xf8, 0xe7, 0x37, 0xaa, 0xd5, 0x61, 0x79, 0x9f, 0xf6,
-	0x4e, 0x74, 0xd5, 0x8b, 0xae, 0x46, 0x03, 0x8c, 0x24, 0x38, 0xb9, 0x78, 0x2f, 0x03, 0x3f, 0xb3,
-	0x79, 0x15, 0x1a, 0x69, 0x18, 0xb7, 0xff, 0xad, 0x00, 0x2d, 0x6c, 0x11, 0x7b, 0x44, 0xf4, 0x8e,
-	0x77, 0xf8, 0xc3, 0x6e, 0x9c, 0x07, 0x0d, 0x98, 0x53, 0xa3, 0xb8, 0x0a, 0x40, 0xd9, 0xd2, 0x0b,
-	0xa3, 0x09, 0xd7, 0xdc, 0xae, 0xad, 0x5a, 0x23, 0x76, 0x07, 0xb7, 0xfb, 0x9d, 0x6c, 0x8e, 0x6b,
-	0xb0, 0x30, 0x70, 0xce, 0x6c, 0x46, 0x4f, 0x39, 0x0e, 0x83, 0xd7, 0x06, 0xce, 0x99, 0x45, 0x4f,
-	0xb9, 0x1a, 0xd4, 0x3d, 0xae, 0x26, 0xf0, 0xae, 0x17, 0xf8, 0xb4, 0xcf, 0xd5, 0xf1, 0x2f, 0x58,
-	0x55, 0x84, 0xef, 0x6b, 0x54, 0xde, 0x35, 0xa6, 0xae, 0x51, 0xf2, 0x70, 0x17, 0xac, 0x32, 0x4b,
-	0xdc, 0x2d, 0xf3, 0x11, 0xac, 0xe5, 0xd8, 0x8c, 0xa7, 0xf7, 0x09, 0xcc, 0xeb, 0xab, 0x81, 0xc7,
-	0x66, 0xe0, 0x73, 0xe2, 0x7b, 0xf9, 0x17, 0xaf, 0x01, 0xee, 0x30, 0x7f, 0x5f, 0x80, 0xeb, 0x69,
-	0x49, 0x3b, 0xbe, 0x2f, 0x07, 0x30, 0xfe, 0xfe, 0x43, 0x90, 0xf1, 0x6c, 0x36, 0xc7, 0xb3, 0x7d,
-	0xd8, 0x38, 0xcf, 0x9e, 0x2b, 0xb8, 0xf7, 0x74, 0xfa, 0x6c, 0x77, 0xc2, 0xf0, 0x62, 0xc7, 0x92,
-	0xf6, 0x17, 0x53, 0xf6, 0x67, 0x83, 0xae, 0x84, 0x5d, 0xc1, 0xaa, 0x36, 0xb4, 0x12, 0x75, 0x41,
-	0x4f, 0x1c, 0x51, 0x9a, 0xee, 0xc3, 0x5a, 0x0e, 0x0d, 0x95, 0x6c, 0xc9, 0xe9, 0x23, 0x9e, 0x58,
-	0x4a, 0xdb, 0xcd, 0xce, 0xf4, 0xdb, 0x19, 0x19, 0x70, 0x9b, 0xbc, 0x0b, 0xcf, 0x1c, 0x2e, 0xaf,
-	0x51, 0x4a, 0xc9, 0x33, 0x68, 0xa4, 0x61, 0x94, 0xff, 0xe5, 0x94, 0xfc, 0xeb, 0x19, 0xf9, 0x29,
-	0xb6, 0x48, 0x4b, 0x13, 0x56, 0x34, 0x1e, 0xf5, 0x82, 0x48, 0xcf, 0x17, 0xb0, 0x3a, 0x4d, 0x40,
-	0x4d, 0x6d, 0x58, 0x98, 0x6a, 0x26, 0xf1, 0x5a, 0x72, 0xbd, 0x76, 0x3c, 0xb1, 0x47, 0xa7, 0xe5,
-	0x5d, 0xc8, 0xb5, 0x06, 0xcd, 0x0c, 0x17, 0x5e, 0xf1, 0x16, 0xac, 0x1e, 0x0a, 0x1a, 0x26, 0xe2,
-	0x1a, 0x19, 0xb8, 0x06, 0xcd, 0x0c, 0x05, 0x99, 0x7e, 0x0b, 0xd7, 0xa7, 0x48, 0xcf, 0xbc, 0xc0,
-	0x1b, 0x0c, 0x07, 0x97, 0x30, 0xc6, 0xb8, 0x09, 0xaa, 0x37, 0xda, 0xc2, 0x1b, 0x90, 0x68, 0x88,
-	0x9c, 0xb1, 0x4a, 0x12, 0x7b, 0xa1, 0x21, 0xf3, 0x17, 0xb0, 0x71, 0x9e, 0xfc, 0x4b, 0xc4, 0x48,
-	0x19, 0xee, 0x30, 0x91, 0xe3, 0x53, 0x1b, 0x5a, 0x59, 0x12, 0x3a, 0xd5, 0x85, 0x9b, 0xd3, 0xb4,
-	0x97, 0x81, 0xf0, 0xfc, 0x1d, 0x59, 0x6a, 0xdf, 0x93, 0x63, 0xb7, 0xc1, 0xbc, 0x48, 0x07, 0x5a,
-	0xd2, 0x00, 0xe3, 0x11, 0x89, 0xf6, 0xc4, 0x89, 0xf9, 0x29, 0xd4, 0x53, 0x28, 0x46, 0xa2, 0x01,
-	0x73, 0x8e, 0xeb, 0xb2, 0x68, 0x4c, 0xd0, 0x0b, 0x19, 0x03, 0x8b, 0x70, 0x72, 0x4e, 0x0c, 0xb2,
-	0x24, 0xd4, 0xbc, 0x05, 0xcd, 0x57, 0x09, 0x5c, 0x5e, 0xe9, 0xdc, 0x92, 0xb0, 0x88, 0x25, 0xc1,
-	0xdc, 0x83, 0x56, 0x96, 0xe1, 0x4a, 0xc5, 0xe8, 0x7a, 0x52, 0xce, 0x24, 0x5b, 0x23, 0xf5, 0x55,
-	0x28, 0x7a, 0x2e, 0x3e, 0x46, 0x8a, 0x9e, 0x9b, 0x3a, 0x88, 0xe2, 0x54, 0x02, 0x6c, 0xc2, 0xc6,
-	0x79, 0xc2, 0xd0, 0xcf, 0x3a, 0x2c, 0x3f, 0x09, 0x3c, 0xa1, 0x2f, 0x60, 0x14, 0x98, 0xcf, 0xc0,
-	0x48, 0x82, 0x97, 0xc8, 0xb4, 0x9f, 0x0a, 0xb0, 0x71, 0x40, 0xc3, 0xa1, 0xaf, 0xa6, 0xd5, 0xd0,
-	0x61, 0x24, 0x10, 0xdf, 0xd2, 0x21, 0x0b, 0x1c, 0x3f, 0xb2, 0xfb, 0x23, 0x58, 0x92, 0xf9, 0x60,
-	0xf7, 0x18, 0x71, 0x04, 0x71, 0xed, 0x20, 0x7a, 0x51, 0x55, 0x24, 0xfc, 0x40, 0xa3, 0xdf, 0x71,
-	0xf9, 0xea, 0x72, 0x7a, 0x52, 0x68, 0xb2, 0x71, 0x80, 0x86, 0x54, 0xf3, 0xf8, 0x0a, 0xca, 0x03,
-	0x65, 0x99, 0xed, 0xf8, 0x9e, 0xa3, 0x1b, 0x48, 0x69, 0x7b, 0x65, 0x7a, 0x02, 0xdf, 0x91, 0x44,
-	0xab, 0xa4, 0xb7, 0xaa, 0x85, 0xf1, 0x39, 0x34, 0x12, 0xa5, 0x6a, 0x32, 0xa8, 0xce, 0x2a, 0x1d,
-	0xf5, 0x04, 0x2d, 0x9e, 0x57, 0x6f, 0xc2, 0x8d, 0x73, 0xfd, 0xc2, 0x10, 0xfe, 0xa9, 0xa0, 0xc3,
-	0x85, 0x81, 0x8e, 0xfc, 0xfd, 0x7f, 0x98, 0xd7, 0xfb, 0xf1, 0xd0, 0xcf, 0x31, 0x10, 0x37, 0x9d,
-	0x6b, 0x5b, 0xf1, 0x5c, 0xdb, 0xf2, 0x22, 0x3a, 0x93, 0x13, 0x51, 0x59, 0xdf, 0x53, 0xf6, 0x4d,
-	0x46, 0xa0, 0x87, 0x64, 0x40, 0x05, 0x49, 0x1f, 0xfe, 0x1f, 0x0a, 0xd0, 0x48, 0xe3, 0x78, 0xfe,
-	0x77, 0xa1, 0xee, 0x92, 0x90, 0x91, 0x9e, 0x52, 0x96, 0x4e, 0x85, 0xfb, 0xc5, 0x56, 0xc1, 0x32,
-	0x26, 0xe4, 0xd8, 0xc6, 0xfb, 0x50, 0xc1, 0xc3, 0xc2, 0x9e, 0x51, 0xbc, 0x4c, 0xcf, 0xc0, 0x03,
-	0xd6, 0x2b, 0x79, 0x85, 0x5f, 0x06, 0x2e, 0xcd, 0x33, 0xb6, 0x0d, 0xad, 0x2c, 0x09, 0xfd, 0x5b,
-	0x8f, 0x9b, 0xe4, 0x6b, 0x87, 0x1f, 0x30, 0x2a, 0xb7, 0xb8, 0x11, 0xe3, 0x07, 0xd0, 0xce, 0x23,
-	0x22, 0xeb, 0xdf, 0x0b, 0x50, 0x3b, 0x24, 0xe9, 0x5b, 0xf1, 0xae, 0x07, 0x9a, 0x73, 0x3a, 0xc5,
-	0xbc, 0x7c, 0xbf, 0x07, 0x4d, 0xf5, 0x4c, 0x90, 0x01, 0x62, 0x22, 0xe7, 0x8d, 0xb0, 0xa2, 0xc8,
-	0xd3, 0xd5, 0x32, 0xfb, 0xdc, 0x9a, 0xcd, 0x79, 0x6e, 0xd5, 0x61, 0x39, 0xe1, 0x07, 0x7a, 0xf7,
-	0x34, 0xe9, 0xbb, 0x45, 0x94, 0xde, 0x38, 0x32, 0xef, 0xe8, 0xa6, 0x79, 0x1d, 0xd6, 0x73, 0x85,
-	0xa1, 0xae, 0xdf, 0xc9, 0x3a, 0x9f, 0x6a, 0x60, 0x3b, 0x81, 0xfb, 0x88, 0x88, 0xd4, 0xa8, 0x61,
-	0xfc, 0x00, 0x2b, 0x5c, 0xd0, 0x30, 0xe9, 0xbc, 0x3d, 0xa0, 0x6e, 0xf4, 0xba, 0xbe, 0x9d, 0x33,
-	0xc1, 0xa4, 0x9b, 0x22, 0x75, 0x89, 0x55, 0xe7, 0x59, 0x50, 0x3e, 0x5e, 0x6e, 0x5d, 0x68, 0x40,
-	0xfc, 0x43, 0x44, 0xe5, 0x78, 0xdc, 0x65, 0x9e, 0x6b, 0x5f, 0x6a, 0x76, 0x52, 0xf9, 0x5e, 0xd6,
-	0x1c, 0xf8, 0x63, 0xd0, 0xaf, 0xe2, 0xb1, 0x48, 0xa7, 0xf8, 0x47, 0x6f, 0x33, 0x3a, 0x3b, 0x1f,
-	0x61, 0x1e, 0xa6, 0x0b, 0x89, 0x9c, 0x74, 0xa6, 0x09, 0x97, 0xa8, 0xc8, 0x87, 0x50, 0xb9, 0xef,
-	0xf4, 0x4e, 0x86, 0xf1, 0x24, 0xbb, 0x09, 0xa5, 0x1e, 0x0d, 0x7a, 0x43, 0xc6, 0x48, 0xd0, 0x1b,
-	0x63, 0xed, 0x4d, 0x42, 0x72, 0x87, 0x7a, 0x8e, 0xea, 0x74, 0xc1, 0x37, 0x6c, 0x12, 0x32, 0xef,
-	0x41, 0x35, 0x12, 0x8a, 0x26, 0xdc, 0x86, 0x39, 0x32, 0x9a, 0x24, 0x4b, 0xb5, 0x13, 0xfd, 0x43,
-	0x66, 0x57, 0xa2, 0x96, 0x26, 0x62, 0xa7, 0x15, 0x94, 0x91, 0x3d, 0x46, 0x07, 0x29, 0xbb, 0xcc,
-	0x1d, 0x79, 0x4d, 0x33, 0xb4, 0x77, 0x12, 0xdf, 0x00, 0xe3, 0xd0, 0x77, 0x46, 0x24, 0x3d, 0xbf,
-	0xee, 0x41, 0x3d, 0x85, 0x5e, 0x75, 0x3c, 0x36, 0xa0, 0x26, 0x4f, 0x4e, 0xc9, 0x8a, 0x64, 0xcb,
-	0x7b, 0x35, 0xc1, 0x30, 0xd7, 0x7f, 0xd0, 0x73, 0xa2, 0x02, 0xdf, 0xef, 0x18, 0x78, 0x4f, 0x4e,
-	0x6b, 0xd3, 0x92, 0x2f, 0x91, 0x04, 0xca, 0x4c, 0x87, 0x89, 0x94, 0xed, 0x32, 0x5a, 0x09, 0x10,
-	0x8d, 0xff, 0x0d, 0xac, 0x4f, 0xd0, 0xf7, 0x3e, 0xee, 0x6d, 0xc0, 0x07, 0xf9, 0xd2, 0x51, 0xbb,
-	0xa1, 0x7f, 0x19, 0x95, 0xd4, 0xf8, 0xfc, 0xfe, 0x4f, 0xff, 0x6a, 0x89, 0xd8, 0x85, 0x43, 0xde,
-	0x1f, 0x0b, 0x50, 0x93, 0x2d, 0x2e, 0xe9, 0xe7, 0xcf, 0xa8, 0x01, 0xe3, 0x90, 0x95, 0x0e, 0xb8,
-	0x1c, 0xce, 0x25, 0x90, 0xd3, 0x9c, 0xe4, 0x70, 0x9e, 0x21, 0x21, 0xdb, 0x93, 0x09, 0xed, 0xbf,
-	0x2d, 0xdd, 0xeb, 0xb0, 0x96, 0x23, 0x2a, 0xce, 0x87, 0xf2, 0xab, 0xb7, 0x4e, 0xbd, 0x32, 0x2d,
-	0x4e, 0x29, 0x3b, 0x39, 0xf2, 0xe9, 0x69, 0x34, 0x7c, 0x46, 0x6b, 0x49, 0x3b, 0x21, 0x63, 0x1e,
-	0x3a, 0x3d, 0x82, 0xbf, 0x83, 0xc7, 0x6b, 0xf3, 0x1b, 0xa8, 0xbc, 0xba, 0xea, 0x88, 0x7c, 0xff,
-	0xb3, 0x1f, 0x3b, 0x23, 0x4f, 0x10, 0xce, 0x3b, 0x1e, 0xdd, 0xd2, 0x5f, 0x5b, 0x7d, 0xba, 0x35,
-	0x12, 0x5b, 0xea, 0xbf, 0xc0, 0x5b, 0x99, 0x9f, 0x8d, 0xba, 0xf3, 0x8a, 0x70, 0xf7, 0x3f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x82, 0x1c, 0x56, 0x23, 0x8f, 0x1e, 0x00, 0x00,
+	// 2469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6e, 0xdc, 0xc6,
+	0xf5, 0x07, 0x77, 0x25, 0x59, 0x3a, 0xfb, 0xa1, 0x15, 0x77, 0xa5, 0xa5, 0xa4, 0x58, 0x96, 0x69,
+	0x27, 0xd1, 0x3f, 0xc1, 0x5f, 0x4a, 0xe4, 0xc4, 0x08, 0x9c, 0xb6, 0xa8, 0x6c, 0x4b, 0xb6, 0x62,
+	0x39, 0x56, 0x28, 0x7f, 0x04, 0x41, 0x51, 0x82, 0xbb, 0x1c, 0x49, 0x84, 0xb8, 0x1c, 0x7a, 0x66,
+	0x76, 0xa5, 0xbd, 0xe9, 0x23, 0xb4, 0x2f, 0x50, 0xf4, 0xa6, 0x40, 0x7b, 0xdf, 0x37, 0xe8, 0x4d,
+	0x1f, 0x21, 0xbd, 0xe8, 0x83, 0xf4, 0xa2, 0x17, 0x2d, 0xe6, 0x83, 0xdc, 0xe1, 0x92, 0xfa, 0xb0,
+	0x6a, 0x14, 0xb9, 0x31, 0xf6, 0xfc, 0xce, 0x9c, 0xcf, 0x99, 0x39, 0xe7, 0x0c, 0x65, 0x68, 0x33,
+	0xaf, 0x13, 0x22, 0xd6, 0xf3, 0x22, 0xef, 0x08, 0x11, 0xdf, 0x63, 0xde, 0x7a, 0x4c, 0x30, 0xc3,
+	0xe6, 0x5c, 0x8e, 0xb1, 0x54, 0x79, 0xdb, 0x47, 0x64, 0x28, 0xf9, 0x4b, 0x75, 0x86, 0x63, 0x3c,
+	0x5a, 0xbf, 0x34, 0x4f, 0x50, 0x1c, 0x06, 0x5d, 0x8f, 0x05, 0x38, 0xd2, 0xe0, 0x5a, 0x88, 0x8f,
+	0xfa, 0x2c, 0x08, 0x25, 0x69, 0xff, 0xdb, 0x80, 0xd9, 0x97, 0x5c, 0xf1, 0x63, 0x74, 0x18, 0x44,
+	0x01, 0x5f, 0x6c, 0x9a, 0x30, 0x11, 0x79, 0x3d, 0x64, 0x19, 0xab, 0xc6, 0xda, 0x8c, 0x23, 0x7e,
+	0x9b, 0x0b, 0x30, 0x45, 0xbb, 0xc7, 0xa8, 0xe7, 0x59, 0x25, 0x81, 0x2a, 0xca, 0xb4, 0xe0, 0x46,
+	0x17, 0x87, 0xfd, 0x5e, 0x44, 0xad, 0xf2, 0x6a, 0x79, 0x6d, 0xc6, 0x49, 0x48, 0x73, 0x1d, 0x9a,
+	0x31, 0x09, 0x7a, 0x1e, 0x19, 0xba, 0x27, 0x68, 0xe8, 0x26, 0xab, 0x26, 0xc4, 0xaa, 0x39, 0xc5,
+	0x7a, 0x86, 0x86, 0x8f, 0xd4, 0x7a, 0x13, 0x26, 0xd8, 0x30, 0x46, 0xd6, 0xa4, 0xb4, 0xca, 0x7f,
+	0x9b, 0xb7, 0xa0, 0xc2, 0x5d, 0x77, 0x43, 0x14, 0x1d, 0xb1, 0x63, 0x6b, 0x6a, 0xd5, 0x58, 0x9b,
+	0x70, 0x80, 0x43, 0x7b, 0x02, 0x31, 0x97, 0x61, 0x86, 0xe0, 0x53, 0xb7, 0x8b, 0xfb, 0x11, 0xb3,
+	0x6e, 0x08, 0xf6, 0x34, 0xc1, 0xa7, 0x8f, 0x38, 0x6d, 0xde, 0x85, 0xa9, 0xc3, 0x00, 0x85, 0x3e,
+	0xb5, 0xa6, 0x57, 0xcb, 0x6b, 0x95, 0xcd, 0xea, 0xba, 0xcc, 0xd7, 0x0e, 0x07, 0x1d, 0xc5, 0xb3,
+	0xff, 0x64, 0x40, 0xe3, 0x40, 0x04, 0xa3, 0xa5, 0xe0, 0x63, 0x98, 0xe5, 0x56, 0x3a, 0x1e, 0x45,
+	0xae, 0x8a, 0x5b, 0x66, 0xa3, 0x9e, 0xc0, 0x52, 0xc4, 0x7c, 0x01, 0x72, 0x5f, 0x5c, 0x3f, 0x15,
+	0xa6, 0x56, 0x49, 0x98, 0xb3, 0xd7, 0xf3, 0x5b, 0x39, 0x96, 0x6a, 0xa7, 0xc1, 0xb2, 0x00, 0xe5,
+	0x09, 0x1d, 0x20, 0x42, 0x03, 0x1c, 0x59, 0x65, 0x61, 0x31, 0x21, 0xb9, 0xa3, 0xa6, 0xb4, 0xfa,
+	0xe8, 0xd8, 0x8b, 0x8e, 0x90, 0x83, 0x68, 0x3f, 0x64, 0xe6, 0x53, 0xa8, 0x75, 0xd0, 0x21, 0x26,
+	0x19, 0x47, 0x2b, 0x9b, 0x77, 0x0a, 0xac, 0x8f, 0x87, 0xe9, 0x54, 0xa5, 0xa4, 0x8a, 0x65, 0x07,
+	0xaa, 0xde, 0x21, 0x43, 0xc4, 0xd5, 0x76, 0xfa, 0x8a, 0x8a, 0x2a, 0x42, 0x50, 0xc2, 0xf6, 0x5f,
+	0x0d, 0xa8, 0xc9, 0x9f, 0xaf, 0xa5, 0xeb, 0x66, 0x1d, 0x4a, 0x81, 0x2f, 0x1c, 0x2b, 0x3b, 0xa5,
+	0xc0, 0x37, 0x97, 0x60, 0x3a, 0xc6, 0x54, 0x88, 0xaa, 0xf3, 0x94, 0xd2, 0x66, 0x03, 0xca, 0xbe,
+	0x1f, 0xaa, 0xe0, 0xf9, 0x4f, 0xf3, 0x36, 0x54, 0x59, 0xd0, 0x43, 0x6e, 0x3f, 0xf6, 0x3d, 0x86,
+	0x7c, 0x6b, 0x42, 0xe8, 0xa9, 0x70, 0xec, 0x95, 0x84, 0x8a, 0xb7, 0x61, 0xf2, 0xfa, 0xdb, 0x60,
+	0xff, 0xd3, 0x80, 0xfa, 0x2b, 0x8a, 0xc8, 0x3e, 0x22, 0xbd, 0x80, 0x52, 0x75, 0x2d, 0x8e, 0x31,
+	0x65, 0xc9, 0xb5, 0xe0, 0xbf, 0x39, 0xd6, 0xa7, 0x88, 0xa8, 0x20, 0xc4, 0x6f, 0xf3, 0x53, 0x98,
+	0x8b, 0x3d, 0x4a, 0x4f, 0x31, 0xf1, 0xdd, 0xee, 0x31, 0xea, 0x9e, 0xd0, 0x7e, 0x4f, 0x84, 0x33,
+	0xe1, 0x34, 0x12, 0xc6, 0x23, 0x85, 0x9b, 0xdf, 0x01, 0xc4, 0x24, 0x18, 0x04, 0x21, 0x3a, 0x42,
+	0xf2, 0x72, 0x54, 0x36, 0x3f, 0x2f, 0xf0, 0x38, 0xeb, 0xcb, 0xfa, 0x7e, 0x2a, 0xb3, 0x1d, 0x31,
+	0x32, 0x74, 0x34, 0x25, 0x4b, 0x3f, 0x87, 0xd9, 0x31, 0x36, 0xcf, 0xe9, 0x09, 0x1a, 0x2a, 0xcf,
+	0xf9, 0x4f, 0xb3, 0x05, 0x93, 0x03, 0x2f, 0xec, 0x23, 0xe5, 0xb9, 0x24, 0x1e, 0x94, 0xbe, 0x32,
+	0xec, 0x1f, 0x0d, 0xa8, 0x3e, 0xee, 0x5c, 0x12, 0x77, 0x1d, 0x4a, 0x7e, 0x47, 0xc9, 0x96, 0xfc,
+	0x4e, 0x9a, 0x87, 0xb2, 0x96, 0x87, 0x17, 0x05, 0xa1, 0x6d, 0x14, 0x84, 0xf6, 0xb8, 0xf3, 0xbf,
+	0x09, 0xec, 0x8f, 0x06, 0x54, 0x46, 0x96, 0xa8, 0xb9, 0x07, 0x0d, 0xee, 0xa7, 0x1b, 0x8f, 0x30,
+	0xcb, 0x10, 0x5e, 0xde, 0xbe, 0x74, 0x03, 0x9c, 0xd9, 0x7e, 0x86, 0xa6, 0xe6, 0x0e, 0xd4, 0xfd,
+	0x4e, 0x46, 0x97, 0xac, 0x02, 0xb7, 0x2e, 0x89, 0xd8, 0xa9, 0xf9, 0x1a, 0x45, 0xed, 0x8f, 0xa1,
+	0xb2, 0x1f, 0x44, 0x47, 0x0e, 0x7a, 0xdb, 0x47, 0x94, 0xf1, 0x72, 0x10, 0x7b, 0xc3, 0x10, 0x7b,
+	0xbe, 0x0a, 0x32, 0x21, 0xed, 0x35, 0xa8, 0xca, 0x85, 0x34, 0xc6, 0x11, 0x45, 0x17, 0xac, 0xfc,
+	0x04, 0xaa, 0x07, 0x21, 0x42, 0x71, 0xa2, 0x73, 0x09, 0xa6, 0xfd, 0x3e, 0x11, 0x8d, 0x41, 0xdd,
+	0xc9, 0x94, 0xb6, 0x67, 0xa1, 0xa6, 0xd6, 0x4a, 0xb5, 0xf6, 0xdf, 0x0d, 0x30, 0xb7, 0xcf, 0x50,
+	0xb7, 0xcf, 0xd0, 0x53, 0x8c, 0x4f, 0x12, 0x1d, 0x45, 0x3d, 0x62, 0x05, 0x20, 0xf6, 0x88, 0xd7,
+	0x43, 0x0c, 0x11, 0x19, 0xfe, 0x8c, 0xa3, 0x21, 0xe6, 0x3e, 0xcc, 0xa0, 0x33, 0x46, 0x3c, 0x17,
+	0x45, 0x03, 0xd1, 0x2d, 0x2a, 0x9b, 0xf7, 0x0a, 0xb2, 0x93, 0xb7, 0xb6, 0xbe, 0xcd, 0xc5, 0xb6,
+	0xa3, 0x81, 0x3c, 0x13, 0xd3, 0x48, 0x91, 0x4b, 0x5f, 0x43, 0x2d, 0xc3, 0x7a, 0xa7, 0xf3, 0x70,
+	0x08, 0xcd, 0x8c, 0x29, 0x95, 0xc7, 0x5b, 0x50, 0x41, 0x67, 0x01, 0x73, 0x29, 0xf3, 0x58, 0x9f,
+	0xaa, 0x04, 0x01, 0x87, 0x0e, 0x04, 0x22, 0x5a, 0x21, 0xf3, 0x71, 0x9f, 0xa5, 0xad, 0x50, 0x50,
+	0x0a, 0x47, 0x24, 0xb9, 0x05, 0x8a, 0xb2, 0x07, 0xd0, 0x78, 0x82, 0x98, 0x2c, 0x88, 0x49, 0xfa,
+	0x16, 0x60, 0x4a, 0x04, 0x2e, 0x4f, 0xdc, 0x8c, 0xa3, 0x28, 0xf3, 0x0e, 0xd4, 0x82, 0xa8, 0x1b,
+	0xf6, 0x7d, 0xe4, 0x0e, 0x02, 0x74, 0x4a, 0x85, 0x89, 0x69, 0xa7, 0xaa, 0xc0, 0xd7, 0x1c, 0x33,
+	0x3f, 0x84, 0x3a, 0x3a, 0x93, 0x8b, 0x94, 0x12, 0xd9, 0x7a, 0x6b, 0x0a, 0x15, 0xd5, 0x8d, 0xda,
+	0x08, 0xe6, 0x34, 0xbb, 0x2a, 0xba, 0x7d, 0x98, 0x93, 0xd5, 0x5d, 0xab, 0x94, 0xef, 0xd2, 0x31,
+	0x1a, 0x74, 0x0c, 0xb1, 0xff, 0x61, 0x40, 0x3b, 0xb5, 0xf3, 0x34, 0xa0, 0x0c, 0x93, 0xe1, 0x65,
+	0x61, 0xce, 0xc3, 0x54, 0x2f, 0x88, 0xdc, 0xc0, 0x17, 0xf1, 0x95, 0x9d, 0xc9, 0x5e, 0x10, 0xed,
+	0xfa, 0x02, 0xf6, 0xce, 0x38, 0x5c, 0x56, 0xb0, 0x77, 0xb6, 0xeb, 0x9b, 0x6b, 0xd0, 0xe0, 0x70,
+	0x41, 0x0f, 0xa8, 0xf7, 0xbc, 0xb3, 0x97, 0x5a, 0x1b, 0x68, 0xc1, 0x64, 0x18, 0xf4, 0x02, 0x26,
+	0x86, 0x88, 0xb2, 0x23, 0x09, 0xf3, 0x01, 0x2c, 0x66, 0xf2, 0x95, 0x69, 0x12, 0x53, 0x22, 0xc1,
+	0x6d, 0x3d, 0x75, 0x7a, 0x1f, 0x40, 0x60, 0xe5, 0x83, 0x53, 0xb9, 0xdc, 0x85, 0x59, 0x95, 0x4b,
+	0xd5, 0xa2, 0x93, 0xfa, 0xb1, 0x7a, 0x6e, 0x26, 0x55, 0x43, 0x74, 0xea, 0x54, 0x27, 0xa9, 0xdd,
+	0x86, 0xf9, 0x27, 0x88, 0x69, 0x75, 0x40, 0x65, 0xd0, 0xfe, 0x01, 0x16, 0xc6, 0x19, 0xca, 0xfa,
+	0x2f, 0xa1, 0x92, 0xad, 0x5c, 0x7c, 0x0f, 0x57, 0x0a, 0x2c, 0xeb, 0xc2, 0xba, 0x88, 0xdd, 0x02,
+	0xf3, 0x00, 0x31, 0x07, 0x79, 0xfe, 0x8b, 0x28, 0x4c, 0xf6, 0xcc, 0x9e, 0x87, 0x66, 0x06, 0x55,
+	0x75, 0x60, 0x04, 0xbf, 0x21, 0x01, 0x43, 0xc9, 0xea, 0x05, 0x68, 0x65, 0x61, 0xb5, 0xfc, 0x1b,
+	0x98, 0x93, 0x53, 0xca, 0xcb, 0x61, 0x9c, 0x2c, 0x36, 0xbf, 0x84, 0x8a, 0x74, 0xcf, 0x15, 0x93,
+	0x1e, 0x77, 0xb9, 0xbe, 0xd9, 0x5a, 0x4f, 0x07, 0x57, 0x91, 0x7d, 0x26, 0x24, 0x80, 0xa5, 0xbf,
+	0xb9, 0x9f, 0xba, 0xae, 0x91, 0x43, 0x0e, 0x3a, 0x24, 0x88, 0x1e, 0xf3, 0x7b, 0xa9, 0x3b, 0x94,
+	0x85, 0xd5, 0xf2, 0x36, 0xcc, 0x3b, 0xfd, 0xe8, 0x29, 0xf2, 0x42, 0x76, 0x2c, 0xba, 0x6f, 0x22,
+	0x60, 0xc1, 0xc2, 0x38, 0x43, 0x89, 0x7c, 0x01, 0xd6, 0xee, 0x51, 0x84, 0x09, 0x92, 0xcc, 0x6d,
+	0x42, 0x30, 0xc9, 0xd4, 0x65, 0xc6, 0x10, 0x89, 0x46, 0xd5, 0x56, 0x90, 0xf6, 0x32, 0x2c, 0x16,
+	0x48, 0x29, 0x95, 0x0f, 0xb8, 0xd3, 0xbc, 0x28, 0x67, 0xcb, 0xc1, 0x1d, 0xa8, 0x9d, 0x7a, 0x01,
+	0x73, 0xd3, 0xa1, 0x48, 0xea, 0xac, 0x72, 0x70, 0x5f, 0x61, 0x32, 0x32, 0x5d, 0x56, 0xe9, 0xdc,
+	0x84, 0x85, 0x7d, 0x82, 0x0e, 0xc3, 0xe0, 0xe8, 0x78, 0xac, 0xca, 0xf0, 0xe1, 0x5c, 0x24, 0x2e,
+	0xb9, 0x7f, 0x09, 0x69, 0x1f, 0x41, 0x3b, 0x27, 0xa3, 0xce, 0xd5, 0x1e, 0xd4, 0xe5, 0x2a, 0x97,
+	0x88, 0x01, 0x33, 0x39, 0xd4, 0x1f, 0x9e, 0x7b, 0xa8, 0xf5, 0x71, 0xd4, 0xa9, 0x75, 0x35, 0x8a,
+	0xda, 0xff, 0x32, 0xc0, 0xdc, 0x8a, 0xe3, 0x70, 0x98, 0xf5, 0xac, 0x01, 0x65, 0xfa, 0x36, 0x4c,
+	0xea, 0x34, 0x7d, 0x1b, 0xf2, 0xab, 0x7b, 0x88, 0x49, 0x17, 0xa9, 0x8a, 0x27, 0x09, 0x3e, 0x4b,
+	0x79, 0x61, 0x88, 0x4f, 0x5d, 0xed, 0x31, 0x23, 0x8a, 0xc3, 0xb4, 0xd3, 0x10, 0x0c, 0x67, 0x84,
+	0xe7, 0x27, 0xe1, 0x89, 0xf7, 0x35, 0x09, 0x4f, 0x5e, 0x73, 0x12, 0xfe, 0xb3, 0x01, 0xcd, 0x4c,
+	0xf4, 0x2a, 0xc7, 0x3f, 0xbd, 0x99, 0xbd, 0x09, 0x73, 0x7b, 0xb8, 0x7b, 0x22, 0x5b, 0x47, 0x72,
+	0x35, 0x5a, 0x60, 0xea, 0xe0, 0xe8, 0xe2, 0xbd, 0x8a, 0xc2, 0xdc, 0xe2, 0x05, 0x68, 0x65, 0x61,
+	0xb5, 0xfc, 0x2f, 0x06, 0x58, 0xaa, 0xcf, 0xee, 0x20, 0xd6, 0x3d, 0xde, 0xa2, 0x8f, 0x3b, 0xe9,
+	0x39, 0x68, 0xc1, 0xa4, 0x78, 0x93, 0x89, 0x04, 0x54, 0x1d, 0x49, 0x98, 0x6d, 0xb8, 0xe1, 0x77,
+	0x5c, 0x31, 0x5f, 0xa8, 0x16, 0xeb, 0x77, 0xbe, 0xe5, 0x13, 0xc6, 0x22, 0x4c, 0xf3, 0x4e, 0x40,
+	0xf0, 0x29, 0x55, 0x13, 0xf5, 0x8d, 0x9e, 0x77, 0xe6, 0xe0, 0x53, 0x2a, 0x5e, 0x6c, 0x01, 0x15,
+	0xe5, 0xbd, 0x13, 0x44, 0x21, 0x3e, 0xa2, 0x62, 0xfb, 0xa7, 0x9d, 0xba, 0x82, 0x1f, 0x4a, 0x94,
+	0xdf, 0x35, 0x22, 0xae, 0x91, 0xbe, 0xb9, 0xd3, 0x4e, 0x95, 0x68, 0x77, 0xcb, 0x7e, 0x02, 0x8b,
+	0x05, 0x3e, 0xab, 0xdd, 0xfb, 0x04, 0xa6, 0xe4, 0xd5, 0x50, 0xdb, 0x66, 0xaa, 0x77, 0xe5, 0x77,
+	0xfc, 0x5f, 0x75, 0x0d, 0xd4, 0x0a, 0xfb, 0xb7, 0x06, 0xdc, 0xcc, 0x6a, 0xda, 0x0a, 0x43, 0x3e,
+	0xc5, 0xd2, 0xf7, 0x9f, 0x82, 0x5c, 0x64, 0x13, 0x05, 0x91, 0xed, 0xc1, 0xca, 0x79, 0xfe, 0x5c,
+	0x23, 0xbc, 0x67, 0xe3, 0x7b, 0xbb, 0x15, 0xc7, 0x17, 0x07, 0xa6, 0xfb, 0x5f, 0xca, 0xf8, 0x9f,
+	0x4f, 0xba, 0x50, 0x76, 0x0d, 0xaf, 0x96, 0xc0, 0xd2, 0xea, 0x82, 0x1c, 0xdb, 0x92, 0x63, 0xba,
+	0x07, 0x8b, 0x05, 0x3c, 0x65, 0x64, 0x83, 0x8f, 0x70, 0xe9, 0xd8, 0x57, 0xd9, 0x6c, 0xaf, 0x8f,
+	0x7f, 0x44, 0x51, 0x02, 0x6a, 0x19, 0xbf, 0x0b, 0xcf, 0x3d, 0xca, 0xaf, 0x51, 0xc6, 0xc8, 0x73,
+	0x68, 0x65, 0x61, 0xa5, 0xff, 0xcb, 0x31, 0xfd, 0x37, 0x73, 0xfa, 0x33, 0x62, 0x89, 0x95, 0x36,
+	0xcc, 0x4b, 0x3c, 0xe9, 0x05, 0x89, 0x9d, 0x2f, 0x60, 0x61, 0x9c, 0xa1, 0x2c, 0xe9, 0x2f, 0x6c,
+	0x23, 0xfb, 0xc2, 0xe6, 0x52, 0x6f, 0xbc, 0x80, 0xed, 0xe0, 0x71, 0x7d, 0x17, 0x4a, 0x2d, 0x42,
+	0x3b, 0x27, 0xa5, 0xae, 0xb8, 0x05, 0x0b, 0x07, 0x0c, 0xc7, 0x5a, 0x5e, 0x13, 0x07, 0x17, 0xa1,
+	0x9d, 0xe3, 0x28, 0xa1, 0x5f, 0xc3, 0xcd, 0x31, 0xd6, 0xf3, 0x20, 0x0a, 0x7a, 0xfd, 0xde, 0x15,
+	0x9c, 0xe1, 0x9f, 0x04, 0x44, 0xc3, 0x64, 0x41, 0x0f, 0x25, 0x93, 0x78, 0xd9, 0xa9, 0x70, 0xec,
+	0xa5, 0x84, 0xec, 0x9f, 0xc1, 0xca, 0x79, 0xfa, 0xaf, 0x90, 0x23, 0xe1, 0xb8, 0x47, 0x58, 0x41,
+	0x4c, 0x4b, 0x60, 0xe5, 0x59, 0x2a, 0xa8, 0x0e, 0xdc, 0x1e, 0xe7, 0xbd, 0x8a, 0x58, 0x10, 0x6e,
+	0xf1, 0x52, 0xfb, 0x9e, 0x02, 0xbb, 0x0b, 0xf6, 0x45, 0x36, 0x94, 0x27, 0x2d, 0x30, 0x9f, 0xa0,
+	0x64, 0x4d, 0x7a, 0x30, 0x3f, 0x85, 0x66, 0x06, 0x55, 0x99, 0x68, 0xc1, 0xa4, 0xe7, 0xfb, 0x24,
+	0x19, 0x13, 0x24, 0xc1, 0x73, 0xe0, 0x20, 0x8a, 0xce, 0xc9, 0x41, 0x9e, 0xa5, 0x2c, 0x6f, 0x40,
+	0xfb, 0xb5, 0x86, 0xf3, 0x2b, 0x5d, 0x58, 0x12, 0x66, 0x54, 0x49, 0xb0, 0x77, 0xc0, 0xca, 0x0b,
+	0x5c, 0xab, 0x18, 0xdd, 0xd4, 0xf5, 0x8c, 0x4e, 0x6b, 0x62, 0xfe, 0x1d, 0x3e, 0x43, 0xd9, 0xab,
+	0xb0, 0x72, 0x9e, 0x32, 0x15, 0x67, 0x13, 0xe6, 0x76, 0xa3, 0x80, 0xc9, 0x0b, 0x98, 0x24, 0xe6,
+	0x33, 0x30, 0x75, 0xf0, 0x0a, 0x27, 0xed, 0x47, 0x03, 0x56, 0xf6, 0x71, 0xdc, 0x0f, 0xc5, 0xb4,
+	0x1a, 0x7b, 0x04, 0x45, 0xec, 0x1b, 0xdc, 0x27, 0x91, 0x17, 0x26, 0x7e, 0x7f, 0x04, 0xb3, 0xe2,
+	0xf1, 0xd3, 0x25, 0xc8, 0x63, 0xc8, 0x77, 0xa3, 0xe4, 0x59, 0x5a, 0xe3, 0xf0, 0x23, 0x89, 0x7e,
+	0x4b, 0xf9, 0xd3, 0xd5, 0xeb, 0x72, 0xa5, 0x7a, 0xe3, 0x00, 0x09, 0x89, 0xe6, 0xf1, 0x15, 0x54,
+	0x7b, 0xc2, 0x33, 0xd7, 0x0b, 0x03, 0x4f, 0x36, 0x90, 0xca, 0xe6, 0xfc, 0xf8, 0x04, 0xbe, 0xc5,
+	0x99, 0x4e, 0x45, 0x2e, 0x15, 0x84, 0xf9, 0x39, 0xb4, 0xb4, 0x52, 0x35, 0x1a, 0x54, 0x27, 0x84,
+	0x8d, 0xa6, 0xc6, 0x4b, 0xe7, 0xd5, 0xdb, 0x70, 0xeb, 0xdc, 0xb8, 0x54, 0x0a, 0xff, 0x60, 0xc8,
+	0x74, 0xa9, 0x44, 0x27, 0xf1, 0xfe, 0x3f, 0x4c, 0xc9, 0xf5, 0x96, 0x71, 0x91, 0x83, 0x6a, 0xd1,
+	0xb9, 0xbe, 0x95, 0xce, 0xf5, 0xad, 0x28, 0xa3, 0xe5, 0x82, 0x8c, 0xf2, 0xfa, 0x9e, 0xf1, 0x6f,
+	0x34, 0x02, 0x3d, 0x46, 0x3d, 0xcc, 0x50, 0x76, 0xf3, 0x7f, 0x67, 0x40, 0x2b, 0x8b, 0xab, 0xfd,
+	0xbf, 0x07, 0x4d, 0x1f, 0xc5, 0x04, 0x75, 0x85, 0xb1, 0xec, 0x51, 0x78, 0x58, 0xb2, 0x0c, 0xc7,
+	0x1c, 0xb1, 0x53, 0x1f, 0x1f, 0x42, 0x4d, 0x6d, 0x96, 0xea, 0x19, 0xa5, 0xab, 0xf4, 0x8c, 0x6a,
+	0x4f, 0xa3, 0xf8, 0x15, 0x7e, 0x15, 0xf9, 0xb8, 0xc8, 0xd9, 0x25, 0xb0, 0xf2, 0x2c, 0x15, 0xdf,
+	0x72, 0xda, 0x24, 0xdf, 0x78, 0x74, 0x9f, 0x60, 0xbe, 0xc4, 0x4f, 0x04, 0x3f, 0x80, 0xa5, 0x22,
+	0xa6, 0x12, 0xfd, 0x1b, 0xff, 0x9c, 0x8e, 0xb2, 0xb7, 0xe2, 0x5d, 0x37, 0xb4, 0x60, 0x77, 0x4a,
+	0x45, 0xe7, 0xfd, 0x3e, 0xb4, 0xc5, 0x33, 0x81, 0x27, 0x88, 0xb0, 0x82, 0x37, 0xc2, 0xbc, 0x60,
+	0x8f, 0x57, 0xcb, 0xfc, 0x73, 0x6b, 0xa2, 0xe0, 0xb9, 0xd5, 0x84, 0x39, 0x2d, 0x0e, 0x15, 0xdd,
+	0x33, 0x3d, 0x76, 0x07, 0x09, 0xbb, 0xc8, 0xbf, 0x5e, 0x98, 0xf6, 0x4d, 0x58, 0x2e, 0x54, 0xa6,
+	0x6c, 0xfd, 0x86, 0xd7, 0xf9, 0x4c, 0x03, 0xdb, 0x8a, 0x7c, 0xfe, 0x31, 0x42, 0x1f, 0x35, 0xcc,
+	0xef, 0x61, 0x9e, 0x32, 0x1c, 0xeb, 0xc1, 0xbb, 0x3d, 0xec, 0x27, 0xaf, 0xeb, 0xbb, 0x05, 0x13,
+	0x4c, 0xb6, 0x29, 0x62, 0x1f, 0x39, 0x4d, 0x9a, 0x07, 0xf9, 0xe3, 0xe5, 0xce, 0x85, 0x0e, 0xa4,
+	0x1f, 0x22, 0x6a, 0xc7, 0xc3, 0x0e, 0x09, 0x7c, 0xf7, 0x4a, 0xb3, 0x93, 0x38, 0xef, 0x55, 0x29,
+	0x21, 0x11, 0xf3, 0x17, 0xe9, 0x58, 0x24, 0x8f, 0xf8, 0x47, 0x97, 0x39, 0x9d, 0x9f, 0x8f, 0xd4,
+	0x39, 0xcc, 0x16, 0x12, 0x3e, 0xe9, 0x8c, 0x33, 0xae, 0x50, 0x91, 0x0f, 0xa0, 0xf6, 0xd0, 0xeb,
+	0x9e, 0xf4, 0xd3, 0x49, 0x76, 0x15, 0x2a, 0x5d, 0x1c, 0x75, 0xfb, 0x84, 0xa0, 0xa8, 0x3b, 0x54,
+	0xb5, 0x57, 0x87, 0xf8, 0x0a, 0xf1, 0x1c, 0x95, 0xc7, 0x45, 0xbd, 0x61, 0x75, 0xc8, 0xbe, 0x0f,
+	0xf5, 0x44, 0xa9, 0x72, 0xe1, 0x2e, 0x4c, 0xa2, 0xc1, 0xe8, 0xb0, 0xd4, 0xd7, 0x93, 0xbf, 0xcc,
+	0x6d, 0x73, 0xd4, 0x91, 0x4c, 0xd5, 0x69, 0x19, 0x26, 0x68, 0x87, 0xe0, 0x5e, 0xc6, 0x2f, 0x7b,
+	0x0b, 0x16, 0x0b, 0x78, 0xef, 0xa4, 0x9e, 0x7f, 0x03, 0x0a, 0xbd, 0x01, 0xca, 0xce, 0xaf, 0x3b,
+	0xd0, 0xcc, 0xa0, 0xd7, 0x1d, 0x8f, 0x4d, 0x68, 0xf0, 0x9d, 0x13, 0xba, 0x12, 0xdd, 0xfc, 0x5e,
+	0x8d, 0x30, 0x75, 0xd6, 0xbf, 0x87, 0x76, 0x0a, 0xbe, 0xdf, 0x31, 0xf0, 0x3e, 0x58, 0x79, 0xcd,
+	0x57, 0x38, 0x04, 0xc2, 0x4d, 0x8f, 0xb0, 0x8c, 0xef, 0x3c, 0x5b, 0x1a, 0xa8, 0x9c, 0xff, 0x15,
+	0x2c, 0x8f, 0xd0, 0xf7, 0x3e, 0xee, 0xad, 0xc0, 0x07, 0xc5, 0xda, 0x95, 0x75, 0x53, 0x7e, 0x5e,
+	0xe6, 0xdc, 0x74, 0xff, 0xfe, 0x0f, 0xe6, 0x34, 0xec, 0xc2, 0x21, 0xef, 0xf7, 0x06, 0x34, 0x78,
+	0x8b, 0xd3, 0xe3, 0xfc, 0x09, 0x35, 0x60, 0x35, 0x64, 0x65, 0x13, 0xce, 0x87, 0x73, 0x0e, 0x14,
+	0x34, 0x27, 0x3e, 0x9c, 0xe7, 0x58, 0x4a, 0x6c, 0x77, 0xc4, 0xfb, 0x6f, 0x4b, 0xf7, 0x32, 0x2c,
+	0x16, 0xa8, 0x4a, 0xcf, 0x43, 0xf5, 0xf5, 0xa5, 0x53, 0x2f, 0x3f, 0x16, 0xa7, 0x98, 0x9c, 0x1c,
+	0x86, 0xf8, 0x34, 0x19, 0x3e, 0x13, 0x9a, 0xf3, 0x4e, 0xd0, 0x90, 0xc6, 0x5e, 0x17, 0xa9, 0x3f,
+	0x26, 0xa4, 0xb4, 0xfd, 0x35, 0xd4, 0x5e, 0x5f, 0x77, 0x44, 0x7e, 0xf8, 0xd9, 0x0f, 0xeb, 0x83,
+	0x80, 0x21, 0x4a, 0xd7, 0x03, 0xbc, 0x21, 0x7f, 0x6d, 0x1c, 0xe1, 0x8d, 0x01, 0xdb, 0x10, 0xff,
+	0x1d, 0x60, 0x23, 0xf7, 0xd9, 0xa8, 0x33, 0x25, 0x18, 0xf7, 0xfe, 0x33, 0x00, 0x7a, 0x2a, 0xfa,
+	0x86, 0x98, 0x20, 0x00, 0x00,
 }
@@ -29,78 +29,79 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor_9ee75fe63cfd9360) }
 
 var fileDescriptor_9ee75fe63cfd9360 = []byte{
-	// 1134 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x6d, 0x6f, 0x23, 0x35,
-	0x10, 0xc7, 0xa9, 0x04, 0x27, 0xe1, 0xe3, 0xd1, 0x9c, 0x38, 0xa9, 0x48, 0x70, 0x70, 0x2d, 0x1c,
-	0xcd, 0x91, 0xdc, 0x03, 0xc7, 0xfb, 0xdc, 0x43, 0x7b, 0x45, 0x57, 0x11, 0x92, 0x6b, 0x8b, 0x40,
-	0x3a, 0xc9, 0x4d, 0xa6, 0xc9, 0xd2, 0xcd, 0x7a, 0xb1, 0x9d, 0x88, 0xbe, 0x42, 0xe2, 0x2d, 0x12,
-	0x9f, 0x8d, 0x8f, 0x84, 0xf6, 0xc1, 0xde, 0xf1, 0xee, 0xac, 0xb3, 0x79, 0x17, 0xe5, 0xff, 0xf3,
-	0x8c, 0x3d, 0x1e, 0x8f, 0x67, 0xcd, 0x76, 0x8d, 0xb8, 0x88, 0xc1, 0x2c, 0x45, 0x22, 0xe6, 0xa0,
-	0x34, 0xa8, 0x75, 0x34, 0x85, 0x7e, 0xaa, 0xa4, 0x91, 0xfc, 0x16, 0xa5, 0xed, 0xde, 0xf6, 0xfe,
-	0x9d, 0x09, 0x23, 0x0a, 0xfc, 0xd1, 0x7f, 0x3d, 0xf6, 0xfe, 0xeb, 0x5c, 0x3b, 0x29, 0x34, 0x7e,
-	0xcc, 0xde, 0x1e, 0x45, 0xc9, 0x9c, 0x7f, 0xde, 0x6f, 0x8e, 0xc9, 0x84, 0x31, 0xfc, 0xb1, 0x02,
-	0x6d, 0x76, 0xbf, 0x68, 0xd5, 0x75, 0x2a, 0x13, 0x0d, 0x5f, 0xbd, 0xc5, 0x5f, 0xb1, 0x77, 0x26,
-	0x31, 0x40, 0xca, 0x29, 0x36, 0x57, 0xac, 0xb1, 0x3b, 0xed, 0x80, 0xb3, 0xf6, 0x86, 0xdd, 0x7c,
-	0xf1, 0x27, 0x4c, 0x57, 0x06, 0x5e, 0x4a, 0x79, 0xc5, 0xf7, 0x89, 0x21, 0x48, 0xb7, 0x96, 0xbf,
-	0xde, 0x84, 0x39, 0xfb, 0xbf, 0xb0, 0x77, 0x8f, 0xc0, 0x4c, 0xa6, 0x0b, 0x58, 0x0a, 0x7e, 0x97,
-	0x18, 0xe6, 0x54, 0x6b, 0x7b, 0x2f, 0x0c, 0x39, 0xcb, 0x73, 0xf6, 0xc1, 0x11, 0x98, 0x11, 0xa8,
-	0x65, 0xa4, 0x75, 0x24, 0x13, 0xcd, 0xef, 0xd1, 0x23, 0x11, 0x62, 0x7d, 0x7c, 0xdb, 0x81, 0xc4,
-	0x21, 0x9a, 0x80, 0x19, 0x83, 0x98, 0xfd, 0x94, 0xc4, 0xd7, 0x64, 0x88, 0x90, 0x1e, 0x0a, 0x91,
-	0x87, 0x39, 0xfb, 0x82, 0xbd, 0x57, 0x0a, 0xe7, 0x2a, 0x32, 0xc0, 0x03, 0x23, 0x73, 0xc0, 0x7a,
-	0xf8, 0x66, 0x23, 0xe7, 0x5c, 0xfc, 0xc6, 0xd8, 0xb3, 0x85, 0x48, 0xe6, 0xf0, 0xfa, 0x3a, 0x05,
-	0x4e, 0x45, 0xb8, 0x92, 0xad, 0xf9, 0xfd, 0x0d, 0x14, 0x9e, 0xff, 0x18, 0x2e, 0x15, 0xe8, 0xc5,
-	0xc4, 0x88, 0x96, 0xf9, 0x63, 0x20, 0x34, 0x7f, 0x9f, 0xc3, 0x7b, 0x3d, 0x5e, 0x25, 0x2f, 0x41,
-	0xc4, 0x66, 0xf1, 0x6c, 0x01, 0xd3, 0x2b, 0x72, 0xaf, 0x7d, 0x24, 0xb4, 0xd7, 0x75, 0xd2, 0x39,
-	0x4a, 0xd9, 0xc7, 0xc7, 0xf3, 0x44, 0x2a, 0x28, 0xe4, 0x17, 0x4a, 0x49, 0xc5, 0x7b, 0x84, 0x85,
-	0x06, 0x65, 0xdd, 0xdd, 0xef, 0x06, 0xfb, 0xd1, 0x8b, 0xa5, 0x98, 0x95, 0x67, 0x84, 0x8e, 0x5e,
-	0x05, 0x84, 0xa3, 0x87, 0x39, 0xe7, 0xe2, 0x77, 0xf6, 0xe1, 0x48, 0xc1, 0x65, 0x1c, 0xcd, 0x17,
-	0xf6, 0x24, 0x52, 0x41, 0xa9, 0x31, 0xd6, 0xd1, 0x41, 0x17, 0x14, 0x1f, 0x96, 0x61, 0x9a, 0xc6,
-	0xd7, 0xa5, 0x1f, 0x2a, 0x89, 0x90, 0x1e, 0x3a, 0x2c, 0x1e, 0x86, 0x33, 0xf9, 0x95, 0x9c, 0x5e,
-	0xe5, 0xd5, 0x55, 0x93, 0x99, 0x5c, 0xc9, 0xa1, 0x4c, 0xc6, 0x14, 0xde, 0x8b, 0xd3, 0x24, 0xae,
-	0xcc, 0x53, 0xd3, 0xc2, 0x40, 0x68, 0x2f, 0x7c, 0x0e, 0x27, 0x58, 0x59, 0x28, 0x0f, 0xc1, 0x4c,
-	0x17, 0x43, 0xfd, 0xfc, 0x42, 0x90, 0x09, 0xd6, 0xa0, 0x42, 0x09, 0x46, 0xc0, 0xce, 0xe3, 0x5f,
-	0xec, 0x53, 0x5f, 0x1e, 0xc6, 0xf1, 0x48, 0x45, 0x6b, 0xcd, 0x1f, 0x6c, 0xb4, 0x64, 0x51, 0xeb,
-	0xfb, 0xe1, 0x16, 0x23, 0xda, 0x97, 0x3c, 0x4c, 0xd3, 0x0e, 0x4b, 0x1e, 0xa6, 0x69, 0xf7, 0x25,
-	0xe7, 0x30, 0xf6, 0x38, 0x86, 0x34, 0x8e, 0xa6, 0xc2, 0x44, 0x32, 0xc9, 0x8a, 0xc9, 0x4a, 0x93,
-	0x1e, 0x1b, 0x54, 0xc8, 0x23, 0x01, 0xe3, 0xcc, 0x39, 0x11, 0xda, 0x80, 0x2a, 0x9d, 0x51, 0x99,
-	0x83, 0x81, 0x50, 0xe6, 0xf8, 0x1c, 0xae, 0x81, 0x85, 0x32, 0x92, 0x3a, 0xca, 0x26, 0x41, 0xd6,
-	0x40, 0x1f, 0x09, 0xd5, 0xc0, 0x3a, 0x89, 0xcb, 0xc5, 0xb9, 0x88, 0xcc, 0xa1, 0xac, 0x3c, 0x51,
-	0xe3, 0x6b, 0x4c, 0xa8, 0x5c, 0x34, 0x50, 0xec, 0x6b, 0x62, 0x64, 0x8a, 0x42, 0x4b, 0xfa, 0xaa,
-	0x31, 0x21, 0x5f, 0x0d, 0x14, 0x1f, 0x84, 0x9a, 0x78, 0x12, 0x25, 0xd1, 0x72, 0xb5, 0x24, 0x0f,
-	0x02, 0x8d, 0x86, 0x0e, 0x42, 0xdb, 0x08, 0x37, 0x81, 0x25, 0xfb, 0x68, 0x62, 0x84, 0x32, 0x78,
-	0xb5, 0xf4, 0x12, 0x7c, 0xc8, 0x3a, 0xed, 0x75, 0x62, 0x9d, 0xbb, 0x7f, 0x76, 0xd8, 0x6e, 0x5d,
-	0x3e, 0x4d, 0x4c, 0x14, 0x0f, 0x2f, 0x0d, 0x28, 0xfe, 0x7d, 0x07, 0x6b, 0x15, 0x6e, 0xe7, 0xf0,
-	0x64, 0xcb, 0x51, 0xf8, 0x62, 0x38, 0x02, 0x4b, 0x69, 0xf2, 0x62, 0x40, 0x7a, 0xe8, 0x62, 0xf0,
-	0x30, 0x1c, 0xdc, 0x33, 0x34, 0x87, 0xac, 0x3c, 0x90, 0xc1, 0xad, 0x43, 0xa1, 0xe0, 0x36, 0x59,
-	0x9c, 0x4c, 0x58, 0xad, 0x32, 0x9c, 0x4c, 0x26, 0x1a, 0x0d, 0x25, 0x53, 0xdb, 0x08, 0xbc, 0xde,
-	0x31, 0x68, 0xd8, 0x98, 0x4c, 0x75, 0x28, 0xb4, 0xde, 0x26, 0x8b, 0xef, 0xdd, 0xe3, 0x24, 0x32,
-	0x45, 0xd1, 0x20, 0xef, 0xdd, 0x4a, 0x0e, 0xdd, 0xbb, 0x98, 0x72, 0xc6, 0xff, 0xde, 0x61, 0xb7,
-	0x47, 0x32, 0x5d, 0xc5, 0x79, 0xd7, 0x97, 0x0a, 0x05, 0x89, 0xf9, 0x51, 0xae, 0x54, 0x22, 0x62,
-	0x4e, 0x05, 0xa7, 0x85, 0xb5, 0x7e, 0x1f, 0x6d, 0x33, 0x04, 0x27, 0x68, 0x36, 0xb9, 0x72, 0xf9,
-	0xbc, 0x6d, 0xf2, 0xa5, 0x1e, 0x4a, 0x50, 0x0f, 0xc3, 0x57, 0xc4, 0x73, 0x58, 0x4a, 0x03, 0x65,
-	0x0c, 0xa9, 0x91, 0x18, 0x08, 0x5d, 0x11, 0x3e, 0x87, 0x73, 0xe2, 0x34, 0x99, 0x49, 0xcf, 0xcd,
-	0x01, 0xd9, 0x9b, 0xf8, 0x50, 0x28, 0x27, 0x9a, 0xac, 0x73, 0xa7, 0x19, 0x2f, 0x97, 0x79, 0x2e,
-	0xf4, 0x48, 0xc9, 0x0c, 0x9a, 0xf1, 0xc0, 0xd5, 0x89, 0x30, 0xeb, 0xf2, 0xbb, 0x8e, 0x34, 0xfe,
-	0xa0, 0x9c, 0x80, 0xcd, 0xc3, 0xbb, 0xf4, 0x27, 0x90, 0xbf, 0xaa, 0xbd, 0x30, 0xe4, 0x2c, 0xaf,
-	0xd9, 0x27, 0x95, 0xe7, 0x31, 0xe8, 0xac, 0xaa, 0xc1, 0x8c, 0x87, 0x67, 0xe8, 0x38, 0xeb, 0xad,
-	0xdf, 0x15, 0x77, 0x7e, 0xff, 0xdd, 0x61, 0x9f, 0xd5, 0xee, 0x8e, 0x61, 0x32, 0xcb, 0x3e, 0x79,
-	0x8b, 0x5e, 0xe2, 0xc9, 0xe6, 0xbb, 0x06, 0xf3, 0x76, 0x22, 0x3f, 0x6c, 0x3b, 0x0c, 0x77, 0x1a,
-	0x65, 0xe0, 0xed, 0x61, 0xb8, 0x47, 0x7e, 0x03, 0x60, 0x24, 0xd4, 0x69, 0xd4, 0x49, 0xe7, 0xe8,
-	0x67, 0x76, 0xe3, 0xa9, 0x98, 0x5e, 0xad, 0x52, 0x4e, 0x3d, 0x55, 0x14, 0x92, 0x35, 0xfc, 0x65,
-	0x80, 0xb0, 0x06, 0x1f, 0xec, 0x70, 0x95, 0xb5, 0x7e, 0xda, 0x48, 0x05, 0x87, 0x4a, 0x2e, 0x4b,
-	0xeb, 0x2d, 0xb5, 0xce, 0xa7, 0xc2, 0xad, 0x5f, 0x03, 0x46, 0x3e, 0xdf, 0xb0, 0x9b, 0x93, 0x58,
-	0xac, 0xa1, 0xdc, 0x2f, 0xf2, 0x81, 0xa0, 0xd2, 0x83, 0x0f, 0x04, 0x18, 0xf3, 0x52, 0xde, 0xc8,
-	0x34, 0x17, 0xe9, 0x94, 0xb7, 0x6a, 0x30, 0xe5, 0x2b, 0xc8, 0xef, 0x48, 0xca, 0xbf, 0x6d, 0x33,
-	0x74, 0x10, 0x1a, 0x5b, 0x6b, 0x83, 0x7a, 0x9d, 0x58, 0x7c, 0x89, 0xe4, 0xbd, 0x42, 0xb1, 0x92,
-	0xbd, 0xb6, 0x56, 0xc2, 0x5b, 0xca, 0xfe, 0x06, 0xca, 0x19, 0xbf, 0x66, 0xb7, 0xaa, 0xff, 0x51,
-	0x9f, 0xd3, 0x0f, 0x1a, 0x68, 0x76, 0x38, 0x83, 0xce, 0x7c, 0xfd, 0x91, 0x2b, 0xd3, 0x75, 0xeb,
-	0x23, 0x57, 0xae, 0x6e, 0x7a, 0xe4, 0x2a, 0x21, 0x6c, 0x39, 0xbb, 0x4d, 0xda, 0xb7, 0xde, 0xa9,
-	0x21, 0xcb, 0x08, 0xf2, 0xb6, 0x3e, 0xfb, 0x0b, 0x97, 0xee, 0x83, 0xb6, 0x94, 0x24, 0x0a, 0x77,
-	0xaf, 0x13, 0x8b, 0x3f, 0xc9, 0xac, 0x5a, 0x95, 0xd6, 0x90, 0x8d, 0x46, 0x61, 0xbd, 0xdf, 0x0d,
-	0xc6, 0xef, 0xa4, 0x67, 0x79, 0x17, 0x48, 0xbd, 0x93, 0x9e, 0xe1, 0xd6, 0xef, 0x4e, 0x3b, 0x60,
-	0xad, 0x3d, 0x7d, 0xfc, 0xeb, 0xc3, 0x75, 0x64, 0x40, 0xeb, 0x7e, 0x24, 0x07, 0xc5, 0xaf, 0xc1,
-	0x5c, 0x0e, 0xd6, 0x66, 0x90, 0x3f, 0xf9, 0x0e, 0xa8, 0x07, 0xe2, 0x8b, 0x1b, 0xb9, 0xf6, 0xf8,
-	0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x3f, 0xf9, 0x7a, 0x5b, 0x16, 0x00, 0x00,
+	// 1147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x5b, 0x6f, 0x23, 0x35,
+	0x14, 0xc7, 0xa9, 0x04, 0x2b, 0x61, 0xee, 0x66, 0xc5, 0x4a, 0x45, 0x82, 0x85, 0xdd, 0xc2, 0x92,
+	0x96, 0x64, 0x2f, 0x2c, 0xef, 0xd9, 0x4b, 0x2f, 0x68, 0x2b, 0x42, 0xb2, 0x6d, 0x11, 0x48, 0x48,
+	0x6e, 0x72, 0x9a, 0x0c, 0x9d, 0x8c, 0x07, 0xdb, 0x89, 0xc8, 0x13, 0x12, 0xaf, 0x48, 0x7c, 0x25,
+	0xbe, 0x1a, 0x9a, 0x8b, 0x3d, 0xc7, 0x33, 0x67, 0x9c, 0xe9, 0x5b, 0x94, 0xff, 0xcf, 0xe7, 0xef,
+	0xcb, 0xb1, 0x7d, 0xc6, 0x6c, 0xd7, 0x88, 0xcb, 0x18, 0xcc, 0x52, 0x24, 0x62, 0x0e, 0x4a, 0x83,
+	0x5a, 0x47, 0x53, 0xe8, 0xa7, 0x4a, 0x1a, 0xc9, 0x6f, 0x53, 0xda, 0xee, 0x1d, 0xef, 0xdf, 0x99,
+	0x30, 0xa2, 0xc0, 0x1f, 0xff, 0x77, 0xc0, 0xde, 0x7b, 0x9d, 0x6b, 0xa7, 0x85, 0xc6, 0x4f, 0xd8,
+	0x9b, 0xa3, 0x28, 0x99, 0xf3, 0xcf, 0xfa, 0xcd, 0x36, 0x99, 0x30, 0x86, 0x3f, 0x56, 0xa0, 0xcd,
+	0xee, 0xe7, 0xad, 0xba, 0x4e, 0x65, 0xa2, 0xe1, 0xcb, 0x37, 0xf8, 0x2b, 0xf6, 0xd6, 0x24, 0x06,
+	0x48, 0x39, 0xc5, 0xe6, 0x8a, 0x0d, 0x76, 0xb7, 0x1d, 0x70, 0xd1, 0x7e, 0x63, 0xef, 0xbc, 0xfc,
+	0x13, 0xa6, 0x2b, 0x03, 0xc7, 0x52, 0x5e, 0xf3, 0x3d, 0xa2, 0x09, 0xd2, 0x6d, 0xe4, 0xaf, 0xb6,
+	0x61, 0x2e, 0xfe, 0xcf, 0xec, 0xed, 0x23, 0x30, 0x93, 0xe9, 0x02, 0x96, 0x82, 0xdf, 0x23, 0x9a,
+	0x39, 0xd5, 0xc6, 0xbe, 0x1f, 0x86, 0x5c, 0xe4, 0x25, 0xfb, 0xd0, 0xfd, 0x7d, 0x1c, 0x69, 0x23,
+	0xd5, 0x86, 0xf7, 0x42, 0x6d, 0x4b, 0xc8, 0xfa, 0xec, 0x77, 0x62, 0x9d, 0xdd, 0x9c, 0xbd, 0x7f,
+	0x04, 0x66, 0x04, 0x6a, 0x19, 0x69, 0x1d, 0xc9, 0x44, 0xf3, 0x07, 0x74, 0x00, 0x84, 0x58, 0xab,
+	0x6f, 0x3a, 0x90, 0x78, 0x45, 0x26, 0x60, 0xc6, 0x20, 0x66, 0x3f, 0x26, 0xf1, 0x86, 0x5c, 0x11,
+	0xa4, 0x87, 0x56, 0xc4, 0xc3, 0x5c, 0x7c, 0xc1, 0xde, 0x2d, 0x85, 0x0b, 0x15, 0x19, 0xe0, 0x81,
+	0x96, 0x39, 0x60, 0x1d, 0xbe, 0xde, 0xca, 0x39, 0x8b, 0x5f, 0x19, 0x7b, 0xbe, 0x10, 0xc9, 0x1c,
+	0x5e, 0x6f, 0x52, 0xe0, 0xd4, 0x82, 0x56, 0xb2, 0x0d, 0xbf, 0xb7, 0x85, 0xc2, 0xfd, 0x1f, 0xc3,
+	0x95, 0x02, 0xbd, 0x98, 0x18, 0xd1, 0xd2, 0x7f, 0x0c, 0x84, 0xfa, 0xef, 0x73, 0x78, 0xad, 0xc7,
+	0xab, 0xe4, 0x18, 0x44, 0x6c, 0x16, 0xcf, 0x17, 0x30, 0xbd, 0x26, 0xd7, 0xda, 0x47, 0x42, 0x6b,
+	0x5d, 0x27, 0x9d, 0x51, 0xca, 0x3e, 0x3a, 0x99, 0x27, 0x52, 0x41, 0x21, 0xbf, 0x54, 0x4a, 0x2a,
+	0x4e, 0x25, 0x66, 0x83, 0xb2, 0x76, 0x07, 0xdd, 0x60, 0x7f, 0xf6, 0x62, 0x29, 0x66, 0xe5, 0x96,
+	0xa4, 0x67, 0xaf, 0x02, 0xc2, 0xb3, 0x87, 0x39, 0x67, 0xf1, 0x3b, 0xfb, 0x60, 0xa4, 0xe0, 0x2a,
+	0x8e, 0xe6, 0x0b, 0xbb, 0xf1, 0xa9, 0x49, 0xa9, 0x31, 0xd6, 0xa8, 0xd7, 0x05, 0xc5, 0x9b, 0x65,
+	0x98, 0xa6, 0xf1, 0xa6, 0xf4, 0xa1, 0x92, 0x08, 0xe9, 0xa1, 0xcd, 0xe2, 0x61, 0x38, 0x93, 0x5f,
+	0xc9, 0xe9, 0x75, 0x7e, 0x98, 0x6b, 0x32, 0x93, 0x2b, 0x39, 0x94, 0xc9, 0x98, 0xc2, 0x6b, 0x71,
+	0x96, 0xc4, 0x55, 0x78, 0xaa, 0x5b, 0x18, 0x08, 0xad, 0x85, 0xcf, 0xe1, 0x04, 0x2b, 0xcf, 0xe5,
+	0x43, 0x30, 0xd3, 0xc5, 0x50, 0xbf, 0xb8, 0x14, 0x64, 0x82, 0x35, 0xa8, 0x50, 0x82, 0x11, 0xb0,
+	0x73, 0xfc, 0x8b, 0x7d, 0xe2, 0xcb, 0xc3, 0x38, 0x1e, 0xa9, 0x68, 0xad, 0xf9, 0xc3, 0xad, 0x91,
+	0x2c, 0x6a, 0xbd, 0x1f, 0xdd, 0xa0, 0x45, 0xfb, 0x90, 0x87, 0x69, 0xda, 0x61, 0xc8, 0xc3, 0x34,
+	0xed, 0x3e, 0xe4, 0x1c, 0xc6, 0x8e, 0x63, 0x48, 0xe3, 0x68, 0x2a, 0x4c, 0x24, 0x93, 0x89, 0x11,
+	0x66, 0xa5, 0x49, 0xc7, 0x06, 0x15, 0x72, 0x24, 0x60, 0x9c, 0x39, 0xa7, 0x42, 0x1b, 0x50, 0xa5,
+	0x19, 0x95, 0x39, 0x18, 0x08, 0x65, 0x8e, 0xcf, 0xe1, 0x33, 0xb0, 0x50, 0x46, 0x52, 0x47, 0x59,
+	0x27, 0xc8, 0x33, 0xd0, 0x47, 0x42, 0x67, 0x60, 0x9d, 0xc4, 0xc7, 0xc5, 0x85, 0x88, 0xcc, 0xa1,
+	0xac, 0x9c, 0xa8, 0xf6, 0x35, 0x26, 0x74, 0x5c, 0x34, 0x50, 0xec, 0x35, 0x31, 0x32, 0x45, 0x53,
+	0x4b, 0x7a, 0xd5, 0x98, 0x90, 0x57, 0x03, 0xc5, 0x1b, 0xa1, 0x26, 0x9e, 0x46, 0x49, 0xb4, 0x5c,
+	0x2d, 0xc9, 0x8d, 0x40, 0xa3, 0xa1, 0x8d, 0xd0, 0xd6, 0x02, 0x17, 0x48, 0x13, 0x23, 0x94, 0xc1,
+	0xa3, 0xa5, 0x87, 0xe0, 0x43, 0xa1, 0x02, 0xa9, 0xc9, 0x3a, 0xbb, 0x7f, 0x76, 0xd8, 0x6e, 0x5d,
+	0x3e, 0x4b, 0x4c, 0x14, 0x0f, 0xaf, 0x0c, 0x28, 0xfe, 0x5d, 0x87, 0x68, 0x15, 0x6e, 0xfb, 0xf0,
+	0xf4, 0x86, 0xad, 0xf0, 0xc5, 0x70, 0x04, 0x96, 0xd2, 0xe4, 0xc5, 0x80, 0xf4, 0xd0, 0xc5, 0xe0,
+	0x61, 0x78, 0x72, 0xcf, 0x51, 0x1f, 0xb2, 0xe3, 0x81, 0x9c, 0xdc, 0x3a, 0x14, 0x9a, 0xdc, 0x26,
+	0x8b, 0x93, 0x09, 0xab, 0x55, 0x86, 0x93, 0xc9, 0x44, 0xa3, 0xa1, 0x64, 0x6a, 0x6b, 0x81, 0xc7,
+	0x3b, 0x06, 0x0d, 0x5b, 0x93, 0xa9, 0x0e, 0x85, 0xc6, 0xdb, 0x64, 0xf1, 0xbd, 0x7b, 0x92, 0x44,
+	0xa6, 0x38, 0x34, 0xc8, 0x7b, 0xb7, 0x92, 0x43, 0xf7, 0x2e, 0xa6, 0x5c, 0xf0, 0xbf, 0x77, 0xd8,
+	0x9d, 0x91, 0x4c, 0x57, 0xb1, 0x30, 0x30, 0x86, 0x54, 0x28, 0x48, 0xcc, 0x0f, 0x72, 0xa5, 0x12,
+	0x11, 0x73, 0x6a, 0x72, 0x5a, 0x58, 0xeb, 0xfb, 0xf8, 0x26, 0x4d, 0x70, 0x82, 0x66, 0x9d, 0x2b,
+	0x87, 0xcf, 0xdb, 0x3a, 0x5f, 0xea, 0xa1, 0x04, 0xf5, 0x30, 0x7c, 0x45, 0xbc, 0x80, 0xa5, 0x34,
+	0x50, 0xce, 0x21, 0xd5, 0x12, 0x03, 0xa1, 0x2b, 0xc2, 0xe7, 0x70, 0x4e, 0x9c, 0x25, 0x33, 0xe9,
+	0xd9, 0xf4, 0xc8, 0xda, 0x64, 0x26, 0x29, 0xab, 0xfd, 0x4e, 0xac, 0xb3, 0xd3, 0x8c, 0x97, 0xc3,
+	0xbc, 0x10, 0x7a, 0xa4, 0x64, 0x06, 0xcd, 0x78, 0xe0, 0xea, 0x44, 0x98, 0xb5, 0xfc, 0xb6, 0x23,
+	0x8d, 0xbf, 0x5f, 0x27, 0x60, 0xf3, 0xf0, 0x1e, 0xfd, 0x09, 0xe4, 0x8f, 0xea, 0x7e, 0x18, 0x72,
+	0x91, 0xd7, 0xec, 0xe3, 0xca, 0x79, 0x0c, 0xda, 0x08, 0x95, 0x8d, 0x27, 0xdc, 0x43, 0xc7, 0x59,
+	0xb7, 0x7e, 0x57, 0xdc, 0xf9, 0xfe, 0xbb, 0xc3, 0x3e, 0xad, 0xdd, 0x1d, 0xc3, 0x64, 0x96, 0x7d,
+	0xf9, 0x16, 0xb5, 0xc4, 0xd3, 0xed, 0x77, 0x0d, 0xe6, 0x6d, 0x47, 0xbe, 0xbf, 0x69, 0x33, 0x5c,
+	0x69, 0x94, 0x13, 0x6f, 0x37, 0xc3, 0x03, 0xf2, 0x1b, 0x00, 0x23, 0xa1, 0x4a, 0xa3, 0x4e, 0x3a,
+	0xa3, 0x9f, 0xd8, 0xad, 0x67, 0x62, 0x7a, 0xbd, 0x4a, 0x39, 0xf5, 0x32, 0x52, 0x48, 0x36, 0xf0,
+	0x17, 0x01, 0xc2, 0x06, 0x7c, 0xb8, 0xc3, 0x55, 0x56, 0xfa, 0x69, 0x23, 0x15, 0x1c, 0x2a, 0xb9,
+	0x2c, 0xa3, 0xb7, 0x9c, 0x75, 0x3e, 0x15, 0x2e, 0xfd, 0x1a, 0x30, 0xf2, 0xcc, 0x1e, 0x08, 0x62,
+	0xb1, 0x86, 0x72, 0xbd, 0xc8, 0x07, 0x82, 0x4a, 0x0f, 0x3e, 0x10, 0x60, 0xcc, 0x4b, 0x79, 0x23,
+	0xd3, 0x5c, 0xa4, 0x53, 0xde, 0xaa, 0xc1, 0x94, 0xaf, 0x20, 0xbf, 0x22, 0x29, 0xff, 0xb6, 0xc5,
+	0x50, 0x2f, 0xd4, 0xb6, 0x56, 0x06, 0xed, 0x77, 0x62, 0xf1, 0x25, 0x92, 0xd7, 0x0a, 0xc5, 0x48,
+	0xee, 0xb7, 0x95, 0x12, 0xde, 0x50, 0xf6, 0xb6, 0x50, 0x2e, 0xf8, 0x86, 0xdd, 0xae, 0xfe, 0x47,
+	0x75, 0x4e, 0x3f, 0x18, 0xa0, 0x59, 0xe1, 0x0c, 0x3a, 0xf3, 0xf5, 0x37, 0xb5, 0x4c, 0xd7, 0xad,
+	0x6f, 0x6a, 0xb9, 0xba, 0xed, 0x4d, 0xad, 0x84, 0x70, 0xe4, 0xec, 0x36, 0x69, 0x5f, 0x7a, 0xa7,
+	0x86, 0x22, 0x23, 0xc8, 0x5b, 0xfa, 0xec, 0x2f, 0x7c, 0x74, 0xf7, 0xda, 0x52, 0x92, 0x38, 0xb8,
+	0xf7, 0x3b, 0xb1, 0xf8, 0x93, 0xcc, 0xaa, 0xd5, 0xd1, 0x1a, 0x8a, 0xd1, 0x38, 0x58, 0x0f, 0xba,
+	0xc1, 0xf8, 0x59, 0xf6, 0x3c, 0xaf, 0x02, 0xa9, 0x67, 0xd9, 0x73, 0x5c, 0xfa, 0xdd, 0x6d, 0x07,
+	0x6c, 0xb4, 0x67, 0x4f, 0x7e, 0x79, 0xb4, 0x8e, 0x0c, 0x68, 0xdd, 0x8f, 0xe4, 0xa0, 0xf8, 0x35,
+	0x98, 0xcb, 0xc1, 0xda, 0x0c, 0xf2, 0x17, 0xe6, 0x01, 0xf5, 0x1e, 0x7d, 0x79, 0x2b, 0xd7, 0x9e,
+	0xfc, 0x3f, 0x00, 0x1e, 0xe8, 0xe6, 0xa8, 0xca, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -123,6 +124,8 @@ type TabletManagerClient interface {
 	ExecuteHook(ctx context.Context, in *tabletmanagerdata.ExecuteHookRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteHookResponse, error)
 	// GetSchema asks the tablet for its schema
 	GetSchema(ctx context.Context, in *tabletmanagerdata.GetSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaResponse, error)
+	// GetSchemaHistory asks the tablet for the schema versions recorded by its schema tracker
+	GetSchemaHistory(ctx context.Context, in *tabletmanagerdata.GetSchemaHistoryRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaHistoryResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(ctx context.Context, in *tabletmanagerdata.GetPermissionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPermissionsResponse, error)
 	SetReadOnly(ctx context.Context, in *tabletmanagerdata.SetReadOnlyRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadOnlyResponse, error)
@@ -256,6 +259,15 @@ func (c *tabletManagerClient) GetSchema(ctx context.Context, in *tabletmanagerda
 	return out, nil
 }
 
+func (c *tabletManagerClient) GetSchemaHistory(ctx context.Context, in *tabletmanagerdata.GetSchemaHistoryRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaHistoryResponse, error) {
+	out := new(tabletmanagerdata.GetSchemaHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetSchemaHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) GetPermissions(ctx context.Context, in *tabletmanagerdata.GetPermissionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPermissionsResponse, error) {
 	out := new(tabletmanagerdata.GetPermissionsResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetPermissions", in, out, opts...)
@@ -753,6 +765,8 @@ type TabletManagerServer interface {
 	ExecuteHook(context.Context, *tabletmanagerdata.ExecuteHookRequest) (*tabletmanagerdata.ExecuteHookResponse, error)
 	// GetSchema asks the tablet for its schema
 	GetSchema(context.Context, *tabletmanagerdata.GetSchemaRequest) (*tabletmanagerdata.GetSchemaResponse, error)
+	// GetSchemaHistory asks the tablet for the schema versions recorded by its schema tracker
+	GetSchemaHistory(context.Context, *tabletmanagerdata.GetSchemaHistoryRequest) (*tabletmanagerdata.GetSchemaHistoryResponse, error)
 	// GetPermissions asks the tablet for its permissions
 	GetPermissions(context.Context, *tabletmanagerdata.GetPermissionsRequest) (*tabletmanagerdata.GetPermissionsResponse, error)
 	SetReadOnly(context.Context, *tabletmanagerdata.SetReadOnlyRequest) (*tabletmanagerdata.SetReadOnlyResponse, error)
@@ -858,6 +872,9 @@ func (*UnimplementedTabletManagerServer) ExecuteHook(ctx context.Context, req *t
 func (*UnimplementedTabletManagerServer) GetSchema(ctx context.Context, req *tabletmanagerdata.GetSchemaRequest) (*tabletmanagerdata.GetSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
 }
+func (*UnimplementedTabletManagerServer) GetSchemaHistory(ctx context.Context, req *tabletmanagerdata.GetSchemaHistoryRequest) (*tabletmanagerdata.GetSchemaHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaHistory not implemented")
+}
 func (*UnimplementedTabletManagerServer) GetPermissions(ctx context.Context, req *tabletmanagerdata.GetPermissionsRequest) (*tabletmanagerdata.GetPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPermissions not implemented")
 }
@@ -1082,6 +1099,24 @@ func _TabletManager_GetSchema_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_GetSchemaHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetSchemaHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetSchemaHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetSchemaHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetSchemaHistory(ctx, req.(*tabletmanagerdata.GetSchemaHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.GetPermissionsRequest)
 	if err := dec(in); err != nil {
@@ -1990,6 +2025,10 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchema",
 			Handler:    _TabletManager_GetSchema_Handler,
 		},
+		{
+			MethodName: "GetSchemaHistory",
+			Handler:    _TabletManager_GetSchemaHistory_Handler,
+		},
 		{
 			MethodName: "GetPermissions",
 			Handler:    _TabletManager_GetPermissions_Handler,
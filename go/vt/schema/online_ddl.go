@@ -24,15 +24,26 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/shlex"
 	"github.com/google/uuid"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 var (
-	migrationBasePath   = "schema-migration"
-	onlineDdlUUIDRegexp = regexp.MustCompile(`^[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}$`)
+	migrationBasePath     = "schema-migration"
+	onlineDdlUUIDRegexp   = regexp.MustCompile(`^[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}$`)
+	revertStatementRegexp = regexp.MustCompile(`(?i)^\s*revert\s+([0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12})\s*$`)
+	// onlineDDLTableNameRegexp matches the ghost, changelog and old tables of gh-ost, and the new and
+	// old tables of pt-online-schema-change, both with the tools' default names, e.g. _t1_gho, and with
+	// the names online DDL forces, e.g. _4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_gho
+	onlineDDLTableNameRegexp = regexp.MustCompile(`^_.+_(gho|ghc|del|new|old)$`)
+	// vreplTableNameRegexp matches the target and swap tables of a vreplication migration
+	vreplTableNameRegexp = regexp.MustCompile(`^_[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}_[0-9]{14}_(vrepl|swap)$`)
 )
 
 // MigrationBasePath is the root for all schema migration entries
@@ -66,15 +77,43 @@ func MigrationJobsKeyspaceShardPath(keyspace, shard string) string {
 type OnlineDDLStatus string
 
 const (
-	OnlineDDLStatusRequested OnlineDDLStatus = "requested"
-	OnlineDDLStatusCancelled OnlineDDLStatus = "cancelled"
-	OnlineDDLStatusQueued    OnlineDDLStatus = "queued"
-	OnlineDDLStatusReady     OnlineDDLStatus = "ready"
-	OnlineDDLStatusRunning   OnlineDDLStatus = "running"
-	OnlineDDLStatusComplete  OnlineDDLStatus = "complete"
-	OnlineDDLStatusFailed    OnlineDDLStatus = "failed"
+	OnlineDDLStatusRequested       OnlineDDLStatus = "requested"
+	OnlineDDLStatusCancelled       OnlineDDLStatus = "cancelled"
+	OnlineDDLStatusQueued          OnlineDDLStatus = "queued"
+	OnlineDDLStatusReady           OnlineDDLStatus = "ready"
+	OnlineDDLStatusRunning         OnlineDDLStatus = "running"
+	OnlineDDLStatusReadyToComplete OnlineDDLStatus = "ready_to_complete"
+	OnlineDDLStatusComplete        OnlineDDLStatus = "complete"
+	OnlineDDLStatusFailed          OnlineDDLStatus = "failed"
+	OnlineDDLStatusUndropped       OnlineDDLStatus = "undropped"
 )
 
+const (
+	// postponeCompletionOption is the migration option which postpones cut-over until the migration is completed by the user
+	postponeCompletionOption = "postpone-completion"
+	// dependsOnOption is the migration option which lists migrations that must complete before the migration runs
+	dependsOnOption = "depends-on"
+)
+
+const sqlInsertSchemaMigration = `INSERT IGNORE INTO %s.schema_migrations (
+		migration_uuid,
+		keyspace,
+		shard,
+		mysql_schema,
+		mysql_table,
+		migration_statement,
+		strategy,
+		options,
+		requested_timestamp,
+		migration_context,
+		migration_status
+	) VALUES (
+		%a, %a, %a, %a, %a, %a, %a, %a, FROM_UNIXTIME(%a), %a, %a
+	)`
+
+// vitessOptions are migration options interpreted by vitess, rather than by the migration tool
+var vitessOptions = []string{postponeCompletionOption, dependsOnOption}
+
 const (
 	// DDLStrategyNormal means not an online-ddl migration. Just a normal MySQL ALTER TABLE
 	DDLStrategyNormal sqlparser.DDLStrategy = ""
@@ -82,6 +121,8 @@ const (
 	DDLStrategyGhost sqlparser.DDLStrategy = "gh-ost"
 	// DDLStrategyPTOSC requests pt-online-schema-change to run the migration
 	DDLStrategyPTOSC sqlparser.DDLStrategy = "pt-osc"
+	// DDLStrategyOnline requests vreplication to run the migration
+	DDLStrategyOnline sqlparser.DDLStrategy = "online"
 )
 
 // OnlineDDL encapsulates the relevant information in an online schema change request
@@ -97,6 +138,8 @@ type OnlineDDL struct {
 	Status      OnlineDDLStatus       `json:"status,omitempty"`
 	TabletAlias string                `json:"tablet,omitempty"`
 	Retries     int64                 `json:"retries,omitempty"`
+	// MigrationContext groups migrations which succeed or fail together
+	MigrationContext string `json:"context,omitempty"`
 }
 
 func ValidateDDLStrategy(strategy string) (sqlparser.DDLStrategy, error) {
@@ -105,6 +148,8 @@ func ValidateDDLStrategy(strategy string) (sqlparser.DDLStrategy, error) {
 		return DDLStrategyGhost, nil
 	case DDLStrategyPTOSC:
 		return DDLStrategyPTOSC, nil
+	case DDLStrategyOnline:
+		return DDLStrategyOnline, nil
 	case DDLStrategyNormal:
 		return DDLStrategyNormal, nil
 	default:
@@ -155,6 +200,122 @@ func (onlineDDL *OnlineDDL) RequestTimeSeconds() int64 {
 	return onlineDDL.RequestTime / int64(time.Second)
 }
 
+// RevertedUUID returns the UUID of the migration reverted by this migration, if this migration is a revert
+func (onlineDDL *OnlineDDL) RevertedUUID() (uuid string, isRevert bool) {
+	submatch := revertStatementRegexp.FindStringSubmatch(onlineDDL.SQL)
+	if len(submatch) == 0 {
+		return "", false
+	}
+	return submatch[1], true
+}
+
+// DropTableStatement returns the migration's statement, parsed, if the migration drops a table
+func (onlineDDL *OnlineDDL) DropTableStatement() (ddl *sqlparser.DDL, isDrop bool) {
+	stmt, err := sqlparser.Parse(onlineDDL.SQL)
+	if err != nil {
+		return nil, false
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.Action != sqlparser.DropDDLAction {
+		return nil, false
+	}
+	return ddl, true
+}
+
+// IsPostponeCompletion returns true when the migration's options request its cut-over be postponed
+// until the migration is explicitly completed
+func (onlineDDL *OnlineDDL) IsPostponeCompletion() bool {
+	for _, option := range onlineDDL.splitOptions() {
+		if strings.TrimLeft(option, "-") == postponeCompletionOption {
+			return true
+		}
+	}
+	return false
+}
+
+// DependsOn returns the UUIDs of the migrations which must complete before this migration runs,
+// as given by e.g. --depends-on=<uuid>,<uuid>
+func (onlineDDL *OnlineDDL) DependsOn() (uuids []string) {
+	for _, option := range onlineDDL.splitOptions() {
+		name, value := splitOption(option)
+		if name != dependsOnOption {
+			continue
+		}
+		for _, uuid := range strings.Split(value, ",") {
+			if uuid = strings.TrimSpace(uuid); uuid != "" {
+				uuids = append(uuids, uuid)
+			}
+		}
+	}
+	return uuids
+}
+
+// RuntimeOptions returns the migration's options, excluding those interpreted by vitess, to be passed
+// on to the migration tool
+func (onlineDDL *OnlineDDL) RuntimeOptions() (options []string) {
+	for _, option := range onlineDDL.splitOptions() {
+		name, _ := splitOption(option)
+		isVitessOption := false
+		for _, vitessOption := range vitessOptions {
+			if name == vitessOption {
+				isVitessOption = true
+			}
+		}
+		if !isVitessOption {
+			options = append(options, option)
+		}
+	}
+	return options
+}
+
+// splitOptions splits the migration's options the way a shell splits arguments, so that vitess
+// and the migration tool see the same options
+func (onlineDDL *OnlineDDL) splitOptions() []string {
+	options, _ := shlex.Split(onlineDDL.Options)
+	return options
+}
+
+// splitOption splits a --name=value option into its name and value
+func splitOption(option string) (name string, value string) {
+	option = strings.TrimLeft(option, "-")
+	if i := strings.Index(option, "="); i >= 0 {
+		return option[:i], option[i+1:]
+	}
+	return option, ""
+}
+
+// InsertQuery returns the VExec query which submits this migration to the tablets' schema_migrations
+// table. shard and mysql_schema are left empty, for each tablet to fill in.
+func (onlineDDL *OnlineDDL) InsertQuery() (string, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlInsertSchemaMigration, "_vt",
+		":migration_uuid",
+		":keyspace",
+		":shard",
+		":mysql_schema",
+		":mysql_table",
+		":migration_statement",
+		":strategy",
+		":options",
+		":requested_timestamp",
+		":migration_context",
+		":migration_status",
+	)
+	bindVars := map[string]*querypb.BindVariable{
+		"migration_uuid":      sqltypes.StringBindVariable(onlineDDL.UUID),
+		"keyspace":            sqltypes.StringBindVariable(onlineDDL.Keyspace),
+		"shard":               sqltypes.StringBindVariable(""),
+		"mysql_schema":        sqltypes.StringBindVariable(""),
+		"mysql_table":         sqltypes.StringBindVariable(onlineDDL.Table),
+		"migration_statement": sqltypes.StringBindVariable(onlineDDL.SQL),
+		"strategy":            sqltypes.StringBindVariable(string(onlineDDL.Strategy)),
+		"options":             sqltypes.StringBindVariable(onlineDDL.Options),
+		"requested_timestamp": sqltypes.Int64BindVariable(onlineDDL.RequestTimeSeconds()),
+		"migration_context":   sqltypes.StringBindVariable(onlineDDL.MigrationContext),
+		"migration_status":    sqltypes.StringBindVariable(string(onlineDDL.Status)),
+	}
+	return parsed.GenerateQuery(bindVars, nil)
+}
+
 // JobsKeyspaceShardPath returns job/<keyspace>/<shard>/<uuid>
 func (onlineDDL *OnlineDDL) JobsKeyspaceShardPath(shard string) string {
 	return MigrationJobsKeyspaceShardPath(onlineDDL.Keyspace, shard)
@@ -198,6 +359,18 @@ func CreateUUID() (string, error) {
 	return result, nil
 }
 
+// IsOnlineDDLTableName answers 'true' when the given table name stands for a table created by an
+// online DDL migration, via gh-ost, pt-online-schema-change or vreplication
+func IsOnlineDDLTableName(tableName string) bool {
+	return onlineDDLTableNameRegexp.MatchString(tableName) || vreplTableNameRegexp.MatchString(tableName)
+}
+
+// RevertStatement returns the statement of a migration which reverts the migration of the given UUID, e.g.:
+// revert a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a
+func RevertStatement(uuid string) string {
+	return fmt.Sprintf("revert %s", uuid)
+}
+
 // IsOnlineDDLUUID answers 'true' when the given string is an online-ddl UUID, e.g.:
 // a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a
 func IsOnlineDDLUUID(uuid string) bool {
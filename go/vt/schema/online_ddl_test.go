@@ -38,6 +38,11 @@ func TestValidateDDLStrategy(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, DDLStrategyPTOSC, strategy)
 	}
+	{
+		strategy, err := ValidateDDLStrategy("online")
+		assert.NoError(t, err)
+		assert.Equal(t, DDLStrategyOnline, strategy)
+	}
 	{
 		strategy, err := ValidateDDLStrategy("")
 		assert.NoError(t, err)
@@ -66,3 +71,133 @@ func TestIsOnlineDDLUUID(t *testing.T) {
 		assert.False(t, IsOnlineDDLUUID(tc))
 	}
 }
+
+func TestIsOnlineDDLTableName(t *testing.T) {
+	names := []string{
+		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_gho",
+		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_ghc",
+		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_del",
+		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_new",
+		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_vrepl",
+		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_swap",
+		"_t1_gho",
+		"_t1_ghc",
+		"_t1_del",
+		"_t1_new",
+		"_t1_old",
+		"__t1_old",
+	}
+	for _, tableName := range names {
+		assert.True(t, IsOnlineDDLTableName(tableName), tableName)
+	}
+	irrelevantNames := []string{
+		"t1",
+		"_t1",
+		"_audit",
+		"t1_gho",
+		"_t1_vrepl",
+		"_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410",
+	}
+	for _, tableName := range irrelevantNames {
+		assert.False(t, IsOnlineDDLTableName(tableName), tableName)
+	}
+}
+
+func TestRevertedUUID(t *testing.T) {
+	uuid := "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"
+	onlineDDL := &OnlineDDL{SQL: RevertStatement(uuid)}
+	reverted, isRevert := onlineDDL.RevertedUUID()
+	assert.True(t, isRevert)
+	assert.Equal(t, uuid, reverted)
+
+	onlineDDL.SQL = "REVERT  a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a "
+	reverted, isRevert = onlineDDL.RevertedUUID()
+	assert.True(t, isRevert)
+	assert.Equal(t, uuid, reverted)
+
+	tt := []string{
+		"alter table t add column i int",
+		"revert",
+		"revert a0638f6b-ec7b-11ea-9bf8-000d3a9b8a9a",
+		"revert a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a, b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a",
+	}
+	for _, tc := range tt {
+		onlineDDL.SQL = tc
+		_, isRevert := onlineDDL.RevertedUUID()
+		assert.False(t, isRevert, tc)
+	}
+}
+
+func TestDropTableStatement(t *testing.T) {
+	onlineDDL := &OnlineDDL{SQL: "drop with 'online' table if exists t"}
+	ddl, isDrop := onlineDDL.DropTableStatement()
+	assert.True(t, isDrop)
+	assert.True(t, ddl.IfExists)
+	assert.Equal(t, "t", ddl.FromTables[0].Name.String())
+
+	tt := []string{
+		"alter with 'online' table t add column i int",
+		"revert a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a",
+		"drop database d",
+	}
+	for _, tc := range tt {
+		onlineDDL.SQL = tc
+		_, isDrop := onlineDDL.DropTableStatement()
+		assert.False(t, isDrop, tc)
+	}
+}
+
+func TestIsPostponeCompletion(t *testing.T) {
+	tt := map[string]bool{
+		"":                      false,
+		"--postpone-completion": true,
+		"-postpone-completion":  true,
+		"--max-load=Threads_running=100 --postpone-completion":        true,
+		"--postpone-completion-other":                                 false,
+		`--critical-load="Threads_running=500 --postpone-completion"`: false,
+	}
+	for options, expected := range tt {
+		onlineDDL := &OnlineDDL{Options: options}
+		assert.Equal(t, expected, onlineDDL.IsPostponeCompletion(), options)
+	}
+}
+
+func TestDependsOn(t *testing.T) {
+	tt := map[string][]string{
+		"":                      nil,
+		"--postpone-completion": nil,
+		"--depends-on=a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a":                                                                    {"a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"},
+		"--max-load=Threads_running=100 -depends-on=a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a,b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a": {"a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a", "b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"},
+		`--depends-on="a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a, b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"`:                            {"a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a", "b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"},
+		`--critical-load="Threads_running=500 --depends-on=a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"`:                              nil,
+	}
+	for options, expected := range tt {
+		onlineDDL := &OnlineDDL{Options: options}
+		assert.Equal(t, expected, onlineDDL.DependsOn(), options)
+	}
+}
+
+func TestRuntimeOptions(t *testing.T) {
+	onlineDDL := &OnlineDDL{Options: `--max-load=Threads_running=100 --postpone-completion --depends-on=a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a --critical-load="Threads_running=500"`}
+	assert.Equal(t, []string{"--max-load=Threads_running=100", "--critical-load=Threads_running=500"}, onlineDDL.RuntimeOptions())
+
+	onlineDDL.Options = ""
+	assert.Empty(t, onlineDDL.RuntimeOptions())
+}
+
+func TestInsertQuery(t *testing.T) {
+	onlineDDL := &OnlineDDL{
+		Keyspace:         "test_ks",
+		Table:            "t",
+		SQL:              "alter with 'online' table t add column i int",
+		UUID:             "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a",
+		Strategy:         DDLStrategyOnline,
+		RequestTime:      1600000000000000000,
+		Status:           OnlineDDLStatusQueued,
+		MigrationContext: "ctx",
+	}
+	query, err := onlineDDL.InsertQuery()
+	assert.NoError(t, err)
+	assert.Contains(t, query, "INSERT IGNORE INTO _vt.schema_migrations")
+	assert.Contains(t, query, "'a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a', 'test_ks', '', '', 't', 'alter with \\'online\\' table t add column i int', 'online', '', FROM_UNIXTIME(1600000000), 'ctx', 'queued'")
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
 )
 
 var (
@@ -46,6 +48,9 @@ var (
 		// ALTER TABLE tbl something
 		regexp.MustCompile(alterTableBasicPattern + `([\S]+)\s+(.*$)`),
 	}
+	// DROP WITH 'gh-ost' TABLE
+	// DROP WITH 'online' '--some-option' TABLE
+	dropTableOnlineHintRegexp = regexp.MustCompile(`(?s)(?i)^\s*drop\s+with\s+.*?\btable\s+`)
 )
 
 // ParseAlterTableOptions parses a ALTER ... TABLE... statement into:
@@ -72,9 +77,13 @@ func ParseAlterTableOptions(alterStatement string) (explicitSchema, explicitTabl
 }
 
 // RemoveOnlineDDLHints removes a WITH_GHOST or WITH_PT hint, which is vitess-specific,
-// from an ALTER TABLE statement
+// from an ALTER TABLE or a DROP TABLE statement
 // e.g "ALTER WITH 'gh-ost' TABLE my_table DROP COLUMN i" -> "ALTER TABLE `my_table` DROP COLUMN i"
+// e.g "DROP WITH 'online' TABLE my_table" -> "DROP TABLE my_table"
 func RemoveOnlineDDLHints(alterStatement string) (normalizedAlterStatement string) {
+	if dropTableOnlineHintRegexp.MatchString(alterStatement) {
+		return strings.TrimSpace(dropTableOnlineHintRegexp.ReplaceAllString(alterStatement, "DROP TABLE "))
+	}
 	explicitSchema, explicitTable, alterOptions := ParseAlterTableOptions(alterStatement)
 
 	if explicitTable == "" {
@@ -85,3 +94,34 @@ func RemoveOnlineDDLHints(alterStatement string) (normalizedAlterStatement strin
 	}
 	return fmt.Sprintf("ALTER TABLE `%s`.`%s` %s", explicitSchema, explicitTable, alterOptions)
 }
+
+// AddOnlineDDLHint adds a strategy hint to an ALTER TABLE or a DROP TABLE statement,
+// so that it runs as an online DDL. Other statements, and statements with the normal
+// strategy, are returned unchanged.
+// e.g "ALTER TABLE my_table DROP COLUMN i", "gh-ost" -> "alter with 'gh-ost' table my_table DROP COLUMN i"
+// e.g "DROP TABLE my_table", "online" -> "drop with 'online' table my_table"
+func AddOnlineDDLHint(statement string, strategy sqlparser.DDLStrategy) (string, error) {
+	if strategy == DDLStrategyNormal {
+		return statement, nil
+	}
+	stmt, err := sqlparser.Parse(statement)
+	if err != nil {
+		return "", err
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok {
+		return statement, nil
+	}
+	switch ddl.Action {
+	case sqlparser.AlterDDLAction:
+		_, _, alterOptions := ParseAlterTableOptions(statement)
+		return fmt.Sprintf("alter with '%s' table %s %s", strategy, sqlparser.String(ddl.Table), alterOptions), nil
+	case sqlparser.DropDDLAction:
+		exists := ""
+		if ddl.IfExists {
+			exists = " if exists"
+		}
+		return fmt.Sprintf("drop with '%s' table%s %s", strategy, exists, sqlparser.String(ddl.FromTables)), nil
+	}
+	return statement, nil
+}
@@ -78,6 +78,9 @@ func TestRemoveOnlineDDLHints(t *testing.T) {
 		ADD j INT
 		`: "ALTER TABLE `scm`.`my_table` DROP COLUMN i," + `
 		ADD j INT`,
+		"DROP TABLE my_table":                                   "DROP TABLE my_table",
+		"DROP WITH 'online' TABLE my_table":                     "DROP TABLE my_table",
+		"drop   with 'gh-ost' '--max-load=1' table if exists t": "DROP TABLE if exists t",
 	}
 	for query, expect := range tests {
 		normalizedQuery := RemoveOnlineDDLHints(query)
@@ -86,3 +89,27 @@ func TestRemoveOnlineDDLHints(t *testing.T) {
 		}
 	}
 }
+
+func TestAddOnlineDDLHint(t *testing.T) {
+	tests := map[string]string{
+		"ALTER TABLE my_table DROP COLUMN i":            "alter with 'online' table my_table DROP COLUMN i",
+		"alter table `scm`.`my_table` add column i int": "alter with 'online' table scm.my_table add column i int",
+		"alter table `order` add column i int":          "alter with 'online' table `order` add column i int",
+		"drop table my_table":                           "drop with 'online' table my_table",
+		"DROP TABLE IF EXISTS a, `order`":               "drop with 'online' table if exists a, `order`",
+		"create table t (id int)":                       "create table t (id int)",
+	}
+	for statement, expected := range tests {
+		hinted, err := AddOnlineDDLHint(statement, DDLStrategyOnline)
+		if err != nil {
+			t.Errorf("AddOnlineDDLHint(%q): %v", statement, err)
+			continue
+		}
+		if hinted != expected {
+			t.Errorf("AddOnlineDDLHint(%q): got %q, expected %q", statement, hinted, expected)
+		}
+	}
+	if hinted, _ := AddOnlineDDLHint("drop table t", DDLStrategyNormal); hinted != "drop table t" {
+		t.Errorf("AddOnlineDDLHint with the normal strategy: got %q", hinted)
+	}
+}
@@ -0,0 +1,382 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schemadiff computes the DDL statements needed to bring a schema,
+// given as a set of CREATE TABLE statements, to a desired set of CREATE TABLE
+// statements.
+package schemadiff
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	autoIncrementOptionRegexp = regexp.MustCompile(`(?i)\bauto_increment\s*=?\s*[0-9]+`)
+	defaultOptionRegexp       = regexp.MustCompile(`(?i)\bdefault\s+`)
+	charsetOptionRegexp       = regexp.MustCompile(`(?i)\bcharacter\s+set\b`)
+	tableOptionRegexp         = regexp.MustCompile(`([a-z_]+)\s*=\s*('(?:[^']|'')*'|\S+)`)
+)
+
+// integerTypes are the types for which MySQL ignores the display width.
+var integerTypes = map[string]bool{
+	"tinyint":   true,
+	"smallint":  true,
+	"mediumint": true,
+	"int":       true,
+	"integer":   true,
+	"bigint":    true,
+}
+
+// fractionalTypes are the numeric types other than integer types. The
+// defaults of numeric columns are compared by value.
+var fractionalTypes = map[string]bool{
+	"decimal": true,
+	"numeric": true,
+	"dec":     true,
+	"fixed":   true,
+	"float":   true,
+	"double":  true,
+	"real":    true,
+}
+
+// Table is a parsed CREATE TABLE statement.
+type Table struct {
+	Name string
+	// Statement is the original CREATE TABLE statement.
+	Statement string
+	ddl       *sqlparser.DDL
+}
+
+// ParseTable parses a single CREATE TABLE statement.
+func ParseTable(sql string) (*Table, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %q: %v", sql, err)
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.Action != sqlparser.CreateDDLAction || ddl.TableSpec == nil {
+		return nil, fmt.Errorf("not a CREATE TABLE statement: %q", sql)
+	}
+	return &Table{
+		Name:      ddl.Table.Name.String(),
+		Statement: strings.TrimSpace(sql),
+		ddl:       ddl,
+	}, nil
+}
+
+// ParseTables parses a list of CREATE TABLE statements, keyed by table name.
+func ParseTables(sqls []string) (map[string]*Table, error) {
+	tables := make(map[string]*Table, len(sqls))
+	for _, sql := range sqls {
+		table, err := ParseTable(sql)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := tables[table.Name]; ok {
+			return nil, fmt.Errorf("table %s is defined more than once", table.Name)
+		}
+		tables[table.Name] = table
+	}
+	return tables, nil
+}
+
+// DiffSchemas returns the statements which transform the current schema
+// into the desired schema. Both schemas are lists of CREATE TABLE statements.
+// The result lists CREATE TABLE statements first, then ALTER TABLE statements,
+// then DROP TABLE statements, each group sorted by table name.
+// An empty result means the schemas are equivalent.
+func DiffSchemas(current, desired []string) ([]string, error) {
+	currentTables, err := ParseTables(current)
+	if err != nil {
+		return nil, fmt.Errorf("current schema: %v", err)
+	}
+	desiredTables, err := ParseTables(desired)
+	if err != nil {
+		return nil, fmt.Errorf("desired schema: %v", err)
+	}
+
+	var creates, alters, drops []string
+	for _, name := range sortedNames(desiredTables) {
+		currentTable, ok := currentTables[name]
+		if !ok {
+			creates = append(creates, desiredTables[name].Statement)
+			continue
+		}
+		alter, err := DiffTables(currentTable, desiredTables[name])
+		if err != nil {
+			return nil, err
+		}
+		if alter != "" {
+			alters = append(alters, alter)
+		}
+	}
+	for _, name := range sortedNames(currentTables) {
+		if _, ok := desiredTables[name]; !ok {
+			drops = append(drops, fmt.Sprintf("drop table %s", sqlparser.String(currentTables[name].ddl.Table)))
+		}
+	}
+
+	diff := make([]string, 0, len(creates)+len(alters)+len(drops))
+	diff = append(diff, creates...)
+	diff = append(diff, alters...)
+	diff = append(diff, drops...)
+	return diff, nil
+}
+
+// DiffTables returns the ALTER TABLE statement which transforms the current
+// table into the desired table, or an empty string if they are equivalent.
+// Columns are matched by name: a renamed column is dropped and re-added.
+// Column order is not taken into account.
+func DiffTables(current, desired *Table) (string, error) {
+	currentSpec := current.ddl.TableSpec
+	desiredSpec := desired.ddl.TableSpec
+	var specs []string
+
+	// Keys and constraints are dropped first, so that columns they refer to
+	// can be dropped or modified.
+	currentIndexes := indexesByName(currentSpec.Indexes)
+	desiredIndexes := indexesByName(desiredSpec.Indexes)
+	for _, name := range sortedIndexNames(currentSpec.Indexes) {
+		currentIndex := currentIndexes[name]
+		if desiredIndex, ok := desiredIndexes[name]; ok && indexesEqual(currentIndex, desiredIndex) {
+			continue
+		}
+		if currentIndex.Info.Primary {
+			specs = append(specs, "drop primary key")
+		} else {
+			specs = append(specs, fmt.Sprintf("drop key %s", sqlparser.String(sqlparser.NewColIdent(name))))
+		}
+	}
+	currentConstraints := constraintsByDefinition(currentSpec.Constraints)
+	desiredConstraints := constraintsByDefinition(desiredSpec.Constraints)
+	for _, definition := range sortedConstraintDefinitions(currentConstraints) {
+		if _, ok := desiredConstraints[definition]; ok {
+			continue
+		}
+		constraint := currentConstraints[definition]
+		if constraint.Name == "" {
+			return "", fmt.Errorf("table %s: cannot drop unnamed constraint %s", current.Name, sqlparser.String(constraint))
+		}
+		if _, ok := constraint.Details.(*sqlparser.ForeignKeyDefinition); ok {
+			specs = append(specs, fmt.Sprintf("drop foreign key %s", constraint.Name))
+		} else {
+			specs = append(specs, fmt.Sprintf("drop check %s", constraint.Name))
+		}
+	}
+
+	currentColumns := columnsByName(currentSpec.Columns)
+	desiredColumns := columnsByName(desiredSpec.Columns)
+	for _, column := range currentSpec.Columns {
+		if _, ok := desiredColumns[column.Name.Lowered()]; !ok {
+			specs = append(specs, fmt.Sprintf("drop column %s", sqlparser.String(column.Name)))
+		}
+	}
+	for _, column := range desiredSpec.Columns {
+		currentColumn, ok := currentColumns[column.Name.Lowered()]
+		if ok && !columnsEqual(currentColumn, column) {
+			specs = append(specs, fmt.Sprintf("modify column %s", sqlparser.String(column)))
+		}
+	}
+	for _, column := range desiredSpec.Columns {
+		if _, ok := currentColumns[column.Name.Lowered()]; !ok {
+			specs = append(specs, fmt.Sprintf("add column %s", sqlparser.String(column)))
+		}
+	}
+
+	for _, name := range sortedIndexNames(desiredSpec.Indexes) {
+		desiredIndex := desiredIndexes[name]
+		if currentIndex, ok := currentIndexes[name]; ok && indexesEqual(currentIndex, desiredIndex) {
+			continue
+		}
+		specs = append(specs, fmt.Sprintf("add %s", sqlparser.String(desiredIndex)))
+	}
+	for _, definition := range sortedConstraintDefinitions(desiredConstraints) {
+		if _, ok := currentConstraints[definition]; ok {
+			continue
+		}
+		specs = append(specs, fmt.Sprintf("add %s", sqlparser.String(desiredConstraints[definition])))
+	}
+
+	// Only the table options stated by the desired table are compared, since
+	// the current table always reports its engine and charset.
+	if !tableOptionsContain(currentSpec.Options, desiredSpec.Options) {
+		specs = append(specs, strings.TrimSpace(autoIncrementOptionRegexp.ReplaceAllString(desiredSpec.Options, "")))
+	}
+
+	if len(specs) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("alter table %s %s", sqlparser.String(current.ddl.Table), strings.Join(specs, ", ")), nil
+}
+
+func sortedNames(tables map[string]*Table) []string {
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func columnsByName(columns []*sqlparser.ColumnDefinition) map[string]*sqlparser.ColumnDefinition {
+	m := make(map[string]*sqlparser.ColumnDefinition, len(columns))
+	for _, column := range columns {
+		m[column.Name.Lowered()] = column
+	}
+	return m
+}
+
+// columnsEqual compares two column definitions, ignoring differences which
+// MySQL does not distinguish or does not report in SHOW CREATE TABLE.
+// Charset and collation are only compared if the desired column states them.
+func columnsEqual(current, desired *sqlparser.ColumnDefinition) bool {
+	currentType := normalizeColumnType(current.Type)
+	desiredType := normalizeColumnType(desired.Type)
+	if desiredType.Charset == "" {
+		currentType.Charset = ""
+	}
+	if desiredType.Collate == "" {
+		currentType.Collate = ""
+	}
+	return sqlparser.String(&currentType) == sqlparser.String(&desiredType)
+}
+
+func normalizeColumnType(ct sqlparser.ColumnType) sqlparser.ColumnType {
+	ct.Type = strings.ToLower(ct.Type)
+	switch ct.Type {
+	case "bool", "boolean":
+		ct.Type = "tinyint"
+	case "integer":
+		ct.Type = "int"
+	}
+	if integerTypes[ct.Type] && !ct.Zerofill {
+		ct.Length = nil
+	}
+	ct.Charset = strings.ToLower(ct.Charset)
+	ct.Collate = strings.ToLower(ct.Collate)
+	if _, ok := ct.Default.(*sqlparser.NullVal); ok {
+		ct.Default = nil
+	}
+	if literal, ok := ct.Default.(*sqlparser.Literal); ok && (literal.Type == sqlparser.StrVal || literal.Type == sqlparser.IntVal || literal.Type == sqlparser.FloatVal) {
+		val := literal.Val
+		// MySQL reports the default of a decimal(10,2) column declared
+		// with DEFAULT 0 as '0.00'.
+		if integerTypes[ct.Type] || fractionalTypes[ct.Type] {
+			if number, ok := new(big.Rat).SetString(string(val)); ok {
+				val = []byte(number.RatString())
+			}
+		}
+		ct.Default = &sqlparser.Literal{Type: sqlparser.StrVal, Val: val}
+	}
+	return ct
+}
+
+// indexName returns the name by which an index is matched. MySQL names
+// unnamed indexes after their first column.
+func indexName(index *sqlparser.IndexDefinition) string {
+	if index.Info.Primary {
+		return "primary"
+	}
+	if !index.Info.Name.IsEmpty() {
+		return index.Info.Name.Lowered()
+	}
+	if len(index.Columns) > 0 {
+		return index.Columns[0].Column.Lowered()
+	}
+	return ""
+}
+
+func indexesByName(indexes []*sqlparser.IndexDefinition) map[string]*sqlparser.IndexDefinition {
+	m := make(map[string]*sqlparser.IndexDefinition, len(indexes))
+	for _, index := range indexes {
+		m[indexName(index)] = index
+	}
+	return m
+}
+
+func sortedIndexNames(indexes []*sqlparser.IndexDefinition) []string {
+	names := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		names = append(names, indexName(index))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func indexesEqual(current, desired *sqlparser.IndexDefinition) bool {
+	return normalizeIndex(current) == normalizeIndex(desired)
+}
+
+// normalizeIndex formats an index without its name, which has already been
+// matched, and with its type spelled the same way regardless of case.
+func normalizeIndex(index *sqlparser.IndexDefinition) string {
+	normalized := *index
+	info := *index.Info
+	info.Name = sqlparser.NewColIdent("")
+	info.Type = strings.ToLower(info.Type)
+	normalized.Info = &info
+	return strings.ToLower(sqlparser.String(&normalized))
+}
+
+// constraintsByDefinition maps constraints by their normalized definition.
+// Unnamed constraints are matched by definition alone.
+func constraintsByDefinition(constraints []*sqlparser.ConstraintDefinition) map[string]*sqlparser.ConstraintDefinition {
+	m := make(map[string]*sqlparser.ConstraintDefinition, len(constraints))
+	for _, constraint := range constraints {
+		m[strings.ToLower(sqlparser.String(constraint.Details))] = constraint
+	}
+	return m
+}
+
+func sortedConstraintDefinitions(constraints map[string]*sqlparser.ConstraintDefinition) []string {
+	definitions := make([]string, 0, len(constraints))
+	for definition := range constraints {
+		definitions = append(definitions, definition)
+	}
+	sort.Strings(definitions)
+	return definitions
+}
+
+// parseTableOptions maps table options to their values, ignoring the
+// AUTO_INCREMENT counter, the optional DEFAULT keyword and case.
+func parseTableOptions(options string) map[string]string {
+	options = autoIncrementOptionRegexp.ReplaceAllString(options, "")
+	options = defaultOptionRegexp.ReplaceAllString(options, "")
+	options = charsetOptionRegexp.ReplaceAllString(options, "charset")
+	m := make(map[string]string)
+	for _, submatch := range tableOptionRegexp.FindAllStringSubmatch(strings.ToLower(options), -1) {
+		m[submatch[1]] = submatch[2]
+	}
+	return m
+}
+
+// tableOptionsContain returns true if all desired table options are set to
+// the same value in the current table options.
+func tableOptionsContain(current, desired string) bool {
+	currentOptions := parseTableOptions(current)
+	for name, value := range parseTableOptions(desired) {
+		if currentOptions[name] != value {
+			return false
+		}
+	}
+	return true
+}
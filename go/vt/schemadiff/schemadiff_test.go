@@ -0,0 +1,150 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// showCreateT1 is t1 as reported by SHOW CREATE TABLE.
+const showCreateT1 = "CREATE TABLE `t1` (\n" +
+	"  `id` int(11) NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(64) DEFAULT NULL,\n" +
+	"  `val` int(11) NOT NULL DEFAULT '0',\n" +
+	"  `flag` tinyint(1) DEFAULT NULL,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  KEY `name_idx` (`name`)\n" +
+	") ENGINE=InnoDB AUTO_INCREMENT=17 DEFAULT CHARSET=utf8mb4"
+
+func TestDiffTables(t *testing.T) {
+	tt := []struct {
+		name     string
+		desired  string
+		expected string
+	}{
+		{
+			name:    "equivalent",
+			desired: "create table t1 (id int not null auto_increment, name varchar(64), val int not null default 0, flag bool, primary key (id), key name_idx (name)) engine=innodb",
+		},
+		{
+			name:     "add column and key",
+			desired:  "create table t1 (id int not null auto_increment, name varchar(64), val int not null default 0, flag bool, created timestamp not null default current_timestamp, primary key (id), key name_idx (name), key created_idx (created))",
+			expected: "alter table t1 add column created timestamp not null default current_timestamp(), add key created_idx (created)",
+		},
+		{
+			name:     "drop column and key",
+			desired:  "create table t1 (id int not null auto_increment, name varchar(64), val int not null default 0, primary key (id))",
+			expected: "alter table t1 drop key name_idx, drop column flag",
+		},
+		{
+			name:     "modify column",
+			desired:  "create table t1 (id bigint not null auto_increment, name varchar(128), val int not null default 0, flag bool, primary key (id), key name_idx (name))",
+			expected: "alter table t1 modify column id bigint not null auto_increment, modify column name varchar(128)",
+		},
+		{
+			name:     "change key",
+			desired:  "create table t1 (id int not null auto_increment, name varchar(64), val int not null default 0, flag bool, primary key (id), unique key name_idx (name, val))",
+			expected: "alter table t1 drop key name_idx, add unique key name_idx (name, val)",
+		},
+		{
+			name:     "rename column",
+			desired:  "create table t1 (id int not null auto_increment, title varchar(64), val int not null default 0, flag bool, primary key (id), key name_idx (title))",
+			expected: "alter table t1 drop key name_idx, drop column name, add column title varchar(64), add key name_idx (title)",
+		},
+		{
+			name:     "table options",
+			desired:  "create table t1 (id int not null auto_increment, name varchar(64), val int not null default 0, flag bool, primary key (id), key name_idx (name)) engine=InnoDB default charset=utf8",
+			expected: "alter table t1 engine=InnoDB default charset=utf8",
+		},
+	}
+	current, err := ParseTable(showCreateT1)
+	require.NoError(t, err)
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			desired, err := ParseTable(tc.desired)
+			require.NoError(t, err)
+			alter, err := DiffTables(current, desired)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, alter)
+		})
+	}
+}
+
+func TestDiffTablesNumericDefaults(t *testing.T) {
+	current, err := ParseTable("CREATE TABLE `t2` (`id` int(11) NOT NULL, `price` decimal(10,2) NOT NULL DEFAULT '0.00', `ratio` double DEFAULT '1.5', `code` varchar(8) DEFAULT '0.00', PRIMARY KEY (`id`))")
+	require.NoError(t, err)
+	tt := []struct {
+		desired  string
+		expected string
+	}{
+		{
+			desired: "create table t2 (id int not null, price decimal(10,2) not null default 0, ratio double default 1.50, code varchar(8) default '0.00', primary key (id))",
+		},
+		{
+			desired:  "create table t2 (id int not null, price decimal(10,2) not null default 1, ratio double default 1.5, code varchar(8) default '0.00', primary key (id))",
+			expected: "alter table t2 modify column price decimal(10,2) not null default 1",
+		},
+		{
+			// Strings are compared as they are.
+			desired:  "create table t2 (id int not null, price decimal(10,2) not null default 0, ratio double default 1.5, code varchar(8) default '0', primary key (id))",
+			expected: "alter table t2 modify column code varchar(8) default '0'",
+		},
+	}
+	for _, tc := range tt {
+		desired, err := ParseTable(tc.desired)
+		require.NoError(t, err)
+		alter, err := DiffTables(current, desired)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, alter, tc.desired)
+	}
+}
+
+func TestDiffSchemas(t *testing.T) {
+	current := []string{
+		showCreateT1,
+		"CREATE TABLE `t2` (`id` int(11) NOT NULL, PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		"CREATE TABLE `t3` (`id` int(11) NOT NULL, PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+	}
+	desired := []string{
+		"create table t4 (id int, primary key (id))",
+		"create table t2 (id int not null, primary key (id))",
+		"create table t1 (id int not null auto_increment, name varchar(64), val int not null default 0, flag bool, primary key (id))",
+	}
+	diff, err := DiffSchemas(current, desired)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"create table t4 (id int, primary key (id))",
+		"alter table t1 drop key name_idx",
+		"drop table t3",
+	}, diff)
+
+	diff, err = DiffSchemas(current, current)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestDiffSchemasErrors(t *testing.T) {
+	_, err := DiffSchemas(nil, []string{"create table t1 (id int)", "create table t1 (id bigint)"})
+	assert.Error(t, err)
+	_, err = DiffSchemas(nil, []string{"alter table t1 add column i int"})
+	assert.Error(t, err)
+	_, err = DiffSchemas(nil, []string{"create table t1 ("})
+	assert.Error(t, err)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemamanager
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+// DeclarativeController implements Controller interface. It wraps a Controller
+// which reads the complete desired schema of a keyspace as CREATE TABLE
+// statements, and reads instead the statements which bring the current
+// schema of the keyspace to the desired schema.
+type DeclarativeController struct {
+	Controller
+	wr          *wrangler.Wrangler
+	ddlStrategy sqlparser.DDLStrategy
+	allowDrops  bool
+}
+
+// NewDeclarativeController creates a new DeclarativeController instance.
+// ALTER TABLE and DROP TABLE statements are submitted with the given strategy,
+// unless it is empty or schema.DDLStrategyNormal. Tables missing from the
+// desired schema are only dropped if allowDrops is set.
+func NewDeclarativeController(controller Controller, wr *wrangler.Wrangler, ddlStrategy sqlparser.DDLStrategy, allowDrops bool) *DeclarativeController {
+	return &DeclarativeController{
+		Controller:  controller,
+		wr:          wr,
+		ddlStrategy: ddlStrategy,
+		allowDrops:  allowDrops,
+	}
+}
+
+// Read reads the desired schema from the wrapped controller and returns the
+// statements which transform the current schema into it.
+func (controller *DeclarativeController) Read(ctx context.Context) ([]string, error) {
+	desired, err := controller.Controller.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sqls, err := controller.diffShards(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	var drops []string
+	for _, sql := range sqls {
+		stmt, err := sqlparser.Parse(sql)
+		if err != nil {
+			return nil, err
+		}
+		if ddl, ok := stmt.(*sqlparser.DDL); ok && ddl.Action == sqlparser.DropDDLAction {
+			drops = append(drops, sqlparser.String(ddl.FromTables))
+		}
+	}
+	if len(drops) > 0 && !controller.allowDrops {
+		return nil, fmt.Errorf("the desired schema of keyspace %s does not contain tables %s, use -allow_drops to drop them", controller.Keyspace(), strings.Join(drops, ", "))
+	}
+	for i, sql := range sqls {
+		if sqls[i], err = schema.AddOnlineDDLHint(sql, controller.ddlStrategy); err != nil {
+			return nil, err
+		}
+	}
+	return sqls, nil
+}
+
+// diffShards returns the statements which transform the current schema of
+// every shard of the keyspace into the desired schema. It fails if shards
+// need different statements, as the same statements are applied to all shards.
+func (controller *DeclarativeController) diffShards(ctx context.Context, desired []string) ([]string, error) {
+	keyspace := controller.Keyspace()
+	shardNames, err := controller.wr.TopoServer().GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get shard names for keyspace: %s, error: %v", keyspace, err)
+	}
+	if len(shardNames) == 0 {
+		return nil, fmt.Errorf("keyspace: %s does not contain any shards", keyspace)
+	}
+	sort.Strings(shardNames)
+	var diff []string
+	for i, shardName := range shardNames {
+		current, err := controller.readCurrentSchema(ctx, shardName)
+		if err != nil {
+			return nil, err
+		}
+		shardDiff, err := schemadiff.DiffSchemas(current, desired)
+		if err != nil {
+			return nil, fmt.Errorf("shard: %s: %v", shardName, err)
+		}
+		if i == 0 {
+			diff = shardDiff
+			continue
+		}
+		if !reflect.DeepEqual(diff, shardDiff) {
+			return nil, fmt.Errorf("shards %s and %s of keyspace %s have different schemas, repair the schema drift first: %v vs %v", shardNames[0], shardName, keyspace, diff, shardDiff)
+		}
+	}
+	return diff, nil
+}
+
+// readCurrentSchema returns the CREATE TABLE statements of the master of a
+// shard, excluding views and internal tables such as online DDL and table GC
+// artifacts.
+func (controller *DeclarativeController) readCurrentSchema(ctx context.Context, shardName string) ([]string, error) {
+	keyspace := controller.Keyspace()
+	shardInfo, err := controller.wr.TopoServer().GetShard(ctx, keyspace, shardName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get shard info, keyspace: %s, shard: %s, error: %v", keyspace, shardName, err)
+	}
+	if !shardInfo.HasMaster() {
+		return nil, fmt.Errorf("shard: %s does not have a master", shardName)
+	}
+	sd, err := controller.wr.GetSchema(ctx, shardInfo.MasterAlias, nil, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get schema, keyspace: %s, shard: %s, error: %v", keyspace, shardName, err)
+	}
+	var sqls []string
+	for _, td := range sd.TableDefinitions {
+		if schema.IsOnlineDDLTableName(td.Name) || schema.IsGCTableName(td.Name) {
+			continue
+		}
+		sqls = append(sqls, td.Schema)
+	}
+	return sqls, nil
+}
+
+var _ Controller = (*DeclarativeController)(nil)
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemamanager
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestDeclarativeController(t *testing.T) {
+	tmc := newFakeTabletManagerClient()
+	tmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:   "t1",
+				Schema: "CREATE TABLE `t1` (`id` int(11) NOT NULL, `name` varchar(64) DEFAULT NULL, PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			},
+			{
+				Name:   "t2",
+				Schema: "CREATE TABLE `t2` (`id` int(11) NOT NULL, PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			},
+			{
+				Name:   "_t1_gho",
+				Schema: "CREATE TABLE `_t1_gho` (`id` int(11) NOT NULL, PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			},
+			{
+				Name:   "_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_vrepl",
+				Schema: "CREATE TABLE `_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_vrepl` (`id` int(11) NOT NULL, PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			},
+			{
+				Name:   "_audit",
+				Schema: "CREATE TABLE `_audit` (`id` int(11) NOT NULL, PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			},
+		},
+	})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), tmc)
+	desired := "create table t1 (id int not null, name varchar(64), val int, primary key (id));\n" +
+		"create table t3 (id int not null, primary key (id));\n" +
+		"create table _audit (id int not null, primary key (id))"
+	ctx := context.Background()
+
+	controller := NewDeclarativeController(NewPlainController(desired, "test_keyspace"), wr, schema.DDLStrategyNormal, true)
+	sqls, err := controller.Read(ctx)
+	if err != nil {
+		t.Fatalf("controller.Read should succeed, but got error: %v", err)
+	}
+	expected := []string{
+		"create table t3 (id int not null, primary key (id))",
+		"alter table t1 add column val int",
+		"drop table t2",
+	}
+	if !reflect.DeepEqual(sqls, expected) {
+		t.Fatalf("expect to get sqls: %v, but got: %v", expected, sqls)
+	}
+
+	controller = NewDeclarativeController(NewPlainController(desired, "test_keyspace"), wr, schema.DDLStrategyOnline, true)
+	sqls, err = controller.Read(ctx)
+	if err != nil {
+		t.Fatalf("controller.Read should succeed, but got error: %v", err)
+	}
+	expected = []string{
+		"create table t3 (id int not null, primary key (id))",
+		"alter with 'online' table t1 add column val int",
+		"drop with 'online' table t2",
+	}
+	if !reflect.DeepEqual(sqls, expected) {
+		t.Fatalf("expect ALTER TABLE and DROP TABLE to be submitted as online DDL: %v, but got: %v", expected, sqls)
+	}
+
+	controller = NewDeclarativeController(NewPlainController(desired, "test_keyspace"), wr, schema.DDLStrategyOnline, false)
+	if _, err := controller.Read(ctx); err == nil || !strings.Contains(err.Error(), "does not contain tables t2, use -allow_drops") {
+		t.Fatalf("controller.Read should refuse to drop tables without -allow_drops, but got: %v", err)
+	}
+
+	controller = NewDeclarativeController(NewPlainController("alter table t1 add column val int", "test_keyspace"), wr, "", true)
+	if _, err := controller.Read(ctx); err == nil {
+		t.Fatalf("controller.Read should fail on a statement other than CREATE TABLE")
+	}
+}
+
+func TestDeclarativeControllerShardsDisagree(t *testing.T) {
+	t1 := "CREATE TABLE `t1` (`id` int(11) NOT NULL, PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"
+	tmc := newFakeTabletManagerClient()
+	tmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{Name: "t1", Schema: t1}},
+	})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), tmc)
+	desired := "create table t1 (id int not null, val int, primary key (id))"
+	ctx := context.Background()
+
+	// Differences which need no statement, such as the AUTO_INCREMENT counter, are ignored.
+	tmc.shardSchemas["1"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{Name: "t1", Schema: t1 + " AUTO_INCREMENT=5"}},
+	}
+	controller := NewDeclarativeController(NewPlainController(desired, "test_keyspace"), wr, schema.DDLStrategyNormal, false)
+	sqls, err := controller.Read(ctx)
+	if err != nil {
+		t.Fatalf("controller.Read should succeed, but got error: %v", err)
+	}
+	if expected := []string{"alter table t1 add column val int"}; !reflect.DeepEqual(sqls, expected) {
+		t.Fatalf("expect to get sqls: %v, but got: %v", expected, sqls)
+	}
+
+	tmc.shardSchemas["2"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "t1",
+			Schema: "CREATE TABLE `t1` (`id` int(11) NOT NULL, `val` int(11) DEFAULT NULL, PRIMARY KEY (`id`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		}},
+	}
+	if _, err := controller.Read(ctx); err == nil || !strings.Contains(err.Error(), "shards 0 and 2 of keyspace test_keyspace have different schemas") {
+		t.Fatalf("controller.Read should fail when shards need different statements, but got: %v", err)
+	}
+}
@@ -0,0 +1,609 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemamanager
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// AlterAlgorithm is the least disruptive algorithm by which MySQL can apply
+// a schema change.
+type AlterAlgorithm string
+
+const (
+	// AlterAlgorithmInstant only changes table metadata.
+	AlterAlgorithmInstant AlterAlgorithm = "instant"
+	// AlterAlgorithmInplace rebuilds the table or its indexes, while allowing
+	// concurrent writes.
+	AlterAlgorithmInplace AlterAlgorithm = "inplace"
+	// AlterAlgorithmCopy copies the table, blocking writes to it.
+	AlterAlgorithmCopy AlterAlgorithm = "copy"
+)
+
+const sqlSelectRunningStreams = "select id, workflow, source from _vt.vreplication where db_name=%a and state='Running'"
+
+// rebuildBytesPerSecond is a rough, conservative rate at which MySQL
+// rebuilds or copies a table. It only serves to estimate durations.
+const rebuildBytesPerSecond = 32 * 1024 * 1024
+
+var (
+	mysqlVersionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)`)
+	// alterSpecKeyRegexp matches the specs of an ALTER TABLE which apply to
+	// keys, constraints or partitions rather than to columns.
+	alterSpecKeyRegexp = regexp.MustCompile(`^(index|key|unique|primary|foreign|fulltext|spatial|constraint|check|partition)\b`)
+)
+
+// ImpactReport is the preflight analysis of a single schema change.
+type ImpactReport struct {
+	SQL   string
+	Table string
+	// Algorithm is empty when the statement is not analyzed.
+	Algorithm         AlterAlgorithm
+	EstimatedDuration time.Duration
+	Warnings          []string
+}
+
+// String returns the report in a human readable form.
+func (report *ImpactReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s;\n", report.SQL)
+	if report.Algorithm == "" {
+		b.WriteString("  not analyzed\n")
+	} else {
+		fmt.Fprintf(&b, "  algorithm: %s, estimated duration: %v\n", report.Algorithm, report.EstimatedDuration)
+	}
+	for _, warning := range report.Warnings {
+		fmt.Fprintf(&b, "  warning: %s\n", warning)
+	}
+	return b.String()
+}
+
+// serverCapabilities lists the changes a MySQL server applies instantly.
+type serverCapabilities struct {
+	known                bool
+	instantAddLastColumn bool
+	instantAddAnyColumn  bool
+	instantDropColumn    bool
+	instantRenameColumn  bool
+	instantColumnDefault bool
+}
+
+// newServerCapabilities returns the capabilities of a server by its @@version.
+func newServerCapabilities(version string) *serverCapabilities {
+	submatch := mysqlVersionRegexp.FindStringSubmatch(version)
+	if len(submatch) == 0 {
+		return &serverCapabilities{}
+	}
+	var v [3]int
+	for i := range v {
+		v[i], _ = strconv.Atoi(submatch[i+1])
+	}
+	atLeast := func(major, minor, patch int) bool {
+		if v[0] != major {
+			return v[0] > major
+		}
+		if v[1] != minor {
+			return v[1] > minor
+		}
+		return v[2] >= patch
+	}
+	capabilities := &serverCapabilities{known: true}
+	if strings.Contains(strings.ToLower(version), "mariadb") {
+		capabilities.instantAddLastColumn = atLeast(10, 3, 2)
+		capabilities.instantAddAnyColumn = atLeast(10, 4, 0)
+		capabilities.instantDropColumn = atLeast(10, 4, 0)
+		capabilities.instantRenameColumn = atLeast(10, 3, 0)
+		capabilities.instantColumnDefault = atLeast(10, 3, 2)
+		return capabilities
+	}
+	capabilities.instantAddLastColumn = atLeast(8, 0, 12)
+	capabilities.instantAddAnyColumn = atLeast(8, 0, 29)
+	capabilities.instantDropColumn = atLeast(8, 0, 29)
+	capabilities.instantRenameColumn = atLeast(8, 0, 28)
+	capabilities.instantColumnDefault = atLeast(8, 0, 12)
+	return capabilities
+}
+
+// intersect returns the capabilities which both servers have.
+func (capabilities *serverCapabilities) intersect(other *serverCapabilities) *serverCapabilities {
+	return &serverCapabilities{
+		known:                capabilities.known && other.known,
+		instantAddLastColumn: capabilities.instantAddLastColumn && other.instantAddLastColumn,
+		instantAddAnyColumn:  capabilities.instantAddAnyColumn && other.instantAddAnyColumn,
+		instantDropColumn:    capabilities.instantDropColumn && other.instantDropColumn,
+		instantRenameColumn:  capabilities.instantRenameColumn && other.instantRenameColumn,
+		instantColumnDefault: capabilities.instantColumnDefault && other.instantColumnDefault,
+	}
+}
+
+// alterImpact is what an ALTER TABLE does to its table's columns.
+type alterImpact struct {
+	algorithm AlterAlgorithm
+	// changedColumns are the columns the ALTER TABLE drops, renames or
+	// redefines, mapped to the change.
+	changedColumns map[string]string
+}
+
+// worse returns the more disruptive of two algorithms.
+func worse(a, b AlterAlgorithm) AlterAlgorithm {
+	rank := map[AlterAlgorithm]int{AlterAlgorithmInstant: 0, AlterAlgorithmInplace: 1, AlterAlgorithmCopy: 2}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// splitAlterSpecs splits the options of an ALTER TABLE on top level commas.
+func splitAlterSpecs(alterOptions string) (specs []string) {
+	depth := 0
+	var quote rune
+	start := 0
+	for i, c := range alterOptions {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			specs = append(specs, strings.TrimSpace(alterOptions[start:i]))
+			start = i + 1
+		}
+	}
+	return append(specs, strings.TrimSpace(alterOptions[start:]))
+}
+
+// analyzeAlterOptions classifies the options of an ALTER TABLE under the
+// given server capabilities. Options it does not recognize are assumed to
+// copy the table.
+func analyzeAlterOptions(alterOptions string, capabilities *serverCapabilities) *alterImpact {
+	impact := &alterImpact{algorithm: AlterAlgorithmInstant, changedColumns: map[string]string{}}
+	instantIf := func(instant bool) AlterAlgorithm {
+		if instant {
+			return AlterAlgorithmInstant
+		}
+		return AlterAlgorithmInplace
+	}
+	for _, spec := range splitAlterSpecs(alterOptions) {
+		words := strings.Fields(strings.ToLower(spec))
+		if len(words) == 0 {
+			continue
+		}
+		for i := range words {
+			words[i] = strings.Trim(words[i], "`")
+		}
+		rest := strings.Join(words[1:], " ")
+		onColumn := !alterSpecKeyRegexp.MatchString(rest)
+		// columnName returns the column named by the spec, after an optional COLUMN keyword
+		columnName := func() string {
+			if len(words) > 1 && words[1] == "column" {
+				words = append(words[:1], words[2:]...)
+			}
+			if len(words) > 1 {
+				return words[1]
+			}
+			return ""
+		}
+		algorithm := AlterAlgorithmCopy
+		// Table options may be written as option=value
+		switch strings.SplitN(words[0], "=", 2)[0] {
+		case "add":
+			switch {
+			case onColumn:
+				positioned := strings.Contains(" "+rest+" ", " first ") || strings.Contains(" "+rest+" ", " after ")
+				algorithm = instantIf(capabilities.instantAddAnyColumn || (capabilities.instantAddLastColumn && !positioned))
+			case strings.HasPrefix(rest, "foreign"), strings.HasPrefix(rest, "constraint") && strings.Contains(rest, "foreign key"):
+				// In place only with foreign_key_checks disabled
+				algorithm = AlterAlgorithmCopy
+			default:
+				algorithm = AlterAlgorithmInplace
+			}
+		case "drop":
+			switch {
+			case onColumn:
+				column := columnName()
+				impact.changedColumns[column] = "drops"
+				algorithm = instantIf(capabilities.instantDropColumn)
+			case strings.HasPrefix(rest, "primary"):
+				algorithm = AlterAlgorithmCopy
+			default:
+				algorithm = AlterAlgorithmInplace
+			}
+		case "modify", "change":
+			column := columnName()
+			impact.changedColumns[column] = "redefines"
+			algorithm = AlterAlgorithmCopy
+		case "rename":
+			switch {
+			case strings.HasPrefix(rest, "column"):
+				column := columnName()
+				impact.changedColumns[column] = "renames"
+				algorithm = instantIf(capabilities.instantRenameColumn)
+			case strings.HasPrefix(rest, "index"), strings.HasPrefix(rest, "key"):
+				algorithm = AlterAlgorithmInplace
+			}
+		case "alter":
+			if onColumn && (strings.Contains(rest, "set default") || strings.Contains(rest, "drop default")) {
+				algorithm = instantIf(capabilities.instantColumnDefault)
+			} else {
+				algorithm = AlterAlgorithmInplace
+			}
+		case "engine", "force", "row_format", "key_block_size":
+			algorithm = AlterAlgorithmInplace
+		case "comment":
+			algorithm = AlterAlgorithmInstant
+		}
+		impact.algorithm = worse(impact.algorithm, algorithm)
+	}
+	return impact
+}
+
+// AnalyzeImpact reports, for each of the given statements, how MySQL applies
+// it on the keyspace's masters, roughly how long it takes, and whether it
+// breaks the keyspace's VSchema, routing rules, or running vreplication
+// streams. It does not apply the statements. The executor must be open.
+func (exec *TabletExecutor) AnalyzeImpact(ctx context.Context, sqls []string) ([]*ImpactReport, error) {
+	if exec.isClosed {
+		return nil, fmt.Errorf("executor is closed")
+	}
+	capabilities, versionsWarning, err := exec.readServerCapabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tableSizes := map[string]uint64{}
+	for _, tablet := range exec.tablets {
+		sd, err := exec.wr.TabletManagerClient().GetSchema(ctx, tablet, nil, nil, false)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get database schema, error: %v", err)
+		}
+		for _, td := range sd.TableDefinitions {
+			// Shards apply changes in parallel, so the biggest shard dominates
+			if td.DataLength > tableSizes[td.Name] {
+				tableSizes[td.Name] = td.DataLength
+			}
+		}
+	}
+	vschema, err := exec.wr.TopoServer().GetVSchema(ctx, exec.keyspace)
+	if err != nil {
+		if !topo.IsErrType(err, topo.NoNode) {
+			return nil, err
+		}
+		vschema = &vschemapb.Keyspace{}
+	}
+	routingRules, err := exec.wr.TopoServer().GetRoutingRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	streams, err := exec.readRunningStreams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []*ImpactReport
+	for _, sql := range sqls {
+		report := &ImpactReport{SQL: sql}
+		reports = append(reports, report)
+		stmt, err := sqlparser.Parse(sql)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sql: %s, got error: %v", sql, err)
+		}
+		ddl, ok := stmt.(*sqlparser.DDL)
+		if !ok {
+			continue
+		}
+		// Tables the statement removes, and columns it changes, by table
+		var removedTables []string
+		changedColumns := map[string]string{}
+		switch ddl.Action {
+		case sqlparser.CreateDDLAction, sqlparser.TruncateDDLAction:
+			report.Table = ddl.Table.Name.String()
+			report.Algorithm = AlterAlgorithmInstant
+		case sqlparser.DropDDLAction, sqlparser.RenameDDLAction:
+			for _, table := range ddl.FromTables {
+				removedTables = append(removedTables, table.Name.String())
+			}
+			report.Table = strings.Join(removedTables, ", ")
+			report.Algorithm = AlterAlgorithmInstant
+		case sqlparser.AlterDDLAction:
+			report.Table = ddl.Table.Name.String()
+			_, _, alterOptions := schema.ParseAlterTableOptions(sql)
+			impact := analyzeAlterOptions(alterOptions, capabilities)
+			report.Algorithm = impact.algorithm
+			changedColumns = impact.changedColumns
+			isOnline := ddl.OnlineHint != nil && ddl.OnlineHint.Strategy != schema.DDLStrategyNormal
+			// An online migration copies the table, however MySQL would apply the change directly.
+			if report.Algorithm != AlterAlgorithmInstant || isOnline {
+				report.EstimatedDuration = time.Duration(tableSizes[report.Table]/rebuildBytesPerSecond) * time.Second
+			}
+			if isOnline {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("runs as an online migration with strategy %s, which copies the table without blocking writes", ddl.OnlineHint.Strategy))
+			}
+			if versionsWarning != "" {
+				report.Warnings = append(report.Warnings, versionsWarning)
+			}
+		default:
+			continue
+		}
+		if !capabilities.known && report.Algorithm != AlterAlgorithmInstant {
+			report.Warnings = append(report.Warnings, "MySQL version unknown, assuming no instant DDL")
+		}
+		report.Warnings = append(report.Warnings, vschemaWarnings(exec.keyspace, vschema, routingRules, report.Table, removedTables, changedColumns)...)
+		for _, stream := range streams {
+			report.Warnings = append(report.Warnings, stream.warnings(exec.keyspace, report.Table, removedTables, changedColumns)...)
+		}
+	}
+	return reports, nil
+}
+
+// readServerCapabilities reads the MySQL version of every master, and returns the
+// capabilities of the least capable one. When the masters run different versions,
+// it also returns a warning which lists them.
+func (exec *TabletExecutor) readServerCapabilities(ctx context.Context) (capabilities *serverCapabilities, versionsWarning string, err error) {
+	shardsByVersion := map[string][]string{}
+	for _, tablet := range exec.tablets {
+		version, err := exec.readMySQLVersion(ctx, tablet)
+		if err != nil {
+			return nil, "", err
+		}
+		shardsByVersion[version] = append(shardsByVersion[version], tablet.Shard)
+		if capabilities == nil {
+			capabilities = newServerCapabilities(version)
+		} else {
+			capabilities = capabilities.intersect(newServerCapabilities(version))
+		}
+	}
+	if capabilities == nil {
+		capabilities = newServerCapabilities("")
+	}
+	if len(shardsByVersion) > 1 {
+		var versions []string
+		for version, shards := range shardsByVersion {
+			sort.Strings(shards)
+			versions = append(versions, fmt.Sprintf("%q on shards %s", version, strings.Join(shards, ", ")))
+		}
+		sort.Strings(versions)
+		versionsWarning = fmt.Sprintf("shards run different MySQL versions (%s), assuming the least capable", strings.Join(versions, "; "))
+	}
+	return capabilities, versionsWarning, nil
+}
+
+// readMySQLVersion reads the MySQL version of the given tablet.
+func (exec *TabletExecutor) readMySQLVersion(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	qr, err := exec.wr.TabletManagerClient().ExecuteFetchAsDba(ctx, tablet, false, []byte("select @@version"), 1, false, false)
+	if err != nil {
+		return "", fmt.Errorf("unable to read MySQL version, shard: %s, error: %v", tablet.Shard, err)
+	}
+	result := sqltypes.Proto3ToResult(qr)
+	if len(result.Rows) == 0 {
+		return "", nil
+	}
+	return result.Rows[0][0].ToString(), nil
+}
+
+// vschemaWarnings reports the vindex columns and the routing rules which a
+// schema change breaks.
+func vschemaWarnings(keyspace string, vschema *vschemapb.Keyspace, routingRules *vschemapb.RoutingRules, table string, removedTables []string, changedColumns map[string]string) (warnings []string) {
+	for _, removedTable := range removedTables {
+		if _, ok := vschema.Tables[removedTable]; ok {
+			warnings = append(warnings, fmt.Sprintf("table %s is in the VSchema", removedTable))
+		}
+		qualified := keyspace + "." + removedTable
+		for _, rule := range routingRules.Rules {
+			references := rule.FromTable == removedTable || rule.FromTable == qualified
+			for _, toTable := range rule.ToTables {
+				references = references || toTable == qualified || strings.HasPrefix(toTable, qualified+"@")
+			}
+			if references {
+				warnings = append(warnings, fmt.Sprintf("table %s is referenced by the routing rule from %s", removedTable, rule.FromTable))
+			}
+		}
+	}
+	vschemaTable, ok := vschema.Tables[table]
+	if !ok {
+		return warnings
+	}
+	for _, columnVindex := range vschemaTable.ColumnVindexes {
+		columns := columnVindex.Columns
+		if columnVindex.Column != "" {
+			columns = append(columns, columnVindex.Column)
+		}
+		for _, column := range columns {
+			if change, ok := changedColumns[strings.ToLower(column)]; ok {
+				warnings = append(warnings, fmt.Sprintf("%s column %s, used by vindex %s of table %s", change, column, columnVindex.Name, table))
+			}
+		}
+	}
+	if autoIncrement := vschemaTable.AutoIncrement; autoIncrement != nil {
+		if change, ok := changedColumns[strings.ToLower(autoIncrement.Column)]; ok {
+			warnings = append(warnings, fmt.Sprintf("%s column %s, used by sequence %s of table %s", change, autoIncrement.Column, autoIncrement.Sequence, table))
+		}
+	}
+	return warnings
+}
+
+// runningStream is a running vreplication stream which either reads from or
+// writes to some keyspace.
+type runningStream struct {
+	id             int64
+	workflow       string
+	targetKeyspace string
+	bls            *binlogdatapb.BinlogSource
+}
+
+// readRunningStreams reads the running vreplication streams from the masters
+// of all keyspaces, since streams reading from this keyspace run on the
+// masters of their target keyspaces.
+func (exec *TabletExecutor) readRunningStreams(ctx context.Context) ([]*runningStream, error) {
+	ts := exec.wr.TopoServer()
+	keyspaces, err := ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var streams []*runningStream
+	for _, keyspace := range keyspaces {
+		shardNames, err := ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return nil, err
+		}
+		for _, shardName := range shardNames {
+			shardInfo, err := ts.GetShard(ctx, keyspace, shardName)
+			if err != nil {
+				return nil, err
+			}
+			if !shardInfo.HasMaster() {
+				continue
+			}
+			tabletInfo, err := ts.GetTablet(ctx, shardInfo.MasterAlias)
+			if err != nil {
+				return nil, err
+			}
+			query, err := sqlparser.BuildParsedQuery(sqlSelectRunningStreams, ":db_name").GenerateQuery(map[string]*querypb.BindVariable{
+				"db_name": sqltypes.StringBindVariable(topoproto.TabletDbName(tabletInfo.Tablet)),
+			}, nil)
+			if err != nil {
+				return nil, err
+			}
+			qr, err := exec.wr.TabletManagerClient().VReplicationExec(ctx, tabletInfo.Tablet, query)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read vreplication streams, keyspace: %s, shard: %s, error: %v", keyspace, shardName, err)
+			}
+			for _, row := range sqltypes.Proto3ToResult(qr).Rows {
+				if len(row) < 3 {
+					continue
+				}
+				id, err := row[0].ToInt64()
+				if err != nil {
+					return nil, err
+				}
+				var bls binlogdatapb.BinlogSource
+				if err := proto.UnmarshalText(row[2].ToString(), &bls); err != nil {
+					return nil, err
+				}
+				streams = append(streams, &runningStream{id: id, workflow: row[1].ToString(), targetKeyspace: keyspace, bls: &bls})
+			}
+		}
+	}
+	return streams, nil
+}
+
+// warnings reports whether a schema change to the given keyspace breaks the stream.
+func (stream *runningStream) warnings(keyspace, table string, removedTables []string, changedColumns map[string]string) (warnings []string) {
+	if stream.bls.Filter == nil {
+		return nil
+	}
+	describe := fmt.Sprintf("vreplication stream %d of workflow %s", stream.id, stream.workflow)
+	for _, rule := range stream.bls.Filter.Rules {
+		sourceTable, targetTable, columns := parseFilterRule(rule)
+		var streamTables []string
+		if stream.bls.Keyspace == keyspace {
+			streamTables = append(streamTables, sourceTable)
+		}
+		if stream.targetKeyspace == keyspace {
+			streamTables = append(streamTables, targetTable)
+		}
+		for _, streamTable := range streamTables {
+			for _, removedTable := range removedTables {
+				if removedTable == streamTable {
+					warnings = append(warnings, fmt.Sprintf("table %s is used by running %s", removedTable, describe))
+				}
+			}
+			if streamTable != table {
+				continue
+			}
+			for _, column := range sortedColumns(changedColumns) {
+				if columns == nil || columns[column] {
+					warnings = append(warnings, fmt.Sprintf("%s column %s, used by running %s", changedColumns[column], column, describe))
+				}
+			}
+		}
+	}
+	return warnings
+}
+
+// sortedColumns returns the columns of a changed columns map, sorted.
+func sortedColumns(changedColumns map[string]string) []string {
+	columns := make([]string, 0, len(changedColumns))
+	for column := range changedColumns {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// parseFilterRule returns the tables a vreplication filter rule reads from
+// and writes to, and the columns it names. columns is nil when the rule
+// uses all columns.
+func parseFilterRule(rule *binlogdatapb.Rule) (sourceTable, targetTable string, columns map[string]bool) {
+	sourceTable, targetTable = rule.Match, rule.Match
+	stmt, err := sqlparser.Parse(rule.Filter)
+	if err != nil {
+		return sourceTable, targetTable, nil
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return sourceTable, targetTable, nil
+	}
+	if len(sel.From) == 1 {
+		if aliased, ok := sel.From[0].(*sqlparser.AliasedTableExpr); ok {
+			if tableName, ok := aliased.Expr.(sqlparser.TableName); ok {
+				sourceTable = tableName.Name.String()
+			}
+		}
+	}
+	columns = map[string]bool{}
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			// select *
+			return sourceTable, targetTable, nil
+		}
+		if !aliased.As.IsEmpty() {
+			columns[aliased.As.Lowered()] = true
+		}
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			columns[col.Name.Lowered()] = true
+		}
+		return true, nil
+	}, sel.SelectExprs, sel.Where)
+	return sourceTable, targetTable, columns
+}
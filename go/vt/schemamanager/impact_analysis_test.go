@@ -0,0 +1,181 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemamanager
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestAnalyzeAlterOptions(t *testing.T) {
+	tt := []struct {
+		version        string
+		alterOptions   string
+		algorithm      AlterAlgorithm
+		changedColumns map[string]string
+	}{
+		{version: "8.0.23", alterOptions: "add column i int", algorithm: AlterAlgorithmInstant},
+		{version: "8.0.23", alterOptions: "add column i int after id", algorithm: AlterAlgorithmInplace},
+		{version: "8.0.30", alterOptions: "add column i int after id", algorithm: AlterAlgorithmInstant},
+		{version: "5.7.31", alterOptions: "add column i int", algorithm: AlterAlgorithmInplace},
+		{version: "10.4.12-MariaDB", alterOptions: "add column i int first", algorithm: AlterAlgorithmInstant},
+		{version: "", alterOptions: "add column i int", algorithm: AlterAlgorithmInplace},
+		{version: "8.0.23", alterOptions: "add key name_idx (name, val)", algorithm: AlterAlgorithmInplace},
+		{version: "8.0.23", alterOptions: "add constraint fk foreign key (pid) references p (id)", algorithm: AlterAlgorithmCopy},
+		{version: "8.0.23", alterOptions: "drop key name_idx, drop column `name`", algorithm: AlterAlgorithmInplace, changedColumns: map[string]string{"name": "drops"}},
+		{version: "8.0.23", alterOptions: "modify id bigint, change column val value int", algorithm: AlterAlgorithmCopy, changedColumns: map[string]string{"id": "redefines", "val": "redefines"}},
+		{version: "8.0.28", alterOptions: "rename column val to value", algorithm: AlterAlgorithmInstant, changedColumns: map[string]string{"val": "renames"}},
+		{version: "8.0.23", alterOptions: "alter column val set default 1", algorithm: AlterAlgorithmInstant},
+		{version: "8.0.23", alterOptions: "engine=InnoDB", algorithm: AlterAlgorithmInplace},
+		{version: "8.0.23", alterOptions: "convert to character set utf8mb4", algorithm: AlterAlgorithmCopy},
+		{version: "8.0.23", alterOptions: "add column e enum('a,b', 'c'), drop primary key", algorithm: AlterAlgorithmCopy},
+	}
+	for _, tc := range tt {
+		impact := analyzeAlterOptions(tc.alterOptions, newServerCapabilities(tc.version))
+		if impact.algorithm != tc.algorithm {
+			t.Errorf("%s on %s: expect algorithm %s, got: %s", tc.alterOptions, tc.version, tc.algorithm, impact.algorithm)
+		}
+		if tc.changedColumns == nil {
+			tc.changedColumns = map[string]string{}
+		}
+		if !reflect.DeepEqual(impact.changedColumns, tc.changedColumns) {
+			t.Errorf("%s: expect changed columns %v, got: %v", tc.alterOptions, tc.changedColumns, impact.changedColumns)
+		}
+	}
+}
+
+func TestAnalyzeImpact(t *testing.T) {
+	ctx := context.Background()
+	ts := newFakeTopo(t)
+	tmc := newFakeTabletManagerClient()
+	tmc.mysqlVersion = "8.0.23-log"
+	tmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "t1", DataLength: 320 * 1024 * 1024},
+			{Name: "t2", DataLength: 1024},
+		},
+	})
+	if err := ts.SaveVSchema(ctx, "test_keyspace", &vschemapb.Keyspace{
+		Sharded:  true,
+		Vindexes: map[string]*vschemapb.Vindex{"hash": {Type: "hash"}},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Column: "id"}}},
+		},
+	}); err != nil {
+		t.Fatalf("SaveVSchema failed: %v", err)
+	}
+	if err := ts.SaveRoutingRules(ctx, &vschemapb.RoutingRules{
+		Rules: []*vschemapb.RoutingRule{{FromTable: "t2", ToTables: []string{"test_keyspace.t2"}}},
+	}); err != nil {
+		t.Fatalf("SaveRoutingRules failed: %v", err)
+	}
+	tmc.vreplicationStreams[4] = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|workflow|source", "int64|varchar|varchar"),
+		`1|wf|keyspace:"test_keyspace" shard:"0" filter:<rules:<match:"t1_copy" filter:"select id, name from t1" > > `,
+	)
+	executor := NewTabletExecutor(wrangler.New(logutil.NewConsoleLogger(), ts, tmc), testWaitReplicasTimeout)
+	if err := executor.Open(ctx, "test_keyspace"); err != nil {
+		t.Fatalf("executor.Open should succeed, but got error: %v", err)
+	}
+	defer executor.Close()
+
+	reports, err := executor.AnalyzeImpact(ctx, []string{
+		"alter table t1 add column val int",
+		"alter table t1 modify id bigint, drop column name",
+		"drop table t2",
+		"alter with 'online' table t1 add column val int",
+	})
+	if err != nil {
+		t.Fatalf("AnalyzeImpact should succeed, but got error: %v", err)
+	}
+	expected := []*ImpactReport{
+		{
+			SQL:       "alter table t1 add column val int",
+			Table:     "t1",
+			Algorithm: AlterAlgorithmInstant,
+		},
+		{
+			SQL:               "alter table t1 modify id bigint, drop column name",
+			Table:             "t1",
+			Algorithm:         AlterAlgorithmCopy,
+			EstimatedDuration: 10 * time.Second,
+			Warnings: []string{
+				"redefines column id, used by vindex hash of table t1",
+				"redefines column id, used by running vreplication stream 1 of workflow wf",
+				"drops column name, used by running vreplication stream 1 of workflow wf",
+			},
+		},
+		{
+			SQL:       "drop table t2",
+			Table:     "t2",
+			Algorithm: AlterAlgorithmInstant,
+			Warnings:  []string{"table t2 is referenced by the routing rule from t2"},
+		},
+		{
+			// An online migration copies the table even if MySQL would not.
+			SQL:               "alter with 'online' table t1 add column val int",
+			Table:             "t1",
+			Algorithm:         AlterAlgorithmInstant,
+			EstimatedDuration: 10 * time.Second,
+			Warnings:          []string{"runs as an online migration with strategy online, which copies the table without blocking writes"},
+		},
+	}
+	if !reflect.DeepEqual(reports, expected) {
+		t.Fatalf("expect impact reports:\n%v, but got:\n%v", expected, reports)
+	}
+}
+
+func TestAnalyzeImpactMySQLVersions(t *testing.T) {
+	ctx := context.Background()
+	tmc := newFakeTabletManagerClient()
+	tmc.mysqlVersion = "8.0.23-log"
+	tmc.shardMySQLVersions["2"] = "5.7.31-log"
+	tmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{Name: "t1", DataLength: 320 * 1024 * 1024}},
+	})
+	executor := NewTabletExecutor(wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), tmc), testWaitReplicasTimeout)
+	if err := executor.Open(ctx, "test_keyspace"); err != nil {
+		t.Fatalf("executor.Open should succeed, but got error: %v", err)
+	}
+	defer executor.Close()
+
+	reports, err := executor.AnalyzeImpact(ctx, []string{"alter table t1 add column val int"})
+	if err != nil {
+		t.Fatalf("AnalyzeImpact should succeed, but got error: %v", err)
+	}
+	// MySQL 5.7 cannot add a column instantly.
+	expected := []*ImpactReport{{
+		SQL:               "alter table t1 add column val int",
+		Table:             "t1",
+		Algorithm:         AlterAlgorithmInplace,
+		EstimatedDuration: 10 * time.Second,
+		Warnings:          []string{`shards run different MySQL versions ("5.7.31-log" on shards 2; "8.0.23-log" on shards 0, 1), assuming the least capable`},
+	}}
+	if !reflect.DeepEqual(reports, expected) {
+		t.Fatalf("expect impact reports:\n%v, but got:\n%v", expected, reports)
+	}
+}
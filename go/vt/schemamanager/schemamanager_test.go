@@ -24,6 +24,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/topo"
@@ -237,6 +238,9 @@ func newFakeTabletManagerClient() *fakeTabletManagerClient {
 		TabletManagerClient: faketmclient.NewFakeTabletManagerClient(),
 		preflightSchemas:    make(map[string]*tabletmanagerdatapb.SchemaChangeResult),
 		schemaDefinitions:   make(map[string]*tabletmanagerdatapb.SchemaDefinition),
+		shardSchemas:        make(map[string]*tabletmanagerdatapb.SchemaDefinition),
+		shardMySQLVersions:  make(map[string]string),
+		vreplicationStreams: make(map[uint32]*sqltypes.Result),
 	}
 }
 
@@ -245,6 +249,12 @@ type fakeTabletManagerClient struct {
 	EnableExecuteFetchAsDbaError bool
 	preflightSchemas             map[string]*tabletmanagerdatapb.SchemaChangeResult
 	schemaDefinitions            map[string]*tabletmanagerdatapb.SchemaDefinition
+	// shardSchemas overrides schemaDefinitions for the tablets of a shard
+	shardSchemas map[string]*tabletmanagerdatapb.SchemaDefinition
+	mysqlVersion string
+	// shardMySQLVersions overrides mysqlVersion for the tablets of a shard
+	shardMySQLVersions  map[string]string
+	vreplicationStreams map[uint32]*sqltypes.Result
 }
 
 func (client *fakeTabletManagerClient) AddSchemaChange(sql string, schemaResult *tabletmanagerdatapb.SchemaChangeResult) {
@@ -270,6 +280,9 @@ func (client *fakeTabletManagerClient) PreflightSchema(ctx context.Context, tabl
 }
 
 func (client *fakeTabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	if result, ok := client.shardSchemas[tablet.Shard]; ok {
+		return result, nil
+	}
 	result, ok := client.schemaDefinitions[topoproto.TabletDbName(tablet)]
 	if !ok {
 		return nil, fmt.Errorf("unknown database: %s", topoproto.TabletDbName(tablet))
@@ -281,9 +294,23 @@ func (client *fakeTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, ta
 	if client.EnableExecuteFetchAsDbaError {
 		return nil, fmt.Errorf("ExecuteFetchAsDba occur an unknown error")
 	}
+	if string(query) == "select @@version" && client.mysqlVersion != "" {
+		version := client.mysqlVersion
+		if shardVersion, ok := client.shardMySQLVersions[tablet.Shard]; ok {
+			version = shardVersion
+		}
+		return sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("@@version", "varchar"), version)), nil
+	}
 	return client.TabletManagerClient.ExecuteFetchAsDba(ctx, tablet, usePool, query, maxRows, disableBinlogs, reloadSchema)
 }
 
+func (client *fakeTabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	if result, ok := client.vreplicationStreams[tablet.Alias.Uid]; ok {
+		return sqltypes.ResultToProto3(result), nil
+	}
+	return &querypb.QueryResult{}, nil
+}
+
 // newFakeTopo returns a topo with:
 // - a keyspace named 'test_keyspace'.
 // - 3 shards named '1', '2', '3'.
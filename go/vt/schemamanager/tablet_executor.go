@@ -40,6 +40,7 @@ type TabletExecutor struct {
 	allowBigSchemaChange bool
 	keyspace             string
 	waitReplicasTimeout  time.Duration
+	migrationContext     string
 }
 
 // NewTabletExecutor creates a new TabletExecutor instance
@@ -64,6 +65,12 @@ func (exec *TabletExecutor) DisallowBigSchemaChange() {
 	exec.allowBigSchemaChange = false
 }
 
+// SetMigrationContext sets the context of the online DDL migrations submitted
+// by TabletExecutor. Migrations in a context succeed or fail together.
+func (exec *TabletExecutor) SetMigrationContext(migrationContext string) {
+	exec.migrationContext = migrationContext
+}
+
 // Open opens a connection to the master for every shard.
 func (exec *TabletExecutor) Open(ctx context.Context, keyspace string) error {
 	if !exec.isClosed {
@@ -248,13 +255,27 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 		tableName := ""
 		switch ddl := stat.(type) {
 		case *sqlparser.DDL:
-			if ddl.Action == sqlparser.AlterDDLAction {
+			switch ddl.Action {
+			case sqlparser.AlterDDLAction:
+				if ddl.OnlineHint != nil {
+					strategy = ddl.OnlineHint.Strategy
+					options = ddl.OnlineHint.Options
+				}
+				tableName = ddl.Table.Name.String()
+			case sqlparser.DropDDLAction:
 				if ddl.OnlineHint != nil {
+					// An online DROP TABLE renames the table away, to be lazily dropped by the table GC.
+					if len(ddl.FromTables) != 1 {
+						execResult.ExecutorErr = fmt.Sprintf("online DROP TABLE must drop a single table: %s", sql)
+						return &execResult
+					}
 					strategy = ddl.OnlineHint.Strategy
 					options = ddl.OnlineHint.Options
+					tableName = ddl.FromTables[0].Name.String()
 				}
+			default:
+				tableName = ddl.Table.Name.String()
 			}
-			tableName = ddl.Table.Name.String()
 		}
 		exec.wr.Logger().Infof("Received DDL request. strategy = %+v", strategy)
 		exec.executeOnAllTablets(ctx, &execResult, sql, tableName, strategy, options)
@@ -275,6 +296,7 @@ func (exec *TabletExecutor) executeOnAllTablets(
 			execResult.ExecutorErr = err.Error()
 			return
 		}
+		onlineDDL.MigrationContext = exec.migrationContext
 		conn, err := exec.wr.TopoServer().ConnForCell(ctx, topo.GlobalCell)
 		if err != nil {
 			execResult.ExecutorErr = fmt.Sprintf("online DDL ConnForCell error:%s", err.Error())
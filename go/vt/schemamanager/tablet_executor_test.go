@@ -216,3 +216,17 @@ func TestTabletExecutorExecute(t *testing.T) {
 		t.Fatalf("execute should fail, call execute.Open first")
 	}
 }
+
+func TestTabletExecutorExecuteOnlineDropMultipleTables(t *testing.T) {
+	executor := newFakeExecutor(t)
+	ctx := context.Background()
+	if err := executor.Open(ctx, "test_keyspace"); err != nil {
+		t.Fatalf("executor.Open should succeed, but got error: %v", err)
+	}
+	defer executor.Close()
+
+	result := executor.Execute(ctx, []string{"DROP WITH 'online' TABLE t1, t2"})
+	if !strings.Contains(result.ExecutorErr, "single table") {
+		t.Fatalf("online DROP TABLE of multiple tables should fail, but got: %v", result.ExecutorErr)
+	}
+}
@@ -208,7 +208,7 @@ type (
 		Charset     string
 	}
 
-	// DDLStrategy suggests how an ALTER TABLE should run (e.g. "" for normal, "gh-ost" or "pt-osc")
+	// DDLStrategy suggests how an ALTER TABLE should run (e.g. "" for normal, "gh-ost", "pt-osc" or "online")
 	DDLStrategy string
 
 	// OnlineDDLHint indicates strategy and options for running an online DDL
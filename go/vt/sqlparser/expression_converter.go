@@ -18,6 +18,7 @@ package sqlparser
 
 import (
 	"fmt"
+	"strconv"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
@@ -75,3 +76,253 @@ func Convert(e Expr) (evalengine.Expr, error) {
 	}
 	return nil, ErrExprNotSupported
 }
+
+// ConvertVReplicationExpr converts the filter and select expressions
+// of vreplication streams. On top of what Convert supports, it handles
+// comparisons, logical operators, IN, BETWEEN, IS, casts and builtin
+// functions, and column references: findColumn returns the offset of
+// the column in the rows the expression is evaluated against.
+// Strings are compared and measured byte-wise, without collations,
+// which is why vtgate must keep using Convert.
+func ConvertVReplicationExpr(e Expr, findColumn func(col *ColName) (int, error)) (evalengine.Expr, error) {
+	return convert(e, findColumn)
+}
+
+func convert(e Expr, findColumn func(col *ColName) (int, error)) (evalengine.Expr, error) {
+	switch node := e.(type) {
+	case Argument:
+		return evalengine.NewBindVar(string(node[1:])), nil
+	case *Literal:
+		switch node.Type {
+		case IntVal:
+			return evalengine.NewLiteralIntFromBytes(node.Val)
+		case FloatVal:
+			return evalengine.NewLiteralFloat(node.Val)
+		case StrVal:
+			return evalengine.NewLiteralString(node.Val), nil
+		}
+	case BoolVal:
+		if node {
+			return evalengine.NewLiteralIntFromBytes([]byte("1"))
+		}
+		return evalengine.NewLiteralIntFromBytes([]byte("0"))
+	case *NullVal:
+		return evalengine.NewLiteralNull(), nil
+	case *ColName:
+		if findColumn == nil {
+			return nil, ErrExprNotSupported
+		}
+		offset, err := findColumn(node)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewColumn(offset), nil
+	case *BinaryExpr:
+		var op evalengine.BinaryExpr
+		switch node.Operator {
+		case PlusOp:
+			op = &evalengine.Addition{}
+		case MinusOp:
+			op = &evalengine.Subtraction{}
+		case MultOp:
+			op = &evalengine.Multiplication{}
+		case DivOp:
+			op = &evalengine.Division{}
+		case JSONExtractOp, JSONUnquoteExtractOp:
+			return convertJSONExtract(node, findColumn)
+		default:
+			return nil, ErrExprNotSupported
+		}
+		return convertBinaryOp(op, node.Left, node.Right, findColumn)
+	case *ComparisonExpr:
+		if node.Escape != nil {
+			return nil, ErrExprNotSupported
+		}
+		var op evalengine.BinaryExpr
+		switch node.Operator {
+		case EqualOp:
+			op = &evalengine.Equal{}
+		case NotEqualOp:
+			op = &evalengine.NotEqual{}
+		case NullSafeEqualOp:
+			op = &evalengine.NullSafeEqual{}
+		case LessThanOp:
+			op = &evalengine.LessThan{}
+		case LessEqualOp:
+			op = &evalengine.LessEqual{}
+		case GreaterThanOp:
+			op = &evalengine.GreaterThan{}
+		case GreaterEqualOp:
+			op = &evalengine.GreaterEqual{}
+		case InOp, NotInOp:
+			return convertIn(node, findColumn)
+		default:
+			return nil, ErrExprNotSupported
+		}
+		return convertBinaryOp(op, node.Left, node.Right, findColumn)
+	case *RangeCond:
+		left, err := convert(node.Left, findColumn)
+		if err != nil {
+			return nil, err
+		}
+		from, err := convert(node.From, findColumn)
+		if err != nil {
+			return nil, err
+		}
+		to, err := convert(node.To, findColumn)
+		if err != nil {
+			return nil, err
+		}
+		var between evalengine.Expr = &evalengine.BinaryOp{
+			Expr:  &evalengine.And{},
+			Left:  &evalengine.BinaryOp{Expr: &evalengine.GreaterEqual{}, Left: left, Right: from},
+			Right: &evalengine.BinaryOp{Expr: &evalengine.LessEqual{}, Left: left, Right: to},
+		}
+		if node.Operator == NotBetweenOp {
+			between = &evalengine.NotExpr{Inner: between}
+		}
+		return between, nil
+	case *AndExpr:
+		return convertBinaryOp(&evalengine.And{}, node.Left, node.Right, findColumn)
+	case *OrExpr:
+		return convertBinaryOp(&evalengine.Or{}, node.Left, node.Right, findColumn)
+	case *XorExpr:
+		return convertBinaryOp(&evalengine.Xor{}, node.Left, node.Right, findColumn)
+	case *NotExpr:
+		inner, err := convert(node.Expr, findColumn)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.NotExpr{Inner: inner}, nil
+	case *IsExpr:
+		inner, err := convert(node.Expr, findColumn)
+		if err != nil {
+			return nil, err
+		}
+		var op evalengine.IsOp
+		switch node.Operator {
+		case IsNullOp:
+			op = evalengine.IsNullOp
+		case IsNotNullOp:
+			op = evalengine.IsNotNullOp
+		case IsTrueOp:
+			op = evalengine.IsTrueOp
+		case IsNotTrueOp:
+			op = evalengine.IsNotTrueOp
+		case IsFalseOp:
+			op = evalengine.IsFalseOp
+		case IsNotFalseOp:
+			op = evalengine.IsNotFalseOp
+		default:
+			return nil, ErrExprNotSupported
+		}
+		return &evalengine.IsExpr{Op: op, Inner: inner}, nil
+	case *FuncExpr:
+		if node.Distinct || !node.Qualifier.IsEmpty() || !evalengine.IsBuiltinFunc(node.Name.String()) {
+			return nil, ErrExprNotSupported
+		}
+		args := make([]evalengine.Expr, 0, len(node.Exprs))
+		for _, selExpr := range node.Exprs {
+			aliased, ok := selExpr.(*AliasedExpr)
+			if !ok {
+				return nil, ErrExprNotSupported
+			}
+			arg, err := convert(aliased.Expr, findColumn)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		return evalengine.NewFuncExpr(node.Name.String(), args)
+	case *ConvertExpr:
+		inner, err := convert(node.Expr, findColumn)
+		if err != nil {
+			return nil, err
+		}
+		length, err := convertTypeLength(node.Type.Length)
+		if err != nil {
+			return nil, err
+		}
+		scale, err := convertTypeLength(node.Type.Scale)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewConvertExpr(inner, node.Type.Type, length, scale)
+	case *ConvertUsingExpr:
+		inner, err := convert(node.Expr, findColumn)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewConvertUsingExpr(inner, node.Type), nil
+	}
+	return nil, ErrExprNotSupported
+}
+
+// convertTypeLength returns the value of the length or scale of a
+// ConvertType, or -1 if there's none.
+func convertTypeLength(l *Literal) (int, error) {
+	if l == nil {
+		return -1, nil
+	}
+	return strconv.Atoi(string(l.Val))
+}
+
+// convertJSONExtract converts the -> and ->> operators to calls to
+// json_extract and json_unquote.
+func convertJSONExtract(node *BinaryExpr, findColumn func(col *ColName) (int, error)) (evalengine.Expr, error) {
+	doc, err := convert(node.Left, findColumn)
+	if err != nil {
+		return nil, err
+	}
+	path, err := convert(node.Right, findColumn)
+	if err != nil {
+		return nil, err
+	}
+	extract, err := evalengine.NewFuncExpr("json_extract", []evalengine.Expr{doc, path})
+	if err != nil {
+		return nil, err
+	}
+	if node.Operator == JSONExtractOp {
+		return extract, nil
+	}
+	return evalengine.NewFuncExpr("json_unquote", []evalengine.Expr{extract})
+}
+
+func convertBinaryOp(op evalengine.BinaryExpr, l, r Expr, findColumn func(col *ColName) (int, error)) (evalengine.Expr, error) {
+	left, err := convert(l, findColumn)
+	if err != nil {
+		return nil, err
+	}
+	right, err := convert(r, findColumn)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.BinaryOp{
+		Expr:  op,
+		Left:  left,
+		Right: right,
+	}, nil
+}
+
+func convertIn(node *ComparisonExpr, findColumn func(col *ColName) (int, error)) (evalengine.Expr, error) {
+	tuple, ok := node.Right.(ValTuple)
+	if !ok {
+		return nil, ErrExprNotSupported
+	}
+	left, err := convert(node.Left, findColumn)
+	if err != nil {
+		return nil, err
+	}
+	in := &evalengine.InExpr{
+		Left:   left,
+		Negate: node.Operator == NotInOp,
+	}
+	for _, expr := range tuple {
+		val, err := convert(expr, findColumn)
+		if err != nil {
+			return nil, err
+		}
+		in.List = append(in.List, val)
+	}
+	return in, nil
+}
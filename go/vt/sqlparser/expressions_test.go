@@ -17,6 +17,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"fmt"
 	"testing"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -99,3 +100,190 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluateVReplicationExpr(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   sqltypes.Value
+	}{{
+		expression: "40 + null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "2 > 1 and 'a' < 'b'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 = 2 or null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "null <=> null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'10' = 10.0",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "3 in (1, 2, 3)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "3 not in (1, null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "5 between 1 and 10",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null is null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "not 0",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "upper('abc')",
+		expected:   sqltypes.NewVarBinary("ABC"),
+	}, {
+		expression: "char_length(trim('  héllo '))",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "ifnull(null, 'x')",
+		expected:   sqltypes.NewVarBinary("x"),
+	}, {
+		expression: "date('2020-10-18 15:04:05')",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-10-18")),
+	}, {
+		expression: "month('2020-10-18')",
+		expected:   sqltypes.NewInt64(10),
+	}, {
+		expression: "year('not a date')",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "cast('12.7abc' as signed)",
+		expected:   sqltypes.NewInt64(12),
+	}, {
+		expression: "cast(12.7 as unsigned)",
+		expected:   sqltypes.NewUint64(13),
+	}, {
+		expression: "convert('2020-10-18 15:04:05', date)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-10-18")),
+	}, {
+		expression: "cast('héllo' as char(2))",
+		expected:   sqltypes.NewVarBinary("hé"),
+	}, {
+		expression: "cast(3.14159 as decimal(10, 2))",
+		expected:   sqltypes.NewFloat64(3.14),
+	}, {
+		expression: "convert('abc' using utf8mb4)",
+		expected:   sqltypes.NewVarBinary("abc"),
+	}, {
+		expression: `cast('{"b":[1,2],"a":"x"}' as json)`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"a": "x", "b": [1, 2]}`)),
+	}, {
+		expression: "concat('a', 1, 'b')",
+		expected:   sqltypes.NewVarBinary("a1b"),
+	}, {
+		expression: "concat('a', null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "concat_ws(' ', 'john', null, 'smith')",
+		expected:   sqltypes.NewVarBinary("john smith"),
+	}, {
+		expression: "substring_index('john ronald smith', ' ', -1)",
+		expected:   sqltypes.NewVarBinary("smith"),
+	}, {
+		expression: `json_extract('{"a": {"b": [10, 20]}}', '$.a.b[1]')`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte("20")),
+	}, {
+		expression: `json_extract('{"a": 1, "b": 2}', '$.b', '$.c', '$.a')`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte("[2, 1]")),
+	}, {
+		expression: `json_extract('{"a": 1}', '$.c')`,
+		expected:   sqltypes.NULL,
+	}, {
+		expression: `json_unquote(json_extract('{"a": "x\\ty"}', '$.a'))`,
+		expected:   sqltypes.NewVarBinary("x\ty"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := Parse("select " + test.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			expr, err := ConvertVReplicationExpr(astExpr, nil)
+			require.NoError(t, err)
+			r, err := expr.Evaluate(evalengine.ExpressionEnv{Filter: true})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, r.Value(), "expected %s", test.expected.String())
+		})
+	}
+}
+
+// TestConvertIsLimitedForVTGate checks that the expressions vreplication
+// evaluates byte-wise are not evaluated by vtgate, which must leave them
+// to MySQL and its collations.
+func TestConvertIsLimitedForVTGate(t *testing.T) {
+	for _, expression := range []string{
+		"'a' = 'A'",
+		"1 and 0",
+		"3 in (1, 2, 3)",
+		"length('é')",
+		"concat('a', 'b')",
+		"cast('1' as signed)",
+		"null",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			stmt, err := Parse("select " + expression)
+			require.NoError(t, err)
+			_, err = Convert(stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr)
+			assert.Equal(t, ErrExprNotSupported, err)
+		})
+	}
+}
+
+func TestEvaluateVReplicationExprWithColumns(t *testing.T) {
+	fields := []string{"id", "name", "deleted_at"}
+	findColumn := func(col *ColName) (int, error) {
+		for i, field := range fields {
+			if col.Name.EqualString(field) {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("column %s not found", String(col))
+	}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("alice"), sqltypes.NULL},
+		{sqltypes.NewInt64(2), sqltypes.NewVarChar("bob"), sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-01-02 03:04:05"))},
+		{sqltypes.NewInt64(3), sqltypes.NewVarChar("carol"), sqltypes.NULL},
+	}
+
+	tests := []struct {
+		expression string
+		expected   []bool
+	}{{
+		expression: "deleted_at is null",
+		expected:   []bool{true, false, true},
+	}, {
+		expression: "id in (2, 3) and name != 'carol'",
+		expected:   []bool{false, true, false},
+	}, {
+		expression: "lower(name) like 'a%'",
+	}, {
+		expression: "year(deleted_at) = 2020 or id = 1",
+		expected:   []bool{true, true, false},
+	}, {
+		expression: "missing = 1",
+	}}
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := Parse("select 1 from t where " + test.expression)
+			require.NoError(t, err)
+			expr, err := ConvertVReplicationExpr(stmt.(*Select).Where.Expr, findColumn)
+			if test.expected == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for i, row := range rows {
+				r, err := expr.Evaluate(evalengine.ExpressionEnv{Row: row, Filter: true})
+				require.NoError(t, err)
+				assert.Equal(t, test.expected[i], r.IsTruthy(), "row %d", i)
+			}
+		})
+	}
+}
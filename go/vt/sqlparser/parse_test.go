@@ -1195,6 +1195,12 @@ var (
 	}, {
 		input:  "drop table if exists a",
 		output: "drop table if exists a",
+	}, {
+		input:  "drop with 'online' table a",
+		output: "drop table a",
+	}, {
+		input:  "drop with 'gh-ost' '--max-load=Threads_running=100' table if exists a",
+		output: "drop table if exists a",
 	}, {
 		input:  "drop view if exists a",
 		output: "drop table if exists a",
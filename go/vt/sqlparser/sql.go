@@ -885,949 +885,840 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 45,
-	34, 324,
-	148, 324,
-	160, 324,
-	185, 338,
-	186, 338,
-	-2, 326,
+	34, 325,
+	148, 325,
+	160, 325,
+	185, 339,
+	186, 339,
+	-2, 327,
 	-1, 50,
-	150, 348,
-	-2, 346,
+	150, 349,
+	-2, 347,
 	-1, 74,
-	54, 384,
-	-2, 392,
-	-1, 416,
-	136, 749,
-	-2, 745,
-	-1, 417,
-	136, 750,
-	-2, 746,
-	-1, 432,
 	54, 385,
-	-2, 397,
-	-1, 433,
+	-2, 393,
+	-1, 418,
+	136, 751,
+	-2, 747,
+	-1, 419,
+	136, 752,
+	-2, 748,
+	-1, 434,
 	54, 386,
 	-2, 398,
-	-1, 453,
-	104, 1017,
+	-1, 435,
+	54, 387,
+	-2, 399,
+	-1, 455,
+	104, 1019,
 	-2, 76,
-	-1, 454,
-	104, 925,
+	-1, 456,
+	104, 927,
 	-2, 77,
-	-1, 459,
-	104, 887,
-	-2, 708,
 	-1, 461,
-	104, 961,
-	-2, 710,
-	-1, 972,
-	136, 752,
-	-2, 748,
-	-1, 1059,
+	104, 889,
+	-2, 709,
+	-1, 463,
+	104, 963,
+	-2, 711,
+	-1, 976,
+	136, 754,
+	-2, 750,
+	-1, 1063,
 	72, 58,
 	74, 58,
 	-2, 62,
-	-1, 1424,
-	5, 636,
-	18, 636,
-	20, 636,
-	32, 636,
-	75, 636,
-	-2, 423,
-	-1, 1623,
-	44, 679,
-	-2, 677,
+	-1, 1429,
+	5, 637,
+	18, 637,
+	20, 637,
+	32, 637,
+	75, 637,
+	-2, 424,
+	-1, 1628,
+	44, 680,
+	-2, 678,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 19650
+const yyLast = 19288
 
 var yyAct = [...]int{
 
-	416, 1719, 1709, 1471, 997, 1346, 1645, 1566, 1535, 1623,
-	360, 1677, 1245, 1081, 1588, 1549, 639, 1265, 375, 1053,
-	1400, 1440, 1403, 620, 1077, 73, 3, 1246, 1296, 1404,
-	766, 1080, 1127, 719, 588, 1112, 1090, 389, 1410, 1232,
-	94, 1416, 1365, 1050, 306, 893, 329, 306, 1313, 912,
-	959, 458, 94, 966, 306, 1181, 1323, 637, 804, 797,
-	71, 1095, 585, 1032, 792, 1055, 1039, 759, 764, 1106,
-	425, 434, 362, 992, 787, 936, 769, 29, 428, 1123,
-	786, 1061, 94, 584, 803, 94, 306, 419, 306, 346,
-	794, 776, 801, 351, 69, 629, 358, 907, 455, 74,
-	922, 68, 1620, 1464, 1545, 1568, 609, 1712, 304, 8,
-	7, 732, 6, 1674, 1707, 1653, 294, 1700, 342, 292,
-	733, 1472, 1673, 1652, 1382, 1501, 593, 1434, 302, 298,
-	299, 300, 76, 77, 78, 79, 80, 81, 1071, 31,
-	32, 33, 62, 35, 36, 347, 1304, 420, 350, 805,
-	590, 806, 592, 440, 444, 1149, 349, 31, 652, 66,
-	62, 35, 36, 348, 37, 56, 57, 1105, 59, 1148,
-	1435, 1436, 1348, 60, 452, 1072, 1073, 1614, 681, 680,
-	690, 691, 683, 684, 685, 686, 687, 688, 689, 682,
-	1538, 401, 692, 407, 408, 405, 406, 404, 403, 402,
-	645, 646, 46, 1655, 1113, 647, 61, 409, 410, 648,
-	645, 646, 1492, 1490, 339, 96, 97, 98, 295, 1282,
-	651, 921, 1281, 341, 61, 1283, 1147, 337, 1349, 96,
-	97, 98, 640, 641, 650, 969, 881, 642, 1706, 1350,
-	878, 1699, 1646, 1345, 293, 1033, 880, 1638, 1723, 635,
-	1131, 1727, 1131, 923, 924, 925, 301, 1443, 610, 1131,
-	96, 97, 98, 587, 1597, 296, 595, 1266, 1268, 1589,
-	446, 1351, 39, 40, 42, 41, 44, 882, 58, 1144,
-	1141, 1142, 879, 1140, 1591, 886, 655, 1099, 598, 869,
-	1427, 1342, 1426, 306, 600, 601, 1425, 1344, 306, 309,
-	611, 45, 65, 64, 306, 297, 54, 55, 43, 1099,
-	306, 618, 1366, 591, 624, 1161, 1151, 1154, 1160, 1200,
-	1078, 94, 47, 48, 1627, 49, 50, 51, 52, 1517,
-	94, 626, 605, 628, 96, 97, 98, 1463, 1197, 704,
-	705, 1433, 94, 94, 1237, 1210, 1189, 1067, 1651, 1267,
-	1333, 692, 653, 1368, 780, 1590, 1146, 599, 1615, 717,
-	616, 1278, 608, 634, 682, 625, 627, 692, 615, 661,
-	1113, 837, 622, 1011, 617, 636, 630, 1656, 1145, 1721,
-	672, 1636, 1722, 1606, 1720, 913, 908, 654, 1598, 1596,
-	1370, 1414, 1374, 84, 1369, 807, 1367, 1329, 1330, 1331,
-	602, 1372, 603, 665, 1384, 604, 1098, 612, 613, 614,
-	1371, 631, 632, 993, 871, 63, 1343, 1641, 1341, 96,
-	97, 98, 1150, 1373, 1375, 993, 943, 1207, 1098, 643,
-	702, 1728, 85, 63, 1302, 1016, 1017, 1152, 666, 667,
-	941, 942, 940, 94, 704, 705, 306, 757, 306, 306,
-	756, 94, 1102, 664, 662, 773, 663, 94, 623, 1103,
-	1195, 1665, 1194, 704, 705, 1544, 1543, 455, 720, 1332,
-	825, 621, 1704, 1455, 1337, 1334, 1325, 1335, 1328, 1317,
-	1324, 670, 671, 669, 1326, 1327, 669, 914, 909, 61,
-	1729, 785, 685, 686, 687, 688, 689, 682, 1336, 672,
-	692, 939, 672, 670, 671, 669, 770, 1701, 755, 1693,
-	784, 838, 802, 796, 735, 737, 739, 741, 743, 745,
-	746, 672, 758, 736, 738, 1316, 742, 744, 1702, 747,
-	1694, 1174, 1175, 1176, 1305, 1703, 1390, 851, 854, 855,
-	856, 857, 858, 859, 594, 860, 861, 862, 863, 864,
-	839, 840, 841, 842, 823, 824, 852, 1695, 826, 1685,
-	827, 828, 829, 830, 831, 832, 833, 834, 835, 836,
-	843, 844, 845, 846, 847, 848, 849, 850, 1391, 1013,
-	931, 933, 934, 1667, 306, 445, 1637, 932, 865, 670,
-	671, 669, 867, 94, 450, 870, 1562, 872, 306, 306,
-	94, 94, 94, 670, 671, 669, 306, 672, 1196, 1541,
-	306, 1386, 1505, 306, 891, 892, 429, 306, 1392, 94,
-	1318, 672, 1314, 906, 94, 94, 94, 306, 94, 94,
-	671, 669, 898, 853, 1012, 596, 597, 884, 670, 671,
-	669, 94, 94, 897, 96, 97, 98, 672, 815, 606,
-	868, 1594, 1705, 670, 671, 669, 672, 875, 876, 877,
-	1063, 1413, 873, 874, 1603, 447, 448, 768, 895, 1602,
-	883, 672, 1669, 429, 796, 70, 896, 890, 670, 671,
-	669, 900, 901, 902, 1451, 904, 905, 31, 96, 97,
-	98, 903, 961, 960, 916, 1272, 672, 1062, 910, 911,
-	887, 1100, 962, 690, 691, 683, 684, 685, 686, 687,
-	688, 689, 682, 937, 1512, 692, 94, 96, 97, 98,
-	1064, 1285, 1066, 974, 975, 683, 684, 685, 686, 687,
-	688, 689, 682, 1573, 970, 692, 981, 984, 1594, 1649,
-	668, 915, 994, 1594, 429, 429, 918, 1063, 938, 94,
-	94, 976, 1594, 1628, 61, 378, 377, 380, 381, 382,
-	383, 1025, 1007, 971, 379, 384, 1594, 1593, 94, 972,
-	1533, 1532, 1519, 429, 31, 306, 1515, 429, 94, 1461,
-	1460, 720, 306, 1233, 306, 1457, 1458, 1457, 1456, 1024,
-	429, 1035, 306, 306, 306, 1036, 429, 970, 1233, 1240,
-	94, 963, 964, 94, 72, 1002, 1003, 1064, 1605, 1062,
-	668, 429, 1459, 973, 94, 94, 455, 1051, 1036, 455,
-	1241, 814, 813, 1024, 1286, 1401, 1030, 1006, 1413, 1070,
-	1082, 1213, 972, 1212, 1036, 422, 1024, 1018, 1062, 1027,
-	1014, 61, 1026, 885, 1036, 799, 1031, 61, 1034, 1097,
-	1041, 1044, 1045, 1046, 1042, 31, 1043, 1047, 1059, 1413,
-	1417, 1418, 1024, 1686, 1114, 1115, 1116, 1551, 1107, 1028,
-	306, 94, 1524, 94, 1128, 1153, 1447, 1065, 866, 306,
-	306, 306, 306, 306, 1060, 1069, 306, 306, 1417, 1418,
-	306, 94, 1068, 1108, 1109, 1110, 1111, 1129, 1085, 1290,
-	1130, 1124, 61, 1118, 1117, 1347, 1552, 1134, 306, 1119,
-	1120, 1121, 1122, 1714, 306, 306, 306, 1710, 1449, 1420,
-	306, 94, 61, 1401, 1319, 919, 889, 1257, 1136, 1423,
-	1138, 1422, 1258, 1255, 1135, 1254, 1167, 1253, 1256, 435,
-	1171, 1125, 1126, 1155, 1156, 1157, 1158, 1159, 1165, 1690,
-	1162, 1163, 1672, 436, 1164, 1393, 1222, 977, 978, 1688,
-	767, 983, 986, 987, 1516, 1231, 1259, 1184, 1045, 1046,
-	1230, 1185, 1166, 1661, 1658, 771, 772, 438, 937, 437,
-	1170, 1692, 1192, 1193, 1172, 1676, 1001, 1678, 1199, 1004,
-	1005, 1202, 1203, 1684, 1041, 1044, 1045, 1046, 1042, 1209,
-	1043, 1047, 1683, 1211, 1220, 1624, 1214, 1215, 1216, 1217,
-	1218, 1622, 1221, 938, 418, 989, 1177, 1309, 760, 812,
-	619, 1301, 1697, 1643, 1642, 1571, 306, 1547, 1299, 990,
-	761, 1292, 1510, 1009, 1137, 888, 1049, 306, 306, 306,
-	306, 306, 423, 424, 1229, 426, 1247, 1191, 1696, 306,
-	1681, 420, 1228, 306, 1662, 1262, 1263, 306, 1242, 1509,
-	427, 1190, 306, 306, 417, 1206, 306, 306, 306, 1235,
-	72, 1238, 1508, 1396, 1219, 1233, 649, 1201, 1264, 1284,
-	1198, 94, 781, 1225, 1716, 1715, 1226, 774, 1716, 1625,
-	1291, 1539, 1010, 422, 1287, 1297, 1297, 1082, 1236, 70,
-	75, 1234, 67, 1, 95, 1273, 328, 1708, 307, 1275,
-	1473, 307, 1249, 1250, 1260, 1252, 95, 1248, 307, 895,
-	1251, 1548, 1270, 1271, 1298, 1143, 1276, 1274, 1644, 1279,
-	1587, 1439, 1088, 1079, 83, 94, 94, 1308, 582, 1310,
-	1311, 1312, 82, 1635, 1306, 1307, 95, 435, 1289, 95,
-	307, 633, 307, 1087, 1293, 1294, 1295, 1086, 1595, 1303,
-	1104, 436, 1537, 1448, 1300, 94, 1640, 1315, 681, 680,
-	690, 691, 683, 684, 685, 686, 687, 688, 689, 682,
-	820, 818, 692, 432, 433, 438, 819, 437, 817, 822,
-	94, 1359, 1360, 1321, 821, 816, 960, 321, 1338, 920,
-	338, 1048, 808, 1186, 1187, 1133, 1362, 775, 86, 1340,
-	1339, 1139, 1462, 1101, 318, 644, 1353, 323, 291, 700,
-	1354, 1363, 1352, 1227, 1204, 1182, 1280, 1387, 306, 1322,
-	456, 449, 1407, 1682, 1355, 1383, 1659, 1657, 94, 1377,
-	1621, 1567, 1361, 1660, 94, 94, 1376, 1619, 1408, 1691,
-	1675, 1247, 1008, 1402, 1362, 1015, 971, 763, 1507, 1364,
-	1395, 1205, 972, 729, 991, 790, 361, 930, 376, 1424,
-	94, 373, 374, 306, 1019, 1239, 674, 359, 353, 1405,
-	789, 782, 1040, 1038, 1037, 1412, 795, 94, 1419, 94,
-	1415, 94, 1394, 1421, 1297, 1297, 1297, 788, 1023, 431,
-	988, 1613, 1438, 1082, 1500, 1082, 430, 53, 1428, 1399,
-	1454, 1429, 34, 1431, 343, 1432, 1430, 657, 1437, 439,
-	306, 28, 1444, 1445, 1446, 23, 1097, 22, 1442, 21,
-	20, 1452, 1453, 19, 25, 18, 17, 16, 607, 38,
-	306, 27, 26, 15, 14, 13, 94, 12, 1474, 94,
-	94, 94, 306, 11, 10, 9, 5, 307, 4, 660,
-	24, 718, 307, 2, 0, 0, 1482, 0, 307, 0,
-	1484, 0, 0, 0, 307, 0, 0, 1479, 1480, 1498,
-	0, 1493, 1494, 0, 1465, 95, 0, 0, 0, 0,
-	0, 0, 0, 0, 95, 390, 30, 0, 0, 0,
-	0, 0, 0, 1488, 1468, 0, 95, 95, 0, 0,
-	0, 1513, 1514, 1466, 0, 1518, 1478, 0, 0, 0,
-	0, 0, 0, 1511, 1247, 0, 30, 1506, 1467, 0,
-	1469, 0, 0, 1529, 1483, 0, 1485, 1486, 94, 1487,
-	0, 1521, 1489, 1520, 1491, 0, 0, 0, 94, 0,
-	0, 1287, 0, 0, 1082, 429, 0, 0, 0, 0,
-	1530, 0, 0, 0, 0, 421, 94, 0, 0, 0,
-	1531, 0, 0, 94, 681, 680, 690, 691, 683, 684,
-	685, 686, 687, 688, 689, 682, 0, 1555, 692, 1550,
-	0, 0, 1540, 0, 1542, 681, 680, 690, 691, 683,
-	684, 685, 686, 687, 688, 689, 682, 95, 0, 692,
-	307, 1534, 307, 307, 442, 95, 94, 0, 94, 0,
-	94, 95, 1554, 1546, 0, 94, 0, 94, 94, 94,
-	306, 1582, 0, 1580, 94, 1581, 1583, 1584, 0, 1576,
-	1574, 1565, 1572, 1570, 0, 0, 0, 387, 0, 1585,
-	1405, 1553, 94, 306, 1405, 1592, 0, 0, 1599, 0,
-	1607, 1609, 1610, 1611, 1612, 1600, 1616, 1601, 1617, 1618,
-	0, 1561, 0, 0, 352, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1629, 93, 1630, 1631,
-	1634, 1579, 0, 0, 0, 94, 1626, 0, 0, 340,
-	0, 1586, 1633, 1632, 0, 0, 94, 94, 0, 0,
-	0, 1405, 0, 0, 0, 0, 0, 1608, 1650, 0,
-	1648, 1647, 1550, 1082, 0, 0, 0, 1654, 94, 457,
-	0, 0, 586, 0, 0, 0, 1247, 0, 1663, 306,
-	0, 0, 0, 0, 0, 0, 0, 94, 307, 1668,
-	0, 0, 0, 0, 0, 1671, 0, 95, 0, 0,
-	0, 0, 307, 307, 95, 95, 95, 1680, 1679, 0,
-	307, 0, 0, 0, 307, 1689, 1687, 307, 0, 0,
-	0, 307, 0, 95, 0, 1504, 0, 94, 95, 95,
-	95, 307, 95, 95, 0, 1698, 0, 0, 0, 0,
-	0, 0, 0, 1666, 0, 95, 95, 0, 0, 0,
-	0, 1713, 0, 0, 0, 0, 638, 0, 1724, 0,
-	0, 0, 1725, 1726, 0, 638, 681, 680, 690, 691,
-	683, 684, 685, 686, 687, 688, 689, 682, 0, 30,
-	692, 0, 0, 0, 0, 0, 676, 0, 679, 0,
-	0, 0, 701, 703, 693, 694, 695, 696, 697, 698,
-	699, 0, 677, 678, 675, 681, 680, 690, 691, 683,
-	684, 685, 686, 687, 688, 689, 682, 0, 1503, 692,
-	95, 0, 0, 716, 0, 0, 0, 721, 722, 723,
-	724, 725, 726, 727, 728, 0, 731, 734, 734, 734,
-	740, 734, 734, 740, 734, 748, 749, 750, 751, 752,
-	753, 754, 0, 95, 95, 0, 0, 30, 0, 681,
-	680, 690, 691, 683, 684, 685, 686, 687, 688, 689,
-	682, 0, 95, 692, 0, 0, 0, 0, 0, 307,
-	0, 0, 95, 791, 0, 0, 307, 0, 307, 0,
-	0, 0, 0, 0, 0, 0, 307, 307, 307, 0,
-	0, 0, 0, 0, 95, 0, 0, 95, 457, 673,
-	0, 0, 0, 0, 0, 0, 0, 457, 95, 95,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 656,
-	658, 0, 0, 0, 96, 97, 98, 1356, 0, 0,
-	0, 0, 0, 0, 0, 352, 0, 0, 0, 0,
-	0, 0, 0, 0, 730, 0, 0, 681, 680, 690,
-	691, 683, 684, 685, 686, 687, 688, 689, 682, 0,
-	0, 692, 0, 0, 307, 95, 0, 95, 0, 0,
-	0, 762, 765, 307, 307, 307, 307, 307, 310, 1497,
-	307, 307, 0, 0, 307, 95, 0, 313, 0, 0,
-	0, 0, 0, 0, 0, 322, 0, 0, 0, 0,
-	0, 0, 307, 0, 0, 0, 0, 0, 307, 307,
-	307, 0, 0, 0, 307, 95, 0, 0, 638, 0,
-	778, 0, 0, 0, 0, 638, 638, 638, 457, 320,
-	0, 0, 0, 0, 809, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 0, 0, 0, 0, 638,
-	638, 638, 0, 638, 638, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 311, 638, 638, 0, 0,
-	0, 0, 0, 0, 681, 680, 690, 691, 683, 684,
-	685, 686, 687, 688, 689, 682, 1496, 0, 692, 0,
-	0, 0, 324, 314, 0, 325, 326, 333, 0, 0,
-	0, 317, 319, 330, 315, 316, 335, 334, 0, 312,
-	332, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	307, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 307, 307, 307, 307, 307, 1495, 0, 0, 0,
-	0, 0, 0, 307, 0, 355, 0, 307, 0, 0,
-	0, 307, 0, 0, 0, 0, 307, 307, 0, 0,
-	307, 307, 307, 0, 0, 0, 0, 899, 0, 0,
-	457, 0, 0, 0, 0, 95, 0, 457, 457, 457,
-	0, 681, 680, 690, 691, 683, 684, 685, 686, 687,
-	688, 689, 682, 0, 917, 692, 457, 0, 0, 0,
-	0, 457, 457, 457, 0, 457, 457, 0, 0, 0,
-	0, 0, 0, 926, 927, 928, 929, 1052, 457, 457,
-	0, 0, 0, 0, 0, 0, 1183, 0, 0, 95,
-	95, 681, 680, 690, 691, 683, 684, 685, 686, 687,
-	688, 689, 682, 0, 0, 692, 681, 680, 690, 691,
-	683, 684, 685, 686, 687, 688, 689, 682, 0, 95,
-	692, 0, 0, 0, 0, 0, 0, 0, 979, 980,
-	0, 681, 680, 690, 691, 683, 684, 685, 686, 687,
-	688, 689, 682, 0, 95, 692, 0, 0, 0, 0,
-	0, 0, 0, 965, 0, 457, 638, 0, 638, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 995,
-	0, 0, 0, 0, 0, 0, 638, 0, 0, 0,
-	0, 0, 307, 0, 0, 0, 999, 1000, 0, 0,
-	0, 0, 95, 0, 0, 0, 0, 0, 95, 95,
-	0, 0, 0, 0, 0, 1020, 0, 0, 0, 0,
-	0, 0, 1076, 0, 0, 778, 0, 0, 457, 0,
-	0, 0, 0, 0, 95, 0, 0, 307, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 457, 0, 0,
-	457, 95, 0, 95, 0, 95, 0, 0, 0, 0,
-	0, 457, 586, 0, 0, 0, 1188, 0, 0, 421,
-	680, 690, 691, 683, 684, 685, 686, 687, 688, 689,
-	682, 1132, 0, 692, 307, 0, 0, 0, 0, 0,
+	418, 1724, 1714, 1476, 1650, 1682, 362, 1351, 1628, 1250,
+	1540, 1571, 1085, 1593, 1554, 1445, 1270, 1057, 377, 391,
+	1116, 722, 1251, 1408, 762, 1405, 1237, 1081, 1301, 1409,
+	1084, 1421, 1131, 590, 1094, 1054, 348, 1415, 1370, 640,
+	94, 897, 642, 963, 308, 970, 331, 308, 1328, 73,
+	3, 1186, 94, 427, 308, 1318, 1099, 807, 460, 587,
+	800, 1036, 1059, 1043, 436, 767, 916, 623, 790, 364,
+	29, 421, 940, 789, 772, 1127, 806, 996, 586, 911,
+	769, 353, 94, 779, 360, 94, 308, 69, 308, 804,
+	797, 632, 349, 74, 926, 352, 611, 68, 1065, 735,
+	1625, 8, 1469, 7, 1550, 1573, 736, 1717, 6, 1679,
+	71, 1712, 1658, 1705, 304, 299, 301, 302, 294, 1477,
+	1678, 292, 1657, 1387, 457, 1506, 76, 77, 78, 79,
+	80, 81, 595, 1440, 1441, 1439, 1153, 1076, 1077, 1075,
+	422, 31, 32, 33, 62, 35, 36, 808, 655, 809,
+	1152, 442, 446, 351, 31, 648, 649, 62, 35, 36,
+	350, 66, 1309, 1109, 1543, 1117, 37, 56, 57, 1660,
+	59, 454, 650, 1353, 341, 60, 651, 648, 649, 973,
+	1619, 684, 683, 693, 694, 686, 687, 688, 689, 690,
+	691, 692, 685, 1497, 1495, 695, 96, 97, 98, 1287,
+	339, 925, 1286, 1371, 46, 1288, 343, 1151, 61, 403,
+	654, 409, 410, 407, 408, 406, 405, 404, 884, 653,
+	295, 61, 96, 97, 98, 411, 412, 643, 644, 1354,
+	645, 1355, 881, 1711, 1704, 883, 1651, 1350, 1037, 1135,
+	1643, 638, 303, 589, 1373, 1135, 293, 927, 928, 929,
+	1448, 1732, 1135, 1271, 1273, 612, 597, 448, 1356, 885,
+	1148, 1145, 1146, 297, 1144, 889, 1602, 297, 96, 97,
+	98, 882, 658, 1103, 39, 40, 42, 41, 44, 872,
+	58, 1375, 1432, 1379, 357, 1374, 1431, 1372, 1728, 1430,
+	593, 613, 1377, 308, 602, 603, 600, 1155, 1158, 308,
+	311, 1376, 614, 45, 65, 64, 308, 1594, 54, 55,
+	43, 1347, 308, 621, 1378, 1380, 627, 1349, 298, 707,
+	708, 1103, 1596, 94, 47, 48, 1632, 49, 50, 51,
+	52, 1110, 94, 1522, 607, 1272, 1468, 1150, 1438, 629,
+	1242, 631, 1205, 1661, 94, 94, 1165, 1656, 1215, 1164,
+	1202, 1194, 1071, 840, 783, 637, 720, 619, 1082, 1149,
+	695, 1620, 685, 1117, 1283, 695, 1015, 639, 96, 97,
+	98, 657, 917, 628, 630, 684, 683, 693, 694, 686,
+	687, 688, 689, 690, 691, 692, 685, 669, 670, 695,
+	1603, 1601, 1102, 1595, 912, 664, 84, 633, 656, 615,
+	616, 617, 604, 1154, 605, 675, 1641, 606, 1611, 634,
+	635, 96, 97, 98, 625, 1419, 810, 63, 1156, 1726,
+	674, 672, 1727, 672, 1725, 673, 674, 672, 1201, 705,
+	63, 646, 1187, 1391, 1389, 85, 1348, 675, 1346, 675,
+	1102, 1338, 997, 675, 668, 94, 947, 667, 308, 665,
+	308, 308, 828, 94, 666, 874, 997, 758, 1212, 94,
+	945, 946, 944, 723, 1106, 1460, 626, 707, 708, 1307,
+	1706, 1107, 596, 760, 918, 707, 708, 686, 687, 688,
+	689, 690, 691, 692, 685, 788, 776, 695, 1334, 1335,
+	1336, 1707, 805, 841, 1646, 457, 913, 1670, 673, 674,
+	672, 773, 759, 447, 738, 740, 742, 744, 746, 748,
+	749, 739, 741, 624, 745, 747, 675, 750, 761, 854,
+	857, 858, 859, 860, 861, 862, 1549, 863, 864, 865,
+	866, 867, 842, 843, 844, 845, 826, 827, 855, 1733,
+	829, 1698, 830, 831, 832, 833, 834, 835, 836, 837,
+	838, 839, 846, 847, 848, 849, 850, 851, 852, 853,
+	1337, 61, 1699, 598, 599, 1342, 1339, 1330, 1340, 1333,
+	1200, 1329, 1199, 943, 452, 1331, 1332, 688, 689, 690,
+	691, 692, 685, 449, 450, 695, 308, 1548, 1322, 1341,
+	868, 673, 674, 672, 870, 94, 1395, 873, 1734, 875,
+	308, 308, 94, 94, 94, 1020, 1021, 1321, 308, 675,
+	1310, 1709, 308, 771, 1708, 856, 308, 895, 896, 1700,
+	308, 1690, 94, 1672, 1642, 1017, 431, 94, 94, 94,
+	308, 94, 94, 1352, 871, 1179, 1180, 1181, 1396, 1567,
+	1546, 878, 879, 880, 94, 94, 673, 674, 672, 1510,
+	709, 710, 711, 712, 713, 714, 715, 716, 717, 718,
+	1418, 900, 1397, 1319, 675, 910, 904, 905, 906, 902,
+	908, 909, 887, 673, 674, 672, 1608, 608, 899, 1607,
+	1016, 1599, 1710, 914, 915, 935, 937, 938, 1323, 70,
+	901, 675, 936, 96, 97, 98, 964, 965, 941, 673,
+	674, 672, 890, 1674, 431, 966, 673, 674, 672, 1456,
+	892, 96, 97, 98, 920, 1290, 1104, 675, 72, 94,
+	96, 97, 98, 1238, 675, 1238, 919, 1517, 380, 379,
+	382, 383, 384, 385, 671, 985, 988, 381, 386, 1599,
+	1654, 998, 1599, 431, 1610, 922, 1599, 1633, 942, 1599,
+	1598, 1039, 94, 94, 1045, 1048, 1049, 1050, 1046, 431,
+	1047, 1051, 975, 974, 1422, 1423, 1538, 1537, 1524, 431,
+	1067, 94, 1520, 431, 1067, 419, 1028, 723, 308, 976,
+	1464, 94, 1466, 1465, 1040, 308, 1418, 308, 1462, 1463,
+	1462, 1461, 967, 968, 1040, 308, 308, 308, 1028, 431,
+	1006, 1007, 1406, 94, 980, 1418, 94, 1040, 431, 977,
+	31, 671, 431, 1010, 1277, 95, 1066, 94, 94, 309,
+	817, 816, 309, 1022, 31, 1034, 974, 95, 1040, 309,
+	1068, 1066, 1070, 1291, 1068, 1245, 1066, 1029, 1030, 1074,
+	1218, 1217, 976, 1028, 1055, 457, 1018, 424, 457, 888,
+	802, 1101, 1118, 1119, 1120, 31, 1246, 95, 61, 1086,
+	95, 309, 1691, 309, 1032, 1556, 1111, 1529, 1132, 1452,
+	1578, 1422, 1423, 308, 94, 869, 94, 61, 1157, 1295,
+	1073, 1128, 308, 308, 308, 308, 308, 1064, 1122, 308,
+	308, 61, 1072, 308, 308, 94, 1089, 1069, 1557, 1028,
+	1133, 1138, 1134, 431, 981, 982, 1121, 1719, 987, 990,
+	991, 1715, 308, 1140, 61, 1142, 1454, 1425, 308, 308,
+	308, 1406, 61, 1324, 308, 94, 923, 893, 1428, 1264,
+	1262, 1049, 1050, 1005, 1170, 1263, 1008, 1009, 1427, 1259,
+	1129, 1130, 1695, 684, 683, 693, 694, 686, 687, 688,
+	689, 690, 691, 692, 685, 1509, 1260, 695, 1258, 1677,
+	1398, 1261, 939, 1227, 941, 948, 949, 950, 951, 952,
+	953, 954, 955, 956, 957, 958, 959, 960, 961, 962,
+	770, 1693, 1521, 1168, 1172, 1236, 1235, 1666, 1176, 1663,
+	1697, 1681, 1683, 1689, 1688, 296, 684, 683, 693, 694,
+	686, 687, 688, 689, 690, 691, 692, 685, 1225, 1629,
+	695, 1627, 420, 1314, 942, 815, 1226, 1182, 622, 993,
+	1306, 1648, 1002, 1647, 763, 1045, 1048, 1049, 1050, 1046,
+	308, 1047, 1051, 994, 1196, 1224, 764, 1576, 300, 1304,
+	437, 308, 308, 308, 308, 308, 1252, 1231, 422, 1195,
+	1297, 1515, 1552, 308, 438, 1013, 1141, 308, 891, 1053,
+	1702, 308, 1243, 425, 426, 1240, 308, 308, 309, 428,
+	308, 308, 308, 1211, 309, 1701, 774, 775, 440, 1686,
+	439, 309, 1234, 1289, 1230, 94, 1247, 309, 1667, 1514,
+	1233, 429, 72, 1513, 1296, 1241, 1401, 1292, 95, 1302,
+	1302, 1278, 1238, 1239, 652, 1280, 1269, 95, 1721, 1720,
+	1254, 1255, 1206, 1257, 1253, 1265, 1203, 1256, 784, 95,
+	95, 777, 1721, 1275, 1630, 1276, 1544, 1086, 1303, 437,
+	899, 1281, 1284, 1311, 1312, 1014, 1001, 424, 70, 94,
+	94, 1508, 75, 438, 1313, 67, 1315, 1316, 1317, 1294,
+	1, 1191, 1192, 1298, 1299, 1300, 330, 1713, 1112, 1113,
+	1114, 1115, 1478, 1553, 1147, 434, 435, 440, 1649, 439,
+	94, 1592, 1209, 1444, 1123, 1124, 1125, 1126, 1320, 1326,
+	1327, 1092, 684, 683, 693, 694, 686, 687, 688, 689,
+	690, 691, 692, 685, 1343, 94, 695, 1083, 83, 584,
+	82, 964, 1640, 636, 1091, 1090, 1600, 1308, 1108, 1357,
+	430, 1542, 1453, 1305, 1645, 823, 821, 822, 820, 825,
+	95, 1369, 824, 309, 819, 309, 309, 323, 95, 924,
+	1183, 1184, 1185, 308, 95, 1360, 340, 1367, 1358, 1366,
+	1382, 1052, 1359, 94, 1381, 811, 1137, 778, 86, 94,
+	94, 1345, 1252, 1344, 1143, 1407, 1467, 975, 1392, 1105,
+	320, 647, 325, 291, 703, 1232, 1410, 1285, 458, 451,
+	1412, 1687, 1664, 1662, 976, 94, 1368, 1417, 308, 1626,
+	1572, 1665, 1624, 1696, 1680, 1367, 1012, 1019, 1426, 766,
+	1388, 1512, 94, 1400, 94, 1210, 94, 1404, 732, 1302,
+	1302, 1302, 995, 1434, 793, 1436, 1443, 1437, 363, 934,
+	378, 375, 1433, 1435, 376, 1459, 1023, 1244, 677, 361,
+	355, 792, 1442, 785, 1044, 308, 1042, 1449, 1450, 1451,
+	1101, 1447, 1041, 798, 1086, 1424, 1086, 1420, 791, 1457,
+	1458, 1027, 433, 992, 1618, 308, 1505, 432, 53, 34,
+	345, 94, 660, 1479, 94, 94, 94, 308, 441, 392,
+	30, 309, 28, 23, 22, 1471, 21, 20, 19, 25,
+	95, 18, 17, 16, 609, 309, 309, 95, 95, 95,
+	1472, 38, 1474, 309, 27, 26, 15, 309, 14, 13,
+	30, 309, 12, 11, 10, 309, 9, 95, 5, 4,
+	663, 24, 95, 95, 95, 309, 95, 95, 1493, 721,
+	2, 0, 0, 0, 0, 0, 0, 1511, 0, 95,
+	95, 0, 1488, 0, 0, 1252, 1484, 1485, 0, 423,
+	0, 0, 0, 1516, 0, 0, 0, 0, 0, 0,
+	0, 1526, 0, 94, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 94, 0, 1292, 0, 0, 0, 0,
+	0, 0, 1536, 0, 0, 0, 0, 0, 0, 0,
+	0, 94, 1362, 1363, 0, 0, 0, 0, 94, 0,
+	0, 0, 1545, 0, 1547, 1086, 0, 1383, 1384, 0,
+	1385, 1386, 1560, 0, 95, 0, 0, 0, 1525, 0,
+	0, 0, 1393, 1394, 0, 0, 0, 0, 0, 0,
+	1551, 0, 1559, 1558, 0, 1535, 0, 0, 0, 0,
+	1555, 94, 0, 94, 0, 94, 0, 95, 95, 0,
+	94, 1570, 94, 94, 94, 308, 1575, 1410, 0, 94,
+	1585, 1410, 1586, 1588, 1589, 1581, 95, 1577, 0, 0,
+	0, 0, 1590, 309, 1597, 0, 95, 94, 308, 1566,
+	309, 0, 309, 1604, 0, 0, 0, 1612, 0, 1579,
+	309, 309, 309, 1605, 0, 1606, 0, 0, 95, 1584,
+	0, 95, 0, 0, 0, 0, 0, 0, 0, 1591,
+	0, 1455, 95, 95, 0, 1639, 0, 0, 1410, 0,
+	94, 0, 1631, 0, 0, 0, 1638, 1637, 0, 0,
+	0, 94, 94, 683, 693, 694, 686, 687, 688, 689,
+	690, 691, 692, 685, 1653, 1652, 695, 0, 0, 1503,
+	0, 0, 0, 94, 0, 0, 1659, 1252, 0, 0,
+	1668, 0, 0, 0, 308, 0, 1486, 0, 309, 95,
+	0, 95, 94, 1555, 1086, 0, 0, 309, 309, 309,
+	309, 309, 1676, 0, 309, 309, 0, 1684, 309, 309,
+	95, 1685, 0, 0, 0, 0, 0, 0, 0, 1692,
+	1694, 0, 641, 0, 0, 0, 0, 309, 0, 0,
+	0, 641, 94, 309, 309, 309, 0, 0, 0, 309,
+	95, 0, 1703, 1490, 1491, 30, 1492, 0, 0, 1494,
+	0, 1496, 0, 0, 0, 0, 1718, 0, 704, 706,
+	0, 0, 0, 1729, 684, 683, 693, 694, 686, 687,
+	688, 689, 690, 691, 692, 685, 0, 0, 695, 693,
+	694, 686, 687, 688, 689, 690, 691, 692, 685, 719,
+	0, 695, 0, 724, 725, 726, 727, 728, 729, 730,
+	731, 0, 734, 737, 737, 737, 743, 737, 737, 743,
+	737, 751, 752, 753, 754, 755, 756, 757, 1539, 0,
+	0, 0, 0, 30, 0, 1561, 1562, 1563, 1564, 1565,
+	0, 0, 0, 1568, 1569, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 309, 0, 0, 0, 794,
+	0, 0, 0, 0, 0, 0, 309, 309, 309, 309,
+	309, 0, 0, 0, 0, 0, 0, 0, 309, 0,
+	0, 0, 309, 0, 0, 1502, 309, 0, 0, 0,
+	0, 309, 309, 0, 389, 309, 309, 309, 0, 0,
+	0, 0, 0, 679, 0, 682, 0, 0, 978, 979,
+	95, 696, 697, 698, 699, 700, 701, 702, 0, 680,
+	681, 678, 684, 683, 693, 694, 686, 687, 688, 689,
+	690, 691, 692, 685, 93, 0, 695, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 342, 1011, 0, 0,
+	0, 0, 0, 0, 96, 97, 98, 0, 0, 0,
+	0, 0, 0, 0, 95, 95, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 459, 0, 0, 588,
+	684, 683, 693, 694, 686, 687, 688, 689, 690, 691,
+	692, 685, 0, 0, 695, 95, 0, 0, 0, 0,
+	0, 0, 0, 0, 641, 0, 0, 0, 312, 1501,
+	0, 641, 641, 641, 0, 0, 0, 315, 0, 0,
+	95, 0, 0, 0, 0, 324, 0, 0, 0, 0,
+	0, 641, 0, 0, 0, 0, 641, 641, 641, 0,
+	641, 641, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1722, 0, 641, 641, 0, 1361, 0, 309, 322,
+	0, 0, 0, 0, 0, 329, 0, 0, 95, 0,
+	0, 0, 0, 0, 95, 95, 684, 683, 693, 694,
+	686, 687, 688, 689, 690, 691, 692, 685, 0, 0,
+	695, 0, 0, 0, 0, 313, 0, 0, 0, 0,
+	95, 0, 0, 309, 684, 683, 693, 694, 686, 687,
+	688, 689, 690, 691, 692, 685, 0, 95, 695, 95,
+	0, 95, 326, 316, 0, 327, 328, 335, 0, 0,
+	0, 319, 321, 332, 317, 318, 337, 336, 0, 314,
+	334, 333, 0, 0, 0, 0, 0, 0, 0, 0,
+	309, 0, 0, 1189, 0, 0, 0, 1190, 0, 0,
+	1500, 0, 0, 0, 0, 0, 795, 0, 1197, 1198,
+	309, 0, 0, 0, 1204, 0, 95, 1207, 1208, 95,
+	95, 95, 309, 0, 0, 1214, 0, 0, 0, 1216,
+	0, 0, 1219, 1220, 1221, 1222, 1223, 0, 0, 0,
+	0, 0, 0, 0, 1056, 0, 0, 0, 0, 0,
+	306, 0, 0, 0, 0, 0, 0, 459, 0, 0,
+	344, 0, 0, 0, 0, 0, 459, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 659, 661,
+	0, 1267, 1268, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 592, 0, 594, 684, 683, 693, 694, 686,
+	687, 688, 689, 690, 691, 692, 685, 0, 95, 695,
+	0, 0, 0, 0, 0, 0, 0, 0, 95, 0,
+	0, 0, 0, 641, 0, 641, 1188, 0, 390, 0,
+	0, 0, 0, 0, 0, 0, 95, 0, 0, 0,
+	0, 0, 0, 95, 641, 0, 684, 683, 693, 694,
+	686, 687, 688, 689, 690, 691, 692, 685, 0, 0,
+	695, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 307, 0, 0, 338, 0, 0, 0, 781,
+	0, 0, 307, 0, 0, 0, 95, 459, 95, 0,
+	95, 0, 0, 812, 0, 95, 0, 95, 95, 95,
+	309, 0, 0, 0, 95, 445, 445, 0, 0, 0,
+	0, 0, 0, 0, 307, 0, 307, 0, 1364, 1365,
+	0, 0, 95, 309, 1193, 0, 0, 423, 684, 683,
+	693, 694, 686, 687, 688, 689, 690, 691, 692, 685,
+	0, 0, 695, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 95, 0, 444, 0, 0,
+	0, 0, 0, 0, 0, 1413, 95, 95, 0, 794,
+	0, 0, 0, 0, 0, 0, 1248, 1249, 0, 0,
+	794, 794, 794, 794, 794, 0, 1429, 0, 95, 601,
+	0, 0, 0, 0, 0, 610, 1056, 0, 1274, 309,
+	0, 0, 618, 0, 0, 794, 0, 95, 620, 794,
+	0, 0, 0, 0, 0, 0, 0, 354, 0, 459,
+	0, 0, 0, 0, 0, 0, 459, 459, 459, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 459, 95, 0, 0,
+	0, 459, 459, 459, 0, 459, 459, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 459, 459,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 641,
+	0, 0, 0, 1487, 0, 0, 0, 1489, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1498, 1499,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 641,
+	0, 307, 0, 0, 0, 0, 0, 307, 0, 0,
+	0, 0, 0, 0, 307, 0, 0, 0, 1518, 1519,
+	307, 0, 1523, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 969, 787, 459, 0, 799, 0, 0,
+	1534, 0, 0, 0, 0, 0, 0, 0, 0, 999,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1003, 1004, 0, 0,
+	0, 0, 0, 0, 0, 0, 1411, 0, 30, 0,
+	0, 0, 0, 0, 0, 1024, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 781, 0, 0, 459, 0,
+	0, 0, 0, 0, 0, 0, 0, 794, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 459, 0, 0,
+	459, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 459, 588, 0, 0, 0, 0, 0, 1587, 0,
+	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 307, 0, 307, 801,
+	0, 0, 0, 0, 0, 0, 0, 0, 1614, 1615,
+	1616, 1617, 818, 1621, 0, 1622, 1623, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 876, 877, 459, 0,
+	459, 0, 0, 1634, 886, 1635, 1636, 0, 799, 0,
+	0, 0, 894, 0, 676, 0, 0, 0, 0, 459,
+	0, 0, 0, 0, 0, 0, 907, 1504, 0, 0,
+	0, 0, 0, 0, 0, 1655, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1178,
+	354, 0, 0, 0, 0, 0, 0, 0, 0, 733,
+	0, 0, 0, 0, 0, 0, 1673, 0, 0, 0,
+	1531, 1532, 1533, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 765, 768, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 307, 0, 0, 0, 0, 0,
-	95, 0, 0, 95, 95, 95, 307, 0, 457, 0,
-	457, 791, 0, 0, 0, 0, 0, 0, 1243, 1244,
-	0, 0, 791, 791, 791, 791, 791, 0, 457, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1052, 0,
-	1269, 0, 0, 0, 0, 0, 0, 791, 0, 0,
-	0, 791, 0, 0, 0, 0, 0, 0, 1173, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 706,
-	707, 708, 709, 710, 711, 712, 713, 714, 715, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 95, 0, 0, 0, 0, 0, 1208, 0,
-	0, 0, 95, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1223, 1224, 765,
-	95, 638, 0, 0, 0, 0, 0, 95, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	638, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	95, 0, 95, 995, 95, 0, 0, 0, 0, 95,
-	0, 95, 95, 95, 307, 0, 0, 0, 95, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 95, 307, 0, 0,
-	388, 0, 0, 0, 0, 0, 0, 0, 457, 0,
-	0, 0, 0, 0, 0, 0, 0, 1406, 0, 30,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 95,
-	0, 0, 0, 0, 305, 0, 0, 336, 791, 0,
-	95, 95, 0, 0, 305, 0, 0, 0, 0, 0,
-	0, 0, 1320, 457, 0, 0, 0, 0, 0, 0,
-	0, 0, 95, 0, 0, 0, 0, 443, 443, 0,
-	0, 0, 0, 307, 0, 0, 305, 0, 305, 0,
-	0, 95, 457, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1385, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 457, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1397, 0,
-	0, 95, 0, 0, 0, 0, 0, 0, 457, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1499, 0,
-	0, 0, 0, 0, 0, 457, 0, 0, 995, 0,
-	935, 1409, 1411, 944, 945, 946, 947, 948, 949, 950,
-	951, 952, 953, 954, 955, 956, 957, 958, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1411, 0, 0,
-	0, 1526, 1527, 1528, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 457, 0, 457, 0, 1441, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	998, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 638, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1470, 0, 0, 1475, 1476, 1477, 0,
-	0, 1502, 0, 0, 0, 0, 0, 0, 1406, 0,
-	30, 0, 1406, 305, 0, 0, 0, 0, 305, 0,
-	0, 352, 0, 0, 305, 0, 0, 0, 0, 1522,
-	305, 0, 1523, 0, 0, 1525, 0, 0, 0, 0,
-	0, 0, 0, 1604, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 995, 0, 0, 0, 0, 0, 0, 0, 1406,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 457, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1536, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 457, 0, 0, 0, 0, 0, 0,
-	457, 0, 0, 1569, 352, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 443, 0, 0, 0, 0, 1178, 1179, 1180,
-	0, 0, 0, 1575, 0, 1577, 305, 1578, 305, 798,
-	0, 0, 1536, 0, 1536, 1536, 1536, 0, 0, 0,
-	0, 1441, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1536,
-	0, 0, 1711, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1639, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 457, 457, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 995, 0, 1664, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1670, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 305, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 305, 305,
-	0, 0, 0, 0, 0, 0, 305, 0, 0, 0,
-	305, 0, 0, 305, 1536, 0, 0, 894, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 305, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1357, 1358,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1378, 1379, 0, 1380, 1381, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1388, 1389,
-	0, 0, 0, 0, 0, 0, 0, 443, 894, 0,
-	0, 0, 443, 443, 0, 0, 443, 443, 443, 0,
-	0, 0, 996, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 443, 443, 443, 443, 443, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 305, 0, 0, 0, 0,
-	0, 894, 305, 0, 305, 0, 0, 0, 0, 0,
-	0, 0, 305, 1057, 305, 0, 0, 1450, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	641, 0, 0, 0, 0, 0, 0, 0, 307, 307,
+	0, 0, 0, 0, 0, 0, 307, 0, 0, 0,
+	307, 0, 0, 0, 307, 0, 0, 0, 898, 1730,
+	1731, 0, 0, 0, 0, 0, 0, 0, 307, 0,
+	0, 0, 0, 0, 0, 0, 0, 1411, 0, 30,
+	0, 1411, 0, 0, 999, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1031, 0, 0, 0, 0, 0,
+	0, 1035, 0, 1038, 0, 0, 0, 0, 0, 0,
+	0, 0, 1609, 1063, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 459,
+	0, 0, 0, 0, 0, 0, 0, 0, 1411, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 445, 898,
+	0, 0, 0, 445, 445, 0, 0, 445, 445, 445,
+	0, 0, 0, 1000, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1325, 459, 0, 0, 0, 0, 1139,
+	0, 0, 445, 445, 445, 445, 445, 0, 1159, 1160,
+	1161, 1162, 1163, 903, 0, 1166, 1167, 0, 0, 799,
+	1169, 0, 0, 0, 459, 0, 307, 0, 0, 0,
+	0, 0, 898, 307, 0, 307, 0, 0, 1171, 0,
+	921, 0, 0, 307, 1061, 307, 1175, 0, 0, 459,
+	1177, 0, 0, 0, 0, 0, 0, 0, 0, 930,
+	931, 932, 933, 0, 0, 0, 0, 0, 0, 0,
+	459, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1716, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 459, 0, 0,
+	999, 0, 0, 1414, 1416, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 983, 984, 0, 0, 0, 0,
+	0, 307, 0, 0, 0, 0, 0, 0, 0, 1416,
+	307, 307, 307, 307, 307, 0, 0, 307, 307, 0,
+	0, 307, 307, 0, 0, 0, 459, 0, 459, 0,
+	1446, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	307, 0, 0, 0, 0, 0, 1173, 1174, 307, 0,
+	0, 0, 307, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1279, 0, 0, 0, 0, 1080, 0,
+	0, 0, 0, 0, 0, 1475, 0, 0, 1480, 1481,
+	1482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	445, 445, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 445, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1136, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1481, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 999, 0, 0, 0, 445, 307, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1000, 307,
+	307, 307, 307, 307, 0, 0, 0, 459, 0, 0,
+	0, 1266, 0, 0, 0, 307, 0, 1541, 0, 1061,
+	0, 0, 0, 0, 307, 307, 0, 0, 307, 1282,
+	898, 0, 0, 0, 0, 459, 0, 0, 0, 0,
+	0, 0, 459, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	305, 0, 0, 0, 0, 0, 0, 0, 0, 305,
-	305, 305, 305, 305, 0, 0, 305, 305, 0, 0,
-	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 305, 0,
-	0, 0, 0, 0, 1168, 1169, 305, 0, 0, 0,
-	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1399,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1580, 0, 1582, 0, 1583,
+	0, 0, 0, 0, 1541, 1213, 1541, 1541, 1541, 0,
+	0, 0, 0, 1446, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1228, 1229, 768, 0, 0, 0,
+	0, 1541, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 443, 443,
+	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	0, 1470, 0, 0, 1644, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 898, 459, 459, 0, 0, 0,
+	0, 1473, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 307, 0, 1483, 0, 999, 0, 1669, 0, 0,
+	0, 0, 0, 0, 1000, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1675, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 443,
-	0, 1556, 1557, 1558, 1559, 1560, 0, 0, 0, 1563,
-	1564, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 307, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 443, 305, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 996, 305, 305, 305,
-	305, 305, 0, 0, 0, 0, 0, 0, 0, 1261,
-	0, 0, 0, 305, 0, 0, 0, 1057, 0, 0,
-	0, 0, 305, 305, 0, 0, 305, 1277, 894, 0,
+	0, 0, 0, 0, 0, 0, 1541, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 307, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 307, 0, 0, 0, 0, 0, 0,
+	1390, 0, 0, 0, 0, 307, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1402, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1000, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1613, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 443,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1717, 0, 0,
-	0, 894, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 996, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 305, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 305, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 996, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1057, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 305, 0, 0, 568, 556, 0, 0,
-	512, 571, 485, 502, 579, 503, 506, 543, 470, 525,
-	195, 500, 0, 489, 465, 496, 466, 487, 514, 131,
-	518, 484, 558, 528, 570, 163, 0, 490, 545, 237,
-	123, 171, 169, 255, 136, 132, 130, 122, 152, 175,
-	205, 252, 199, 577, 166, 534, 0, 245, 183, 0,
-	0, 0, 516, 560, 523, 553, 511, 544, 475, 533,
-	572, 501, 541, 573, 0, 0, 996, 96, 97, 98,
-	0, 1083, 1084, 0, 0, 0, 0, 0, 118, 305,
-	538, 567, 498, 540, 542, 581, 464, 535, 0, 468,
-	471, 578, 563, 493, 494, 1288, 0, 0, 0, 0,
-	0, 0, 515, 524, 550, 509, 0, 0, 0, 0,
-	0, 0, 0, 0, 491, 0, 532, 0, 0, 0,
-	472, 469, 0, 0, 0, 0, 513, 0, 0, 0,
-	474, 0, 492, 551, 0, 462, 142, 555, 562, 510,
-	308, 566, 508, 507, 569, 216, 0, 249, 146, 162,
-	114, 159, 100, 110, 0, 144, 192, 224, 228, 559,
-	488, 497, 124, 495, 226, 203, 268, 531, 206, 225,
-	167, 257, 217, 267, 277, 278, 253, 275, 286, 242,
-	103, 251, 265, 119, 236, 0, 0, 0, 105, 263,
-	248, 181, 156, 157, 104, 0, 222, 129, 140, 126,
-	194, 260, 261, 125, 289, 111, 274, 107, 112, 273,
-	188, 256, 264, 182, 174, 106, 262, 180, 173, 161,
-	135, 148, 214, 170, 215, 149, 185, 184, 186, 0,
-	467, 0, 246, 271, 290, 116, 483, 254, 282, 285,
-	0, 218, 117, 141, 134, 213, 139, 164, 281, 283,
-	284, 187, 113, 151, 243, 160, 168, 221, 288, 202,
-	227, 120, 270, 244, 479, 482, 477, 478, 526, 527,
-	574, 575, 576, 552, 473, 0, 480, 481, 0, 557,
-	564, 565, 530, 99, 108, 165, 287, 219, 138, 272,
-	463, 476, 128, 486, 0, 0, 499, 504, 505, 517,
-	519, 520, 521, 522, 529, 536, 537, 539, 546, 547,
-	548, 549, 554, 561, 580, 101, 102, 109, 115, 121,
-	127, 133, 137, 143, 147, 150, 153, 154, 155, 158,
-	172, 176, 177, 178, 179, 189, 190, 191, 193, 196,
-	197, 198, 200, 201, 204, 207, 208, 209, 210, 211,
-	212, 220, 223, 229, 230, 231, 232, 233, 234, 235,
-	238, 239, 240, 241, 247, 250, 258, 259, 269, 276,
-	279, 145, 266, 280, 568, 556, 0, 0, 512, 571,
-	485, 502, 579, 503, 506, 543, 470, 525, 195, 500,
-	0, 489, 465, 496, 466, 487, 514, 131, 518, 484,
-	558, 528, 570, 163, 0, 490, 545, 237, 123, 171,
+	1671, 0, 0, 0, 0, 0, 0, 0, 0, 1507,
+	0, 0, 0, 1061, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 354,
+	0, 0, 0, 0, 0, 0, 307, 1527, 0, 0,
+	1528, 0, 0, 1530, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 570, 558, 0, 0, 514, 573,
+	487, 504, 581, 505, 508, 545, 472, 527, 195, 502,
+	0, 491, 467, 498, 468, 489, 516, 131, 520, 486,
+	560, 530, 572, 163, 0, 492, 547, 237, 123, 171,
 	169, 255, 136, 132, 130, 122, 152, 175, 205, 252,
-	199, 577, 166, 534, 0, 245, 183, 0, 0, 0,
-	516, 560, 523, 553, 511, 544, 475, 533, 572, 501,
-	541, 573, 0, 0, 0, 96, 97, 98, 0, 1083,
-	1084, 0, 0, 0, 0, 0, 118, 0, 538, 567,
-	498, 540, 542, 581, 464, 535, 0, 468, 471, 578,
-	563, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	515, 524, 550, 509, 0, 0, 0, 0, 0, 0,
-	0, 0, 491, 0, 532, 0, 0, 0, 472, 469,
-	0, 0, 0, 0, 513, 0, 0, 0, 474, 0,
-	492, 551, 0, 462, 142, 555, 562, 510, 308, 566,
-	508, 507, 569, 216, 0, 249, 146, 162, 114, 159,
-	100, 110, 0, 144, 192, 224, 228, 559, 488, 497,
-	124, 495, 226, 203, 268, 531, 206, 225, 167, 257,
+	199, 579, 166, 536, 0, 245, 183, 0, 0, 1000,
+	518, 562, 525, 555, 513, 546, 477, 535, 574, 503,
+	543, 575, 307, 0, 0, 96, 97, 98, 0, 1087,
+	1088, 1574, 354, 0, 0, 0, 118, 0, 540, 569,
+	500, 542, 544, 583, 466, 537, 0, 470, 473, 580,
+	565, 495, 496, 1293, 0, 0, 0, 0, 0, 0,
+	517, 526, 552, 511, 0, 0, 0, 0, 0, 0,
+	0, 0, 493, 0, 534, 0, 0, 0, 474, 471,
+	0, 0, 0, 0, 515, 0, 0, 0, 476, 0,
+	494, 553, 0, 464, 142, 557, 564, 512, 310, 568,
+	510, 509, 571, 216, 0, 249, 146, 162, 114, 159,
+	100, 110, 0, 144, 192, 224, 228, 561, 490, 499,
+	124, 497, 226, 203, 268, 533, 206, 225, 167, 257,
 	217, 267, 277, 278, 253, 275, 286, 242, 103, 251,
 	265, 119, 236, 0, 0, 0, 105, 263, 248, 181,
 	156, 157, 104, 0, 222, 129, 140, 126, 194, 260,
 	261, 125, 289, 111, 274, 107, 112, 273, 188, 256,
 	264, 182, 174, 106, 262, 180, 173, 161, 135, 148,
-	214, 170, 215, 149, 185, 184, 186, 0, 467, 0,
-	246, 271, 290, 116, 483, 254, 282, 285, 0, 218,
+	214, 170, 215, 149, 185, 184, 186, 0, 469, 0,
+	246, 271, 290, 116, 485, 254, 282, 285, 0, 218,
 	117, 141, 134, 213, 139, 164, 281, 283, 284, 187,
 	113, 151, 243, 160, 168, 221, 288, 202, 227, 120,
-	270, 244, 479, 482, 477, 478, 526, 527, 574, 575,
-	576, 552, 473, 0, 480, 481, 0, 557, 564, 565,
-	530, 99, 108, 165, 287, 219, 138, 272, 463, 476,
-	128, 486, 0, 0, 499, 504, 505, 517, 519, 520,
-	521, 522, 529, 536, 537, 539, 546, 547, 548, 549,
-	554, 561, 580, 101, 102, 109, 115, 121, 127, 133,
+	270, 244, 481, 484, 479, 480, 528, 529, 576, 577,
+	578, 554, 475, 0, 482, 483, 0, 559, 566, 567,
+	532, 99, 108, 165, 287, 219, 138, 272, 465, 478,
+	128, 488, 0, 0, 501, 506, 507, 519, 521, 522,
+	523, 524, 531, 538, 539, 541, 548, 549, 550, 551,
+	556, 563, 582, 101, 102, 109, 115, 121, 127, 133,
 	137, 143, 147, 150, 153, 154, 155, 158, 172, 176,
 	177, 178, 179, 189, 190, 191, 193, 196, 197, 198,
 	200, 201, 204, 207, 208, 209, 210, 211, 212, 220,
 	223, 229, 230, 231, 232, 233, 234, 235, 238, 239,
 	240, 241, 247, 250, 258, 259, 269, 276, 279, 145,
-	266, 280, 568, 556, 0, 0, 512, 571, 485, 502,
-	579, 503, 506, 543, 470, 525, 195, 500, 0, 489,
-	465, 496, 466, 487, 514, 131, 518, 484, 558, 528,
-	570, 163, 0, 490, 545, 237, 123, 171, 169, 255,
-	136, 132, 130, 122, 152, 175, 205, 252, 199, 577,
-	166, 534, 0, 245, 183, 0, 0, 0, 516, 560,
-	523, 553, 511, 544, 475, 533, 572, 501, 541, 573,
-	61, 0, 0, 96, 97, 98, 0, 0, 0, 0,
-	0, 0, 0, 0, 118, 0, 538, 567, 498, 540,
-	542, 581, 464, 535, 0, 468, 471, 578, 563, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 515, 524,
-	550, 509, 0, 0, 0, 0, 0, 0, 0, 0,
-	491, 0, 532, 0, 0, 0, 472, 469, 0, 0,
-	0, 0, 513, 0, 0, 0, 474, 0, 492, 551,
-	0, 462, 142, 555, 562, 510, 308, 566, 508, 507,
-	569, 216, 0, 249, 146, 162, 114, 159, 100, 110,
-	0, 144, 192, 224, 228, 559, 488, 497, 124, 495,
-	226, 203, 268, 531, 206, 225, 167, 257, 217, 267,
+	266, 280, 570, 558, 0, 0, 514, 573, 487, 504,
+	581, 505, 508, 545, 472, 527, 195, 502, 0, 491,
+	467, 498, 468, 489, 516, 131, 520, 486, 560, 530,
+	572, 163, 0, 492, 547, 237, 123, 171, 169, 255,
+	136, 132, 130, 122, 152, 175, 205, 252, 199, 579,
+	166, 536, 0, 245, 183, 0, 0, 0, 518, 562,
+	525, 555, 513, 546, 477, 535, 574, 503, 543, 575,
+	0, 0, 0, 96, 97, 98, 0, 1087, 1088, 0,
+	0, 0, 0, 0, 118, 0, 540, 569, 500, 542,
+	544, 583, 466, 537, 0, 470, 473, 580, 565, 495,
+	496, 0, 0, 0, 0, 0, 0, 0, 517, 526,
+	552, 511, 0, 0, 0, 0, 0, 0, 0, 0,
+	493, 0, 534, 0, 0, 0, 474, 471, 0, 0,
+	0, 0, 515, 0, 0, 0, 476, 0, 494, 553,
+	0, 464, 142, 557, 564, 512, 310, 568, 510, 509,
+	571, 216, 0, 249, 146, 162, 114, 159, 100, 110,
+	0, 144, 192, 224, 228, 561, 490, 499, 124, 497,
+	226, 203, 268, 533, 206, 225, 167, 257, 217, 267,
 	277, 278, 253, 275, 286, 242, 103, 251, 265, 119,
 	236, 0, 0, 0, 105, 263, 248, 181, 156, 157,
 	104, 0, 222, 129, 140, 126, 194, 260, 261, 125,
 	289, 111, 274, 107, 112, 273, 188, 256, 264, 182,
 	174, 106, 262, 180, 173, 161, 135, 148, 214, 170,
-	215, 149, 185, 184, 186, 0, 467, 0, 246, 271,
-	290, 116, 483, 254, 282, 285, 0, 218, 117, 141,
+	215, 149, 185, 184, 186, 0, 469, 0, 246, 271,
+	290, 116, 485, 254, 282, 285, 0, 218, 117, 141,
 	134, 213, 139, 164, 281, 283, 284, 187, 113, 151,
 	243, 160, 168, 221, 288, 202, 227, 120, 270, 244,
-	479, 482, 477, 478, 526, 527, 574, 575, 576, 552,
-	473, 0, 480, 481, 0, 557, 564, 565, 530, 99,
-	108, 165, 287, 219, 138, 272, 463, 476, 128, 486,
-	0, 0, 499, 504, 505, 517, 519, 520, 521, 522,
-	529, 536, 537, 539, 546, 547, 548, 549, 554, 561,
-	580, 101, 102, 109, 115, 121, 127, 133, 137, 143,
+	481, 484, 479, 480, 528, 529, 576, 577, 578, 554,
+	475, 0, 482, 483, 0, 559, 566, 567, 532, 99,
+	108, 165, 287, 219, 138, 272, 465, 478, 128, 488,
+	0, 0, 501, 506, 507, 519, 521, 522, 523, 524,
+	531, 538, 539, 541, 548, 549, 550, 551, 556, 563,
+	582, 101, 102, 109, 115, 121, 127, 133, 137, 143,
 	147, 150, 153, 154, 155, 158, 172, 176, 177, 178,
 	179, 189, 190, 191, 193, 196, 197, 198, 200, 201,
 	204, 207, 208, 209, 210, 211, 212, 220, 223, 229,
 	230, 231, 232, 233, 234, 235, 238, 239, 240, 241,
 	247, 250, 258, 259, 269, 276, 279, 145, 266, 280,
-	568, 556, 0, 0, 512, 571, 485, 502, 579, 503,
-	506, 543, 470, 525, 195, 500, 0, 489, 465, 496,
-	466, 487, 514, 131, 518, 484, 558, 528, 570, 163,
-	0, 490, 545, 237, 123, 171, 169, 255, 136, 132,
-	130, 122, 152, 175, 205, 252, 199, 577, 166, 534,
-	0, 245, 183, 0, 0, 0, 516, 560, 523, 553,
-	511, 544, 475, 533, 572, 501, 541, 573, 0, 0,
+	570, 558, 0, 0, 514, 573, 487, 504, 581, 505,
+	508, 545, 472, 527, 195, 502, 0, 491, 467, 498,
+	468, 489, 516, 131, 520, 486, 560, 530, 572, 163,
+	0, 492, 547, 237, 123, 171, 169, 255, 136, 132,
+	130, 122, 152, 175, 205, 252, 199, 579, 166, 536,
+	0, 245, 183, 0, 0, 0, 518, 562, 525, 555,
+	513, 546, 477, 535, 574, 503, 543, 575, 61, 0,
 	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
-	0, 0, 118, 0, 538, 567, 498, 540, 542, 581,
-	464, 535, 0, 468, 471, 578, 563, 493, 494, 0,
-	0, 0, 0, 0, 0, 0, 515, 524, 550, 509,
-	0, 0, 0, 0, 0, 0, 1398, 0, 491, 0,
-	532, 0, 0, 0, 472, 469, 0, 0, 0, 0,
-	513, 0, 0, 0, 474, 0, 492, 551, 0, 462,
-	142, 555, 562, 510, 308, 566, 508, 507, 569, 216,
+	0, 0, 118, 0, 540, 569, 500, 542, 544, 583,
+	466, 537, 0, 470, 473, 580, 565, 495, 496, 0,
+	0, 0, 0, 0, 0, 0, 517, 526, 552, 511,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 0,
+	534, 0, 0, 0, 474, 471, 0, 0, 0, 0,
+	515, 0, 0, 0, 476, 0, 494, 553, 0, 464,
+	142, 557, 564, 512, 310, 568, 510, 509, 571, 216,
 	0, 249, 146, 162, 114, 159, 100, 110, 0, 144,
-	192, 224, 228, 559, 488, 497, 124, 495, 226, 203,
-	268, 531, 206, 225, 167, 257, 217, 267, 277, 278,
+	192, 224, 228, 561, 490, 499, 124, 497, 226, 203,
+	268, 533, 206, 225, 167, 257, 217, 267, 277, 278,
 	253, 275, 286, 242, 103, 251, 265, 119, 236, 0,
 	0, 0, 105, 263, 248, 181, 156, 157, 104, 0,
 	222, 129, 140, 126, 194, 260, 261, 125, 289, 111,
 	274, 107, 112, 273, 188, 256, 264, 182, 174, 106,
 	262, 180, 173, 161, 135, 148, 214, 170, 215, 149,
-	185, 184, 186, 0, 467, 0, 246, 271, 290, 116,
-	483, 254, 282, 285, 0, 218, 117, 141, 134, 213,
+	185, 184, 186, 0, 469, 0, 246, 271, 290, 116,
+	485, 254, 282, 285, 0, 218, 117, 141, 134, 213,
 	139, 164, 281, 283, 284, 187, 113, 151, 243, 160,
-	168, 221, 288, 202, 227, 120, 270, 244, 479, 482,
-	477, 478, 526, 527, 574, 575, 576, 552, 473, 0,
-	480, 481, 0, 557, 564, 565, 530, 99, 108, 165,
-	287, 219, 138, 272, 463, 476, 128, 486, 0, 0,
-	499, 504, 505, 517, 519, 520, 521, 522, 529, 536,
-	537, 539, 546, 547, 548, 549, 554, 561, 580, 101,
+	168, 221, 288, 202, 227, 120, 270, 244, 481, 484,
+	479, 480, 528, 529, 576, 577, 578, 554, 475, 0,
+	482, 483, 0, 559, 566, 567, 532, 99, 108, 165,
+	287, 219, 138, 272, 465, 478, 128, 488, 0, 0,
+	501, 506, 507, 519, 521, 522, 523, 524, 531, 538,
+	539, 541, 548, 549, 550, 551, 556, 563, 582, 101,
 	102, 109, 115, 121, 127, 133, 137, 143, 147, 150,
 	153, 154, 155, 158, 172, 176, 177, 178, 179, 189,
 	190, 191, 193, 196, 197, 198, 200, 201, 204, 207,
 	208, 209, 210, 211, 212, 220, 223, 229, 230, 231,
 	232, 233, 234, 235, 238, 239, 240, 241, 247, 250,
-	258, 259, 269, 276, 279, 145, 266, 280, 568, 556,
-	0, 0, 512, 571, 485, 502, 579, 503, 506, 543,
-	470, 525, 195, 500, 0, 489, 465, 496, 466, 487,
-	514, 131, 518, 484, 558, 528, 570, 163, 0, 490,
-	545, 237, 123, 171, 169, 255, 136, 132, 130, 122,
-	152, 175, 205, 252, 199, 577, 166, 534, 0, 245,
-	183, 0, 0, 0, 516, 560, 523, 553, 511, 544,
-	475, 533, 572, 501, 541, 573, 0, 0, 0, 96,
+	258, 259, 269, 276, 279, 145, 266, 280, 570, 558,
+	0, 0, 514, 573, 487, 504, 581, 505, 508, 545,
+	472, 527, 195, 502, 0, 491, 467, 498, 468, 489,
+	516, 131, 520, 486, 560, 530, 572, 163, 0, 492,
+	547, 237, 123, 171, 169, 255, 136, 132, 130, 122,
+	152, 175, 205, 252, 199, 579, 166, 536, 0, 245,
+	183, 0, 0, 0, 518, 562, 525, 555, 513, 546,
+	477, 535, 574, 503, 543, 575, 0, 0, 0, 96,
 	97, 98, 0, 0, 0, 0, 0, 0, 0, 0,
-	118, 0, 538, 567, 498, 540, 542, 581, 464, 535,
-	0, 468, 471, 578, 563, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 515, 524, 550, 509, 0, 0,
-	0, 0, 0, 0, 1278, 0, 491, 0, 532, 0,
-	0, 0, 472, 469, 0, 0, 0, 0, 513, 0,
-	0, 0, 474, 0, 492, 551, 0, 462, 142, 555,
-	562, 510, 308, 566, 508, 507, 569, 216, 0, 249,
+	118, 0, 540, 569, 500, 542, 544, 583, 466, 537,
+	0, 470, 473, 580, 565, 495, 496, 0, 0, 0,
+	0, 0, 0, 0, 517, 526, 552, 511, 0, 0,
+	0, 0, 0, 0, 1403, 0, 493, 0, 534, 0,
+	0, 0, 474, 471, 0, 0, 0, 0, 515, 0,
+	0, 0, 476, 0, 494, 553, 0, 464, 142, 557,
+	564, 512, 310, 568, 510, 509, 571, 216, 0, 249,
 	146, 162, 114, 159, 100, 110, 0, 144, 192, 224,
-	228, 559, 488, 497, 124, 495, 226, 203, 268, 531,
+	228, 561, 490, 499, 124, 497, 226, 203, 268, 533,
 	206, 225, 167, 257, 217, 267, 277, 278, 253, 275,
 	286, 242, 103, 251, 265, 119, 236, 0, 0, 0,
 	105, 263, 248, 181, 156, 157, 104, 0, 222, 129,
 	140, 126, 194, 260, 261, 125, 289, 111, 274, 107,
 	112, 273, 188, 256, 264, 182, 174, 106, 262, 180,
 	173, 161, 135, 148, 214, 170, 215, 149, 185, 184,
-	186, 0, 467, 0, 246, 271, 290, 116, 483, 254,
+	186, 0, 469, 0, 246, 271, 290, 116, 485, 254,
 	282, 285, 0, 218, 117, 141, 134, 213, 139, 164,
 	281, 283, 284, 187, 113, 151, 243, 160, 168, 221,
-	288, 202, 227, 120, 270, 244, 479, 482, 477, 478,
-	526, 527, 574, 575, 576, 552, 473, 0, 480, 481,
-	0, 557, 564, 565, 530, 99, 108, 165, 287, 219,
-	138, 272, 463, 476, 128, 486, 0, 0, 499, 504,
-	505, 517, 519, 520, 521, 522, 529, 536, 537, 539,
-	546, 547, 548, 549, 554, 561, 580, 101, 102, 109,
+	288, 202, 227, 120, 270, 244, 481, 484, 479, 480,
+	528, 529, 576, 577, 578, 554, 475, 0, 482, 483,
+	0, 559, 566, 567, 532, 99, 108, 165, 287, 219,
+	138, 272, 465, 478, 128, 488, 0, 0, 501, 506,
+	507, 519, 521, 522, 523, 524, 531, 538, 539, 541,
+	548, 549, 550, 551, 556, 563, 582, 101, 102, 109,
 	115, 121, 127, 133, 137, 143, 147, 150, 153, 154,
 	155, 158, 172, 176, 177, 178, 179, 189, 190, 191,
 	193, 196, 197, 198, 200, 201, 204, 207, 208, 209,
 	210, 211, 212, 220, 223, 229, 230, 231, 232, 233,
 	234, 235, 238, 239, 240, 241, 247, 250, 258, 259,
-	269, 276, 279, 145, 266, 280, 568, 556, 0, 0,
-	512, 571, 485, 502, 579, 503, 506, 543, 470, 525,
-	195, 500, 0, 489, 465, 496, 466, 487, 514, 131,
-	518, 484, 558, 528, 570, 163, 0, 490, 545, 237,
+	269, 276, 279, 145, 266, 280, 570, 558, 0, 0,
+	514, 573, 487, 504, 581, 505, 508, 545, 472, 527,
+	195, 502, 0, 491, 467, 498, 468, 489, 516, 131,
+	520, 486, 560, 530, 572, 163, 0, 492, 547, 237,
 	123, 171, 169, 255, 136, 132, 130, 122, 152, 175,
-	205, 252, 199, 577, 166, 534, 0, 245, 183, 0,
-	0, 0, 516, 560, 523, 553, 511, 544, 475, 533,
-	572, 501, 541, 573, 0, 0, 0, 96, 97, 98,
+	205, 252, 199, 579, 166, 536, 0, 245, 183, 0,
+	0, 0, 518, 562, 525, 555, 513, 546, 477, 535,
+	574, 503, 543, 575, 0, 0, 0, 96, 97, 98,
 	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
-	538, 567, 498, 540, 542, 581, 464, 535, 0, 468,
-	471, 578, 563, 493, 494, 0, 0, 0, 0, 0,
-	0, 0, 515, 524, 550, 509, 0, 0, 0, 0,
-	0, 0, 1029, 0, 491, 0, 532, 0, 0, 0,
-	472, 469, 0, 0, 0, 0, 513, 0, 0, 0,
-	474, 0, 492, 551, 0, 462, 142, 555, 562, 510,
-	308, 566, 508, 507, 569, 216, 0, 249, 146, 162,
-	114, 159, 100, 110, 0, 144, 192, 224, 228, 559,
-	488, 497, 124, 495, 226, 203, 268, 531, 206, 225,
+	540, 569, 500, 542, 544, 583, 466, 537, 0, 470,
+	473, 580, 565, 495, 496, 0, 0, 0, 0, 0,
+	0, 0, 517, 526, 552, 511, 0, 0, 0, 0,
+	0, 0, 1283, 0, 493, 0, 534, 0, 0, 0,
+	474, 471, 0, 0, 0, 0, 515, 0, 0, 0,
+	476, 0, 494, 553, 0, 464, 142, 557, 564, 512,
+	310, 568, 510, 509, 571, 216, 0, 249, 146, 162,
+	114, 159, 100, 110, 0, 144, 192, 224, 228, 561,
+	490, 499, 124, 497, 226, 203, 268, 533, 206, 225,
 	167, 257, 217, 267, 277, 278, 253, 275, 286, 242,
 	103, 251, 265, 119, 236, 0, 0, 0, 105, 263,
 	248, 181, 156, 157, 104, 0, 222, 129, 140, 126,
 	194, 260, 261, 125, 289, 111, 274, 107, 112, 273,
 	188, 256, 264, 182, 174, 106, 262, 180, 173, 161,
 	135, 148, 214, 170, 215, 149, 185, 184, 186, 0,
-	467, 0, 246, 271, 290, 116, 483, 254, 282, 285,
+	469, 0, 246, 271, 290, 116, 485, 254, 282, 285,
 	0, 218, 117, 141, 134, 213, 139, 164, 281, 283,
 	284, 187, 113, 151, 243, 160, 168, 221, 288, 202,
-	227, 120, 270, 244, 479, 482, 477, 478, 526, 527,
-	574, 575, 576, 552, 473, 0, 480, 481, 0, 557,
-	564, 565, 530, 99, 108, 165, 287, 219, 138, 272,
-	463, 476, 128, 486, 0, 0, 499, 504, 505, 517,
-	519, 520, 521, 522, 529, 536, 537, 539, 546, 547,
-	548, 549, 554, 561, 580, 101, 102, 109, 115, 121,
+	227, 120, 270, 244, 481, 484, 479, 480, 528, 529,
+	576, 577, 578, 554, 475, 0, 482, 483, 0, 559,
+	566, 567, 532, 99, 108, 165, 287, 219, 138, 272,
+	465, 478, 128, 488, 0, 0, 501, 506, 507, 519,
+	521, 522, 523, 524, 531, 538, 539, 541, 548, 549,
+	550, 551, 556, 563, 582, 101, 102, 109, 115, 121,
 	127, 133, 137, 143, 147, 150, 153, 154, 155, 158,
 	172, 176, 177, 178, 179, 189, 190, 191, 193, 196,
 	197, 198, 200, 201, 204, 207, 208, 209, 210, 211,
 	212, 220, 223, 229, 230, 231, 232, 233, 234, 235,
 	238, 239, 240, 241, 247, 250, 258, 259, 269, 276,
-	279, 145, 266, 280, 568, 556, 0, 0, 512, 571,
-	485, 502, 579, 503, 506, 543, 470, 525, 195, 500,
-	0, 489, 465, 496, 466, 487, 514, 131, 518, 484,
-	558, 528, 570, 163, 0, 490, 545, 237, 123, 171,
+	279, 145, 266, 280, 570, 558, 0, 0, 514, 573,
+	487, 504, 581, 505, 508, 545, 472, 527, 195, 502,
+	0, 491, 467, 498, 468, 489, 516, 131, 520, 486,
+	560, 530, 572, 163, 0, 492, 547, 237, 123, 171,
 	169, 255, 136, 132, 130, 122, 152, 175, 205, 252,
-	199, 577, 166, 534, 0, 245, 183, 0, 0, 0,
-	516, 560, 523, 553, 511, 544, 475, 533, 572, 501,
-	541, 573, 0, 0, 0, 96, 97, 98, 0, 0,
-	0, 0, 0, 0, 0, 0, 118, 0, 538, 567,
-	498, 540, 542, 581, 464, 535, 0, 468, 471, 578,
-	563, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	515, 524, 550, 509, 0, 0, 0, 0, 0, 0,
-	0, 0, 491, 0, 532, 0, 0, 0, 472, 469,
-	0, 0, 0, 0, 513, 0, 0, 0, 474, 0,
-	492, 551, 0, 462, 142, 555, 562, 510, 308, 566,
-	508, 507, 569, 216, 0, 249, 146, 162, 114, 159,
-	100, 110, 0, 144, 192, 224, 228, 559, 488, 497,
-	124, 495, 226, 203, 268, 531, 206, 225, 167, 257,
+	199, 579, 166, 536, 0, 245, 183, 0, 0, 0,
+	518, 562, 525, 555, 513, 546, 477, 535, 574, 503,
+	543, 575, 0, 0, 0, 96, 97, 98, 0, 0,
+	0, 0, 0, 0, 0, 0, 118, 0, 540, 569,
+	500, 542, 544, 583, 466, 537, 0, 470, 473, 580,
+	565, 495, 496, 0, 0, 0, 0, 0, 0, 0,
+	517, 526, 552, 511, 0, 0, 0, 0, 0, 0,
+	1033, 0, 493, 0, 534, 0, 0, 0, 474, 471,
+	0, 0, 0, 0, 515, 0, 0, 0, 476, 0,
+	494, 553, 0, 464, 142, 557, 564, 512, 310, 568,
+	510, 509, 571, 216, 0, 249, 146, 162, 114, 159,
+	100, 110, 0, 144, 192, 224, 228, 561, 490, 499,
+	124, 497, 226, 203, 268, 533, 206, 225, 167, 257,
 	217, 267, 277, 278, 253, 275, 286, 242, 103, 251,
 	265, 119, 236, 0, 0, 0, 105, 263, 248, 181,
 	156, 157, 104, 0, 222, 129, 140, 126, 194, 260,
 	261, 125, 289, 111, 274, 107, 112, 273, 188, 256,
 	264, 182, 174, 106, 262, 180, 173, 161, 135, 148,
-	214, 170, 215, 149, 185, 184, 186, 0, 467, 0,
-	246, 271, 290, 116, 483, 254, 282, 285, 0, 218,
+	214, 170, 215, 149, 185, 184, 186, 0, 469, 0,
+	246, 271, 290, 116, 485, 254, 282, 285, 0, 218,
 	117, 141, 134, 213, 139, 164, 281, 283, 284, 187,
 	113, 151, 243, 160, 168, 221, 288, 202, 227, 120,
-	270, 244, 479, 482, 477, 478, 526, 527, 574, 575,
-	576, 552, 473, 0, 480, 481, 0, 557, 564, 565,
-	530, 99, 108, 165, 287, 219, 138, 272, 463, 476,
-	128, 486, 0, 0, 499, 504, 505, 517, 519, 520,
-	521, 522, 529, 536, 537, 539, 546, 547, 548, 549,
-	554, 561, 580, 101, 102, 109, 115, 121, 127, 133,
+	270, 244, 481, 484, 479, 480, 528, 529, 576, 577,
+	578, 554, 475, 0, 482, 483, 0, 559, 566, 567,
+	532, 99, 108, 165, 287, 219, 138, 272, 465, 478,
+	128, 488, 0, 0, 501, 506, 507, 519, 521, 522,
+	523, 524, 531, 538, 539, 541, 548, 549, 550, 551,
+	556, 563, 582, 101, 102, 109, 115, 121, 127, 133,
 	137, 143, 147, 150, 153, 154, 155, 158, 172, 176,
 	177, 178, 179, 189, 190, 191, 193, 196, 197, 198,
 	200, 201, 204, 207, 208, 209, 210, 211, 212, 220,
 	223, 229, 230, 231, 232, 233, 234, 235, 238, 239,
 	240, 241, 247, 250, 258, 259, 269, 276, 279, 145,
-	266, 280, 568, 556, 0, 0, 512, 571, 485, 502,
-	579, 503, 506, 543, 470, 525, 195, 500, 0, 489,
-	465, 496, 466, 487, 514, 131, 518, 484, 558, 528,
-	570, 163, 0, 490, 545, 237, 123, 171, 169, 255,
-	136, 132, 130, 122, 152, 175, 205, 252, 199, 577,
-	166, 534, 0, 245, 183, 0, 0, 0, 516, 560,
-	523, 553, 511, 544, 475, 533, 572, 501, 541, 573,
+	266, 280, 570, 558, 0, 0, 514, 573, 487, 504,
+	581, 505, 508, 545, 472, 527, 195, 502, 0, 491,
+	467, 498, 468, 489, 516, 131, 520, 486, 560, 530,
+	572, 163, 0, 492, 547, 237, 123, 171, 169, 255,
+	136, 132, 130, 122, 152, 175, 205, 252, 199, 579,
+	166, 536, 0, 245, 183, 0, 0, 0, 518, 562,
+	525, 555, 513, 546, 477, 535, 574, 503, 543, 575,
 	0, 0, 0, 96, 97, 98, 0, 0, 0, 0,
-	0, 0, 0, 0, 118, 0, 538, 567, 498, 540,
-	542, 581, 464, 535, 0, 468, 471, 578, 563, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 515, 524,
-	550, 509, 0, 0, 0, 0, 0, 0, 0, 0,
-	491, 0, 532, 0, 0, 0, 472, 469, 0, 0,
-	0, 0, 513, 0, 0, 0, 474, 0, 492, 551,
-	0, 462, 142, 555, 562, 510, 308, 566, 508, 507,
-	569, 216, 0, 249, 146, 162, 114, 159, 100, 110,
-	0, 144, 192, 224, 228, 559, 488, 497, 124, 495,
-	226, 203, 268, 531, 206, 225, 167, 257, 217, 267,
+	0, 0, 0, 0, 118, 0, 540, 569, 500, 542,
+	544, 583, 466, 537, 0, 470, 473, 580, 565, 495,
+	496, 0, 0, 0, 0, 0, 0, 0, 517, 526,
+	552, 511, 0, 0, 0, 0, 0, 0, 0, 0,
+	493, 0, 534, 0, 0, 0, 474, 471, 0, 0,
+	0, 0, 515, 0, 0, 0, 476, 0, 494, 553,
+	0, 464, 142, 557, 564, 512, 310, 568, 510, 509,
+	571, 216, 0, 249, 146, 162, 114, 159, 100, 110,
+	0, 144, 192, 224, 228, 561, 490, 499, 124, 497,
+	226, 203, 268, 533, 206, 225, 167, 257, 217, 267,
 	277, 278, 253, 275, 286, 242, 103, 251, 265, 119,
 	236, 0, 0, 0, 105, 263, 248, 181, 156, 157,
 	104, 0, 222, 129, 140, 126, 194, 260, 261, 125,
-	289, 111, 274, 107, 460, 273, 188, 256, 264, 182,
+	289, 111, 274, 107, 112, 273, 188, 256, 264, 182,
 	174, 106, 262, 180, 173, 161, 135, 148, 214, 170,
-	215, 149, 185, 184, 186, 0, 467, 0, 246, 271,
-	290, 116, 483, 254, 282, 285, 0, 218, 117, 141,
-	134, 213, 139, 164, 281, 283, 284, 461, 459, 454,
-	453, 160, 168, 221, 288, 202, 227, 120, 270, 244,
-	479, 482, 477, 478, 526, 527, 574, 575, 576, 552,
-	473, 0, 480, 481, 0, 557, 564, 565, 530, 99,
-	108, 165, 287, 219, 138, 272, 463, 476, 128, 486,
-	0, 0, 499, 504, 505, 517, 519, 520, 521, 522,
-	529, 536, 537, 539, 546, 547, 548, 549, 554, 561,
-	580, 101, 102, 109, 115, 121, 127, 133, 137, 143,
+	215, 149, 185, 184, 186, 0, 469, 0, 246, 271,
+	290, 116, 485, 254, 282, 285, 0, 218, 117, 141,
+	134, 213, 139, 164, 281, 283, 284, 187, 113, 151,
+	243, 160, 168, 221, 288, 202, 227, 120, 270, 244,
+	481, 484, 479, 480, 528, 529, 576, 577, 578, 554,
+	475, 0, 482, 483, 0, 559, 566, 567, 532, 99,
+	108, 165, 287, 219, 138, 272, 465, 478, 128, 488,
+	0, 0, 501, 506, 507, 519, 521, 522, 523, 524,
+	531, 538, 539, 541, 548, 549, 550, 551, 556, 563,
+	582, 101, 102, 109, 115, 121, 127, 133, 137, 143,
 	147, 150, 153, 154, 155, 158, 172, 176, 177, 178,
 	179, 189, 190, 191, 193, 196, 197, 198, 200, 201,
 	204, 207, 208, 209, 210, 211, 212, 220, 223, 229,
 	230, 231, 232, 233, 234, 235, 238, 239, 240, 241,
 	247, 250, 258, 259, 269, 276, 279, 145, 266, 280,
-	568, 556, 0, 0, 512, 571, 485, 502, 579, 503,
-	506, 543, 470, 525, 195, 500, 0, 489, 465, 496,
-	466, 487, 514, 131, 518, 484, 558, 528, 570, 163,
-	0, 490, 545, 237, 123, 171, 169, 255, 136, 132,
-	130, 122, 152, 175, 205, 252, 199, 577, 166, 534,
-	0, 245, 183, 0, 0, 0, 516, 560, 523, 553,
-	511, 544, 475, 533, 572, 501, 541, 573, 0, 0,
+	570, 558, 0, 0, 514, 573, 487, 504, 581, 505,
+	508, 545, 472, 527, 195, 502, 0, 491, 467, 498,
+	468, 489, 516, 131, 520, 486, 560, 530, 572, 163,
+	0, 492, 547, 237, 123, 171, 169, 255, 136, 132,
+	130, 122, 152, 175, 205, 252, 199, 579, 166, 536,
+	0, 245, 183, 0, 0, 0, 518, 562, 525, 555,
+	513, 546, 477, 535, 574, 503, 543, 575, 0, 0,
 	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
-	0, 0, 118, 0, 538, 567, 498, 540, 542, 581,
-	464, 535, 0, 468, 471, 578, 563, 493, 494, 0,
-	0, 0, 0, 0, 0, 0, 515, 524, 550, 509,
-	0, 0, 0, 0, 0, 0, 0, 0, 491, 0,
-	532, 0, 0, 0, 472, 469, 0, 0, 0, 0,
-	513, 0, 0, 0, 474, 0, 492, 551, 0, 462,
-	142, 555, 562, 510, 308, 566, 508, 507, 569, 216,
+	0, 0, 118, 0, 540, 569, 500, 542, 544, 583,
+	466, 537, 0, 470, 473, 580, 565, 495, 496, 0,
+	0, 0, 0, 0, 0, 0, 517, 526, 552, 511,
+	0, 0, 0, 0, 0, 0, 0, 0, 493, 0,
+	534, 0, 0, 0, 474, 471, 0, 0, 0, 0,
+	515, 0, 0, 0, 476, 0, 494, 553, 0, 464,
+	142, 557, 564, 512, 310, 568, 510, 509, 571, 216,
 	0, 249, 146, 162, 114, 159, 100, 110, 0, 144,
-	192, 224, 228, 559, 488, 497, 124, 495, 226, 203,
-	268, 531, 206, 225, 167, 257, 217, 267, 277, 278,
-	253, 275, 286, 242, 103, 251, 800, 119, 236, 0,
+	192, 224, 228, 561, 490, 499, 124, 497, 226, 203,
+	268, 533, 206, 225, 167, 257, 217, 267, 277, 278,
+	253, 275, 286, 242, 103, 251, 265, 119, 236, 0,
 	0, 0, 105, 263, 248, 181, 156, 157, 104, 0,
 	222, 129, 140, 126, 194, 260, 261, 125, 289, 111,
-	274, 107, 460, 273, 188, 256, 264, 182, 174, 106,
+	274, 107, 462, 273, 188, 256, 264, 182, 174, 106,
 	262, 180, 173, 161, 135, 148, 214, 170, 215, 149,
-	185, 184, 186, 0, 467, 0, 246, 271, 290, 116,
-	483, 254, 282, 285, 0, 218, 117, 141, 134, 213,
-	139, 164, 281, 283, 284, 461, 459, 454, 453, 160,
-	168, 221, 288, 202, 227, 120, 270, 244, 479, 482,
-	477, 478, 526, 527, 574, 575, 576, 552, 473, 0,
-	480, 481, 0, 557, 564, 565, 530, 99, 108, 165,
-	287, 219, 138, 272, 463, 476, 128, 486, 0, 0,
-	499, 504, 505, 517, 519, 520, 521, 522, 529, 536,
-	537, 539, 546, 547, 548, 549, 554, 561, 580, 101,
+	185, 184, 186, 0, 469, 0, 246, 271, 290, 116,
+	485, 254, 282, 285, 0, 218, 117, 141, 134, 213,
+	139, 164, 281, 283, 284, 463, 461, 456, 455, 160,
+	168, 221, 288, 202, 227, 120, 270, 244, 481, 484,
+	479, 480, 528, 529, 576, 577, 578, 554, 475, 0,
+	482, 483, 0, 559, 566, 567, 532, 99, 108, 165,
+	287, 219, 138, 272, 465, 478, 128, 488, 0, 0,
+	501, 506, 507, 519, 521, 522, 523, 524, 531, 538,
+	539, 541, 548, 549, 550, 551, 556, 563, 582, 101,
 	102, 109, 115, 121, 127, 133, 137, 143, 147, 150,
 	153, 154, 155, 158, 172, 176, 177, 178, 179, 189,
 	190, 191, 193, 196, 197, 198, 200, 201, 204, 207,
 	208, 209, 210, 211, 212, 220, 223, 229, 230, 231,
 	232, 233, 234, 235, 238, 239, 240, 241, 247, 250,
-	258, 259, 269, 276, 279, 145, 266, 280, 568, 556,
-	0, 0, 512, 571, 485, 502, 579, 503, 506, 543,
-	470, 525, 195, 500, 0, 489, 465, 496, 466, 487,
-	514, 131, 518, 484, 558, 528, 570, 163, 0, 490,
-	545, 237, 123, 171, 169, 255, 136, 132, 130, 122,
-	152, 175, 205, 252, 199, 577, 166, 534, 0, 245,
-	183, 0, 0, 0, 516, 560, 523, 553, 511, 544,
-	475, 533, 572, 501, 541, 573, 0, 0, 0, 96,
+	258, 259, 269, 276, 279, 145, 266, 280, 570, 558,
+	0, 0, 514, 573, 487, 504, 581, 505, 508, 545,
+	472, 527, 195, 502, 0, 491, 467, 498, 468, 489,
+	516, 131, 520, 486, 560, 530, 572, 163, 0, 492,
+	547, 237, 123, 171, 169, 255, 136, 132, 130, 122,
+	152, 175, 205, 252, 199, 579, 166, 536, 0, 245,
+	183, 0, 0, 0, 518, 562, 525, 555, 513, 546,
+	477, 535, 574, 503, 543, 575, 0, 0, 0, 96,
 	97, 98, 0, 0, 0, 0, 0, 0, 0, 0,
-	118, 0, 538, 567, 498, 540, 542, 581, 464, 535,
-	0, 468, 471, 578, 563, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 515, 524, 550, 509, 0, 0,
-	0, 0, 0, 0, 0, 0, 491, 0, 532, 0,
-	0, 0, 472, 469, 0, 0, 0, 0, 513, 0,
-	0, 0, 474, 0, 492, 551, 0, 462, 142, 555,
-	562, 510, 308, 566, 508, 507, 569, 216, 0, 249,
+	118, 0, 540, 569, 500, 542, 544, 583, 466, 537,
+	0, 470, 473, 580, 565, 495, 496, 0, 0, 0,
+	0, 0, 0, 0, 517, 526, 552, 511, 0, 0,
+	0, 0, 0, 0, 0, 0, 493, 0, 534, 0,
+	0, 0, 474, 471, 0, 0, 0, 0, 515, 0,
+	0, 0, 476, 0, 494, 553, 0, 464, 142, 557,
+	564, 512, 310, 568, 510, 509, 571, 216, 0, 249,
 	146, 162, 114, 159, 100, 110, 0, 144, 192, 224,
-	228, 559, 488, 497, 124, 495, 226, 203, 268, 531,
+	228, 561, 490, 499, 124, 497, 226, 203, 268, 533,
 	206, 225, 167, 257, 217, 267, 277, 278, 253, 275,
-	286, 242, 103, 251, 451, 119, 236, 0, 0, 0,
+	286, 242, 103, 251, 803, 119, 236, 0, 0, 0,
 	105, 263, 248, 181, 156, 157, 104, 0, 222, 129,
 	140, 126, 194, 260, 261, 125, 289, 111, 274, 107,
-	460, 273, 188, 256, 264, 182, 174, 106, 262, 180,
+	462, 273, 188, 256, 264, 182, 174, 106, 262, 180,
 	173, 161, 135, 148, 214, 170, 215, 149, 185, 184,
-	186, 0, 467, 0, 246, 271, 290, 116, 483, 254,
+	186, 0, 469, 0, 246, 271, 290, 116, 485, 254,
 	282, 285, 0, 218, 117, 141, 134, 213, 139, 164,
-	281, 283, 284, 461, 459, 454, 453, 160, 168, 221,
-	288, 202, 227, 120, 270, 244, 479, 482, 477, 478,
-	526, 527, 574, 575, 576, 552, 473, 0, 480, 481,
-	0, 557, 564, 565, 530, 99, 108, 165, 287, 219,
-	138, 272, 463, 476, 128, 486, 0, 0, 499, 504,
-	505, 517, 519, 520, 521, 522, 529, 536, 537, 539,
-	546, 547, 548, 549, 554, 561, 580, 101, 102, 109,
+	281, 283, 284, 463, 461, 456, 455, 160, 168, 221,
+	288, 202, 227, 120, 270, 244, 481, 484, 479, 480,
+	528, 529, 576, 577, 578, 554, 475, 0, 482, 483,
+	0, 559, 566, 567, 532, 99, 108, 165, 287, 219,
+	138, 272, 465, 478, 128, 488, 0, 0, 501, 506,
+	507, 519, 521, 522, 523, 524, 531, 538, 539, 541,
+	548, 549, 550, 551, 556, 563, 582, 101, 102, 109,
 	115, 121, 127, 133, 137, 143, 147, 150, 153, 154,
 	155, 158, 172, 176, 177, 178, 179, 189, 190, 191,
 	193, 196, 197, 198, 200, 201, 204, 207, 208, 209,
 	210, 211, 212, 220, 223, 229, 230, 231, 232, 233,
 	234, 235, 238, 239, 240, 241, 247, 250, 258, 259,
-	269, 276, 279, 145, 266, 280, 195, 0, 0, 967,
-	0, 357, 0, 0, 0, 131, 0, 356, 0, 0,
-	0, 163, 0, 968, 0, 237, 123, 171, 169, 255,
-	136, 132, 130, 122, 152, 175, 205, 252, 199, 400,
-	166, 0, 0, 245, 183, 0, 0, 0, 0, 0,
-	391, 392, 0, 0, 0, 0, 0, 0, 0, 0,
-	61, 0, 0, 96, 97, 98, 378, 377, 380, 381,
-	382, 383, 0, 0, 118, 379, 384, 385, 386, 0,
-	0, 0, 0, 354, 371, 0, 399, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 368, 369, 441, 0,
-	0, 0, 414, 0, 370, 0, 0, 363, 364, 366,
-	365, 367, 372, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 142, 413, 0, 0, 308, 0, 0, 411,
-	0, 216, 0, 249, 146, 162, 114, 159, 100, 110,
-	0, 144, 192, 224, 228, 0, 0, 0, 124, 0,
-	226, 203, 268, 0, 206, 225, 167, 257, 217, 267,
-	277, 278, 253, 275, 286, 242, 103, 251, 265, 119,
-	236, 0, 0, 0, 105, 263, 248, 181, 156, 157,
-	104, 0, 222, 129, 140, 126, 194, 260, 261, 125,
-	289, 111, 274, 107, 112, 273, 188, 256, 264, 182,
-	174, 106, 262, 180, 173, 161, 135, 148, 214, 170,
-	215, 149, 185, 184, 186, 0, 0, 0, 246, 271,
-	290, 116, 0, 254, 282, 285, 0, 218, 117, 141,
-	134, 213, 139, 164, 281, 283, 284, 187, 113, 151,
-	243, 160, 168, 221, 288, 202, 227, 120, 270, 244,
-	401, 412, 407, 408, 405, 406, 404, 403, 402, 415,
-	393, 394, 395, 396, 398, 0, 409, 410, 397, 99,
-	108, 165, 287, 219, 138, 272, 0, 0, 128, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 102, 109, 115, 121, 127, 133, 137, 143,
-	147, 150, 153, 154, 155, 158, 172, 176, 177, 178,
-	179, 189, 190, 191, 193, 196, 197, 198, 200, 201,
-	204, 207, 208, 209, 210, 211, 212, 220, 223, 229,
-	230, 231, 232, 233, 234, 235, 238, 239, 240, 241,
-	247, 250, 258, 259, 269, 276, 279, 145, 266, 280,
-	195, 0, 0, 0, 0, 357, 0, 0, 0, 131,
-	0, 356, 0, 0, 0, 163, 0, 0, 0, 237,
+	269, 276, 279, 145, 266, 280, 570, 558, 0, 0,
+	514, 573, 487, 504, 581, 505, 508, 545, 472, 527,
+	195, 502, 0, 491, 467, 498, 468, 489, 516, 131,
+	520, 486, 560, 530, 572, 163, 0, 492, 547, 237,
 	123, 171, 169, 255, 136, 132, 130, 122, 152, 175,
-	205, 252, 199, 400, 166, 0, 0, 245, 183, 0,
-	0, 0, 0, 0, 391, 392, 0, 0, 0, 0,
-	0, 0, 1074, 0, 61, 0, 0, 96, 97, 98,
-	378, 377, 380, 381, 382, 383, 0, 0, 118, 379,
-	384, 385, 386, 1075, 0, 0, 0, 354, 371, 0,
-	399, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	368, 369, 0, 0, 0, 0, 414, 0, 370, 0,
-	0, 363, 364, 366, 365, 367, 372, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 142, 413, 0, 0,
-	308, 0, 0, 411, 0, 216, 0, 249, 146, 162,
-	114, 159, 100, 110, 0, 144, 192, 224, 228, 0,
-	0, 0, 124, 0, 226, 203, 268, 0, 206, 225,
+	205, 252, 199, 579, 166, 536, 0, 245, 183, 0,
+	0, 0, 518, 562, 525, 555, 513, 546, 477, 535,
+	574, 503, 543, 575, 0, 0, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	540, 569, 500, 542, 544, 583, 466, 537, 0, 470,
+	473, 580, 565, 495, 496, 0, 0, 0, 0, 0,
+	0, 0, 517, 526, 552, 511, 0, 0, 0, 0,
+	0, 0, 0, 0, 493, 0, 534, 0, 0, 0,
+	474, 471, 0, 0, 0, 0, 515, 0, 0, 0,
+	476, 0, 494, 553, 0, 464, 142, 557, 564, 512,
+	310, 568, 510, 509, 571, 216, 0, 249, 146, 162,
+	114, 159, 100, 110, 0, 144, 192, 224, 228, 561,
+	490, 499, 124, 497, 226, 203, 268, 533, 206, 225,
 	167, 257, 217, 267, 277, 278, 253, 275, 286, 242,
-	103, 251, 265, 119, 236, 0, 0, 0, 105, 263,
+	103, 251, 453, 119, 236, 0, 0, 0, 105, 263,
 	248, 181, 156, 157, 104, 0, 222, 129, 140, 126,
-	194, 260, 261, 125, 289, 111, 274, 107, 112, 273,
+	194, 260, 261, 125, 289, 111, 274, 107, 462, 273,
 	188, 256, 264, 182, 174, 106, 262, 180, 173, 161,
 	135, 148, 214, 170, 215, 149, 185, 184, 186, 0,
-	0, 0, 246, 271, 290, 116, 0, 254, 282, 285,
+	469, 0, 246, 271, 290, 116, 485, 254, 282, 285,
 	0, 218, 117, 141, 134, 213, 139, 164, 281, 283,
-	284, 187, 113, 151, 243, 160, 168, 221, 288, 202,
-	227, 120, 270, 244, 401, 412, 407, 408, 405, 406,
-	404, 403, 402, 415, 393, 394, 395, 396, 398, 0,
-	409, 410, 397, 99, 108, 165, 287, 219, 138, 272,
-	0, 0, 128, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 102, 109, 115, 121,
+	284, 463, 461, 456, 455, 160, 168, 221, 288, 202,
+	227, 120, 270, 244, 481, 484, 479, 480, 528, 529,
+	576, 577, 578, 554, 475, 0, 482, 483, 0, 559,
+	566, 567, 532, 99, 108, 165, 287, 219, 138, 272,
+	465, 478, 128, 488, 0, 0, 501, 506, 507, 519,
+	521, 522, 523, 524, 531, 538, 539, 541, 548, 549,
+	550, 551, 556, 563, 582, 101, 102, 109, 115, 121,
 	127, 133, 137, 143, 147, 150, 153, 154, 155, 158,
 	172, 176, 177, 178, 179, 189, 190, 191, 193, 196,
 	197, 198, 200, 201, 204, 207, 208, 209, 210, 211,
 	212, 220, 223, 229, 230, 231, 232, 233, 234, 235,
 	238, 239, 240, 241, 247, 250, 258, 259, 269, 276,
-	279, 145, 266, 280, 195, 0, 0, 0, 0, 357,
-	0, 0, 0, 131, 0, 356, 0, 0, 0, 163,
-	0, 0, 0, 237, 123, 171, 169, 255, 136, 132,
-	130, 122, 152, 175, 205, 252, 199, 400, 166, 0,
-	0, 245, 183, 0, 0, 0, 0, 0, 391, 392,
+	279, 145, 266, 280, 195, 0, 0, 971, 0, 359,
+	0, 0, 0, 131, 0, 358, 0, 0, 0, 163,
+	0, 972, 0, 237, 123, 171, 169, 255, 136, 132,
+	130, 122, 152, 175, 205, 252, 199, 402, 166, 0,
+	0, 245, 183, 0, 0, 0, 0, 0, 393, 394,
 	0, 0, 0, 0, 0, 0, 0, 0, 61, 0,
-	429, 96, 97, 98, 378, 377, 380, 381, 382, 383,
-	0, 0, 118, 379, 384, 385, 386, 0, 0, 0,
-	0, 354, 371, 0, 399, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 368, 369, 0, 0, 0, 0,
-	414, 0, 370, 0, 0, 363, 364, 366, 365, 367,
-	372, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	142, 413, 0, 0, 308, 0, 0, 411, 0, 216,
+	0, 96, 97, 98, 380, 379, 382, 383, 384, 385,
+	0, 0, 118, 381, 386, 387, 388, 0, 0, 0,
+	0, 356, 373, 0, 401, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 370, 371, 443, 0, 0, 0,
+	416, 0, 372, 0, 0, 365, 366, 368, 367, 369,
+	374, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	142, 415, 0, 0, 310, 0, 0, 413, 0, 216,
 	0, 249, 146, 162, 114, 159, 100, 110, 0, 144,
 	192, 224, 228, 0, 0, 0, 124, 0, 226, 203,
 	268, 0, 206, 225, 167, 257, 217, 267, 277, 278,
@@ -1839,9 +1730,9 @@ var yyAct = [...]int{
 	185, 184, 186, 0, 0, 0, 246, 271, 290, 116,
 	0, 254, 282, 285, 0, 218, 117, 141, 134, 213,
 	139, 164, 281, 283, 284, 187, 113, 151, 243, 160,
-	168, 221, 288, 202, 227, 120, 270, 244, 401, 412,
-	407, 408, 405, 406, 404, 403, 402, 415, 393, 394,
-	395, 396, 398, 0, 409, 410, 397, 99, 108, 165,
+	168, 221, 288, 202, 227, 120, 270, 244, 403, 414,
+	409, 410, 407, 408, 406, 405, 404, 417, 395, 396,
+	397, 398, 400, 0, 411, 412, 399, 99, 108, 165,
 	287, 219, 138, 272, 0, 0, 128, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
@@ -1851,20 +1742,20 @@ var yyAct = [...]int{
 	208, 209, 210, 211, 212, 220, 223, 229, 230, 231,
 	232, 233, 234, 235, 238, 239, 240, 241, 247, 250,
 	258, 259, 269, 276, 279, 145, 266, 280, 195, 0,
-	0, 0, 0, 357, 0, 0, 0, 131, 0, 356,
+	0, 0, 0, 359, 0, 0, 0, 131, 0, 358,
 	0, 0, 0, 163, 0, 0, 0, 237, 123, 171,
 	169, 255, 136, 132, 130, 122, 152, 175, 205, 252,
-	199, 400, 166, 0, 0, 245, 183, 0, 0, 0,
-	0, 0, 391, 392, 0, 0, 0, 0, 0, 0,
-	0, 0, 61, 0, 0, 96, 97, 98, 378, 377,
-	380, 381, 382, 383, 0, 0, 118, 379, 384, 385,
-	386, 0, 0, 0, 0, 354, 371, 0, 399, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 368, 369,
-	441, 0, 0, 0, 414, 0, 370, 0, 0, 363,
-	364, 366, 365, 367, 372, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 142, 413, 0, 0, 308, 0,
-	0, 411, 0, 216, 0, 249, 146, 162, 114, 159,
+	199, 402, 166, 0, 0, 245, 183, 0, 0, 0,
+	0, 0, 393, 394, 0, 0, 0, 0, 0, 0,
+	1078, 0, 61, 0, 0, 96, 97, 98, 380, 379,
+	382, 383, 384, 385, 0, 0, 118, 381, 386, 387,
+	388, 1079, 0, 0, 0, 356, 373, 0, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 370, 371,
+	0, 0, 0, 0, 416, 0, 372, 0, 0, 365,
+	366, 368, 367, 369, 374, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 142, 415, 0, 0, 310, 0,
+	0, 413, 0, 216, 0, 249, 146, 162, 114, 159,
 	100, 110, 0, 144, 192, 224, 228, 0, 0, 0,
 	124, 0, 226, 203, 268, 0, 206, 225, 167, 257,
 	217, 267, 277, 278, 253, 275, 286, 242, 103, 251,
@@ -1876,9 +1767,9 @@ var yyAct = [...]int{
 	246, 271, 290, 116, 0, 254, 282, 285, 0, 218,
 	117, 141, 134, 213, 139, 164, 281, 283, 284, 187,
 	113, 151, 243, 160, 168, 221, 288, 202, 227, 120,
-	270, 244, 401, 412, 407, 408, 405, 406, 404, 403,
-	402, 415, 393, 394, 395, 396, 398, 0, 409, 410,
-	397, 99, 108, 165, 287, 219, 138, 272, 0, 0,
+	270, 244, 403, 414, 409, 410, 407, 408, 406, 405,
+	404, 417, 395, 396, 397, 398, 400, 0, 411, 412,
+	399, 99, 108, 165, 287, 219, 138, 272, 0, 0,
 	128, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 101, 102, 109, 115, 121, 127, 133,
@@ -1887,20 +1778,20 @@ var yyAct = [...]int{
 	200, 201, 204, 207, 208, 209, 210, 211, 212, 220,
 	223, 229, 230, 231, 232, 233, 234, 235, 238, 239,
 	240, 241, 247, 250, 258, 259, 269, 276, 279, 145,
-	266, 280, 195, 0, 0, 0, 0, 357, 0, 0,
-	0, 131, 0, 356, 0, 0, 0, 163, 0, 0,
+	266, 280, 195, 0, 0, 0, 0, 359, 0, 0,
+	0, 131, 0, 358, 0, 0, 0, 163, 0, 0,
 	0, 237, 123, 171, 169, 255, 136, 132, 130, 122,
-	152, 175, 205, 252, 199, 400, 166, 0, 0, 245,
-	183, 0, 0, 0, 0, 0, 391, 392, 0, 0,
-	0, 0, 0, 0, 0, 0, 61, 0, 0, 96,
-	97, 98, 378, 985, 380, 381, 382, 383, 0, 0,
-	118, 379, 384, 385, 386, 0, 0, 0, 0, 354,
-	371, 0, 399, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 368, 369, 441, 0, 0, 0, 414, 0,
-	370, 0, 0, 363, 364, 366, 365, 367, 372, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 142, 413,
-	0, 0, 308, 0, 0, 411, 0, 216, 0, 249,
+	152, 175, 205, 252, 199, 402, 166, 0, 0, 245,
+	183, 0, 0, 0, 0, 0, 393, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 61, 0, 431, 96,
+	97, 98, 380, 379, 382, 383, 384, 385, 0, 0,
+	118, 381, 386, 387, 388, 0, 0, 0, 0, 356,
+	373, 0, 401, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 370, 371, 0, 0, 0, 0, 416, 0,
+	372, 0, 0, 365, 366, 368, 367, 369, 374, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 142, 415,
+	0, 0, 310, 0, 0, 413, 0, 216, 0, 249,
 	146, 162, 114, 159, 100, 110, 0, 144, 192, 224,
 	228, 0, 0, 0, 124, 0, 226, 203, 268, 0,
 	206, 225, 167, 257, 217, 267, 277, 278, 253, 275,
@@ -1912,9 +1803,9 @@ var yyAct = [...]int{
 	186, 0, 0, 0, 246, 271, 290, 116, 0, 254,
 	282, 285, 0, 218, 117, 141, 134, 213, 139, 164,
 	281, 283, 284, 187, 113, 151, 243, 160, 168, 221,
-	288, 202, 227, 120, 270, 244, 401, 412, 407, 408,
-	405, 406, 404, 403, 402, 415, 393, 394, 395, 396,
-	398, 0, 409, 410, 397, 99, 108, 165, 287, 219,
+	288, 202, 227, 120, 270, 244, 403, 414, 409, 410,
+	407, 408, 406, 405, 404, 417, 395, 396, 397, 398,
+	400, 0, 411, 412, 399, 99, 108, 165, 287, 219,
 	138, 272, 0, 0, 128, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 101, 102, 109,
@@ -1924,19 +1815,19 @@ var yyAct = [...]int{
 	210, 211, 212, 220, 223, 229, 230, 231, 232, 233,
 	234, 235, 238, 239, 240, 241, 247, 250, 258, 259,
 	269, 276, 279, 145, 266, 280, 195, 0, 0, 0,
-	0, 357, 0, 0, 0, 131, 0, 356, 0, 0,
+	0, 359, 0, 0, 0, 131, 0, 358, 0, 0,
 	0, 163, 0, 0, 0, 237, 123, 171, 169, 255,
-	136, 132, 130, 122, 152, 175, 205, 252, 199, 400,
+	136, 132, 130, 122, 152, 175, 205, 252, 199, 402,
 	166, 0, 0, 245, 183, 0, 0, 0, 0, 0,
-	391, 392, 0, 0, 0, 0, 0, 0, 0, 0,
-	61, 0, 0, 96, 97, 98, 378, 982, 380, 381,
-	382, 383, 0, 0, 118, 379, 384, 385, 386, 0,
-	0, 0, 0, 354, 371, 0, 399, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 368, 369, 441, 0,
-	0, 0, 414, 0, 370, 0, 0, 363, 364, 366,
-	365, 367, 372, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 142, 413, 0, 0, 308, 0, 0, 411,
+	393, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	61, 0, 0, 96, 97, 98, 380, 379, 382, 383,
+	384, 385, 0, 0, 118, 381, 386, 387, 388, 0,
+	0, 0, 0, 356, 373, 0, 401, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 370, 371, 443, 0,
+	0, 0, 416, 0, 372, 0, 0, 365, 366, 368,
+	367, 369, 374, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 142, 415, 0, 0, 310, 0, 0, 413,
 	0, 216, 0, 249, 146, 162, 114, 159, 100, 110,
 	0, 144, 192, 224, 228, 0, 0, 0, 124, 0,
 	226, 203, 268, 0, 206, 225, 167, 257, 217, 267,
@@ -1949,8 +1840,8 @@ var yyAct = [...]int{
 	290, 116, 0, 254, 282, 285, 0, 218, 117, 141,
 	134, 213, 139, 164, 281, 283, 284, 187, 113, 151,
 	243, 160, 168, 221, 288, 202, 227, 120, 270, 244,
-	401, 412, 407, 408, 405, 406, 404, 403, 402, 415,
-	393, 394, 395, 396, 398, 0, 409, 410, 397, 99,
+	403, 414, 409, 410, 407, 408, 406, 405, 404, 417,
+	395, 396, 397, 398, 400, 0, 411, 412, 399, 99,
 	108, 165, 287, 219, 138, 272, 0, 0, 128, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -1960,96 +1851,96 @@ var yyAct = [...]int{
 	204, 207, 208, 209, 210, 211, 212, 220, 223, 229,
 	230, 231, 232, 233, 234, 235, 238, 239, 240, 241,
 	247, 250, 258, 259, 269, 276, 279, 145, 266, 280,
-	422, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 195, 0, 0, 0, 0, 357, 0,
-	0, 0, 131, 0, 356, 0, 0, 0, 163, 0,
-	0, 0, 237, 123, 171, 169, 255, 136, 132, 130,
-	122, 152, 175, 205, 252, 199, 400, 166, 0, 0,
-	245, 183, 0, 0, 0, 0, 0, 391, 392, 0,
-	0, 0, 0, 0, 0, 0, 0, 61, 0, 0,
-	96, 97, 98, 378, 377, 380, 381, 382, 383, 0,
-	0, 118, 379, 384, 385, 386, 0, 0, 0, 0,
-	354, 371, 0, 399, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 368, 369, 0, 0, 0, 0, 414,
-	0, 370, 0, 0, 363, 364, 366, 365, 367, 372,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 142,
-	413, 0, 0, 308, 0, 0, 411, 0, 216, 0,
-	249, 146, 162, 114, 159, 100, 110, 0, 144, 192,
-	224, 228, 0, 0, 0, 124, 0, 226, 203, 268,
-	0, 206, 225, 167, 257, 217, 267, 277, 278, 253,
-	275, 286, 242, 103, 251, 265, 119, 236, 0, 0,
-	0, 105, 263, 248, 181, 156, 157, 104, 0, 222,
-	129, 140, 126, 194, 260, 261, 125, 289, 111, 274,
-	107, 112, 273, 188, 256, 264, 182, 174, 106, 262,
-	180, 173, 161, 135, 148, 214, 170, 215, 149, 185,
-	184, 186, 0, 0, 0, 246, 271, 290, 116, 0,
-	254, 282, 285, 0, 218, 117, 141, 134, 213, 139,
-	164, 281, 283, 284, 187, 113, 151, 243, 160, 168,
-	221, 288, 202, 227, 120, 270, 244, 401, 412, 407,
-	408, 405, 406, 404, 403, 402, 415, 393, 394, 395,
-	396, 398, 0, 409, 410, 397, 99, 108, 165, 287,
-	219, 138, 272, 0, 0, 128, 0, 0, 0, 0,
+	195, 0, 0, 0, 0, 359, 0, 0, 0, 131,
+	0, 358, 0, 0, 0, 163, 0, 0, 0, 237,
+	123, 171, 169, 255, 136, 132, 130, 122, 152, 175,
+	205, 252, 199, 402, 166, 0, 0, 245, 183, 0,
+	0, 0, 0, 0, 393, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 61, 0, 0, 96, 97, 98,
+	380, 989, 382, 383, 384, 385, 0, 0, 118, 381,
+	386, 387, 388, 0, 0, 0, 0, 356, 373, 0,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	370, 371, 443, 0, 0, 0, 416, 0, 372, 0,
+	0, 365, 366, 368, 367, 369, 374, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 142, 415, 0, 0,
+	310, 0, 0, 413, 0, 216, 0, 249, 146, 162,
+	114, 159, 100, 110, 0, 144, 192, 224, 228, 0,
+	0, 0, 124, 0, 226, 203, 268, 0, 206, 225,
+	167, 257, 217, 267, 277, 278, 253, 275, 286, 242,
+	103, 251, 265, 119, 236, 0, 0, 0, 105, 263,
+	248, 181, 156, 157, 104, 0, 222, 129, 140, 126,
+	194, 260, 261, 125, 289, 111, 274, 107, 112, 273,
+	188, 256, 264, 182, 174, 106, 262, 180, 173, 161,
+	135, 148, 214, 170, 215, 149, 185, 184, 186, 0,
+	0, 0, 246, 271, 290, 116, 0, 254, 282, 285,
+	0, 218, 117, 141, 134, 213, 139, 164, 281, 283,
+	284, 187, 113, 151, 243, 160, 168, 221, 288, 202,
+	227, 120, 270, 244, 403, 414, 409, 410, 407, 408,
+	406, 405, 404, 417, 395, 396, 397, 398, 400, 0,
+	411, 412, 399, 99, 108, 165, 287, 219, 138, 272,
+	0, 0, 128, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 101, 102,
-	109, 115, 121, 127, 133, 137, 143, 147, 150, 153,
-	154, 155, 158, 172, 176, 177, 178, 179, 189, 190,
-	191, 193, 196, 197, 198, 200, 201, 204, 207, 208,
-	209, 210, 211, 212, 220, 223, 229, 230, 231, 232,
-	233, 234, 235, 238, 239, 240, 241, 247, 250, 258,
-	259, 269, 276, 279, 145, 266, 280, 195, 0, 0,
-	0, 0, 357, 0, 0, 0, 131, 0, 356, 0,
-	0, 0, 163, 0, 0, 0, 237, 123, 171, 169,
-	255, 136, 132, 130, 122, 152, 175, 205, 252, 199,
-	400, 166, 0, 0, 245, 183, 0, 0, 0, 0,
-	0, 391, 392, 0, 0, 0, 0, 0, 0, 0,
-	0, 61, 0, 0, 96, 97, 98, 378, 377, 380,
-	381, 382, 383, 0, 0, 118, 379, 384, 385, 386,
-	0, 0, 0, 0, 354, 371, 0, 399, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 368, 369, 0,
-	0, 0, 0, 414, 0, 370, 0, 0, 363, 364,
-	366, 365, 367, 372, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 142, 413, 0, 0, 308, 0, 0,
-	411, 0, 216, 0, 249, 146, 162, 114, 159, 100,
-	110, 0, 144, 192, 224, 228, 0, 0, 0, 124,
-	0, 226, 203, 268, 0, 206, 225, 167, 257, 217,
-	267, 277, 278, 253, 275, 286, 242, 103, 251, 265,
-	119, 236, 0, 0, 0, 105, 263, 248, 181, 156,
-	157, 104, 0, 222, 129, 140, 126, 194, 260, 261,
-	125, 289, 111, 274, 107, 112, 273, 188, 256, 264,
-	182, 174, 106, 262, 180, 173, 161, 135, 148, 214,
-	170, 215, 149, 185, 184, 186, 0, 0, 0, 246,
-	271, 290, 116, 0, 254, 282, 285, 0, 218, 117,
-	141, 134, 213, 139, 164, 281, 283, 284, 187, 113,
-	151, 243, 160, 168, 221, 288, 202, 227, 120, 270,
-	244, 401, 412, 407, 408, 405, 406, 404, 403, 402,
-	415, 393, 394, 395, 396, 398, 0, 409, 410, 397,
-	99, 108, 165, 287, 219, 138, 272, 0, 0, 128,
+	0, 0, 0, 0, 0, 101, 102, 109, 115, 121,
+	127, 133, 137, 143, 147, 150, 153, 154, 155, 158,
+	172, 176, 177, 178, 179, 189, 190, 191, 193, 196,
+	197, 198, 200, 201, 204, 207, 208, 209, 210, 211,
+	212, 220, 223, 229, 230, 231, 232, 233, 234, 235,
+	238, 239, 240, 241, 247, 250, 258, 259, 269, 276,
+	279, 145, 266, 280, 195, 0, 0, 0, 0, 359,
+	0, 0, 0, 131, 0, 358, 0, 0, 0, 163,
+	0, 0, 0, 237, 123, 171, 169, 255, 136, 132,
+	130, 122, 152, 175, 205, 252, 199, 402, 166, 0,
+	0, 245, 183, 0, 0, 0, 0, 0, 393, 394,
+	0, 0, 0, 0, 0, 0, 0, 0, 61, 0,
+	0, 96, 97, 98, 380, 986, 382, 383, 384, 385,
+	0, 0, 118, 381, 386, 387, 388, 0, 0, 0,
+	0, 356, 373, 0, 401, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 370, 371, 443, 0, 0, 0,
+	416, 0, 372, 0, 0, 365, 366, 368, 367, 369,
+	374, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	142, 415, 0, 0, 310, 0, 0, 413, 0, 216,
+	0, 249, 146, 162, 114, 159, 100, 110, 0, 144,
+	192, 224, 228, 0, 0, 0, 124, 0, 226, 203,
+	268, 0, 206, 225, 167, 257, 217, 267, 277, 278,
+	253, 275, 286, 242, 103, 251, 265, 119, 236, 0,
+	0, 0, 105, 263, 248, 181, 156, 157, 104, 0,
+	222, 129, 140, 126, 194, 260, 261, 125, 289, 111,
+	274, 107, 112, 273, 188, 256, 264, 182, 174, 106,
+	262, 180, 173, 161, 135, 148, 214, 170, 215, 149,
+	185, 184, 186, 0, 0, 0, 246, 271, 290, 116,
+	0, 254, 282, 285, 0, 218, 117, 141, 134, 213,
+	139, 164, 281, 283, 284, 187, 113, 151, 243, 160,
+	168, 221, 288, 202, 227, 120, 270, 244, 403, 414,
+	409, 410, 407, 408, 406, 405, 404, 417, 395, 396,
+	397, 398, 400, 0, 411, 412, 399, 99, 108, 165,
+	287, 219, 138, 272, 0, 0, 128, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	102, 109, 115, 121, 127, 133, 137, 143, 147, 150,
+	153, 154, 155, 158, 172, 176, 177, 178, 179, 189,
+	190, 191, 193, 196, 197, 198, 200, 201, 204, 207,
+	208, 209, 210, 211, 212, 220, 223, 229, 230, 231,
+	232, 233, 234, 235, 238, 239, 240, 241, 247, 250,
+	258, 259, 269, 276, 279, 145, 266, 280, 424, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 101, 102, 109, 115, 121, 127, 133, 137,
-	143, 147, 150, 153, 154, 155, 158, 172, 176, 177,
-	178, 179, 189, 190, 191, 193, 196, 197, 198, 200,
-	201, 204, 207, 208, 209, 210, 211, 212, 220, 223,
-	229, 230, 231, 232, 233, 234, 235, 238, 239, 240,
-	241, 247, 250, 258, 259, 269, 276, 279, 145, 266,
-	280, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	131, 0, 0, 0, 0, 0, 163, 0, 0, 0,
+	0, 195, 0, 0, 0, 0, 359, 0, 0, 0,
+	131, 0, 358, 0, 0, 0, 163, 0, 0, 0,
 	237, 123, 171, 169, 255, 136, 132, 130, 122, 152,
-	175, 205, 252, 199, 400, 166, 0, 0, 245, 183,
-	0, 0, 0, 0, 0, 391, 392, 0, 0, 0,
+	175, 205, 252, 199, 402, 166, 0, 0, 245, 183,
+	0, 0, 0, 0, 0, 393, 394, 0, 0, 0,
 	0, 0, 0, 0, 0, 61, 0, 0, 96, 97,
-	98, 378, 377, 380, 381, 382, 383, 0, 0, 118,
-	379, 384, 385, 386, 0, 0, 0, 0, 0, 371,
-	0, 399, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 368, 369, 0, 0, 0, 0, 414, 0, 370,
-	0, 0, 363, 364, 366, 365, 367, 372, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 142, 413, 0,
-	0, 308, 0, 0, 411, 0, 216, 0, 249, 146,
+	98, 380, 379, 382, 383, 384, 385, 0, 0, 118,
+	381, 386, 387, 388, 0, 0, 0, 0, 356, 373,
+	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 370, 371, 0, 0, 0, 0, 416, 0, 372,
+	0, 0, 365, 366, 368, 367, 369, 374, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 142, 415, 0,
+	0, 310, 0, 0, 413, 0, 216, 0, 249, 146,
 	162, 114, 159, 100, 110, 0, 144, 192, 224, 228,
-	0, 0, 0, 124, 0, 226, 203, 268, 1718, 206,
+	0, 0, 0, 124, 0, 226, 203, 268, 0, 206,
 	225, 167, 257, 217, 267, 277, 278, 253, 275, 286,
 	242, 103, 251, 265, 119, 236, 0, 0, 0, 105,
 	263, 248, 181, 156, 157, 104, 0, 222, 129, 140,
@@ -2059,9 +1950,9 @@ var yyAct = [...]int{
 	0, 0, 0, 246, 271, 290, 116, 0, 254, 282,
 	285, 0, 218, 117, 141, 134, 213, 139, 164, 281,
 	283, 284, 187, 113, 151, 243, 160, 168, 221, 288,
-	202, 227, 120, 270, 244, 401, 412, 407, 408, 405,
-	406, 404, 403, 402, 415, 393, 394, 395, 396, 398,
-	0, 409, 410, 397, 99, 108, 165, 287, 219, 138,
+	202, 227, 120, 270, 244, 403, 414, 409, 410, 407,
+	408, 406, 405, 404, 417, 395, 396, 397, 398, 400,
+	0, 411, 412, 399, 99, 108, 165, 287, 219, 138,
 	272, 0, 0, 128, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 101, 102, 109, 115,
@@ -2071,19 +1962,19 @@ var yyAct = [...]int{
 	211, 212, 220, 223, 229, 230, 231, 232, 233, 234,
 	235, 238, 239, 240, 241, 247, 250, 258, 259, 269,
 	276, 279, 145, 266, 280, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 131, 0, 0, 0, 0, 0,
+	359, 0, 0, 0, 131, 0, 358, 0, 0, 0,
 	163, 0, 0, 0, 237, 123, 171, 169, 255, 136,
-	132, 130, 122, 152, 175, 205, 252, 199, 400, 166,
-	0, 0, 245, 183, 0, 0, 0, 0, 0, 391,
-	392, 0, 0, 0, 0, 0, 0, 0, 0, 61,
-	0, 429, 96, 97, 98, 378, 377, 380, 381, 382,
-	383, 0, 0, 118, 379, 384, 385, 386, 0, 0,
-	0, 0, 0, 371, 0, 399, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 368, 369, 0, 0, 0,
-	0, 414, 0, 370, 0, 0, 363, 364, 366, 365,
-	367, 372, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 142, 413, 0, 0, 308, 0, 0, 411, 0,
+	132, 130, 122, 152, 175, 205, 252, 199, 402, 166,
+	0, 0, 245, 183, 0, 0, 0, 0, 0, 393,
+	394, 0, 0, 0, 0, 0, 0, 0, 0, 61,
+	0, 0, 96, 97, 98, 380, 379, 382, 383, 384,
+	385, 0, 0, 118, 381, 386, 387, 388, 0, 0,
+	0, 0, 356, 373, 0, 401, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 370, 371, 0, 0, 0,
+	0, 416, 0, 372, 0, 0, 365, 366, 368, 367,
+	369, 374, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 142, 415, 0, 0, 310, 0, 0, 413, 0,
 	216, 0, 249, 146, 162, 114, 159, 100, 110, 0,
 	144, 192, 224, 228, 0, 0, 0, 124, 0, 226,
 	203, 268, 0, 206, 225, 167, 257, 217, 267, 277,
@@ -2095,9 +1986,9 @@ var yyAct = [...]int{
 	149, 185, 184, 186, 0, 0, 0, 246, 271, 290,
 	116, 0, 254, 282, 285, 0, 218, 117, 141, 134,
 	213, 139, 164, 281, 283, 284, 187, 113, 151, 243,
-	160, 168, 221, 288, 202, 227, 120, 270, 244, 401,
-	412, 407, 408, 405, 406, 404, 403, 402, 415, 393,
-	394, 395, 396, 398, 0, 409, 410, 397, 99, 108,
+	160, 168, 221, 288, 202, 227, 120, 270, 244, 403,
+	414, 409, 410, 407, 408, 406, 405, 404, 417, 395,
+	396, 397, 398, 400, 0, 411, 412, 399, 99, 108,
 	165, 287, 219, 138, 272, 0, 0, 128, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -2110,19 +2001,19 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
 	0, 0, 0, 0, 163, 0, 0, 0, 237, 123,
 	171, 169, 255, 136, 132, 130, 122, 152, 175, 205,
-	252, 199, 400, 166, 0, 0, 245, 183, 0, 0,
-	0, 0, 0, 391, 392, 0, 0, 0, 0, 0,
-	0, 0, 0, 61, 0, 0, 96, 97, 98, 378,
-	377, 380, 381, 382, 383, 0, 0, 118, 379, 384,
-	385, 386, 0, 0, 0, 0, 0, 371, 0, 399,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 368,
-	369, 0, 0, 0, 0, 414, 0, 370, 0, 0,
-	363, 364, 366, 365, 367, 372, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 142, 413, 0, 0, 308,
-	0, 0, 411, 0, 216, 0, 249, 146, 162, 114,
+	252, 199, 402, 166, 0, 0, 245, 183, 0, 0,
+	0, 0, 0, 393, 394, 0, 0, 0, 0, 0,
+	0, 0, 0, 61, 0, 0, 96, 97, 98, 380,
+	379, 382, 383, 384, 385, 0, 0, 118, 381, 386,
+	387, 388, 0, 0, 0, 0, 0, 373, 0, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 370,
+	371, 0, 0, 0, 0, 416, 0, 372, 0, 0,
+	365, 366, 368, 367, 369, 374, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 142, 415, 0, 0, 310,
+	0, 0, 413, 0, 216, 0, 249, 146, 162, 114,
 	159, 100, 110, 0, 144, 192, 224, 228, 0, 0,
-	0, 124, 0, 226, 203, 268, 0, 206, 225, 167,
+	0, 124, 0, 226, 203, 268, 1723, 206, 225, 167,
 	257, 217, 267, 277, 278, 253, 275, 286, 242, 103,
 	251, 265, 119, 236, 0, 0, 0, 105, 263, 248,
 	181, 156, 157, 104, 0, 222, 129, 140, 126, 194,
@@ -2132,9 +2023,9 @@ var yyAct = [...]int{
 	0, 246, 271, 290, 116, 0, 254, 282, 285, 0,
 	218, 117, 141, 134, 213, 139, 164, 281, 283, 284,
 	187, 113, 151, 243, 160, 168, 221, 288, 202, 227,
-	120, 270, 244, 401, 412, 407, 408, 405, 406, 404,
-	403, 402, 415, 393, 394, 395, 396, 398, 0, 409,
-	410, 397, 99, 108, 165, 287, 219, 138, 272, 0,
+	120, 270, 244, 403, 414, 409, 410, 407, 408, 406,
+	405, 404, 417, 395, 396, 397, 398, 400, 0, 411,
+	412, 399, 99, 108, 165, 287, 219, 138, 272, 0,
 	0, 128, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 101, 102, 109, 115, 121, 127,
@@ -2146,17 +2037,17 @@ var yyAct = [...]int{
 	145, 266, 280, 195, 0, 0, 0, 0, 0, 0,
 	0, 0, 131, 0, 0, 0, 0, 0, 163, 0,
 	0, 0, 237, 123, 171, 169, 255, 136, 132, 130,
-	122, 152, 175, 205, 252, 199, 0, 166, 0, 0,
-	245, 183, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 97, 98, 0, 0, 0, 0, 0, 0, 0,
-	0, 118, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 681,
-	680, 690, 691, 683, 684, 685, 686, 687, 688, 689,
-	682, 0, 0, 692, 0, 0, 0, 0, 0, 0,
+	122, 152, 175, 205, 252, 199, 402, 166, 0, 0,
+	245, 183, 0, 0, 0, 0, 0, 393, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 61, 0, 431,
+	96, 97, 98, 380, 379, 382, 383, 384, 385, 0,
+	0, 118, 381, 386, 387, 388, 0, 0, 0, 0,
+	0, 373, 0, 401, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 370, 371, 0, 0, 0, 0, 416,
+	0, 372, 0, 0, 365, 366, 368, 367, 369, 374,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 142,
-	0, 0, 0, 308, 0, 0, 0, 0, 216, 0,
+	415, 0, 0, 310, 0, 0, 413, 0, 216, 0,
 	249, 146, 162, 114, 159, 100, 110, 0, 144, 192,
 	224, 228, 0, 0, 0, 124, 0, 226, 203, 268,
 	0, 206, 225, 167, 257, 217, 267, 277, 278, 253,
@@ -2168,9 +2059,9 @@ var yyAct = [...]int{
 	184, 186, 0, 0, 0, 246, 271, 290, 116, 0,
 	254, 282, 285, 0, 218, 117, 141, 134, 213, 139,
 	164, 281, 283, 284, 187, 113, 151, 243, 160, 168,
-	221, 288, 202, 227, 120, 270, 244, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 99, 108, 165, 287,
+	221, 288, 202, 227, 120, 270, 244, 403, 414, 409,
+	410, 407, 408, 406, 405, 404, 417, 395, 396, 397,
+	398, 400, 0, 411, 412, 399, 99, 108, 165, 287,
 	219, 138, 272, 0, 0, 128, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 101, 102,
@@ -2180,20 +2071,20 @@ var yyAct = [...]int{
 	209, 210, 211, 212, 220, 223, 229, 230, 231, 232,
 	233, 234, 235, 238, 239, 240, 241, 247, 250, 258,
 	259, 269, 276, 279, 145, 266, 280, 195, 0, 0,
-	0, 777, 0, 0, 0, 0, 131, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 0, 0,
 	0, 0, 163, 0, 0, 0, 237, 123, 171, 169,
 	255, 136, 132, 130, 122, 152, 175, 205, 252, 199,
-	0, 166, 0, 0, 245, 183, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 96, 97, 98, 0, 779, 0,
-	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
-	0, 670, 671, 669, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 672,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 142, 0, 0, 0, 308, 0, 0,
-	0, 0, 216, 0, 249, 146, 162, 114, 159, 100,
+	402, 166, 0, 0, 245, 183, 0, 0, 0, 0,
+	0, 393, 394, 0, 0, 0, 0, 0, 0, 0,
+	0, 61, 0, 0, 96, 97, 98, 380, 379, 382,
+	383, 384, 385, 0, 0, 118, 381, 386, 387, 388,
+	0, 0, 0, 0, 0, 373, 0, 401, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 370, 371, 0,
+	0, 0, 0, 416, 0, 372, 0, 0, 365, 366,
+	368, 367, 369, 374, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 142, 415, 0, 0, 310, 0, 0,
+	413, 0, 216, 0, 249, 146, 162, 114, 159, 100,
 	110, 0, 144, 192, 224, 228, 0, 0, 0, 124,
 	0, 226, 203, 268, 0, 206, 225, 167, 257, 217,
 	267, 277, 278, 253, 275, 286, 242, 103, 251, 265,
@@ -2205,8 +2096,8 @@ var yyAct = [...]int{
 	271, 290, 116, 0, 254, 282, 285, 0, 218, 117,
 	141, 134, 213, 139, 164, 281, 283, 284, 187, 113,
 	151, 243, 160, 168, 221, 288, 202, 227, 120, 270,
-	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 403, 414, 409, 410, 407, 408, 406, 405, 404,
+	417, 395, 396, 397, 398, 400, 0, 411, 412, 399,
 	99, 108, 165, 287, 219, 138, 272, 0, 0, 128,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -2223,13 +2114,13 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
 	98, 0, 0, 0, 0, 0, 0, 0, 0, 118,
-	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 142, 90, 91,
-	0, 87, 0, 0, 0, 92, 216, 0, 249, 146,
+	0, 0, 0, 0, 0, 0, 0, 684, 683, 693,
+	694, 686, 687, 688, 689, 690, 691, 692, 685, 0,
+	0, 695, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 142, 0, 0,
+	0, 310, 0, 0, 0, 0, 216, 0, 249, 146,
 	162, 114, 159, 100, 110, 0, 144, 192, 224, 228,
 	0, 0, 0, 124, 0, 226, 203, 268, 0, 206,
 	225, 167, 257, 217, 267, 277, 278, 253, 275, 286,
@@ -2241,7 +2132,7 @@ var yyAct = [...]int{
 	0, 0, 0, 246, 271, 290, 116, 0, 254, 282,
 	285, 0, 218, 117, 141, 134, 213, 139, 164, 281,
 	283, 284, 187, 113, 151, 243, 160, 168, 221, 288,
-	202, 227, 120, 270, 244, 0, 89, 0, 0, 0,
+	202, 227, 120, 270, 244, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 99, 108, 165, 287, 219, 138,
 	272, 0, 0, 128, 0, 0, 0, 0, 0, 0,
@@ -2252,22 +2143,22 @@ var yyAct = [...]int{
 	196, 197, 198, 200, 201, 204, 207, 208, 209, 210,
 	211, 212, 220, 223, 229, 230, 231, 232, 233, 234,
 	235, 238, 239, 240, 241, 247, 250, 258, 259, 269,
-	276, 279, 145, 266, 280, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 131, 1099, 0, 0, 0, 0,
+	276, 279, 145, 266, 280, 195, 0, 0, 0, 780,
+	0, 0, 0, 0, 131, 0, 0, 0, 0, 0,
 	163, 0, 0, 0, 237, 123, 171, 169, 255, 136,
 	132, 130, 122, 152, 175, 205, 252, 199, 0, 166,
 	0, 0, 245, 183, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 98, 0, 0, 0, 0, 0,
-	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 97, 98, 0, 782, 0, 0, 0,
+	0, 0, 0, 118, 0, 0, 0, 0, 0, 673,
+	674, 672, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 675, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 142, 0, 0, 1098, 308, 0, 0, 0, 1094,
-	1091, 0, 1092, 1093, 162, 589, 159, 100, 110, 1089,
-	1096, 192, 224, 228, 0, 0, 0, 124, 0, 226,
+	0, 142, 0, 0, 0, 310, 0, 0, 0, 0,
+	216, 0, 249, 146, 162, 114, 159, 100, 110, 0,
+	144, 192, 224, 228, 0, 0, 0, 124, 0, 226,
 	203, 268, 0, 206, 225, 167, 257, 217, 267, 277,
 	278, 253, 275, 286, 242, 103, 251, 265, 119, 236,
 	0, 0, 0, 105, 263, 248, 181, 156, 157, 104,
@@ -2288,88 +2179,49 @@ var yyAct = [...]int{
 	189, 190, 191, 193, 196, 197, 198, 200, 201, 204,
 	207, 208, 209, 210, 211, 212, 220, 223, 229, 230,
 	231, 232, 233, 234, 235, 238, 239, 240, 241, 247,
-	250, 258, 259, 269, 276, 279, 145, 266, 280, 31,
+	250, 258, 259, 269, 276, 279, 145, 266, 280, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	0, 0, 0, 0, 163, 0, 0, 0, 237, 123,
+	171, 169, 255, 136, 132, 130, 122, 152, 175, 205,
+	252, 199, 0, 166, 0, 0, 245, 183, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 131, 0, 0, 0, 0, 0, 163, 0, 0,
-	0, 237, 123, 171, 169, 255, 136, 132, 130, 122,
-	152, 175, 205, 252, 199, 0, 166, 0, 0, 245,
-	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 61, 0, 429, 96,
-	97, 98, 0, 0, 0, 0, 0, 0, 0, 0,
-	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 96, 97, 98, 0,
+	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 142, 0,
-	0, 0, 308, 0, 0, 0, 0, 216, 0, 249,
-	146, 162, 114, 159, 100, 110, 0, 144, 192, 224,
-	228, 0, 0, 0, 124, 0, 226, 203, 268, 0,
-	206, 225, 167, 257, 217, 267, 277, 278, 253, 275,
-	286, 242, 103, 251, 265, 119, 236, 0, 0, 0,
-	105, 263, 248, 181, 156, 157, 104, 0, 222, 129,
-	140, 126, 194, 260, 261, 125, 289, 111, 274, 107,
-	112, 273, 188, 256, 264, 182, 174, 106, 262, 180,
-	173, 161, 135, 148, 214, 170, 215, 149, 185, 184,
-	186, 0, 0, 0, 246, 271, 290, 116, 0, 254,
-	282, 285, 0, 218, 117, 141, 134, 213, 139, 164,
-	281, 283, 284, 187, 113, 151, 243, 160, 168, 221,
-	288, 202, 227, 120, 270, 244, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 99, 108, 165, 287, 219,
-	138, 272, 0, 0, 128, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 102, 109,
-	115, 121, 127, 133, 137, 143, 147, 150, 153, 154,
-	155, 158, 172, 176, 177, 178, 179, 189, 190, 191,
-	193, 196, 197, 198, 200, 201, 204, 207, 208, 209,
-	210, 211, 212, 220, 223, 229, 230, 231, 232, 233,
-	234, 235, 238, 239, 240, 241, 247, 250, 258, 259,
-	269, 276, 279, 145, 266, 280, 195, 0, 0, 0,
-	1056, 0, 0, 0, 0, 131, 0, 0, 0, 0,
-	0, 163, 0, 0, 0, 237, 123, 171, 169, 255,
-	136, 132, 130, 122, 152, 175, 205, 252, 199, 0,
-	166, 0, 0, 245, 183, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 96, 97, 98, 0, 1058, 0, 0,
-	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 142, 0, 0, 0, 308, 0, 0, 0,
-	0, 216, 0, 249, 146, 162, 114, 159, 100, 110,
-	0, 144, 192, 224, 228, 0, 0, 0, 124, 0,
-	226, 203, 268, 0, 206, 225, 167, 257, 217, 267,
-	277, 278, 253, 275, 286, 242, 103, 251, 265, 119,
-	236, 0, 0, 0, 105, 263, 248, 181, 156, 157,
-	104, 0, 222, 129, 140, 126, 194, 260, 261, 125,
-	289, 111, 274, 107, 112, 273, 188, 256, 264, 182,
-	174, 106, 262, 180, 173, 161, 135, 148, 214, 170,
-	215, 149, 185, 184, 186, 0, 0, 0, 246, 271,
-	290, 116, 0, 254, 282, 285, 0, 218, 117, 141,
-	134, 213, 139, 164, 281, 283, 284, 187, 113, 151,
-	243, 160, 168, 221, 288, 202, 227, 120, 270, 244,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 99,
-	108, 165, 287, 219, 138, 272, 0, 0, 128, 0,
+	0, 0, 0, 0, 0, 142, 90, 91, 0, 87,
+	0, 0, 0, 92, 216, 0, 249, 146, 162, 114,
+	159, 100, 110, 0, 144, 192, 224, 228, 0, 0,
+	0, 124, 0, 226, 203, 268, 0, 206, 225, 167,
+	257, 217, 267, 277, 278, 253, 275, 286, 242, 103,
+	251, 265, 119, 236, 0, 0, 0, 105, 263, 248,
+	181, 156, 157, 104, 0, 222, 129, 140, 126, 194,
+	260, 261, 125, 289, 111, 274, 107, 112, 273, 188,
+	256, 264, 182, 174, 106, 262, 180, 173, 161, 135,
+	148, 214, 170, 215, 149, 185, 184, 186, 0, 0,
+	0, 246, 271, 290, 116, 0, 254, 282, 285, 0,
+	218, 117, 141, 134, 213, 139, 164, 281, 283, 284,
+	187, 113, 151, 243, 160, 168, 221, 288, 202, 227,
+	120, 270, 244, 0, 89, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 99, 108, 165, 287, 219, 138, 272, 0,
+	0, 128, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 102, 109, 115, 121, 127, 133, 137, 143,
-	147, 150, 153, 154, 155, 158, 172, 176, 177, 178,
-	179, 189, 190, 191, 193, 196, 197, 198, 200, 201,
-	204, 207, 208, 209, 210, 211, 212, 220, 223, 229,
-	230, 231, 232, 233, 234, 235, 238, 239, 240, 241,
-	247, 250, 258, 259, 269, 276, 279, 145, 266, 280,
-	31, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 131, 0, 0, 0, 0, 0, 163, 0,
+	0, 0, 0, 0, 101, 102, 109, 115, 121, 127,
+	133, 137, 143, 147, 150, 153, 154, 155, 158, 172,
+	176, 177, 178, 179, 189, 190, 191, 193, 196, 197,
+	198, 200, 201, 204, 207, 208, 209, 210, 211, 212,
+	220, 223, 229, 230, 231, 232, 233, 234, 235, 238,
+	239, 240, 241, 247, 250, 258, 259, 269, 276, 279,
+	145, 266, 280, 195, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 1103, 0, 0, 0, 0, 163, 0,
 	0, 0, 237, 123, 171, 169, 255, 136, 132, 130,
 	122, 152, 175, 205, 252, 199, 0, 166, 0, 0,
 	245, 183, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 61, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	96, 97, 98, 0, 0, 0, 0, 0, 0, 0,
 	0, 118, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -2377,8 +2229,8 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 142,
-	0, 0, 0, 308, 0, 0, 0, 0, 216, 0,
-	249, 146, 162, 114, 159, 100, 110, 0, 144, 192,
+	0, 0, 1102, 310, 0, 0, 0, 1098, 1095, 0,
+	1096, 1097, 162, 591, 159, 100, 110, 1093, 1100, 192,
 	224, 228, 0, 0, 0, 124, 0, 226, 203, 268,
 	0, 206, 225, 167, 257, 217, 267, 277, 278, 253,
 	275, 286, 242, 103, 251, 265, 119, 236, 0, 0,
@@ -2400,57 +2252,96 @@ var yyAct = [...]int{
 	191, 193, 196, 197, 198, 200, 201, 204, 207, 208,
 	209, 210, 211, 212, 220, 223, 229, 230, 231, 232,
 	233, 234, 235, 238, 239, 240, 241, 247, 250, 258,
-	259, 269, 276, 279, 145, 266, 280, 195, 0, 0,
-	0, 1056, 0, 0, 0, 0, 131, 0, 0, 0,
-	0, 0, 163, 0, 0, 0, 237, 123, 171, 169,
-	255, 136, 132, 130, 122, 152, 175, 205, 252, 199,
-	0, 166, 0, 0, 245, 183, 0, 0, 0, 0,
+	259, 269, 276, 279, 145, 266, 280, 31, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 96, 97, 98, 0, 1058, 0,
-	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 0, 0, 0, 0, 163, 0, 0, 0, 237,
+	123, 171, 169, 255, 136, 132, 130, 122, 152, 175,
+	205, 252, 199, 0, 166, 0, 0, 245, 183, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 61, 0, 431, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 142, 0, 0, 0, 308, 0, 0,
-	0, 0, 216, 0, 249, 146, 162, 114, 159, 100,
-	110, 0, 144, 192, 224, 228, 0, 0, 0, 124,
-	0, 226, 203, 268, 0, 1054, 225, 167, 257, 217,
-	267, 277, 278, 253, 275, 286, 242, 103, 251, 265,
-	119, 236, 0, 0, 0, 105, 263, 248, 181, 156,
-	157, 104, 0, 222, 129, 140, 126, 194, 260, 261,
-	125, 289, 111, 274, 107, 112, 273, 188, 256, 264,
-	182, 174, 106, 262, 180, 173, 161, 135, 148, 214,
-	170, 215, 149, 185, 184, 186, 0, 0, 0, 246,
-	271, 290, 116, 0, 254, 282, 285, 0, 218, 117,
-	141, 134, 213, 139, 164, 281, 283, 284, 187, 113,
-	151, 243, 160, 168, 221, 288, 202, 227, 120, 270,
-	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	99, 108, 165, 287, 219, 138, 272, 0, 0, 128,
+	0, 0, 0, 0, 0, 0, 142, 0, 0, 0,
+	310, 0, 0, 0, 0, 216, 0, 249, 146, 162,
+	114, 159, 100, 110, 0, 144, 192, 224, 228, 0,
+	0, 0, 124, 0, 226, 203, 268, 0, 206, 225,
+	167, 257, 217, 267, 277, 278, 253, 275, 286, 242,
+	103, 251, 265, 119, 236, 0, 0, 0, 105, 263,
+	248, 181, 156, 157, 104, 0, 222, 129, 140, 126,
+	194, 260, 261, 125, 289, 111, 274, 107, 112, 273,
+	188, 256, 264, 182, 174, 106, 262, 180, 173, 161,
+	135, 148, 214, 170, 215, 149, 185, 184, 186, 0,
+	0, 0, 246, 271, 290, 116, 0, 254, 282, 285,
+	0, 218, 117, 141, 134, 213, 139, 164, 281, 283,
+	284, 187, 113, 151, 243, 160, 168, 221, 288, 202,
+	227, 120, 270, 244, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 99, 108, 165, 287, 219, 138, 272,
+	0, 0, 128, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 101, 102, 109, 115, 121, 127, 133, 137,
-	143, 147, 150, 153, 154, 155, 158, 172, 176, 177,
-	178, 179, 189, 190, 191, 193, 196, 197, 198, 200,
-	201, 204, 207, 208, 209, 210, 211, 212, 220, 223,
-	229, 230, 231, 232, 233, 234, 235, 238, 239, 240,
-	241, 247, 250, 258, 259, 269, 276, 279, 145, 266,
-	280, 195, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 102, 109, 115, 121,
+	127, 133, 137, 143, 147, 150, 153, 154, 155, 158,
+	172, 176, 177, 178, 179, 189, 190, 191, 193, 196,
+	197, 198, 200, 201, 204, 207, 208, 209, 210, 211,
+	212, 220, 223, 229, 230, 231, 232, 233, 234, 235,
+	238, 239, 240, 241, 247, 250, 258, 259, 269, 276,
+	279, 145, 266, 280, 195, 0, 0, 0, 1060, 0,
+	0, 0, 0, 131, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 237, 123, 171, 169, 255, 136, 132,
+	130, 122, 152, 175, 205, 252, 199, 0, 166, 0,
+	0, 245, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 97, 98, 0, 1062, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	142, 0, 0, 0, 310, 0, 0, 0, 0, 216,
+	0, 249, 146, 162, 114, 159, 100, 110, 0, 144,
+	192, 224, 228, 0, 0, 0, 124, 0, 226, 203,
+	268, 0, 206, 225, 167, 257, 217, 267, 277, 278,
+	253, 275, 286, 242, 103, 251, 265, 119, 236, 0,
+	0, 0, 105, 263, 248, 181, 156, 157, 104, 0,
+	222, 129, 140, 126, 194, 260, 261, 125, 289, 111,
+	274, 107, 112, 273, 188, 256, 264, 182, 174, 106,
+	262, 180, 173, 161, 135, 148, 214, 170, 215, 149,
+	185, 184, 186, 0, 0, 0, 246, 271, 290, 116,
+	0, 254, 282, 285, 0, 218, 117, 141, 134, 213,
+	139, 164, 281, 283, 284, 187, 113, 151, 243, 160,
+	168, 221, 288, 202, 227, 120, 270, 244, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 99, 108, 165,
+	287, 219, 138, 272, 0, 0, 128, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	102, 109, 115, 121, 127, 133, 137, 143, 147, 150,
+	153, 154, 155, 158, 172, 176, 177, 178, 179, 189,
+	190, 191, 193, 196, 197, 198, 200, 201, 204, 207,
+	208, 209, 210, 211, 212, 220, 223, 229, 230, 231,
+	232, 233, 234, 235, 238, 239, 240, 241, 247, 250,
+	258, 259, 269, 276, 279, 145, 266, 280, 31, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 195, 0, 0, 0, 0, 0, 0, 0, 0,
 	131, 0, 0, 0, 0, 0, 163, 0, 0, 0,
 	237, 123, 171, 169, 255, 136, 132, 130, 122, 152,
 	175, 205, 252, 199, 0, 166, 0, 0, 245, 183,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
-	98, 0, 0, 1021, 0, 0, 1022, 0, 0, 118,
+	0, 0, 0, 0, 0, 61, 0, 0, 96, 97,
+	98, 0, 0, 0, 0, 0, 0, 0, 0, 118,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 142, 0, 0,
-	0, 308, 0, 0, 0, 0, 216, 0, 249, 146,
+	0, 310, 0, 0, 0, 0, 216, 0, 249, 146,
 	162, 114, 159, 100, 110, 0, 144, 192, 224, 228,
 	0, 0, 0, 124, 0, 226, 203, 268, 0, 206,
 	225, 167, 257, 217, 267, 277, 278, 253, 275, 286,
@@ -2473,23 +2364,23 @@ var yyAct = [...]int{
 	196, 197, 198, 200, 201, 204, 207, 208, 209, 210,
 	211, 212, 220, 223, 229, 230, 231, 232, 233, 234,
 	235, 238, 239, 240, 241, 247, 250, 258, 259, 269,
-	276, 279, 145, 266, 280, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 131, 0, 811, 0, 0, 0,
+	276, 279, 145, 266, 280, 195, 0, 0, 0, 1060,
+	0, 0, 0, 0, 131, 0, 0, 0, 0, 0,
 	163, 0, 0, 0, 237, 123, 171, 169, 255, 136,
 	132, 130, 122, 152, 175, 205, 252, 199, 0, 166,
 	0, 0, 245, 183, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 98, 0, 810, 0, 0, 0,
+	0, 0, 96, 97, 98, 0, 1062, 0, 0, 0,
 	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 142, 0, 0, 0, 308, 0, 0, 0, 0,
+	0, 142, 0, 0, 0, 310, 0, 0, 0, 0,
 	216, 0, 249, 146, 162, 114, 159, 100, 110, 0,
 	144, 192, 224, 228, 0, 0, 0, 124, 0, 226,
-	203, 268, 0, 206, 225, 167, 257, 217, 267, 277,
+	203, 268, 0, 1058, 225, 167, 257, 217, 267, 277,
 	278, 253, 275, 286, 242, 103, 251, 265, 119, 236,
 	0, 0, 0, 105, 263, 248, 181, 156, 157, 104,
 	0, 222, 129, 140, 126, 194, 260, 261, 125, 289,
@@ -2516,15 +2407,15 @@ var yyAct = [...]int{
 	252, 199, 0, 166, 0, 0, 245, 183, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 96, 97, 98, 0,
-	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
+	0, 1025, 0, 0, 1026, 0, 0, 118, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 583, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 142, 0, 0, 0, 308,
-	0, 0, 0, 0, 216, 0, 249, 146, 162, 589,
-	159, 100, 110, 587, 144, 192, 224, 228, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 142, 0, 0, 0, 310,
+	0, 0, 0, 0, 216, 0, 249, 146, 162, 114,
+	159, 100, 110, 0, 144, 192, 224, 228, 0, 0,
 	0, 124, 0, 226, 203, 268, 0, 206, 225, 167,
 	257, 217, 267, 277, 278, 253, 275, 286, 242, 103,
 	251, 265, 119, 236, 0, 0, 0, 105, 263, 248,
@@ -2547,19 +2438,19 @@ var yyAct = [...]int{
 	220, 223, 229, 230, 231, 232, 233, 234, 235, 238,
 	239, 240, 241, 247, 250, 258, 259, 269, 276, 279,
 	145, 266, 280, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 131, 0, 0, 0, 0, 0, 163, 0,
+	0, 0, 131, 0, 814, 0, 0, 0, 163, 0,
 	0, 0, 237, 123, 171, 169, 255, 136, 132, 130,
 	122, 152, 175, 205, 252, 199, 0, 166, 0, 0,
 	245, 183, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 429,
-	96, 97, 98, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	96, 97, 98, 0, 813, 0, 0, 0, 0, 0,
 	0, 118, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 142,
-	0, 0, 0, 308, 0, 0, 0, 0, 216, 0,
+	0, 0, 0, 310, 0, 0, 0, 0, 216, 0,
 	249, 146, 162, 114, 159, 100, 110, 0, 144, 192,
 	224, 228, 0, 0, 0, 124, 0, 226, 203, 268,
 	0, 206, 225, 167, 257, 217, 267, 277, 278, 253,
@@ -2588,16 +2479,16 @@ var yyAct = [...]int{
 	255, 136, 132, 130, 122, 152, 175, 205, 252, 199,
 	0, 166, 0, 0, 245, 183, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 61, 0, 0, 96, 97, 98, 0, 0, 0,
+	0, 0, 0, 0, 96, 97, 98, 0, 0, 0,
 	0, 0, 0, 0, 0, 118, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	585, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 142, 0, 0, 0, 308, 0, 0,
-	0, 0, 216, 0, 249, 146, 162, 114, 159, 100,
-	110, 0, 144, 192, 224, 228, 0, 0, 0, 124,
+	0, 0, 0, 142, 0, 0, 0, 310, 0, 0,
+	0, 0, 216, 0, 249, 146, 162, 591, 159, 100,
+	110, 589, 144, 192, 224, 228, 0, 0, 0, 124,
 	0, 226, 203, 268, 0, 206, 225, 167, 257, 217,
 	267, 277, 278, 253, 275, 286, 242, 103, 251, 265,
 	119, 236, 0, 0, 0, 105, 263, 248, 181, 156,
@@ -2624,15 +2515,15 @@ var yyAct = [...]int{
 	237, 123, 171, 169, 255, 136, 132, 130, 122, 152,
 	175, 205, 252, 199, 0, 166, 0, 0, 245, 183,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 97,
-	98, 0, 1058, 0, 0, 0, 0, 0, 0, 118,
+	0, 0, 0, 0, 0, 0, 0, 431, 96, 97,
+	98, 0, 0, 0, 0, 0, 0, 0, 0, 118,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 142, 0, 0,
-	0, 308, 0, 0, 0, 0, 216, 0, 249, 146,
+	0, 310, 0, 0, 0, 0, 216, 0, 249, 146,
 	162, 114, 159, 100, 110, 0, 144, 192, 224, 228,
 	0, 0, 0, 124, 0, 226, 203, 268, 0, 206,
 	225, 167, 257, 217, 267, 277, 278, 253, 275, 286,
@@ -2660,15 +2551,15 @@ var yyAct = [...]int{
 	163, 0, 0, 0, 237, 123, 171, 169, 255, 136,
 	132, 130, 122, 152, 175, 205, 252, 199, 0, 166,
 	0, 0, 245, 183, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 96, 97, 98, 0, 779, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 61,
+	0, 0, 96, 97, 98, 0, 0, 0, 0, 0,
 	0, 0, 0, 118, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 142, 0, 0, 0, 308, 0, 0, 0, 0,
+	0, 142, 0, 0, 0, 310, 0, 0, 0, 0,
 	216, 0, 249, 146, 162, 114, 159, 100, 110, 0,
 	144, 192, 224, 228, 0, 0, 0, 124, 0, 226,
 	203, 268, 0, 206, 225, 167, 257, 217, 267, 277,
@@ -2691,94 +2582,94 @@ var yyAct = [...]int{
 	189, 190, 191, 193, 196, 197, 198, 200, 201, 204,
 	207, 208, 209, 210, 211, 212, 220, 223, 229, 230,
 	231, 232, 233, 234, 235, 238, 239, 240, 241, 247,
-	250, 258, 259, 269, 276, 279, 145, 266, 280, 793,
-	0, 0, 0, 0, 0, 0, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 131, 0, 0, 0, 0,
-	0, 163, 0, 0, 0, 237, 123, 171, 169, 255,
-	136, 132, 130, 122, 152, 175, 205, 252, 199, 0,
-	166, 0, 0, 245, 183, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 96, 97, 98, 0, 0, 0, 0,
-	0, 0, 0, 0, 118, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	250, 258, 259, 269, 276, 279, 145, 266, 280, 195,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	0, 0, 0, 0, 163, 0, 0, 0, 237, 123,
+	171, 169, 255, 136, 132, 130, 122, 152, 175, 205,
+	252, 199, 0, 166, 0, 0, 245, 183, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 96, 97, 98, 0,
+	1062, 0, 0, 0, 0, 0, 0, 118, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 142, 0, 0, 0, 308, 0, 0, 0,
-	0, 216, 0, 249, 146, 162, 114, 159, 100, 110,
-	0, 144, 192, 224, 228, 0, 0, 0, 124, 0,
-	226, 203, 268, 0, 206, 225, 167, 257, 217, 267,
-	277, 278, 253, 275, 286, 242, 103, 251, 265, 119,
-	236, 0, 0, 0, 105, 263, 248, 181, 156, 157,
-	104, 0, 222, 129, 140, 126, 194, 260, 261, 125,
-	289, 111, 274, 107, 112, 273, 188, 256, 264, 182,
-	174, 106, 262, 180, 173, 161, 135, 148, 214, 170,
-	215, 149, 185, 184, 186, 0, 0, 0, 246, 271,
-	290, 116, 0, 254, 282, 285, 0, 218, 117, 141,
-	134, 213, 139, 164, 281, 283, 284, 187, 113, 151,
-	243, 160, 168, 221, 288, 202, 227, 120, 270, 244,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 99,
-	108, 165, 287, 219, 138, 272, 0, 0, 128, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 142, 0, 0, 0, 310,
+	0, 0, 0, 0, 216, 0, 249, 146, 162, 114,
+	159, 100, 110, 0, 144, 192, 224, 228, 0, 0,
+	0, 124, 0, 226, 203, 268, 0, 206, 225, 167,
+	257, 217, 267, 277, 278, 253, 275, 286, 242, 103,
+	251, 265, 119, 236, 0, 0, 0, 105, 263, 248,
+	181, 156, 157, 104, 0, 222, 129, 140, 126, 194,
+	260, 261, 125, 289, 111, 274, 107, 112, 273, 188,
+	256, 264, 182, 174, 106, 262, 180, 173, 161, 135,
+	148, 214, 170, 215, 149, 185, 184, 186, 0, 0,
+	0, 246, 271, 290, 116, 0, 254, 282, 285, 0,
+	218, 117, 141, 134, 213, 139, 164, 281, 283, 284,
+	187, 113, 151, 243, 160, 168, 221, 288, 202, 227,
+	120, 270, 244, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 102, 109, 115, 121, 127, 133, 137, 143,
-	147, 150, 153, 154, 155, 158, 172, 176, 177, 178,
-	179, 189, 190, 191, 193, 196, 197, 198, 200, 201,
-	204, 207, 208, 209, 210, 211, 212, 220, 223, 229,
-	230, 231, 232, 233, 234, 235, 238, 239, 240, 241,
-	247, 250, 258, 259, 269, 276, 279, 145, 266, 280,
-	195, 0, 0, 0, 0, 0, 0, 0, 783, 131,
-	0, 0, 0, 0, 0, 163, 0, 0, 0, 237,
-	123, 171, 169, 255, 136, 132, 130, 122, 152, 175,
-	205, 252, 199, 0, 166, 0, 0, 245, 183, 0,
+	0, 0, 99, 108, 165, 287, 219, 138, 272, 0,
+	0, 128, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 96, 97, 98,
-	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 101, 102, 109, 115, 121, 127,
+	133, 137, 143, 147, 150, 153, 154, 155, 158, 172,
+	176, 177, 178, 179, 189, 190, 191, 193, 196, 197,
+	198, 200, 201, 204, 207, 208, 209, 210, 211, 212,
+	220, 223, 229, 230, 231, 232, 233, 234, 235, 238,
+	239, 240, 241, 247, 250, 258, 259, 269, 276, 279,
+	145, 266, 280, 195, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 0, 0, 0, 0, 163, 0,
+	0, 0, 237, 123, 171, 169, 255, 136, 132, 130,
+	122, 152, 175, 205, 252, 199, 0, 166, 0, 0,
+	245, 183, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	96, 97, 98, 0, 782, 0, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 142, 0, 0, 0,
-	308, 0, 0, 0, 0, 216, 0, 249, 146, 162,
-	114, 159, 100, 110, 0, 144, 192, 224, 228, 0,
-	0, 0, 124, 0, 226, 203, 268, 0, 206, 225,
-	167, 257, 217, 267, 277, 278, 253, 275, 286, 242,
-	103, 251, 265, 119, 236, 0, 0, 0, 105, 263,
-	248, 181, 156, 157, 104, 0, 222, 129, 140, 126,
-	194, 260, 261, 125, 289, 111, 274, 107, 112, 273,
-	188, 256, 264, 182, 174, 106, 262, 180, 173, 161,
-	135, 148, 214, 170, 215, 149, 185, 184, 186, 0,
-	0, 0, 246, 271, 290, 116, 0, 254, 282, 285,
-	0, 218, 117, 141, 134, 213, 139, 164, 281, 283,
-	284, 187, 113, 151, 243, 160, 168, 221, 288, 202,
-	227, 120, 270, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 142,
+	0, 0, 0, 310, 0, 0, 0, 0, 216, 0,
+	249, 146, 162, 114, 159, 100, 110, 0, 144, 192,
+	224, 228, 0, 0, 0, 124, 0, 226, 203, 268,
+	0, 206, 225, 167, 257, 217, 267, 277, 278, 253,
+	275, 286, 242, 103, 251, 265, 119, 236, 0, 0,
+	0, 105, 263, 248, 181, 156, 157, 104, 0, 222,
+	129, 140, 126, 194, 260, 261, 125, 289, 111, 274,
+	107, 112, 273, 188, 256, 264, 182, 174, 106, 262,
+	180, 173, 161, 135, 148, 214, 170, 215, 149, 185,
+	184, 186, 0, 0, 0, 246, 271, 290, 116, 0,
+	254, 282, 285, 0, 218, 117, 141, 134, 213, 139,
+	164, 281, 283, 284, 187, 113, 151, 243, 160, 168,
+	221, 288, 202, 227, 120, 270, 244, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 99, 108, 165, 287, 219, 138, 272,
-	0, 0, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 99, 108, 165, 287,
+	219, 138, 272, 0, 0, 128, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 102, 109, 115, 121,
-	127, 133, 137, 143, 147, 150, 153, 154, 155, 158,
-	172, 176, 177, 178, 179, 189, 190, 191, 193, 196,
-	197, 198, 200, 201, 204, 207, 208, 209, 210, 211,
-	212, 220, 223, 229, 230, 231, 232, 233, 234, 235,
-	238, 239, 240, 241, 247, 250, 258, 259, 269, 276,
-	279, 145, 266, 280, 195, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 102,
+	109, 115, 121, 127, 133, 137, 143, 147, 150, 153,
+	154, 155, 158, 172, 176, 177, 178, 179, 189, 190,
+	191, 193, 196, 197, 198, 200, 201, 204, 207, 208,
+	209, 210, 211, 212, 220, 223, 229, 230, 231, 232,
+	233, 234, 235, 238, 239, 240, 241, 247, 250, 258,
+	259, 269, 276, 279, 145, 266, 280, 796, 0, 0,
+	0, 0, 0, 0, 195, 0, 0, 0, 0, 0,
 	0, 0, 0, 131, 0, 0, 0, 0, 0, 163,
 	0, 0, 0, 237, 123, 171, 169, 255, 136, 132,
 	130, 122, 152, 175, 205, 252, 199, 0, 166, 0,
 	0, 245, 183, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 96, 97, 98, 0, 659, 0, 0, 0, 0,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
 	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	142, 0, 0, 0, 308, 0, 0, 0, 0, 216,
+	142, 0, 0, 0, 310, 0, 0, 0, 0, 216,
 	0, 249, 146, 162, 114, 159, 100, 110, 0, 144,
 	192, 224, 228, 0, 0, 0, 124, 0, 226, 203,
 	268, 0, 206, 225, 167, 257, 217, 267, 277, 278,
@@ -2802,7 +2693,7 @@ var yyAct = [...]int{
 	208, 209, 210, 211, 212, 220, 223, 229, 230, 231,
 	232, 233, 234, 235, 238, 239, 240, 241, 247, 250,
 	258, 259, 269, 276, 279, 145, 266, 280, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 131, 0, 0,
+	0, 0, 0, 0, 0, 0, 786, 131, 0, 0,
 	0, 0, 0, 163, 0, 0, 0, 237, 123, 171,
 	169, 255, 136, 132, 130, 122, 152, 175, 205, 252,
 	199, 0, 166, 0, 0, 245, 183, 0, 0, 0,
@@ -2814,7 +2705,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 345, 0, 142, 0, 0, 0, 308, 0,
+	0, 0, 0, 0, 142, 0, 0, 0, 310, 0,
 	0, 0, 0, 216, 0, 249, 146, 162, 114, 159,
 	100, 110, 0, 144, 192, 224, 228, 0, 0, 0,
 	124, 0, 226, 203, 268, 0, 206, 225, 167, 257,
@@ -2837,21 +2728,21 @@ var yyAct = [...]int{
 	177, 178, 179, 189, 190, 191, 193, 196, 197, 198,
 	200, 201, 204, 207, 208, 209, 210, 211, 212, 220,
 	223, 229, 230, 231, 232, 233, 234, 235, 238, 239,
-	240, 241, 247, 250, 258, 259, 269, 276, 279, 344,
+	240, 241, 247, 250, 258, 259, 269, 276, 279, 145,
 	266, 280, 195, 0, 0, 0, 0, 0, 0, 0,
 	0, 131, 0, 0, 0, 0, 0, 163, 0, 0,
 	0, 237, 123, 171, 169, 255, 136, 132, 130, 122,
 	152, 175, 205, 252, 199, 0, 166, 0, 0, 245,
 	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
-	97, 98, 0, 0, 0, 0, 0, 0, 0, 0,
+	97, 98, 0, 662, 0, 0, 0, 0, 0, 0,
 	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 142, 0,
-	303, 0, 308, 0, 0, 0, 0, 216, 0, 249,
+	0, 0, 310, 0, 0, 0, 0, 216, 0, 249,
 	146, 162, 114, 159, 100, 110, 0, 144, 192, 224,
 	228, 0, 0, 0, 124, 0, 226, 203, 268, 0,
 	206, 225, 167, 257, 217, 267, 277, 278, 253, 275,
@@ -2887,7 +2778,7 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 142, 0, 0, 0, 308, 0, 0, 0,
+	347, 0, 142, 0, 0, 0, 310, 0, 0, 0,
 	0, 216, 0, 249, 146, 162, 114, 159, 100, 110,
 	0, 144, 192, 224, 228, 0, 0, 0, 124, 0,
 	226, 203, 268, 0, 206, 225, 167, 257, 217, 267,
@@ -2910,20 +2801,93 @@ var yyAct = [...]int{
 	179, 189, 190, 191, 193, 196, 197, 198, 200, 201,
 	204, 207, 208, 209, 210, 211, 212, 220, 223, 229,
 	230, 231, 232, 233, 234, 235, 238, 239, 240, 241,
-	247, 250, 258, 259, 269, 276, 279, 145, 266, 280,
+	247, 250, 258, 259, 269, 276, 279, 346, 266, 280,
+	195, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 0, 0, 0, 0, 163, 0, 0, 0, 237,
+	123, 171, 169, 255, 136, 132, 130, 122, 152, 175,
+	205, 252, 199, 0, 166, 0, 0, 245, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 142, 0, 305, 0,
+	310, 0, 0, 0, 0, 216, 0, 249, 146, 162,
+	114, 159, 100, 110, 0, 144, 192, 224, 228, 0,
+	0, 0, 124, 0, 226, 203, 268, 0, 206, 225,
+	167, 257, 217, 267, 277, 278, 253, 275, 286, 242,
+	103, 251, 265, 119, 236, 0, 0, 0, 105, 263,
+	248, 181, 156, 157, 104, 0, 222, 129, 140, 126,
+	194, 260, 261, 125, 289, 111, 274, 107, 112, 273,
+	188, 256, 264, 182, 174, 106, 262, 180, 173, 161,
+	135, 148, 214, 170, 215, 149, 185, 184, 186, 0,
+	0, 0, 246, 271, 290, 116, 0, 254, 282, 285,
+	0, 218, 117, 141, 134, 213, 139, 164, 281, 283,
+	284, 187, 113, 151, 243, 160, 168, 221, 288, 202,
+	227, 120, 270, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 99, 108, 165, 287, 219, 138, 272,
+	0, 0, 128, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 102, 109, 115, 121,
+	127, 133, 137, 143, 147, 150, 153, 154, 155, 158,
+	172, 176, 177, 178, 179, 189, 190, 191, 193, 196,
+	197, 198, 200, 201, 204, 207, 208, 209, 210, 211,
+	212, 220, 223, 229, 230, 231, 232, 233, 234, 235,
+	238, 239, 240, 241, 247, 250, 258, 259, 269, 276,
+	279, 145, 266, 280, 195, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 237, 123, 171, 169, 255, 136, 132,
+	130, 122, 152, 175, 205, 252, 199, 0, 166, 0,
+	0, 245, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 97, 98, 0, 0, 0, 0, 0, 0,
+	0, 0, 118, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	142, 0, 0, 0, 310, 0, 0, 0, 0, 216,
+	0, 249, 146, 162, 114, 159, 100, 110, 0, 144,
+	192, 224, 228, 0, 0, 0, 124, 0, 226, 203,
+	268, 0, 206, 225, 167, 257, 217, 267, 277, 278,
+	253, 275, 286, 242, 103, 251, 265, 119, 236, 0,
+	0, 0, 105, 263, 248, 181, 156, 157, 104, 0,
+	222, 129, 140, 126, 194, 260, 261, 125, 289, 111,
+	274, 107, 112, 273, 188, 256, 264, 182, 174, 106,
+	262, 180, 173, 161, 135, 148, 214, 170, 215, 149,
+	185, 184, 186, 0, 0, 0, 246, 271, 290, 116,
+	0, 254, 282, 285, 0, 218, 117, 141, 134, 213,
+	139, 164, 281, 283, 284, 187, 113, 151, 243, 160,
+	168, 221, 288, 202, 227, 120, 270, 244, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 99, 108, 165,
+	287, 219, 138, 272, 0, 0, 128, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	102, 109, 115, 121, 127, 133, 137, 143, 147, 150,
+	153, 154, 155, 158, 172, 176, 177, 178, 179, 189,
+	190, 191, 193, 196, 197, 198, 200, 201, 204, 207,
+	208, 209, 210, 211, 212, 220, 223, 229, 230, 231,
+	232, 233, 234, 235, 238, 239, 240, 241, 247, 250,
+	258, 259, 269, 276, 279, 145, 266, 280,
 }
 var yyPact = [...]int{
 
-	133, -1000, -283, 1094, -1000, -1000, -1000, -1000, -1000, -1000,
+	135, -1000, -287, 1133, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1054,
-	849, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 320, 12682,
-	-30, 158, -18, 18903, 152, 1818, 19267, -1000, 36, -1000,
-	18, 19267, 29, 18539, -1000, -1000, -83, -90, -1000, 10498,
-	975, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 829,
-	1021, 1027, 1043, 670, 1125, -1000, 9029, 9029, 119, 119,
-	119, 7573, -1000, -1000, 15620, 19267, 165, 19267, -156, 114,
-	114, 114, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1076,
+	849, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 323, 12320,
+	-28, 171, -32, 18541, 153, 1828, 18905, -1000, 9, -1000,
+	-22, 18905, 12, 18177, -1000, -1000, -86, -93, -1000, 10136,
+	973, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 841,
+	1042, 1051, 1074, 684, 1107, -1000, 8667, 8667, 106, 106,
+	106, 7211, -1000, -1000, 15258, 18905, 142, 18905, -150, 104,
+	104, 104, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2943,24 +2907,24 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 141, 19267, 568, 568, 261, 569, 19267, 106, 568,
-	106, 106, 106, 19267, -1000, 224, -1000, -1000, -1000, 19267,
-	568, 989, 359, 184, 264, 264, 264, -1000, 215, -1000,
-	4927, 47, 52, -41, 1063, 48, -26, -1000, 359, 4927,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 136, -1000,
-	-1000, 19267, 18175, 151, 299, -1000, -1000, -1000, -1000, -1000,
-	-1000, 666, 496, -1000, 10498, 1650, 774, 774, -1000, -1000,
-	202, -1000, -1000, 11590, 11590, 11590, 11590, 11590, 11590, 11590,
-	11590, 11590, 11590, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 774, 223, -1000,
-	10134, 774, 774, 774, 774, 774, 774, 774, 774, 10498,
-	774, 774, 774, 774, 774, 774, 774, 774, 774, 774,
-	774, 774, 774, 774, 774, 774, -1000, -1000, -1000, 1054,
-	-1000, 849, -1000, -1000, -1000, 998, 10498, 10498, 1054, -1000,
-	906, 9029, -1000, -1000, 917, -1000, -1000, -1000, -1000, 369,
-	1075, -1000, 12318, 218, 1070, 17811, -1000, 16348, 17447, 771,
-	7195, -115, -1000, -1000, -1000, 291, 15256, -1000, -1000, -1000,
-	988, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 149, 18905, 644, 644, 263, -1000, 597, 18905, 103,
+	144, 644, 103, 103, 103, 18905, -1000, 221, -1000, -1000,
+	-1000, 18905, 644, 987, 401, 192, 285, 285, 285, -1000,
+	207, -1000, 4565, 42, 45, -74, 1091, 33, -36, -1000,
+	401, 4565, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	122, -1000, -1000, 18905, 17813, 148, 340, -1000, -1000, -1000,
+	-1000, -1000, -1000, 660, 553, -1000, 10136, 1757, 785, 785,
+	-1000, -1000, 182, -1000, -1000, 11228, 11228, 11228, 11228, 11228,
+	11228, 11228, 11228, 11228, 11228, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 785,
+	220, -1000, 9772, 785, 785, 785, 785, 785, 785, 785,
+	785, 10136, 785, 785, 785, 785, 785, 785, 785, 785,
+	785, 785, 785, 785, 785, 785, 785, 785, -1000, -1000,
+	-1000, 1076, -1000, 849, -1000, -1000, -1000, 1004, 10136, 10136,
+	1076, -1000, 926, 8667, -1000, -1000, 1018, -1000, -1000, -1000,
+	-1000, 400, 1109, -1000, 11956, 218, 1106, 17449, -1000, 15986,
+	17085, 776, 6833, -117, -1000, -1000, -1000, 312, 14894, -1000,
+	-1000, -1000, 984, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2972,239 +2936,228 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 747, 19267, -1000, -1000, 340, 568, -1000, 805,
-	-1000, 568, 4927, 140, 568, 318, 568, 19267, 19267, 4927,
-	4927, 4927, 57, 99, 89, 19267, 557, 769, 135, 19267,
-	1011, 855, 19267, 568, 568, -1000, 6439, -1000, 4927, 359,
-	-1000, 552, 10498, 4927, 4927, 4927, 19267, 4927, 4927, -1000,
-	543, -1000, -1000, 374, -1000, -1000, -1000, -1000, -1000, -1000,
-	4927, 4927, 373, 374, 373, -1000, -1000, -1000, -1000, 10498,
-	264, -1000, 854, -1000, -1000, 27, -1000, -1000, -1000, -1000,
-	-1000, 1094, -1000, -1000, -1000, -128, -1000, -1000, 10498, 10498,
-	10498, 10498, 491, 269, 11590, 416, 328, 11590, 11590, 11590,
-	11590, 11590, 11590, 11590, 11590, 11590, 11590, 11590, 11590, 11590,
-	11590, 11590, 612, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 568, -1000, 1087, 676, 676, 222, 222, 222, 222,
-	222, 222, 222, 222, 222, 11954, 7937, 6439, 670, 736,
-	1054, 9029, 9029, 10498, 10498, 9757, 9393, 9029, 993, 313,
-	496, 19267, -1000, -1000, 11226, -1000, -1000, -1000, -1000, -1000,
-	541, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 19267, 19267,
-	9029, 9029, 9029, 9029, 9029, -1000, 1027, 670, 917, 1006,
-	1082, 259, 560, 766, -1000, 410, 1027, 14892, 749, -1000,
-	917, -1000, -1000, -1000, 19267, -1000, -1000, 17076, -1000, -1000,
-	6061, 19267, 68, 19267, -1000, 760, 933, -1000, -1000, -1000,
-	1013, 14164, 14528, 19267, 735, 648, -1000, -1000, 211, 6817,
-	-115, -1000, 6817, 755, -1000, -127, -92, 8301, 191, -1000,
-	-1000, -1000, -1000, 4549, 13046, 626, 363, -75, -1000, -1000,
-	-1000, 795, -1000, 795, 795, 795, 795, -13, -13, -13,
-	-13, -1000, -1000, -1000, -1000, -1000, 831, 830, -1000, 795,
-	795, 795, 795, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 746, 18905, -1000, -1000, 322, 644,
+	-1000, 802, -1000, 644, 4565, 130, 644, 359, 644, 18905,
+	18905, 4565, 4565, 4565, 49, 88, 71, 18905, 592, 775,
+	115, 18905, 1034, 103, 856, 18905, 644, 644, -1000, 6077,
+	-1000, 4565, 401, -1000, 589, 10136, 4565, 4565, 4565, 18905,
+	4565, 4565, -1000, 585, -1000, -1000, 382, -1000, -1000, -1000,
+	-1000, -1000, -1000, 4565, 4565, 360, 382, 360, -1000, -1000,
+	-1000, -1000, 10136, 285, -1000, 855, -1000, -1000, 7, -1000,
+	-1000, -1000, -1000, -1000, 1133, -1000, -1000, -1000, -134, -1000,
+	-1000, 10136, 10136, 10136, 10136, 596, 294, 11228, 488, 348,
+	11228, 11228, 11228, 11228, 11228, 11228, 11228, 11228, 11228, 11228,
+	11228, 11228, 11228, 11228, 11228, 617, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 644, -1000, 1131, 649, 649, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 11592, 7575,
+	6077, 684, 737, 1076, 8667, 8667, 10136, 10136, 9395, 9031,
+	8667, 997, 342, 553, 18905, -1000, -1000, 10864, -1000, -1000,
+	-1000, -1000, -1000, 551, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 18905, 18905, 8667, 8667, 8667, 8667, 8667, -1000, 1051,
+	684, 1018, 1028, 1125, 252, 606, 772, -1000, 580, 1051,
+	14530, 825, -1000, 1018, -1000, -1000, -1000, 18905, -1000, -1000,
+	16714, -1000, -1000, 5699, 18905, 61, 18905, -1000, 720, 964,
+	-1000, -1000, -1000, 1036, 13802, 14166, 18905, 762, 758, -1000,
+	-1000, 216, 6455, -117, -1000, 6455, 765, -1000, -126, -130,
+	7939, 229, -1000, -1000, -1000, -1000, 4187, 12684, 641, 375,
+	-79, -1000, -1000, -1000, 793, -1000, 793, 793, 793, 793,
+	-52, -52, -52, -52, -1000, -1000, -1000, -1000, -1000, 833,
+	815, -1000, 793, 793, 793, 793, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	828, 828, 828, 801, 801, 91, 10498, 835, -1000, 19267,
-	4927, 1010, 4927, -1000, 139, -1000, -1000, -1000, 19267, 19267,
-	19267, 19267, 19267, 174, -1000, 19267, 19267, 764, -1000, 19267,
-	4927, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 496,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 19267, -1000, -1000,
-	-1000, -1000, 359, 19267, 19267, 19267, 359, 496, -1000, 19267,
-	19267, -1000, -1000, -1000, -1000, -1000, 496, 269, 536, 391,
-	-1000, -1000, 442, -1000, -1000, 2126, -1000, -1000, -1000, -1000,
-	416, 11590, 11590, 11590, 1053, 2126, 2101, 586, 2254, 222,
-	371, 371, 238, 238, 238, 238, 238, 606, 606, -1000,
-	-1000, -1000, 541, -1000, -1000, -1000, 541, 9029, 9029, 762,
-	774, 210, -1000, 829, -1000, -1000, 1027, 715, 715, 388,
-	585, 326, 1068, 715, 307, 1065, 715, 715, 9029, -1000,
-	-1000, 325, -1000, 10498, 541, -1000, 209, -1000, 1380, 759,
-	757, 715, 541, 541, 715, 715, 998, -1000, -1000, 967,
-	-1000, 900, 10498, 10498, 10498, -1000, -1000, -1000, 998, 1032,
-	-1000, 918, 913, 1062, 9029, 16348, 917, -1000, -1000, -1000,
-	208, 1062, 768, 774, -1000, 19267, 16348, 16348, 16348, 16348,
-	16348, -1000, 876, 874, -1000, 872, 866, 905, 19267, -1000,
-	721, 670, 14164, 198, 774, -1000, 16712, -1000, -1000, 68,
-	623, 16348, 19267, -1000, -1000, 16348, 19267, 5683, -1000, 755,
-	-115, -47, -1000, -1000, -1000, -1000, 496, -1000, 641, 750,
-	4171, -1000, -1000, -1000, -1000, 100, -1000, -1000, 826, 568,
-	-1000, 1002, 280, 280, 258, 568, 999, -1000, -1000, -1000,
-	-1000, 991, -1000, 345, -97, -1000, -1000, 453, -13, -13,
-	-1000, -1000, 191, 986, 191, 191, 191, 542, 542, -1000,
-	-1000, -1000, -1000, -1000, 444, -1000, -1000, -1000, 398, -1000,
-	-1000, 805, 545, 853, 19267, 4927, -1000, -1000, -1000, -1000,
-	321, 321, 268, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 66, 833, -1000, -1000, -1000, -1000,
-	-11, 56, 121, -1000, 4927, -1000, 373, -1000, -1000, -1000,
-	373, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1053, 2126,
-	1802, -1000, 11590, 11590, -1000, -1000, 715, 715, 9029, 6439,
-	1054, 998, -1000, -1000, 182, 612, 182, 11590, 11590, -1000,
-	11590, 11590, -1000, -168, 788, 301, -1000, 10498, 510, -1000,
-	6439, -1000, 11590, 11590, -1000, -1000, -1000, -1000, -1000, -1000,
-	498, 538, 898, 496, 496, -1000, -1000, 19267, -1000, -1000,
-	-1000, -1000, 1059, 10498, -1000, 744, -1000, 5305, 1027, 852,
-	19267, 774, 1094, 13423, 19267, 785, -1000, 287, 933, 817,
-	848, 789, -1000, -1000, -1000, -1000, 870, -1000, 868, -1000,
-	-1000, -1000, -1000, -1000, 670, -1000, 148, 144, 142, 19267,
-	-1000, 1062, 16348, 770, -1000, 770, -1000, 205, -1000, -1000,
-	-1000, -139, -100, -1000, -1000, -1000, 4549, -1000, 4549, -1000,
-	19267, 93, -1000, 568, 568, 568, -1000, -1000, -1000, 803,
-	847, 11590, -1000, -1000, -1000, 609, 191, 191, -1000, 343,
-	-1000, -1000, -1000, 713, -1000, 711, 738, 705, 7, 19267,
+	-1000, -1000, -1000, 808, 808, 808, 795, 795, 93, 10136,
+	829, -1000, 18905, 4565, 1032, 4565, -1000, 120, -1000, -1000,
+	-1000, 18905, 18905, 18905, 18905, 18905, 205, -1000, 18905, 18905,
+	757, -1000, 18905, 18905, 4565, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 553, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 18905, -1000, -1000, -1000, -1000, 401, 18905, 18905, 18905,
+	401, 553, -1000, 18905, 18905, -1000, -1000, -1000, -1000, -1000,
+	553, 294, 326, 328, -1000, -1000, 546, -1000, -1000, 2223,
+	-1000, -1000, -1000, -1000, 488, 11228, 11228, 11228, 260, 2223,
+	2141, 1622, 1497, 231, 456, 456, 236, 236, 236, 236,
+	236, 358, 358, -1000, -1000, -1000, 551, -1000, -1000, -1000,
+	551, 8667, 8667, 769, 785, 215, -1000, 841, -1000, -1000,
+	1051, 724, 724, 498, 405, 338, 1104, 724, 330, 1100,
+	724, 724, 8667, -1000, -1000, 356, -1000, 10136, 551, -1000,
+	212, -1000, 828, 767, 766, 724, 551, 551, 724, 724,
+	1004, -1000, -1000, 971, -1000, 907, 10136, 10136, 10136, -1000,
+	-1000, -1000, 1004, 1070, -1000, 934, 933, 1089, 8667, 15986,
+	1018, -1000, -1000, -1000, 204, 1089, 804, 785, -1000, 18905,
+	15986, 15986, 15986, 15986, 15986, -1000, 897, 878, -1000, 895,
+	869, 868, 18905, -1000, 733, 684, 13802, 184, 785, -1000,
+	16350, -1000, -1000, 61, 742, 15986, 18905, -1000, -1000, 15986,
+	18905, 5321, -1000, 765, -117, -67, -1000, -1000, -1000, -1000,
+	553, -1000, 635, 759, 3809, -1000, -1000, -1000, -1000, 80,
+	-1000, -1000, 806, 644, -1000, 1021, 244, 244, 292, 644,
+	1010, -1000, -1000, -1000, -1000, 990, -1000, 380, -81, -1000,
+	-1000, 529, -52, -52, -1000, -1000, 229, 982, 229, 229,
+	229, 583, 583, -1000, -1000, -1000, -1000, -1000, 526, -1000,
+	-1000, -1000, 507, -1000, -1000, 802, 613, 852, 18905, 4565,
+	-1000, -1000, -1000, -1000, 412, 412, 288, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 60, 561,
+	-1000, -1000, -1000, -1000, -10, 48, 108, -1000, 757, 4565,
+	-1000, 360, -1000, -1000, -1000, 360, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 260, 2223, 1911, -1000, 11228, 11228, -1000,
+	-1000, 724, 724, 8667, 6077, 1076, 1004, -1000, -1000, 73,
+	617, 73, 11228, 11228, -1000, 11228, 11228, -1000, -169, 702,
+	331, -1000, 10136, 332, -1000, 6077, -1000, 11228, 11228, -1000,
+	-1000, -1000, -1000, -1000, -1000, 558, 582, 903, 553, 553,
+	-1000, -1000, 18905, -1000, -1000, -1000, -1000, 1082, 10136, -1000,
+	754, -1000, 4943, 1051, 850, 18905, 785, 1133, 13061, 18905,
+	712, -1000, 311, 964, 800, 846, 693, -1000, -1000, -1000,
+	-1000, 877, -1000, 867, -1000, -1000, -1000, -1000, -1000, 684,
+	-1000, 141, 138, 134, 18905, -1000, 1089, 15986, 710, -1000,
+	710, -1000, 202, -1000, -1000, -1000, -131, -137, -1000, -1000,
+	-1000, 4187, -1000, 4187, -1000, 18905, 86, -1000, 644, 644,
+	644, -1000, -1000, -1000, 796, 845, 11228, -1000, -1000, -1000,
+	634, 229, 229, -1000, 335, -1000, -1000, -1000, 716, -1000,
+	714, 706, 708, 6, 18905, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 19267,
-	-1000, -1000, -1000, -1000, -1000, 19267, -174, 568, 19267, 19267,
-	19267, 19267, -1000, 359, 359, -1000, 11590, 2126, 2126, -1000,
-	-1000, 541, -1000, 1027, -1000, 541, 795, 795, -1000, 795,
-	801, -1000, 795, 11, 795, 10, 541, 541, 2086, 2036,
-	1929, 1359, 774, -163, -1000, 496, 10498, -1000, 1704, 1611,
-	532, -13, -1000, -1000, -1000, 1057, 1042, 496, -1000, -1000,
-	-1000, 1004, 754, 640, -1000, -1000, 8665, 702, 912, 193,
-	698, -1000, 1054, 19267, 10498, -1000, -1000, 10498, 799, -1000,
-	10498, -1000, -1000, -1000, 1054, 774, 774, 774, 698, 1054,
-	770, -1000, -1000, 240, -1000, -1000, -1000, 4171, -1000, 696,
-	-1000, 795, -1000, 999, -1000, -1000, -1000, 19267, -49, 1081,
-	2126, -1000, -1000, -1000, -1000, -1000, -13, 529, -13, 385,
-	-1000, 384, -1000, -1000, -226, 4927, -1000, -1000, -1000, -1000,
-	1000, -1000, 6439, -1000, -1000, 794, 834, -1000, -1000, -1000,
-	-1000, 2126, -1000, 998, -1000, -1000, 153, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 11590, 11590, 11590, 11590, 11590,
-	1027, 516, 496, 11590, 11590, -13, -51, -1000, 10498, 10498,
-	996, -1000, 774, -1000, 681, 19267, 774, 19267, -1000, 19267,
-	1027, -1000, 496, 496, 19267, 496, 15984, 19267, 19267, 13787,
-	1027, -1000, 197, 19267, -1000, 692, -1000, 235, -1000, -82,
-	191, -1000, 191, 594, 589, -1000, -1000, 774, 734, -1000,
-	279, 19267, 19267, -1000, -1000, -1000, 1380, 1380, 1380, 1380,
-	63, 541, -1000, 1380, 1380, -278, -1000, 970, 963, 496,
-	666, 1079, -1000, 774, 1094, 188, 640, -1000, -1000, -1000,
-	678, 669, -1000, 669, 669, 198, -1000, 197, -1000, 568,
-	277, 506, -1000, 81, 19267, 330, 995, -1000, 994, -1000,
-	-1000, -1000, -1000, -1000, 65, 6439, 4549, 664, -1000, -1000,
-	-1000, -1000, -1000, 541, 55, -181, -1000, -1000, -1000, -51,
-	157, -1000, 924, 922, 1037, 19267, 640, 19267, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 380, -1000, -1000, 19267, -1000,
-	-1000, 503, -1000, -1000, 598, -1000, 19267, -1000, -1000, 833,
-	-1000, 895, -172, -184, 937, 940, 940, 963, 1033, 959,
-	949, -1000, 479, 587, -1000, -1000, 790, -1000, -1000, 65,
-	907, -174, -1000, 892, -1000, 932, 438, -1000, -1000, -1000,
-	-1000, 477, -1000, 1031, 1005, -1000, 19267, -1000, 62, -1000,
-	-178, -1000, 436, -1000, -1000, -1000, 455, 392, 577, 58,
-	-182, -1000, -1000, -1000, -1000, 846, 774, -190, 842, -1000,
-	1074, 10862, -1000, -1000, 1078, 217, 217, 1380, 541, -1000,
-	-1000, -1000, 90, 401, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 18905, -1000, -1000, -1000, -1000, -1000,
+	18905, -176, 644, 18905, 18905, 18905, 18905, -1000, 401, 401,
+	-1000, 11228, 2223, 2223, -1000, -1000, 551, -1000, 1051, -1000,
+	551, 793, 793, -1000, 793, 795, -1000, 793, -8, 793,
+	-9, 551, 551, 2090, 1939, 1815, 1609, 785, -163, -1000,
+	553, 10136, -1000, 1067, 881, 569, -52, -1000, -1000, -1000,
+	1078, 1072, 553, -1000, -1000, -1000, 1023, 731, 653, -1000,
+	-1000, 8303, 698, 930, 197, 694, -1000, 1076, 18905, 10136,
+	-1000, -1000, 10136, 794, -1000, 10136, -1000, -1000, -1000, 1076,
+	785, 785, 785, 694, 1076, 710, -1000, -1000, 243, -1000,
+	-1000, -1000, 3809, -1000, 692, -1000, 793, -1000, 1010, -1000,
+	-1000, -1000, 18905, -75, 1116, 2223, -1000, -1000, -1000, -1000,
+	-1000, -52, 560, -52, 506, -1000, 445, -1000, -1000, -226,
+	4565, -1000, -1000, -1000, -1000, 1025, -1000, 6077, -1000, -1000,
+	792, 826, -1000, -1000, -1000, -1000, 2223, -1000, 1004, -1000,
+	-1000, 146, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	11228, 11228, 11228, 11228, 11228, 1051, 559, 553, 11228, 11228,
+	-52, -96, -1000, 10136, 10136, 1008, -1000, 785, -1000, 818,
+	18905, 785, 18905, -1000, 18905, 1051, -1000, 553, 553, 18905,
+	553, 15622, 18905, 18905, 13425, 1051, -1000, 235, 18905, -1000,
+	675, -1000, 237, -1000, -64, 229, -1000, 229, 604, 601,
+	-1000, -1000, 785, 670, -1000, 304, 18905, 18905, -1000, -1000,
+	-1000, 828, 828, 828, 828, 66, 551, -1000, 828, 828,
+	-280, -1000, 970, 967, 553, 660, 1114, -1000, 785, 1133,
+	190, 653, -1000, -1000, -1000, 672, 668, -1000, 668, 668,
+	184, -1000, 235, -1000, 644, 302, 544, -1000, 74, 18905,
+	407, 994, -1000, 992, -1000, -1000, -1000, -1000, -1000, 59,
+	6077, 4187, 665, -1000, -1000, -1000, -1000, -1000, 551, 54,
+	-184, -1000, -1000, -1000, -96, 123, -1000, 939, 936, 1071,
+	18905, 653, 18905, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	416, -1000, -1000, 18905, -1000, -1000, 543, -1000, -1000, 629,
+	-1000, 18905, -1000, -1000, 561, -1000, 902, -174, -188, 943,
+	945, 945, 967, 1062, 951, 949, -1000, 541, 586, -1000,
+	-1000, 789, -1000, -1000, 59, 929, -176, -1000, 885, -1000,
+	941, 470, -1000, -1000, -1000, -1000, 539, -1000, 1058, 1043,
+	-1000, 18905, -1000, 55, -1000, -182, -1000, 399, -1000, -1000,
+	-1000, 534, 531, 607, 53, -185, -1000, -1000, -1000, -1000,
+	840, 785, -190, 836, -1000, 1098, 10500, -1000, -1000, 1112,
+	257, 257, 828, 551, -1000, -1000, -1000, 90, 509, -1000,
+	-1000, -1000, -1000, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1363, 1361, 25, 77, 87, 1360, 1359, 1358, 1356,
-	112, 110, 109, 1355, 1354, 1353, 1347, 1345, 1344, 1343,
-	1342, 1341, 1339, 1338, 1337, 1336, 1335, 1334, 1333, 1330,
-	1329, 1327, 1325, 1321, 99, 1319, 1317, 1314, 1312, 1307,
-	1306, 1304, 1301, 1300, 94, 55, 235, 53, 76, 1299,
-	71, 1514, 1298, 43, 80, 74, 1297, 41, 1290, 1288,
-	90, 1286, 1284, 66, 1283, 1282, 64, 1281, 59, 1280,
-	17, 39, 1278, 1277, 1276, 1275, 96, 2115, 1274, 1272,
-	18, 1271, 1268, 120, 1267, 75, 33, 22, 37, 29,
-	1266, 72, 1265, 10, 1264, 73, 1263, 1261, 1260, 1258,
-	30, 1257, 68, 1255, 70, 1252, 11, 7, 1250, 1249,
-	1247, 1243, 1241, 1240, 9, 1237, 1236, 1233, 67, 1232,
-	8, 63, 38, 20, 12, 1231, 1230, 27, 92, 58,
-	84, 1226, 1223, 1219, 585, 1218, 1217, 49, 1215, 105,
-	1214, 23, 95, 106, 544, 1213, 1212, 1211, 1210, 1209,
-	1208, 51, 1064, 1547, 16, 91, 1207, 1205, 1202, 2620,
-	45, 65, 19, 1201, 1200, 1199, 89, 57, 50, 512,
-	1197, 42, 1195, 1194, 1189, 1188, 1186, 1181, 1180, 69,
-	1166, 1164, 1163, 35, 24, 79, 32, 1162, 1160, 1159,
-	1158, 48, 83, 1157, 1153, 62, 61, 1151, 97, 28,
-	1143, 1142, 1138, 1134, 1133, 31, 13, 1132, 21, 1131,
-	14, 1130, 36, 34, 1128, 6, 1125, 15, 1121, 3,
-	0, 1110, 5, 56, 1, 1107, 2, 1106, 1103, 1102,
-	1395, 4, 81, 1100, 111,
+	0, 1410, 1409, 49, 70, 71, 1401, 1400, 1399, 1398,
+	108, 103, 101, 1396, 1394, 1393, 1392, 1389, 1388, 1386,
+	1385, 1384, 1381, 1374, 1373, 1372, 1371, 1369, 1368, 1367,
+	1366, 1364, 1363, 1362, 93, 1358, 1352, 1350, 1349, 1348,
+	1347, 1346, 1344, 1343, 87, 51, 179, 45, 74, 1342,
+	64, 2377, 1341, 35, 73, 68, 1338, 31, 1337, 1335,
+	90, 1333, 1332, 63, 1326, 1324, 2116, 1323, 60, 1321,
+	16, 26, 1320, 1319, 1318, 1317, 84, 284, 1316, 1314,
+	18, 1311, 1310, 106, 1309, 72, 21, 23, 19, 29,
+	1308, 69, 1304, 6, 1302, 77, 1298, 1295, 1293, 1291,
+	80, 1289, 65, 1287, 53, 1286, 5, 11, 1284, 1283,
+	1282, 1281, 1280, 1279, 8, 1273, 1272, 1271, 24, 1270,
+	10, 61, 37, 25, 9, 1269, 1268, 22, 89, 57,
+	76, 1267, 1265, 1264, 503, 1263, 995, 1262, 66, 1261,
+	105, 1260, 67, 91, 96, 472, 1259, 1256, 1254, 1253,
+	1251, 1248, 58, 775, 1844, 42, 83, 1247, 1246, 1245,
+	2238, 41, 62, 17, 1241, 1236, 1229, 36, 39, 43,
+	492, 1227, 38, 1224, 1222, 1219, 1218, 1217, 1216, 1215,
+	331, 1214, 1213, 1212, 20, 27, 75, 32, 1211, 1208,
+	1207, 1206, 55, 78, 1205, 1204, 59, 56, 1203, 79,
+	28, 1202, 1200, 1199, 1198, 1197, 30, 12, 1181, 15,
+	1173, 13, 1171, 34, 33, 1168, 4, 1164, 14, 1163,
+	3, 0, 1162, 7, 48, 1, 1157, 2, 1156, 1150,
+	1145, 1359, 1136, 98, 1142, 99,
 }
 var yyR1 = [...]int{
 
-	0, 228, 229, 229, 1, 1, 1, 1, 1, 1,
+	0, 229, 230, 230, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 220, 220, 220, 21, 33, 3, 3, 3, 3,
+	1, 221, 221, 221, 21, 33, 3, 3, 3, 3,
 	2, 2, 8, 9, 4, 5, 5, 10, 10, 38,
-	38, 11, 12, 12, 12, 12, 232, 232, 60, 60,
+	38, 11, 12, 12, 12, 12, 233, 233, 60, 60,
 	61, 61, 121, 121, 13, 14, 14, 130, 130, 129,
-	129, 129, 131, 131, 131, 131, 169, 169, 15, 15,
-	15, 15, 15, 15, 15, 222, 222, 221, 219, 219,
-	218, 218, 217, 22, 201, 203, 203, 202, 202, 202,
-	202, 202, 202, 192, 172, 172, 172, 172, 175, 175,
-	173, 173, 173, 173, 173, 173, 173, 173, 173, 174,
-	174, 174, 174, 174, 176, 176, 176, 176, 176, 177,
-	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
-	177, 177, 177, 177, 178, 178, 178, 178, 178, 178,
-	178, 178, 191, 191, 179, 179, 185, 185, 186, 186,
-	186, 188, 188, 189, 189, 145, 145, 145, 181, 181,
-	182, 182, 187, 187, 183, 183, 183, 184, 184, 184,
-	190, 190, 190, 190, 190, 180, 180, 193, 193, 211,
-	211, 210, 210, 210, 200, 200, 207, 207, 207, 207,
-	207, 207, 197, 197, 197, 198, 198, 196, 196, 199,
-	199, 209, 209, 208, 194, 194, 195, 195, 212, 212,
-	212, 212, 212, 213, 225, 226, 224, 224, 224, 224,
-	224, 146, 146, 146, 204, 204, 204, 205, 205, 205,
-	206, 206, 206, 16, 16, 16, 16, 16, 16, 16,
+	129, 129, 131, 131, 131, 131, 170, 170, 15, 15,
+	15, 15, 15, 15, 15, 223, 223, 222, 220, 220,
+	219, 219, 218, 22, 202, 204, 204, 203, 203, 203,
+	203, 203, 203, 193, 173, 173, 173, 173, 176, 176,
+	174, 174, 174, 174, 174, 174, 174, 174, 174, 175,
+	175, 175, 175, 175, 177, 177, 177, 177, 177, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 179, 179, 179, 179, 179, 179,
+	179, 179, 192, 192, 180, 180, 186, 186, 187, 187,
+	187, 189, 189, 190, 190, 146, 146, 146, 182, 182,
+	183, 183, 188, 188, 184, 184, 184, 185, 185, 185,
+	191, 191, 191, 191, 191, 181, 181, 194, 194, 212,
+	212, 211, 211, 211, 201, 201, 208, 208, 208, 208,
+	208, 208, 198, 198, 198, 199, 199, 197, 197, 200,
+	200, 210, 210, 209, 195, 195, 196, 196, 213, 213,
+	213, 213, 213, 214, 226, 227, 225, 225, 225, 225,
+	225, 147, 147, 147, 205, 205, 205, 206, 206, 206,
+	207, 207, 207, 16, 16, 16, 16, 16, 16, 16,
 	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 216, 214, 214, 215, 215, 17,
-	23, 23, 18, 18, 18, 18, 18, 19, 19, 24,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 217, 215, 215, 216, 216, 17,
+	23, 23, 18, 18, 18, 18, 18, 18, 19, 19,
+	24, 25, 25, 25, 25, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	138, 138, 227, 227, 140, 140, 136, 136, 139, 139,
-	137, 137, 137, 141, 141, 141, 142, 142, 170, 170,
-	170, 26, 26, 28, 28, 29, 30, 30, 164, 164,
-	165, 165, 31, 32, 37, 37, 37, 37, 37, 37,
-	39, 39, 39, 7, 7, 7, 7, 36, 36, 36,
-	6, 6, 27, 27, 27, 27, 20, 233, 34, 35,
-	35, 44, 44, 44, 40, 40, 40, 43, 43, 43,
-	47, 47, 49, 49, 49, 49, 49, 50, 50, 50,
-	50, 50, 50, 46, 46, 48, 48, 48, 48, 156,
-	156, 156, 155, 155, 52, 52, 53, 53, 54, 54,
-	55, 55, 55, 92, 69, 69, 120, 120, 122, 122,
-	56, 56, 56, 56, 57, 57, 58, 58, 59, 59,
-	163, 163, 162, 162, 162, 161, 161, 62, 62, 62,
-	64, 63, 63, 63, 63, 65, 65, 67, 67, 66,
-	66, 68, 70, 70, 70, 70, 70, 71, 71, 51,
-	51, 51, 51, 51, 51, 51, 51, 133, 133, 73,
-	73, 72, 72, 72, 72, 72, 72, 72, 72, 72,
-	72, 84, 84, 84, 84, 84, 84, 74, 74, 74,
-	74, 74, 74, 74, 45, 45, 85, 85, 85, 91,
-	86, 86, 77, 77, 77, 77, 77, 77, 77, 77,
+	25, 139, 139, 228, 228, 141, 141, 137, 137, 140,
+	140, 138, 138, 138, 142, 142, 142, 143, 143, 171,
+	171, 171, 26, 26, 28, 28, 29, 30, 30, 165,
+	165, 166, 166, 31, 32, 37, 37, 37, 37, 37,
+	37, 39, 39, 39, 7, 7, 7, 7, 36, 36,
+	36, 6, 6, 27, 27, 27, 27, 20, 234, 34,
+	35, 35, 44, 44, 44, 40, 40, 40, 43, 43,
+	43, 47, 47, 49, 49, 49, 49, 49, 50, 50,
+	50, 50, 50, 50, 46, 46, 48, 48, 48, 48,
+	157, 157, 157, 156, 156, 52, 52, 53, 53, 54,
+	54, 55, 55, 55, 92, 69, 69, 120, 120, 122,
+	122, 56, 56, 56, 56, 57, 57, 58, 58, 59,
+	59, 164, 164, 163, 163, 163, 162, 162, 62, 62,
+	62, 64, 63, 63, 63, 63, 65, 65, 67, 67,
+	66, 66, 68, 70, 70, 70, 70, 70, 71, 71,
+	51, 51, 51, 51, 51, 51, 51, 51, 133, 133,
+	73, 73, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 84, 84, 84, 84, 84, 84, 74, 74,
+	74, 74, 74, 74, 74, 45, 45, 85, 85, 85,
+	91, 86, 86, 77, 77, 77, 77, 77, 77, 77,
 	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
 	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
-	77, 77, 77, 77, 77, 77, 81, 81, 81, 81,
-	79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
-	79, 79, 79, 80, 80, 80, 80, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 80, 80, 80, 234,
-	234, 83, 82, 82, 82, 82, 82, 82, 82, 42,
-	42, 42, 42, 42, 168, 168, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 96,
-	96, 41, 41, 94, 94, 95, 97, 97, 93, 93,
-	93, 76, 76, 76, 76, 76, 76, 76, 76, 78,
-	78, 78, 98, 98, 99, 99, 100, 100, 101, 101,
-	102, 103, 103, 103, 104, 104, 104, 104, 118, 118,
-	118, 105, 105, 105, 105, 110, 110, 110, 106, 106,
-	108, 108, 108, 109, 109, 109, 107, 113, 113, 115,
-	115, 114, 114, 112, 112, 117, 117, 116, 116, 111,
-	111, 75, 75, 75, 75, 75, 119, 119, 119, 119,
-	123, 123, 87, 87, 89, 89, 88, 90, 124, 124,
-	127, 125, 125, 128, 128, 128, 128, 128, 126, 126,
-	126, 158, 158, 158, 132, 132, 143, 143, 144, 144,
-	134, 134, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 135, 135, 135, 148, 148, 148, 149, 149,
-	150, 150, 150, 157, 157, 153, 153, 154, 154, 159,
-	159, 160, 160, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
-	151, 151, 151, 152, 152, 152, 152, 152, 152, 152,
+	77, 77, 77, 77, 77, 77, 77, 81, 81, 81,
+	81, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 80, 80, 80, 80, 80, 80,
+	80, 80, 80, 80, 80, 80, 80, 80, 80, 80,
+	235, 235, 83, 82, 82, 82, 82, 82, 82, 82,
+	42, 42, 42, 42, 42, 169, 169, 172, 172, 172,
+	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
+	96, 96, 41, 41, 94, 94, 95, 97, 97, 93,
+	93, 93, 76, 76, 76, 76, 76, 76, 76, 76,
+	78, 78, 78, 98, 98, 99, 99, 100, 100, 101,
+	101, 102, 103, 103, 103, 104, 104, 104, 104, 118,
+	118, 118, 105, 105, 105, 105, 110, 110, 110, 106,
+	106, 108, 108, 108, 109, 109, 109, 107, 113, 113,
+	115, 115, 114, 114, 112, 112, 117, 117, 116, 116,
+	111, 111, 75, 75, 75, 75, 75, 119, 119, 119,
+	119, 123, 123, 87, 87, 89, 89, 88, 90, 124,
+	124, 127, 125, 125, 128, 128, 128, 128, 128, 126,
+	126, 126, 159, 159, 159, 132, 132, 144, 144, 145,
+	145, 134, 134, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 135, 135, 136, 136, 149, 149, 149,
+	150, 150, 151, 151, 151, 158, 158, 154, 154, 155,
+	155, 160, 160, 161, 161, 152, 152, 152, 152, 152,
 	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
 	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
 	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
@@ -3216,15 +3169,27 @@ var yyR1 = [...]int{
 	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
 	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
 	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
-	152, 152, 152, 152, 152, 152, 230, 231, 166, 167,
-	167, 167,
+	152, 152, 152, 152, 152, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 231, 232,
+	167, 168, 168, 168,
 }
 var yyR2 = [...]int{
 
@@ -3256,53 +3221,54 @@ var yyR2 = [...]int{
 	4, 4, 7, 5, 5, 5, 12, 7, 5, 9,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
-	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
-	4, 4, 3, 4, 4, 4, 4, 4, 4, 3,
-	3, 3, 2, 7, 2, 3, 4, 5, 7, 5,
-	4, 2, 4, 4, 3, 3, 5, 2, 3, 3,
-	1, 1, 1, 1, 0, 1, 0, 1, 1, 1,
-	0, 2, 2, 0, 2, 2, 0, 2, 0, 1,
-	1, 2, 1, 1, 2, 1, 1, 5, 0, 1,
-	0, 1, 2, 3, 0, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
-	3, 3, 2, 2, 3, 3, 2, 0, 2, 0,
-	2, 1, 2, 2, 0, 1, 1, 0, 1, 1,
-	0, 1, 0, 1, 2, 3, 4, 1, 1, 1,
-	1, 1, 1, 1, 3, 1, 2, 3, 5, 0,
-	1, 2, 1, 1, 0, 2, 1, 3, 1, 1,
-	1, 3, 3, 3, 3, 7, 1, 3, 1, 3,
-	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
-	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
-	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
-	3, 3, 0, 5, 4, 5, 5, 0, 2, 1,
-	3, 3, 3, 2, 3, 1, 2, 0, 3, 1,
-	1, 3, 3, 4, 4, 5, 3, 4, 5, 6,
-	2, 1, 2, 1, 2, 1, 2, 1, 1, 1,
-	1, 1, 1, 1, 0, 2, 1, 1, 1, 3,
-	1, 3, 1, 1, 1, 1, 1, 3, 3, 3,
+	3, 5, 4, 5, 6, 5, 4, 4, 3, 2,
+	3, 4, 4, 3, 4, 4, 4, 4, 4, 4,
+	3, 3, 3, 2, 7, 2, 3, 4, 5, 7,
+	5, 4, 2, 4, 4, 3, 3, 5, 2, 3,
+	3, 1, 1, 1, 1, 0, 1, 0, 1, 1,
+	1, 0, 2, 2, 0, 2, 2, 0, 2, 0,
+	1, 1, 2, 1, 1, 2, 1, 1, 5, 0,
+	1, 0, 1, 2, 3, 0, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
+	1, 3, 3, 2, 2, 3, 3, 2, 0, 2,
+	0, 2, 1, 2, 2, 0, 1, 1, 0, 1,
+	1, 0, 1, 0, 1, 2, 3, 4, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 2, 3, 5,
+	0, 1, 2, 1, 1, 0, 2, 1, 3, 1,
+	1, 1, 3, 3, 3, 3, 7, 1, 3, 1,
+	3, 4, 4, 4, 3, 2, 4, 0, 1, 0,
+	2, 0, 1, 0, 1, 2, 1, 1, 1, 2,
+	2, 1, 2, 3, 2, 3, 2, 2, 2, 1,
+	1, 3, 3, 0, 5, 4, 5, 5, 0, 2,
+	1, 3, 3, 3, 2, 3, 1, 2, 0, 3,
+	1, 1, 3, 3, 4, 4, 5, 3, 4, 5,
+	6, 2, 1, 2, 1, 2, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 0, 2, 1, 1, 1,
+	3, 1, 3, 1, 1, 1, 1, 1, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 3, 1, 1, 1, 1, 4, 5, 5, 6,
-	4, 4, 6, 6, 6, 8, 8, 8, 8, 9,
-	8, 5, 4, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 8, 8, 0,
-	2, 3, 4, 4, 4, 4, 4, 4, 4, 0,
-	3, 4, 7, 3, 1, 1, 2, 3, 3, 1,
-	2, 2, 1, 2, 1, 2, 2, 1, 2, 0,
-	1, 0, 2, 1, 2, 4, 0, 2, 1, 3,
-	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 0, 3, 0, 2, 0, 3, 1, 3,
-	2, 0, 1, 1, 0, 2, 4, 4, 0, 2,
-	4, 0, 9, 3, 5, 0, 3, 3, 0, 1,
-	0, 2, 2, 0, 2, 2, 2, 0, 3, 0,
-	3, 0, 3, 0, 4, 0, 3, 0, 4, 0,
-	1, 2, 1, 5, 4, 4, 1, 3, 3, 5,
-	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
-	3, 1, 3, 3, 3, 3, 3, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 0, 2, 0, 3,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 2, 3, 0, 1, 1, 1, 1,
-	0, 1, 1, 0, 2, 1, 1, 1, 1, 1,
+	3, 3, 3, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 3, 1, 1, 1, 1, 4, 5, 5,
+	6, 4, 4, 6, 6, 6, 8, 8, 8, 8,
+	9, 8, 5, 4, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 8, 8,
+	0, 2, 3, 4, 4, 4, 4, 4, 4, 4,
+	0, 3, 4, 7, 3, 1, 1, 2, 3, 3,
+	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
+	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
+	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 0, 2, 0, 3, 1,
+	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
+	2, 4, 0, 9, 3, 5, 0, 3, 3, 0,
+	1, 0, 2, 2, 0, 2, 2, 2, 0, 3,
+	0, 3, 0, 3, 0, 4, 0, 3, 0, 4,
+	0, 1, 2, 1, 5, 4, 4, 1, 3, 3,
+	5, 0, 5, 1, 3, 1, 2, 3, 1, 1,
+	3, 3, 1, 3, 3, 3, 3, 3, 2, 1,
+	2, 1, 1, 1, 1, 1, 1, 0, 2, 0,
+	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 1, 2, 3, 0, 1, 1,
+	1, 1, 0, 1, 1, 0, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3334,21 +3300,20 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 0,
-	1, 1,
+	0, 0, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -228, -1, -3, -8, -9, -10, -11, -12, -13,
+	-1000, -229, -1, -3, -8, -9, -10, -11, -12, -13,
 	-14, -15, -16, -17, -18, -19, -24, -25, -26, -28,
 	-29, -30, -31, -32, -6, -27, -20, -21, -33, -4,
-	-230, 6, 7, 8, -38, 10, 11, 31, -22, 139,
+	-231, 6, 7, 8, -38, 10, 11, 31, -22, 139,
 	140, 142, 141, 175, 143, 168, 69, 189, 190, 192,
 	193, 194, 195, -39, 173, 174, 32, 33, 145, 35,
-	40, 73, 9, 282, 170, 169, 26, -229, 384, -44,
-	5, -100, 16, -3, -34, -233, -34, -34, -34, -34,
-	-34, -34, -201, -203, 73, 112, -150, 149, 93, 274,
-	146, 147, 153, -153, -220, -152, 76, 77, 78, 292,
+	40, 73, 9, 282, 170, 169, 26, -230, 384, -44,
+	5, -100, 16, -3, -34, -234, -34, -34, -34, -34,
+	-34, -34, -202, -204, 73, 112, -151, 149, 93, 274,
+	146, 147, 153, -154, -221, -153, 76, 77, 78, 292,
 	161, 324, 325, 189, 203, 197, 224, 216, 293, 326,
 	162, 214, 217, 261, 159, 327, 244, 251, 87, 192,
 	270, 328, 46, 39, 171, 212, 208, 329, 301, 206,
@@ -3368,200 +3333,201 @@ var yyChk = [...]int{
 	210, 211, 225, 198, 221, 191, 381, 182, 175, 377,
 	271, 242, 298, 218, 215, 186, 378, 183, 184, 379,
 	382, 257, 247, 258, 259, 248, 187, 295, 267, 213,
-	243, -135, 149, 274, 146, 248, 295, 147, 147, 148,
-	149, 274, 146, 147, -66, -159, -220, -152, 149, 147,
-	130, 217, 261, 139, 245, 256, 257, 253, -140, 254,
-	181, -170, 147, -136, 244, 247, 248, 187, -227, -220,
-	255, 263, 262, 249, 259, 258, -159, 191, -164, 196,
-	-153, 194, -66, -37, 380, 143, -166, -166, 246, 246,
-	-166, -86, -51, -72, 96, -77, 30, 24, -76, -73,
-	-93, -90, -91, 130, 131, 133, 132, 134, 119, 120,
-	127, 97, 135, -81, -79, -80, -82, 80, 79, 88,
-	81, 82, 83, 84, 89, 90, 91, -153, -159, -88,
-	-230, 63, 64, 283, 284, 285, 286, 291, 287, 99,
-	52, 273, 281, 280, 279, 277, 278, 275, 276, 289,
-	290, 152, 274, 146, 125, 282, -220, -152, 39, -5,
-	-4, -230, 6, 21, 22, -104, 18, 17, -231, 75,
-	-40, -49, 58, 59, -50, 22, 36, 62, 60, -35,
-	-48, 121, -51, -159, -48, -134, 151, -134, -134, -125,
-	-169, 191, -128, 263, 262, -154, -126, -153, -151, 261,
-	217, 260, 144, 299, 95, 23, 25, 239, 98, 130,
-	17, 99, 129, 283, 139, 67, 300, 275, 276, 273,
-	285, 286, 274, 245, 30, 11, 302, 26, 169, 22,
-	36, 123, 141, 102, 103, 172, 24, 170, 91, 305,
-	20, 70, 12, 14, 306, 307, 15, 152, 151, 114,
-	148, 65, 9, 135, 27, 111, 61, 308, 29, 309,
-	310, 311, 312, 63, 112, 18, 277, 278, 32, 313,
-	291, 176, 125, 68, 54, 96, 314, 315, 89, 316,
-	92, 71, 93, 16, 66, 37, 317, 318, 319, 320,
-	113, 142, 282, 64, 321, 146, 6, 288, 31, 168,
-	62, 322, 147, 101, 289, 290, 150, 90, 5, 153,
-	33, 10, 69, 72, 279, 280, 281, 52, 100, 13,
-	323, 94, -202, 112, -192, -195, -153, 163, -213, 159,
-	-66, 148, -66, 282, -144, 152, -144, -144, 147, -66,
-	-220, -220, 139, 141, 144, 71, 80, -23, -66, -143,
-	152, -220, -143, -143, -143, -66, 136, -66, -220, 31,
-	-141, 112, 13, 274, -220, 181, 147, 182, 149, -142,
-	112, -142, -142, -197, 148, 34, 160, -167, -230, -154,
-	185, 186, 185, -139, -138, 251, 252, 246, 250, 13,
-	186, 246, 184, -141, -167, 150, -153, -36, -153, 80,
-	-7, -3, -11, -10, -12, 104, -166, -166, 74, 95,
-	93, 94, 111, -51, -74, 114, 96, 112, 113, 98,
-	116, 115, 126, 119, 120, 121, 122, 123, 124, 125,
-	117, 118, 129, 104, 105, 106, 107, 108, 109, 110,
-	-133, -230, -91, -230, 137, 138, -77, -77, -77, -77,
-	-77, -77, -77, -77, -77, -77, -230, 136, -2, -86,
-	-4, -230, -230, -230, -230, -230, -230, -230, -230, -96,
-	-51, -230, -234, -83, -230, -234, -83, -234, -83, -234,
-	-230, -234, -83, -234, -83, -234, -234, -83, -230, -230,
-	-230, -230, -230, -230, -230, -166, -100, -3, -34, -118,
-	20, 32, -51, -101, -102, -51, -100, 54, -46, -48,
-	-50, 58, 59, 86, 12, -156, -155, 23, -153, 80,
-	136, 12, -67, 27, -66, -53, -54, -55, -56, -69,
-	-92, -230, -66, 12, -60, -61, -66, -68, -159, 74,
-	191, -128, -169, -130, -129, 264, 266, 104, -158, -153,
-	80, 30, 31, 75, 74, -66, -172, -175, -177, -176,
-	-178, -173, -174, 214, 215, 130, 218, 220, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 31, 171, 210,
-	211, 212, 213, 230, 231, 232, 233, 234, 235, 236,
-	237, 197, 216, 293, 198, 199, 200, 201, 202, 203,
-	205, 206, 207, 208, 209, -220, 73, -220, -167, 149,
-	-220, 96, -220, -66, -66, -167, -167, -167, 183, 183,
-	147, 147, 188, -66, 80, 74, 150, -60, 24, 71,
-	-66, -220, -220, -160, -159, -151, -167, -141, 80, -51,
-	-167, -167, -167, -66, -167, -167, 80, -198, 12, 114,
-	-167, -167, -137, 12, 114, -198, -137, -51, -142, 71,
-	-165, 194, 228, 381, 382, 383, -51, -51, -51, -51,
-	-84, 89, 96, 90, 91, -77, -85, -88, -91, 85,
-	114, 112, 113, 98, -77, -77, -77, -77, -77, -77,
-	-77, -77, -77, -77, -77, -77, -77, -77, -77, -168,
-	-220, 80, -220, -76, -76, -153, -47, 22, 36, -46,
-	-154, -160, -151, -44, -231, -231, -100, -46, -46, -51,
-	-51, -93, 80, -46, -93, 80, -46, -46, -43, 22,
-	36, -94, -95, 100, -93, -153, -159, -231, -77, -153,
-	-153, -46, -47, -47, -46, -46, -104, -231, -105, 27,
-	10, 114, 74, 19, 74, -103, 25, 26, -104, -78,
-	-153, 81, 84, -52, 74, 12, -50, -66, -155, 121,
-	-160, -66, -121, 177, -66, 31, 74, -62, -64, -63,
-	-65, 61, 65, 67, 62, 63, 64, 68, -163, 23,
-	-53, -3, -230, -162, 177, -161, 23, -159, 80, -66,
-	-60, -232, 74, 12, 72, -232, 74, 136, -128, -130,
-	74, 265, 267, 268, 71, 92, -51, -184, 129, -204,
-	-205, -206, -154, 80, 81, -192, -193, -194, -207, 163,
-	-212, 154, 156, 157, 153, -196, 164, -213, 148, 29,
-	75, -145, 89, 96, -188, 242, -179, 73, -179, -179,
-	-179, -179, -183, 217, -183, -183, -183, 73, 73, -179,
-	-179, -179, -179, -185, 73, -185, -185, -186, 73, -186,
-	-213, 159, -51, -157, 72, -66, -167, 24, -167, -147,
-	144, 141, 142, -216, 140, 239, 217, 87, 30, 16,
-	283, 177, 298, -220, 178, -66, -66, -66, -66, -66,
-	144, 141, -66, -66, -66, -167, -66, -141, -159, -159,
-	-66, -141, -66, -153, 89, 90, 91, -85, -77, -77,
-	-77, -45, 172, 95, -231, -231, -46, -46, -230, 136,
-	-5, -104, -231, -231, 74, 72, 23, 12, 12, -231,
-	12, 12, -231, -231, -46, -97, -95, 102, -51, -231,
-	136, -231, 74, 74, -231, -231, -231, -231, -231, -118,
-	37, 45, 56, -51, -51, -102, -118, -132, 20, 12,
-	52, 52, -71, 13, -48, -53, -50, 136, -71, -75,
-	31, 52, -3, -230, -230, -124, -127, -93, -54, -55,
-	-55, -54, -55, 61, 61, 61, 66, 61, 66, 61,
-	-63, -159, -231, -231, -3, -70, 69, 151, 70, -230,
-	-161, -121, 72, -53, -66, -53, -68, -159, 121, -129,
-	-131, 269, 266, 272, -220, 80, 74, -206, 104, -195,
-	73, -220, 29, -196, -196, -196, -199, -220, -199, 29,
-	-181, 30, 89, -189, 243, 81, -183, -183, -184, 31,
-	-184, -184, -184, -191, 80, -191, 81, 81, 75, 71,
-	-153, -167, -166, -223, 159, 155, 163, 164, 157, 76,
-	77, 78, 148, 29, 154, 156, 177, 153, -223, -148,
-	-149, 150, 23, 148, 29, 177, -222, 72, 183, 239,
-	183, 150, -167, -137, -137, -45, 95, -77, -77, -231,
-	-231, -47, -154, -100, -118, -171, 130, 214, 171, 212,
-	208, 228, 219, 241, 210, 242, -168, -171, -77, -77,
-	-77, -77, 292, -100, 103, -51, 101, -154, -77, -77,
-	38, 80, 80, 57, -66, -98, 14, -51, 121, -104,
-	-123, 71, -124, -87, -89, -88, -230, -119, -231, -153,
-	-122, -153, -71, 74, 104, -58, -57, 71, 72, -59,
-	71, -57, 61, 61, -231, 148, 148, 148, -122, -71,
-	-53, -71, -71, 136, 266, 270, 271, -205, -206, -209,
-	-208, -153, -212, 164, -199, -199, -199, 73, -182, 71,
-	-77, 75, -184, -184, -220, 130, 75, 74, 75, 74,
-	75, 74, -146, 330, 96, -66, -166, -166, -66, -166,
-	-153, -219, 295, -221, -220, -153, -153, -153, -66, -141,
-	-141, -77, -231, -104, -231, -179, -179, -179, -186, -179,
-	202, -179, 202, -231, -231, 20, 20, 20, 20, -230,
-	-41, 288, -51, 74, 74, 80, -183, -99, 15, 17,
-	28, -123, 74, -231, -231, 74, 52, 136, -231, 74,
-	-100, -127, -51, -51, 73, -51, -230, -230, -230, -231,
-	-100, -71, 75, 74, -179, -120, -153, -187, 239, 10,
-	-183, 80, -183, 81, 81, 330, -167, 27, -218, -217,
-	-154, 73, 72, -118, -183, -220, -77, -77, -77, -77,
-	-77, -104, 80, -77, -77, -183, -107, -112, -139, -51,
-	-86, 29, -89, 52, -3, -153, -87, -153, -153, -104,
-	-120, -120, -231, -120, -120, -162, -104, -211, -210, 72,
-	158, 87, -208, 75, 74, -190, 154, 29, 153, -80,
-	-184, -184, 75, 75, -230, 74, 104, -120, -66, -231,
-	-231, -231, -231, -42, 114, 295, -231, -231, -231, -110,
-	380, -113, 41, -114, 42, 10, -87, 136, 75, -231,
-	-231, -231, -70, -210, -220, -200, 104, 80, 166, -153,
-	-180, 87, 29, 29, -214, -215, 177, -217, -206, 75,
-	-231, 293, 68, 296, -107, 46, 220, -115, 50, -116,
-	-111, 51, 17, -124, -153, 81, -66, 80, -231, 74,
-	-153, -222, 57, 294, 297, -108, 48, -106, 47, -106,
-	-114, 17, -117, 43, 44, 80, 73, -215, 52, -219,
-	57, -109, 49, 71, 92, 80, 17, 17, -120, 179,
-	295, 71, 92, 80, 80, 75, 180, 296, -225, -226,
-	71, -230, 297, -226, 71, 11, 10, -77, 176, -224,
-	167, 162, 165, 31, -224, -231, -231, 161, 30, 89,
+	243, -135, 149, 274, 146, 248, -136, 295, 147, 147,
+	-136, 148, 149, 274, 146, 147, -66, -160, -221, -153,
+	149, 147, 130, 217, 261, 139, 245, 256, 257, 253,
+	-141, 254, 181, -171, 147, -137, 244, 247, 248, 187,
+	-228, -221, 255, 263, 262, 249, 259, 258, -160, 191,
+	-165, 196, -154, 194, -66, -37, 380, 143, -167, -167,
+	246, 246, -167, -86, -51, -72, 96, -77, 30, 24,
+	-76, -73, -93, -90, -91, 130, 131, 133, 132, 134,
+	119, 120, 127, 97, 135, -81, -79, -80, -82, 80,
+	79, 88, 81, 82, 83, 84, 89, 90, 91, -154,
+	-160, -88, -231, 63, 64, 283, 284, 285, 286, 291,
+	287, 99, 52, 273, 281, 280, 279, 277, 278, 275,
+	276, 289, 290, 152, 274, 146, 125, 282, -221, -153,
+	39, -5, -4, -231, 6, 21, 22, -104, 18, 17,
+	-232, 75, -40, -49, 58, 59, -50, 22, 36, 62,
+	60, -35, -48, 121, -51, -160, -48, -134, 151, -134,
+	-134, -125, -170, 191, -128, 263, 262, -155, -126, -154,
+	-152, 261, 217, 260, 144, 299, 95, 23, 25, 239,
+	98, 130, 17, 99, 129, 283, 139, 67, 300, 275,
+	276, 273, 285, 286, 274, 245, 30, 11, 302, 26,
+	169, 22, 36, 123, 141, 102, 103, 172, 24, 170,
+	91, 305, 20, 70, 12, 14, 306, 307, 15, 152,
+	151, 114, 148, 65, 9, 135, 27, 111, 61, 308,
+	29, 309, 310, 311, 312, 63, 112, 18, 277, 278,
+	32, 313, 291, 176, 125, 68, 54, 96, 314, 315,
+	89, 316, 92, 71, 93, 16, 66, 37, 317, 318,
+	319, 320, 113, 142, 282, 64, 321, 146, 6, 288,
+	31, 168, 62, 322, 147, 101, 289, 290, 150, 90,
+	5, 153, 33, 10, 69, 72, 279, 280, 281, 52,
+	100, 13, 323, 94, -203, 112, -193, -196, -154, 163,
+	-214, 159, -66, 148, -66, 282, -145, 152, -145, -145,
+	147, -66, -221, -221, 139, 141, 144, 71, 80, -23,
+	-66, -144, 152, 147, -221, -144, -144, -144, -66, 136,
+	-66, -221, 31, -142, 112, 13, 274, -221, 181, 147,
+	182, 149, -143, 112, -143, -143, -198, 148, 34, 160,
+	-168, -231, -155, 185, 186, 185, -140, -139, 251, 252,
+	246, 250, 13, 186, 246, 184, -142, -168, 150, -154,
+	-36, -154, 80, -7, -3, -11, -10, -12, 104, -167,
+	-167, 74, 95, 93, 94, 111, -51, -74, 114, 96,
+	112, 113, 98, 116, 115, 126, 119, 120, 121, 122,
+	123, 124, 125, 117, 118, 129, 104, 105, 106, 107,
+	108, 109, 110, -133, -231, -91, -231, 137, 138, -77,
+	-77, -77, -77, -77, -77, -77, -77, -77, -77, -231,
+	136, -2, -86, -4, -231, -231, -231, -231, -231, -231,
+	-231, -231, -96, -51, -231, -235, -83, -231, -235, -83,
+	-235, -83, -235, -231, -235, -83, -235, -83, -235, -235,
+	-83, -231, -231, -231, -231, -231, -231, -231, -167, -100,
+	-3, -34, -118, 20, 32, -51, -101, -102, -51, -100,
+	54, -46, -48, -50, 58, 59, 86, 12, -157, -156,
+	23, -154, 80, 136, 12, -67, 27, -66, -53, -54,
+	-55, -56, -69, -92, -231, -66, 12, -60, -61, -66,
+	-68, -160, 74, 191, -128, -170, -130, -129, 264, 266,
+	104, -159, -154, 80, 30, 31, 75, 74, -66, -173,
+	-176, -178, -177, -179, -174, -175, 214, 215, 130, 218,
+	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
+	31, 171, 210, 211, 212, 213, 230, 231, 232, 233,
+	234, 235, 236, 237, 197, 216, 293, 198, 199, 200,
+	201, 202, 203, 205, 206, 207, 208, 209, -221, 73,
+	-221, -168, 149, -221, 96, -221, -66, -66, -168, -168,
+	-168, 183, 183, 147, 147, 188, -66, 80, 74, 150,
+	-60, 24, -144, 71, -66, -221, -221, -161, -160, -152,
+	-168, -142, 80, -51, -168, -168, -168, -66, -168, -168,
+	80, -199, 12, 114, -168, -168, -138, 12, 114, -199,
+	-138, -51, -143, 71, -166, 194, 228, 381, 382, 383,
+	-51, -51, -51, -51, -84, 89, 96, 90, 91, -77,
+	-85, -88, -91, 85, 114, 112, 113, 98, -77, -77,
+	-77, -77, -77, -77, -77, -77, -77, -77, -77, -77,
+	-77, -77, -77, -169, -221, 80, -221, -76, -76, -154,
+	-47, 22, 36, -46, -155, -161, -152, -44, -232, -232,
+	-100, -46, -46, -51, -51, -93, 80, -46, -93, 80,
+	-46, -46, -43, 22, 36, -94, -95, 100, -93, -154,
+	-160, -232, -77, -154, -154, -46, -47, -47, -46, -46,
+	-104, -232, -105, 27, 10, 114, 74, 19, 74, -103,
+	25, 26, -104, -78, -154, 81, 84, -52, 74, 12,
+	-50, -66, -156, 121, -161, -66, -121, 177, -66, 31,
+	74, -62, -64, -63, -65, 61, 65, 67, 62, 63,
+	64, 68, -164, 23, -53, -3, -231, -163, 177, -162,
+	23, -160, 80, -66, -60, -233, 74, 12, 72, -233,
+	74, 136, -128, -130, 74, 265, 267, 268, 71, 92,
+	-51, -185, 129, -205, -206, -207, -155, 80, 81, -193,
+	-194, -195, -208, 163, -213, 154, 156, 157, 153, -197,
+	164, -214, 148, 29, 75, -146, 89, 96, -189, 242,
+	-180, 73, -180, -180, -180, -180, -184, 217, -184, -184,
+	-184, 73, 73, -180, -180, -180, -180, -186, 73, -186,
+	-186, -187, 73, -187, -214, 159, -51, -158, 72, -66,
+	-168, 24, -168, -148, 144, 141, 142, -217, 140, 239,
+	217, 87, 30, 16, 283, 177, 298, -221, 178, -66,
+	-66, -66, -66, -66, 144, 141, -66, -66, -60, -66,
+	-168, -66, -142, -160, -160, -66, -142, -66, -154, 89,
+	90, 91, -85, -77, -77, -77, -45, 172, 95, -232,
+	-232, -46, -46, -231, 136, -5, -104, -232, -232, 74,
+	72, 23, 12, 12, -232, 12, 12, -232, -232, -46,
+	-97, -95, 102, -51, -232, 136, -232, 74, 74, -232,
+	-232, -232, -232, -232, -118, 37, 45, 56, -51, -51,
+	-102, -118, -132, 20, 12, 52, 52, -71, 13, -48,
+	-53, -50, 136, -71, -75, 31, 52, -3, -231, -231,
+	-124, -127, -93, -54, -55, -55, -54, -55, 61, 61,
+	61, 66, 61, 66, 61, -63, -160, -232, -232, -3,
+	-70, 69, 151, 70, -231, -162, -121, 72, -53, -66,
+	-53, -68, -160, 121, -129, -131, 269, 266, 272, -221,
+	80, 74, -207, 104, -196, 73, -221, 29, -197, -197,
+	-197, -200, -221, -200, 29, -182, 30, 89, -190, 243,
+	81, -184, -184, -185, 31, -185, -185, -185, -192, 80,
+	-192, 81, 81, 75, 71, -154, -168, -167, -224, 159,
+	155, 163, 164, 157, 76, 77, 78, 148, 29, 154,
+	156, 177, 153, -224, -149, -150, 150, 23, 148, 29,
+	177, -223, 72, 183, 239, 183, 150, -168, -138, -138,
+	-45, 95, -77, -77, -232, -232, -47, -155, -100, -118,
+	-172, 130, 214, 171, 212, 208, 228, 219, 241, 210,
+	242, -169, -172, -77, -77, -77, -77, 292, -100, 103,
+	-51, 101, -155, -77, -77, 38, 80, 80, 57, -66,
+	-98, 14, -51, 121, -104, -123, 71, -124, -87, -89,
+	-88, -231, -119, -232, -154, -122, -154, -71, 74, 104,
+	-58, -57, 71, 72, -59, 71, -57, 61, 61, -232,
+	148, 148, 148, -122, -71, -53, -71, -71, 136, 266,
+	270, 271, -206, -207, -210, -209, -154, -213, 164, -200,
+	-200, -200, 73, -183, 71, -77, 75, -185, -185, -221,
+	130, 75, 74, 75, 74, 75, 74, -147, 330, 96,
+	-66, -167, -167, -66, -167, -154, -220, 295, -222, -221,
+	-154, -154, -154, -66, -142, -142, -77, -232, -104, -232,
+	-180, -180, -180, -187, -180, 202, -180, 202, -232, -232,
+	20, 20, 20, 20, -231, -41, 288, -51, 74, 74,
+	80, -184, -99, 15, 17, 28, -123, 74, -232, -232,
+	74, 52, 136, -232, 74, -100, -127, -51, -51, 73,
+	-51, -231, -231, -231, -232, -100, -71, 75, 74, -180,
+	-120, -154, -188, 239, 10, -184, 80, -184, 81, 81,
+	330, -168, 27, -219, -218, -155, 73, 72, -118, -184,
+	-221, -77, -77, -77, -77, -77, -104, 80, -77, -77,
+	-184, -107, -112, -140, -51, -86, 29, -89, 52, -3,
+	-154, -87, -154, -154, -104, -120, -120, -232, -120, -120,
+	-163, -104, -212, -211, 72, 158, 87, -209, 75, 74,
+	-191, 154, 29, 153, -80, -185, -185, 75, 75, -231,
+	74, 104, -120, -66, -232, -232, -232, -232, -42, 114,
+	295, -232, -232, -232, -110, 380, -113, 41, -114, 42,
+	10, -87, 136, 75, -232, -232, -232, -70, -211, -221,
+	-201, 104, 80, 166, -154, -181, 87, 29, 29, -215,
+	-216, 177, -218, -207, 75, -232, 293, 68, 296, -107,
+	46, 220, -115, 50, -116, -111, 51, 17, -124, -154,
+	81, -66, 80, -232, 74, -154, -223, 57, 294, 297,
+	-108, 48, -106, 47, -106, -114, 17, -117, 43, 44,
+	80, 73, -216, 52, -220, 57, -109, 49, 71, 92,
+	80, 17, 17, -120, 179, 295, 71, 92, 80, 80,
+	75, 180, 296, -226, -227, 71, -231, 297, -227, 71,
+	11, 10, -77, 176, -225, 167, 162, 165, 31, -225,
+	-232, -232, 161, 30, 89,
 }
 var yyDef = [...]int{
 
 	30, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 23, 24, 25, 26, 27, 28, 29, 636,
-	0, 377, 377, 377, 377, 377, 377, 377, 0, 740,
-	732, 0, 0, 0, 0, -2, 342, 343, 0, 345,
-	-2, 0, 0, 354, 1068, 1068, 0, 0, 1068, 0,
-	0, 1066, 49, 50, 360, 361, 362, 1, 3, 0,
-	381, 644, 0, 0, -2, 379, 0, 0, 720, 720,
-	720, 0, 78, 79, 0, 0, 0, 1051, 0, 718,
-	718, 718, 741, 742, 745, 746, 31, 32, 33, 873,
-	874, 875, 876, 877, 878, 879, 880, 881, 882, 883,
-	884, 885, 886, 887, 888, 889, 890, 891, 892, 893,
-	894, 895, 896, 897, 898, 899, 900, 901, 902, 903,
-	904, 905, 906, 907, 908, 909, 910, 911, 912, 913,
-	914, 915, 916, 917, 918, 919, 920, 921, 922, 923,
-	924, 925, 926, 927, 928, 929, 930, 931, 932, 933,
-	934, 935, 936, 937, 938, 939, 940, 941, 942, 943,
-	944, 945, 946, 947, 948, 949, 950, 951, 952, 953,
-	954, 955, 956, 957, 958, 959, 960, 961, 962, 963,
-	964, 965, 966, 967, 968, 969, 970, 971, 972, 973,
-	974, 975, 976, 977, 978, 979, 980, 981, 982, 983,
-	984, 985, 986, 987, 988, 989, 990, 991, 992, 993,
-	994, 995, 996, 997, 998, 999, 1000, 1001, 1002, 1003,
-	1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013,
-	1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023,
-	1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033,
-	1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043,
-	1044, 1045, 1046, 1047, 1048, 1049, 1050, 1052, 1053, 1054,
-	1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064,
-	1065, 0, 0, 0, 0, 0, 0, 0, 716, 0,
-	716, 716, 716, 0, 288, 459, 749, 750, 1051, 0,
-	0, 0, 333, 0, 336, 336, 336, 302, 0, 304,
-	1069, 0, 0, 0, 311, 0, 0, 317, 333, 1069,
-	325, 339, 340, 327, 322, 323, 341, 344, 0, 349,
-	352, 0, 367, 0, 919, 359, 372, 373, 1068, 1068,
-	376, 34, 510, 469, 0, 475, 477, 0, 512, 513,
-	514, 515, 516, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 542, 543, 544, 545, 621, 622, 623,
-	624, 625, 626, 627, 628, 479, 480, 618, 0, 697,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 609,
-	0, 579, 579, 579, 579, 579, 579, 579, 579, 0,
-	0, 0, 0, 0, 0, 0, -2, -2, 1068, 636,
-	45, 0, 377, 382, 383, 648, 0, 0, 636, 1067,
-	0, 0, -2, -2, 393, 399, 400, 401, 402, 378,
-	0, 405, 409, 0, 0, 0, 721, 0, 0, 64,
-	0, 1039, 701, -2, -2, 0, 0, 747, 748, -2,
-	886, -2, 753, 754, 755, 756, 757, 758, 759, 760,
+	21, 22, 23, 24, 25, 26, 27, 28, 29, 637,
+	0, 378, 378, 378, 378, 378, 378, 378, 0, 742,
+	733, 0, 0, 0, 0, -2, 343, 344, 0, 346,
+	-2, 0, 0, 355, 1070, 1070, 0, 0, 1070, 0,
+	0, 1068, 49, 50, 361, 362, 363, 1, 3, 0,
+	382, 645, 0, 0, -2, 380, 0, 0, 721, 721,
+	721, 0, 78, 79, 0, 0, 0, 1053, 0, 719,
+	719, 719, 743, 744, 747, 748, 31, 32, 33, 875,
+	876, 877, 878, 879, 880, 881, 882, 883, 884, 885,
+	886, 887, 888, 889, 890, 891, 892, 893, 894, 895,
+	896, 897, 898, 899, 900, 901, 902, 903, 904, 905,
+	906, 907, 908, 909, 910, 911, 912, 913, 914, 915,
+	916, 917, 918, 919, 920, 921, 922, 923, 924, 925,
+	926, 927, 928, 929, 930, 931, 932, 933, 934, 935,
+	936, 937, 938, 939, 940, 941, 942, 943, 944, 945,
+	946, 947, 948, 949, 950, 951, 952, 953, 954, 955,
+	956, 957, 958, 959, 960, 961, 962, 963, 964, 965,
+	966, 967, 968, 969, 970, 971, 972, 973, 974, 975,
+	976, 977, 978, 979, 980, 981, 982, 983, 984, 985,
+	986, 987, 988, 989, 990, 991, 992, 993, 994, 995,
+	996, 997, 998, 999, 1000, 1001, 1002, 1003, 1004, 1005,
+	1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015,
+	1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025,
+	1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035,
+	1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045,
+	1046, 1047, 1048, 1049, 1050, 1051, 1052, 1054, 1055, 1056,
+	1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066,
+	1067, 0, 0, 0, 0, 0, 734, 0, 0, 717,
+	0, 0, 717, 717, 717, 0, 289, 460, 751, 752,
+	1053, 0, 0, 0, 334, 0, 337, 337, 337, 303,
+	0, 305, 1071, 0, 0, 0, 312, 0, 0, 318,
+	334, 1071, 326, 340, 341, 328, 323, 324, 342, 345,
+	0, 350, 353, 0, 368, 0, 921, 360, 373, 374,
+	1070, 1070, 377, 34, 511, 470, 0, 476, 478, 0,
+	513, 514, 515, 516, 517, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 543, 544, 545, 546, 622,
+	623, 624, 625, 626, 627, 628, 629, 480, 481, 619,
+	0, 698, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 610, 0, 580, 580, 580, 580, 580, 580, 580,
+	580, 0, 0, 0, 0, 0, 0, 0, -2, -2,
+	1070, 637, 45, 0, 378, 383, 384, 649, 0, 0,
+	637, 1069, 0, 0, -2, -2, 394, 400, 401, 402,
+	403, 379, 0, 406, 410, 0, 0, 0, 722, 0,
+	0, 64, 0, 1041, 702, -2, -2, 0, 0, 749,
+	750, -2, 888, -2, 755, 756, 757, 758, 759, 760,
 	761, 762, 763, 764, 765, 766, 767, 768, 769, 770,
 	771, 772, 773, 774, 775, 776, 777, 778, 779, 780,
 	781, 782, 783, 784, 785, 786, 787, 788, 789, 790,
@@ -3573,121 +3539,122 @@ var yyDef = [...]int{
 	841, 842, 843, 844, 845, 846, 847, 848, 849, 850,
 	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
 	861, 862, 863, 864, 865, 866, 867, 868, 869, 870,
-	871, 872, 0, 0, 97, 98, 0, 0, 217, 888,
-	95, 0, 1069, 0, 0, 0, 0, 0, 0, 1069,
-	1069, 1069, 0, 0, 0, 0, 733, 279, 0, 0,
-	0, 0, 0, 0, 0, 287, 0, 289, 1069, 333,
-	292, 0, 0, 1069, 1069, 1069, 0, 1069, 1069, 299,
-	0, 300, 301, 0, 202, 203, 204, 305, 1070, 1071,
-	1069, 1069, 330, 0, 330, 328, 329, 320, 321, 0,
-	336, 314, 315, 318, 319, 350, 353, 370, 368, 369,
-	371, 363, 364, 365, 366, 0, 374, 375, 0, 0,
-	0, 0, 0, 473, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 497, 498, 499, 500, 501, 502, 503,
-	476, 0, 490, 0, 0, 0, 532, 533, 534, 535,
-	536, 537, 538, 539, 540, 0, 390, 0, 0, 0,
-	636, 0, 0, 0, 0, 0, 0, 0, 387, 0,
-	610, 0, 563, 571, 0, 564, 572, 565, 573, 566,
-	0, 567, 574, 568, 575, 569, 570, 576, 0, 0,
-	0, 390, 390, 0, 0, 35, 644, 0, 392, 651,
-	0, 0, 645, 637, 638, 641, 644, 0, 414, 403,
-	394, 397, 398, 380, 0, 406, 410, 0, 412, 413,
-	0, 0, 62, 0, 458, 0, 416, 418, 419, 420,
-	440, 0, 442, 0, 0, 0, 58, 60, 459, 0,
-	1039, 707, 0, 66, 67, 0, 0, 0, 177, 711,
-	712, 713, 709, 234, 0, 0, 165, 161, 105, 106,
-	107, 154, 109, 154, 154, 154, 154, 174, 174, 174,
-	174, 137, 138, 139, 140, 141, 0, 0, 124, 154,
-	154, 154, 154, 144, 145, 146, 147, 148, 149, 150,
-	151, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	156, 156, 156, 158, 158, 0, 0, 743, 81, 0,
-	1069, 0, 1069, 93, 0, 248, 250, 251, 0, 0,
-	0, 0, 0, 0, 734, 0, 0, 282, 717, 0,
-	1069, 285, 286, 460, 751, 752, 290, 291, 334, 335,
-	293, 294, 295, 296, 297, 298, 337, 0, 205, 206,
-	306, 310, 333, 0, 0, 0, 333, 312, 313, 0,
-	0, 351, 355, 356, 357, 358, 511, 470, 471, 472,
-	474, 491, 0, 493, 495, 481, 482, 506, 507, 508,
-	0, 0, 0, 0, 504, 486, 0, 517, 518, 519,
-	520, 521, 522, 523, 524, 525, 526, 527, 528, 531,
-	594, 595, 0, 529, 530, 541, 0, 0, 0, 391,
-	619, 0, -2, 0, 509, 696, 644, 0, 0, 0,
-	0, 514, 621, 0, 514, 621, 0, 0, 0, 388,
-	389, 616, 613, 0, 0, 618, 0, 580, 0, 0,
-	0, 0, 0, 0, 0, 0, 648, 46, 36, 0,
-	649, 0, 0, 0, 0, 640, 642, 643, 648, 0,
-	629, 0, 0, 467, 0, 0, 395, 42, 411, 407,
-	0, 467, 0, 0, 457, 0, 0, 0, 0, 0,
-	0, 447, 0, 0, 450, 0, 0, 0, 0, 441,
-	0, 0, 0, 462, 980, 443, 0, 445, 446, -2,
-	0, 0, 0, 56, 57, 0, 0, 0, 702, 65,
-	0, 0, 70, 71, 703, 704, 705, 706, 0, 94,
-	235, 237, 240, 241, 242, 99, 101, 102, 0, 0,
-	215, 990, 1023, 920, 209, 209, 918, 222, 207, 208,
-	96, 168, 166, 0, 163, 162, 108, 0, 174, 174,
-	131, 132, 177, 0, 177, 177, 177, 0, 0, 125,
-	126, 127, 128, 119, 0, 120, 121, 122, 0, 123,
-	216, 0, 0, 0, 0, 1069, 83, 719, 84, 1068,
-	0, 0, 735, 249, 722, 723, 724, 725, 726, 727,
-	728, 729, 730, 731, 0, 85, 253, 255, 254, 258,
-	0, 0, 0, 280, 1069, 284, 330, 307, 331, 332,
-	330, 309, 316, 347, 492, 494, 496, 483, 504, 487,
-	0, 484, 0, 0, 478, 546, 0, 0, 390, 0,
-	636, 648, 550, 551, 0, 0, 0, 0, 0, 587,
-	0, 0, 588, 0, 636, 0, 614, 0, 0, 562,
-	0, 581, 0, 0, 582, 583, 584, 585, 586, 38,
-	0, 0, 0, 646, 647, 639, 37, 0, 714, 715,
-	630, 631, 632, 0, 404, 415, 396, 0, 644, 690,
-	0, 0, 682, 0, 0, 467, 698, 0, 417, 436,
-	438, 0, 433, 448, 449, 451, 0, 453, 0, 455,
-	456, 421, 422, 423, 0, 424, 0, 0, 0, 0,
-	444, 467, 0, 467, 59, 467, 61, 0, 461, 68,
-	69, 0, 0, 75, 178, 179, 0, 238, 0, 100,
-	0, 0, 196, 209, 209, 209, 200, 210, 201, 0,
-	170, 0, 167, 104, 164, 0, 177, 177, 133, 0,
-	134, 135, 136, 0, 152, 0, 0, 0, 231, 0,
-	744, 82, 243, 1068, 260, 261, 262, 263, 264, 265,
-	266, 267, 268, 269, 270, 271, 272, 273, 1068, 0,
-	1068, 736, 737, 738, 739, 0, 88, 0, 0, 0,
-	0, 0, 283, 333, 333, 485, 0, 505, 488, 547,
-	548, 0, 620, 644, 40, 0, 154, 154, 599, 154,
-	158, 602, 154, 604, 154, 607, 0, 0, 0, 0,
-	0, 0, 0, 611, 561, 617, 0, 619, 0, 0,
-	0, 174, 653, 650, 39, 634, 0, 468, 408, 43,
-	47, 0, 690, 681, 692, 694, 0, 0, 0, 686,
-	0, 428, 636, 0, 0, 430, 437, 0, 0, 431,
-	0, 432, 452, 454, -2, 0, 0, 0, 0, 636,
-	467, 54, 55, 0, 72, 73, 74, 236, 239, 0,
-	211, 154, 214, 0, 197, 198, 199, 0, 172, 0,
-	169, 155, 129, 130, 175, 176, 174, 0, 174, 0,
-	159, 0, 223, 232, 0, 1069, 244, 245, 246, 247,
-	0, 252, 0, 86, 87, 0, 0, 257, 281, 303,
-	308, 489, 549, 648, 552, 596, 174, 600, 601, 603,
-	605, 606, 608, 554, 553, 0, 0, 0, 0, 0,
-	644, 0, 615, 0, 0, 174, 673, 44, 0, 0,
-	0, 48, 0, 695, 0, 0, 0, 0, 63, 0,
-	644, 699, 700, 434, 0, 439, 0, 0, 0, 442,
-	644, 53, 188, 0, 213, 0, 426, 180, 173, 0,
-	177, 153, 177, 0, 0, 233, 80, 0, 89, 90,
-	0, 0, 0, 41, 597, 598, 0, 0, 0, 0,
-	589, 0, 612, 0, 0, 655, 654, 667, 671, 635,
-	633, 0, 693, 0, 685, 688, 684, 687, 429, 51,
-	0, 0, 464, 0, 0, 462, 52, 187, 189, 0,
-	194, 0, 212, 0, 0, 185, 0, 182, 184, 171,
-	142, 143, 157, 160, 0, 0, 0, 0, 259, 555,
-	557, 556, 558, 0, 0, 0, 560, 577, 578, 673,
-	0, 666, 669, -2, 0, 0, 683, 0, 435, 463,
-	465, 466, 425, 190, 191, 0, 195, 193, 0, 427,
-	103, 0, 181, 183, 0, 275, 0, 91, 92, 85,
-	559, 0, 0, 0, 660, 658, 658, 671, 0, 675,
-	0, 680, 0, 691, 689, 192, 0, 186, 274, 0,
-	0, 88, 590, 0, 593, 663, 0, 656, 659, 657,
-	668, 0, 674, 0, 0, 672, 0, 276, 0, 256,
-	591, 652, 0, 661, 662, 670, 0, 0, 0, 0,
-	0, 664, 665, 676, 678, 218, 0, 0, 219, 220,
-	0, 0, 592, 221, 0, 0, 0, 0, 0, 224,
-	226, 227, 0, 0, 225, 277, 278, 228, 229, 230,
+	871, 872, 873, 874, 0, 0, 97, 98, 0, 0,
+	217, 890, 95, 0, 1071, 0, 0, 0, 0, 0,
+	0, 1071, 1071, 1071, 0, 0, 0, 0, 735, 279,
+	0, 0, 0, 717, 0, 0, 0, 0, 288, 0,
+	290, 1071, 334, 293, 0, 0, 1071, 1071, 1071, 0,
+	1071, 1071, 300, 0, 301, 302, 0, 202, 203, 204,
+	306, 1072, 1073, 1071, 1071, 331, 0, 331, 329, 330,
+	321, 322, 0, 337, 315, 316, 319, 320, 351, 354,
+	371, 369, 370, 372, 364, 365, 366, 367, 0, 375,
+	376, 0, 0, 0, 0, 0, 474, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 498, 499, 500, 501,
+	502, 503, 504, 477, 0, 491, 0, 0, 0, 533,
+	534, 535, 536, 537, 538, 539, 540, 541, 0, 391,
+	0, 0, 0, 637, 0, 0, 0, 0, 0, 0,
+	0, 388, 0, 611, 0, 564, 572, 0, 565, 573,
+	566, 574, 567, 0, 568, 575, 569, 576, 570, 571,
+	577, 0, 0, 0, 391, 391, 0, 0, 35, 645,
+	0, 393, 652, 0, 0, 646, 638, 639, 642, 645,
+	0, 415, 404, 395, 398, 399, 381, 0, 407, 411,
+	0, 413, 414, 0, 0, 62, 0, 459, 0, 417,
+	419, 420, 421, 441, 0, 443, 0, 0, 0, 58,
+	60, 460, 0, 1041, 708, 0, 66, 67, 0, 0,
+	0, 177, 712, 713, 714, 710, 234, 0, 0, 165,
+	161, 105, 106, 107, 154, 109, 154, 154, 154, 154,
+	174, 174, 174, 174, 137, 138, 139, 140, 141, 0,
+	0, 124, 154, 154, 154, 154, 144, 145, 146, 147,
+	148, 149, 150, 151, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 156, 156, 156, 158, 158, 0, 0,
+	745, 81, 0, 1071, 0, 1071, 93, 0, 248, 250,
+	251, 0, 0, 0, 0, 0, 0, 736, 0, 0,
+	282, 718, 0, 0, 1071, 286, 287, 461, 753, 754,
+	291, 292, 335, 336, 294, 295, 296, 297, 298, 299,
+	338, 0, 205, 206, 307, 311, 334, 0, 0, 0,
+	334, 313, 314, 0, 0, 352, 356, 357, 358, 359,
+	512, 471, 472, 473, 475, 492, 0, 494, 496, 482,
+	483, 507, 508, 509, 0, 0, 0, 0, 505, 487,
+	0, 518, 519, 520, 521, 522, 523, 524, 525, 526,
+	527, 528, 529, 532, 595, 596, 0, 530, 531, 542,
+	0, 0, 0, 392, 620, 0, -2, 0, 510, 697,
+	645, 0, 0, 0, 0, 515, 622, 0, 515, 622,
+	0, 0, 0, 389, 390, 617, 614, 0, 0, 619,
+	0, 581, 0, 0, 0, 0, 0, 0, 0, 0,
+	649, 46, 36, 0, 650, 0, 0, 0, 0, 641,
+	643, 644, 649, 0, 630, 0, 0, 468, 0, 0,
+	396, 42, 412, 408, 0, 468, 0, 0, 458, 0,
+	0, 0, 0, 0, 0, 448, 0, 0, 451, 0,
+	0, 0, 0, 442, 0, 0, 0, 463, 982, 444,
+	0, 446, 447, -2, 0, 0, 0, 56, 57, 0,
+	0, 0, 703, 65, 0, 0, 70, 71, 704, 705,
+	706, 707, 0, 94, 235, 237, 240, 241, 242, 99,
+	101, 102, 0, 0, 215, 992, 1025, 922, 209, 209,
+	920, 222, 207, 208, 96, 168, 166, 0, 163, 162,
+	108, 0, 174, 174, 131, 132, 177, 0, 177, 177,
+	177, 0, 0, 125, 126, 127, 128, 119, 0, 120,
+	121, 122, 0, 123, 216, 0, 0, 0, 0, 1071,
+	83, 720, 84, 1070, 0, 0, 737, 249, 723, 724,
+	725, 726, 727, 728, 729, 730, 731, 732, 0, 85,
+	253, 255, 254, 258, 0, 0, 0, 280, 283, 1071,
+	285, 331, 308, 332, 333, 331, 310, 317, 348, 493,
+	495, 497, 484, 505, 488, 0, 485, 0, 0, 479,
+	547, 0, 0, 391, 0, 637, 649, 551, 552, 0,
+	0, 0, 0, 0, 588, 0, 0, 589, 0, 637,
+	0, 615, 0, 0, 563, 0, 582, 0, 0, 583,
+	584, 585, 586, 587, 38, 0, 0, 0, 647, 648,
+	640, 37, 0, 715, 716, 631, 632, 633, 0, 405,
+	416, 397, 0, 645, 691, 0, 0, 683, 0, 0,
+	468, 699, 0, 418, 437, 439, 0, 434, 449, 450,
+	452, 0, 454, 0, 456, 457, 422, 423, 424, 0,
+	425, 0, 0, 0, 0, 445, 468, 0, 468, 59,
+	468, 61, 0, 462, 68, 69, 0, 0, 75, 178,
+	179, 0, 238, 0, 100, 0, 0, 196, 209, 209,
+	209, 200, 210, 201, 0, 170, 0, 167, 104, 164,
+	0, 177, 177, 133, 0, 134, 135, 136, 0, 152,
+	0, 0, 0, 231, 0, 746, 82, 243, 1070, 260,
+	261, 262, 263, 264, 265, 266, 267, 268, 269, 270,
+	271, 272, 273, 1070, 0, 1070, 738, 739, 740, 741,
+	0, 88, 0, 0, 0, 0, 0, 284, 334, 334,
+	486, 0, 506, 489, 548, 549, 0, 621, 645, 40,
+	0, 154, 154, 600, 154, 158, 603, 154, 605, 154,
+	608, 0, 0, 0, 0, 0, 0, 0, 612, 562,
+	618, 0, 620, 0, 0, 0, 174, 654, 651, 39,
+	635, 0, 469, 409, 43, 47, 0, 691, 682, 693,
+	695, 0, 0, 0, 687, 0, 429, 637, 0, 0,
+	431, 438, 0, 0, 432, 0, 433, 453, 455, -2,
+	0, 0, 0, 0, 637, 468, 54, 55, 0, 72,
+	73, 74, 236, 239, 0, 211, 154, 214, 0, 197,
+	198, 199, 0, 172, 0, 169, 155, 129, 130, 175,
+	176, 174, 0, 174, 0, 159, 0, 223, 232, 0,
+	1071, 244, 245, 246, 247, 0, 252, 0, 86, 87,
+	0, 0, 257, 281, 304, 309, 490, 550, 649, 553,
+	597, 174, 601, 602, 604, 606, 607, 609, 555, 554,
+	0, 0, 0, 0, 0, 645, 0, 616, 0, 0,
+	174, 674, 44, 0, 0, 0, 48, 0, 696, 0,
+	0, 0, 0, 63, 0, 645, 700, 701, 435, 0,
+	440, 0, 0, 0, 443, 645, 53, 188, 0, 213,
+	0, 427, 180, 173, 0, 177, 153, 177, 0, 0,
+	233, 80, 0, 89, 90, 0, 0, 0, 41, 598,
+	599, 0, 0, 0, 0, 590, 0, 613, 0, 0,
+	656, 655, 668, 672, 636, 634, 0, 694, 0, 686,
+	689, 685, 688, 430, 51, 0, 0, 465, 0, 0,
+	463, 52, 187, 189, 0, 194, 0, 212, 0, 0,
+	185, 0, 182, 184, 171, 142, 143, 157, 160, 0,
+	0, 0, 0, 259, 556, 558, 557, 559, 0, 0,
+	0, 561, 578, 579, 674, 0, 667, 670, -2, 0,
+	0, 684, 0, 436, 464, 466, 467, 426, 190, 191,
+	0, 195, 193, 0, 428, 103, 0, 181, 183, 0,
+	275, 0, 91, 92, 85, 560, 0, 0, 0, 661,
+	659, 659, 672, 0, 676, 0, 681, 0, 692, 690,
+	192, 0, 186, 274, 0, 0, 88, 591, 0, 594,
+	664, 0, 657, 660, 658, 669, 0, 675, 0, 0,
+	673, 0, 276, 0, 256, 592, 653, 0, 662, 663,
+	671, 0, 0, 0, 0, 0, 665, 666, 677, 679,
+	218, 0, 0, 219, 220, 0, 0, 593, 221, 0,
+	0, 0, 0, 0, 224, 226, 227, 0, 0, 225,
+	277, 278, 228, 229, 230,
 }
 var yyTok1 = [...]int{
 
@@ -5636,23 +5603,23 @@ yydefault:
 			yyVAL.statement = &DDL{Action: DropDDLAction, FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
 		}
 	case 283:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:1635
 		{
-			// Change this to an alter statement
-			yyVAL.statement = &DDL{Action: AlterDDLAction, Table: yyDollar[5].tableName}
+			yyVAL.statement = &DDL{Action: DropDDLAction, OnlineHint: yyDollar[2].OnlineDDLHint, FromTables: yyDollar[5].tableNames, IfExists: yyDollar[4].boolean}
 		}
 	case 284:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1640
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1639
 		{
-			yyVAL.statement = &DDL{Action: DropDDLAction, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: yyDollar[3].boolean}
+			// Change this to an alter statement
+			yyVAL.statement = &DDL{Action: AlterDDLAction, Table: yyDollar[5].tableName}
 		}
 	case 285:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:1644
 		{
-			yyVAL.statement = &DBDDL{Action: DropDBDDLAction, DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
+			yyVAL.statement = &DDL{Action: DropDDLAction, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: yyDollar[3].boolean}
 		}
 	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
@@ -5661,72 +5628,72 @@ yydefault:
 			yyVAL.statement = &DBDDL{Action: DropDBDDLAction, DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
 		}
 	case 287:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1652
+		{
+			yyVAL.statement = &DBDDL{Action: DropDBDDLAction, DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
+		}
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1654
+//line sql.y:1658
 		{
 			yyVAL.statement = &DDL{Action: TruncateDDLAction, Table: yyDollar[3].tableName}
 		}
-	case 288:
+	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1658
+//line sql.y:1662
 		{
 			yyVAL.statement = &DDL{Action: TruncateDDLAction, Table: yyDollar[2].tableName}
 		}
-	case 289:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1663
+//line sql.y:1667
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 290:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1669
+//line sql.y:1673
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 291:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1674
+//line sql.y:1678
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: CharsetStr, ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
 		}
-	case 292:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1679
+//line sql.y:1683
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
 		}
-	case 293:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1684
-		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
-		}
 	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1689
+//line sql.y:1688
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
 	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1693
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 	case 296:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1697
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
 	case 297:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1701
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
@@ -5735,72 +5702,78 @@ yydefault:
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
 	case 299:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1709
 		{
-			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
 	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1714
+//line sql.y:1713
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
 		}
 	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1719
+//line sql.y:1718
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
 		}
 	case 302:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1723
+		{
+			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
+		}
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1724
+//line sql.y:1728
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 303:
+	case 304:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1728
+//line sql.y:1732
 		{
 			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Extended: string(yyDollar[2].str), Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 304:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1733
+//line sql.y:1737
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 305:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1737
+//line sql.y:1741
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 306:
+	case 307:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1741
+//line sql.y:1745
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Scope: yyDollar[2].scope, Type: string(yyDollar[3].bytes)}}
 		}
-	case 307:
+	case 308:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1745
+//line sql.y:1749
 		{
 			yyVAL.statement = &Show{&ShowTableStatus{DatabaseName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 308:
+	case 309:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1749
+//line sql.y:1753
 		{
 			yyVAL.statement = &Show{&ShowColumns{Full: yyDollar[2].str, Table: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
-	case 309:
+	case 310:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1753
+//line sql.y:1757
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -5810,76 +5783,70 @@ yydefault:
 				yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
 			}
 		}
-	case 310:
+	case 311:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1763
+//line sql.y:1767
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Scope: yyDollar[2].scope, Type: string(yyDollar[3].bytes)}}
 		}
-	case 311:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1767
+//line sql.y:1771
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 312:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1771
+//line sql.y:1775
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: yyDollar[4].expr, Scope: ImplicitScope}}
 		}
-	case 313:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1775
+//line sql.y:1779
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}}
 		}
-	case 314:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1780
+//line sql.y:1784
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 315:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1784
+//line sql.y:1788
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 316:
+	case 317:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1788
+//line sql.y:1792
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 317:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1792
+//line sql.y:1796
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 318:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1797
+//line sql.y:1801
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
-	case 319:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1811
+//line sql.y:1815
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 320:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1817
-		{
-			yyVAL.str = string(yyDollar[1].bytes)
-		}
 	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1821
@@ -5888,7 +5855,7 @@ yydefault:
 		}
 	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1827
+//line sql.y:1825
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
@@ -5899,34 +5866,34 @@ yydefault:
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 324:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1837
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1835
 		{
-			yyVAL.str = ""
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 325:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1841
 		{
-			yyVAL.str = "extended "
+			yyVAL.str = ""
 		}
 	case 326:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1847
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1845
 		{
-			yyVAL.str = ""
+			yyVAL.str = "extended "
 		}
 	case 327:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1851
 		{
-			yyVAL.str = "full "
+			yyVAL.str = ""
 		}
 	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1857
+//line sql.y:1855
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = "full "
 		}
 	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -5935,16 +5902,16 @@ yydefault:
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 330:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1867
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1865
 		{
-			yyVAL.str = ""
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 331:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1871
 		{
-			yyVAL.str = yyDollar[2].tableIdent.v
+			yyVAL.str = ""
 		}
 	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
@@ -5953,172 +5920,172 @@ yydefault:
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
 	case 333:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1881
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1879
 		{
-			yyVAL.showFilter = nil
+			yyVAL.str = yyDollar[2].tableIdent.v
 		}
 	case 334:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1885
 		{
-			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
+			yyVAL.showFilter = nil
 		}
 	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1889
 		{
-			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
+			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
 	case 336:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1893
+		{
+			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
+		}
+	case 337:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1895
+//line sql.y:1899
 		{
 			yyVAL.showFilter = nil
 		}
-	case 337:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1899
+//line sql.y:1903
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 338:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1905
+//line sql.y:1909
 		{
 			yyVAL.scope = ImplicitScope
 		}
-	case 339:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1909
+//line sql.y:1913
 		{
 			yyVAL.scope = SessionScope
 		}
-	case 340:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1913
+//line sql.y:1917
 		{
 			yyVAL.scope = GlobalScope
 		}
-	case 341:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1919
+//line sql.y:1923
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 342:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1923
+//line sql.y:1927
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 343:
+	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1929
+//line sql.y:1933
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 344:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1933
+//line sql.y:1937
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 345:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1939
+//line sql.y:1943
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 346:
+	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1945
+//line sql.y:1949
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 347:
+	case 348:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1949
+//line sql.y:1953
 		{
 			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
 		}
-	case 348:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1954
+//line sql.y:1958
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 349:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1956
+//line sql.y:1960
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 350:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1959
+//line sql.y:1963
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 351:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1961
+//line sql.y:1965
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 352:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1966
+//line sql.y:1970
 		{
 			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
 		}
-	case 353:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1972
+//line sql.y:1976
 		{
 			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
 		}
-	case 354:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1977
-		{
-			yyVAL.explainType = EmptyType
-		}
 	case 355:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1981
 		{
-			yyVAL.explainType = JSONType
+			yyVAL.explainType = EmptyType
 		}
 	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1985
 		{
-			yyVAL.explainType = TreeType
+			yyVAL.explainType = JSONType
 		}
 	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1989
 		{
-			yyVAL.explainType = VitessType
+			yyVAL.explainType = TreeType
 		}
 	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1993
 		{
-			yyVAL.explainType = TraditionalType
+			yyVAL.explainType = VitessType
 		}
 	case 359:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1997
 		{
-			yyVAL.explainType = AnalyzeType
+			yyVAL.explainType = TraditionalType
 		}
 	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2003
+//line sql.y:2001
 		{
-			yyVAL.bytes = yyDollar[1].bytes
+			yyVAL.explainType = AnalyzeType
 		}
 	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -6134,15 +6101,15 @@ yydefault:
 		}
 	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2017
+//line sql.y:2015
 		{
-			yyVAL.statement = yyDollar[1].selStmt
+			yyVAL.bytes = yyDollar[1].bytes
 		}
 	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2021
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -6157,13 +6124,13 @@ yydefault:
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 367:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2034
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2033
 		{
-			yyVAL.str = ""
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 368:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:2038
 		{
 			yyVAL.str = ""
@@ -6175,22 +6142,22 @@ yydefault:
 			yyVAL.str = ""
 		}
 	case 370:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2048
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2046
 		{
-			yyVAL.statement = &OtherRead{}
+			yyVAL.str = ""
 		}
 	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2052
 		{
-			yyVAL.statement = &Explain{Type: yyDollar[2].explainType, Statement: yyDollar[3].statement}
+			yyVAL.statement = &OtherRead{}
 		}
 	case 372:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2058
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2056
 		{
-			yyVAL.statement = &OtherAdmin{}
+			yyVAL.statement = &Explain{Type: yyDollar[2].explainType, Statement: yyDollar[3].statement}
 		}
 	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
@@ -6199,7 +6166,7 @@ yydefault:
 			yyVAL.statement = &OtherAdmin{}
 		}
 	case 374:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2066
 		{
 			yyVAL.statement = &OtherAdmin{}
@@ -6211,149 +6178,149 @@ yydefault:
 			yyVAL.statement = &OtherAdmin{}
 		}
 	case 376:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2074
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2076
+//line sql.y:2080
 		{
 			yyVAL.statement = &DDL{Action: FlushDDLAction}
 		}
-	case 377:
+	case 378:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2080
+//line sql.y:2084
 		{
 			setAllowComments(yylex, true)
 		}
-	case 378:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2084
+//line sql.y:2088
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 379:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2090
+//line sql.y:2094
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 380:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2094
+//line sql.y:2098
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 381:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2100
+//line sql.y:2104
 		{
 			yyVAL.boolean = true
 		}
-	case 382:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2104
+//line sql.y:2108
 		{
 			yyVAL.boolean = false
 		}
-	case 383:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2108
+//line sql.y:2112
 		{
 			yyVAL.boolean = true
 		}
-	case 384:
+	case 385:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2113
+//line sql.y:2117
 		{
 			yyVAL.str = ""
 		}
-	case 385:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2117
+//line sql.y:2121
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 386:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2121
+//line sql.y:2125
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 387:
+	case 388:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2126
+//line sql.y:2130
 		{
 			yyVAL.boolean = false
 		}
-	case 388:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2130
+//line sql.y:2134
 		{
 			yyVAL.boolean = true
 		}
-	case 389:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2134
+//line sql.y:2138
 		{
 			yyVAL.boolean = true
 		}
-	case 390:
+	case 391:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2139
+//line sql.y:2143
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 391:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2143
+//line sql.y:2147
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 392:
+	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2148
+//line sql.y:2152
 		{
 			yyVAL.strs = nil
 		}
-	case 393:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2152
+//line sql.y:2156
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 394:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2156
+//line sql.y:2160
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 395:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2160
+//line sql.y:2164
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 396:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2164
+//line sql.y:2168
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 397:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2170
-		{
-			yyVAL.str = SQLNoCacheStr
-		}
 	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2174
 		{
-			yyVAL.str = SQLCacheStr
+			yyVAL.str = SQLNoCacheStr
 		}
 	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2178
 		{
-			yyVAL.str = DistinctStr
+			yyVAL.str = SQLCacheStr
 		}
 	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -6365,164 +6332,164 @@ yydefault:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2186
 		{
-			yyVAL.str = StraightJoinHint
+			yyVAL.str = DistinctStr
 		}
 	case 402:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2190
 		{
-			yyVAL.str = SQLCalcFoundRowsStr
+			yyVAL.str = StraightJoinHint
 		}
 	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2196
+//line sql.y:2194
 		{
-			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
+			yyVAL.str = SQLCalcFoundRowsStr
 		}
 	case 404:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2200
 		{
-			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
+			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
 	case 405:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2204
+		{
+			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
+		}
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2206
+//line sql.y:2210
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 406:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2210
+//line sql.y:2214
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 407:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2214
+//line sql.y:2218
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 408:
+	case 409:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2218
+//line sql.y:2222
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 409:
+	case 410:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2223
+//line sql.y:2227
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 410:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2227
+//line sql.y:2231
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 411:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2231
+//line sql.y:2235
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 413:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2238
+//line sql.y:2242
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 414:
+	case 415:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2243
+//line sql.y:2247
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 415:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2247
+//line sql.y:2251
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 416:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2253
+//line sql.y:2257
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 417:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2257
+//line sql.y:2261
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 420:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2267
+//line sql.y:2271
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 421:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2271
+//line sql.y:2275
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 422:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2275
+//line sql.y:2279
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 423:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2281
+//line sql.y:2285
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 424:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2287
+//line sql.y:2291
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 425:
+	case 426:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2291
+//line sql.y:2295
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 426:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2297
+//line sql.y:2301
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 427:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2301
+//line sql.y:2305
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 428:
+	case 429:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2307
+//line sql.y:2311
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 429:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2311
+//line sql.y:2315
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 430:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2324
-		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
-		}
 	case 431:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2328
@@ -6536,91 +6503,91 @@ yydefault:
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
 	case 433:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2336
 		{
-			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr}
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
 	case 434:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2340
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr}
+		}
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2342
+//line sql.y:2346
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 435:
+	case 436:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2344
+//line sql.y:2348
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 436:
+	case 437:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2348
+//line sql.y:2352
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 437:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2350
+//line sql.y:2354
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 438:
+	case 439:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2354
+//line sql.y:2358
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 439:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2356
+//line sql.y:2360
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 440:
+	case 441:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2359
+//line sql.y:2363
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 441:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2361
+//line sql.y:2365
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 442:
+	case 443:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2364
+//line sql.y:2368
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 443:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2368
+//line sql.y:2372
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 444:
+	case 445:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2372
+//line sql.y:2376
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 446:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2379
-		{
-			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
-		}
 	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2385
+//line sql.y:2383
 		{
-			yyVAL.joinType = NormalJoinType
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
 	case 448:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2389
 		{
 			yyVAL.joinType = NormalJoinType
@@ -6632,44 +6599,50 @@ yydefault:
 			yyVAL.joinType = NormalJoinType
 		}
 	case 450:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2399
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2397
 		{
-			yyVAL.joinType = StraightJoinType
+			yyVAL.joinType = NormalJoinType
 		}
 	case 451:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2405
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2403
 		{
-			yyVAL.joinType = LeftJoinType
+			yyVAL.joinType = StraightJoinType
 		}
 	case 452:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2409
 		{
 			yyVAL.joinType = LeftJoinType
 		}
 	case 453:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2413
 		{
-			yyVAL.joinType = RightJoinType
+			yyVAL.joinType = LeftJoinType
 		}
 	case 454:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2417
 		{
 			yyVAL.joinType = RightJoinType
 		}
 	case 455:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2423
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2421
 		{
-			yyVAL.joinType = NaturalJoinType
+			yyVAL.joinType = RightJoinType
 		}
 	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2427
+		{
+			yyVAL.joinType = NaturalJoinType
+		}
+	case 457:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2431
 		{
 			if yyDollar[2].joinType == LeftJoinType {
 				yyVAL.joinType = NaturalLeftJoinType
@@ -6677,419 +6650,413 @@ yydefault:
 				yyVAL.joinType = NaturalRightJoinType
 			}
 		}
-	case 457:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2437
+//line sql.y:2441
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 458:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2441
+//line sql.y:2445
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 459:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2447
+//line sql.y:2451
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 460:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2451
+//line sql.y:2455
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 461:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2457
+//line sql.y:2461
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 462:
+	case 463:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2462
+//line sql.y:2466
 		{
 			yyVAL.indexHints = nil
 		}
-	case 463:
+	case 464:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2466
+//line sql.y:2470
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columns}
 		}
-	case 464:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2470
+//line sql.y:2474
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp}
 		}
-	case 465:
+	case 466:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2474
+//line sql.y:2478
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columns}
 		}
-	case 466:
+	case 467:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2478
+//line sql.y:2482
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columns}
 		}
-	case 467:
+	case 468:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2483
+//line sql.y:2487
 		{
 			yyVAL.expr = nil
 		}
-	case 468:
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2487
+//line sql.y:2491
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 469:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2493
-		{
-			yyVAL.expr = yyDollar[1].expr
-		}
 	case 470:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2497
 		{
-			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+			yyVAL.expr = yyDollar[1].expr
 		}
 	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2501
 		{
-			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
 	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2505
 		{
-			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
 	case 473:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2509
 		{
-			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
+			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
 	case 474:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2513
 		{
-			yyVAL.expr = &IsExpr{Operator: yyDollar[3].isExprOperator, Expr: yyDollar[1].expr}
+			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
 	case 475:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2517
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yyVAL.expr = &IsExpr{Operator: yyDollar[3].isExprOperator, Expr: yyDollar[1].expr}
 		}
 	case 476:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2521
 		{
-			yyVAL.expr = &Default{ColName: yyDollar[2].str}
+			yyVAL.expr = yyDollar[1].expr
 		}
 	case 477:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2527
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2525
 		{
-			yyVAL.str = ""
+			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
 	case 478:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:2531
 		{
-			yyVAL.str = string(yyDollar[2].colIdent.String())
+			yyVAL.str = ""
 		}
 	case 479:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2537
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2535
 		{
-			yyVAL.boolVal = BoolVal(true)
+			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2541
 		{
-			yyVAL.boolVal = BoolVal(false)
+			yyVAL.boolVal = BoolVal(true)
 		}
 	case 481:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2547
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2545
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].comparisonExprOperator, Right: yyDollar[3].expr}
+			yyVAL.boolVal = BoolVal(false)
 		}
 	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2551
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InOp, Right: yyDollar[3].colTuple}
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].comparisonExprOperator, Right: yyDollar[3].expr}
 		}
 	case 483:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2555
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInOp, Right: yyDollar[4].colTuple}
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InOp, Right: yyDollar[3].colTuple}
 		}
 	case 484:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2559
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeOp, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInOp, Right: yyDollar[4].colTuple}
 		}
 	case 485:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2563
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeOp, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeOp, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
 	case 486:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:2567
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeOp, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
 	case 487:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2571
 		{
-			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpOp, Right: yyDollar[4].expr}
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpOp, Right: yyDollar[3].expr}
 		}
 	case 488:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2575
 		{
-			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenOp, From: yyDollar[3].expr, To: yyDollar[5].expr}
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpOp, Right: yyDollar[4].expr}
 		}
 	case 489:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:2579
 		{
-			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenOp, From: yyDollar[4].expr, To: yyDollar[6].expr}
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenOp, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
 	case 490:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 //line sql.y:2583
 		{
-			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenOp, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
 	case 491:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2589
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2587
 		{
-			yyVAL.isExprOperator = IsNullOp
+			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
 	case 492:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2593
 		{
-			yyVAL.isExprOperator = IsNotNullOp
+			yyVAL.isExprOperator = IsNullOp
 		}
 	case 493:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2597
 		{
-			yyVAL.isExprOperator = IsTrueOp
+			yyVAL.isExprOperator = IsNotNullOp
 		}
 	case 494:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2601
 		{
-			yyVAL.isExprOperator = IsNotTrueOp
+			yyVAL.isExprOperator = IsTrueOp
 		}
 	case 495:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2605
 		{
-			yyVAL.isExprOperator = IsFalseOp
+			yyVAL.isExprOperator = IsNotTrueOp
 		}
 	case 496:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2609
 		{
-			yyVAL.isExprOperator = IsNotFalseOp
+			yyVAL.isExprOperator = IsFalseOp
 		}
 	case 497:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2615
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2613
 		{
-			yyVAL.comparisonExprOperator = EqualOp
+			yyVAL.isExprOperator = IsNotFalseOp
 		}
 	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2619
 		{
-			yyVAL.comparisonExprOperator = LessThanOp
+			yyVAL.comparisonExprOperator = EqualOp
 		}
 	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2623
 		{
-			yyVAL.comparisonExprOperator = GreaterThanOp
+			yyVAL.comparisonExprOperator = LessThanOp
 		}
 	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2627
 		{
-			yyVAL.comparisonExprOperator = LessEqualOp
+			yyVAL.comparisonExprOperator = GreaterThanOp
 		}
 	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2631
 		{
-			yyVAL.comparisonExprOperator = GreaterEqualOp
+			yyVAL.comparisonExprOperator = LessEqualOp
 		}
 	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2635
 		{
-			yyVAL.comparisonExprOperator = NotEqualOp
+			yyVAL.comparisonExprOperator = GreaterEqualOp
 		}
 	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2639
 		{
-			yyVAL.comparisonExprOperator = NullSafeEqualOp
+			yyVAL.comparisonExprOperator = NotEqualOp
 		}
 	case 504:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2644
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2643
 		{
-			yyVAL.expr = nil
+			yyVAL.comparisonExprOperator = NullSafeEqualOp
 		}
 	case 505:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:2648
 		{
-			yyVAL.expr = yyDollar[2].expr
+			yyVAL.expr = nil
 		}
 	case 506:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2654
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2652
 		{
-			yyVAL.colTuple = yyDollar[1].valTuple
+			yyVAL.expr = yyDollar[2].expr
 		}
 	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2658
 		{
-			yyVAL.colTuple = yyDollar[1].subquery
+			yyVAL.colTuple = yyDollar[1].valTuple
 		}
 	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2662
 		{
-			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
+			yyVAL.colTuple = yyDollar[1].subquery
 		}
 	case 509:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2668
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2666
 		{
-			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
+			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
 	case 510:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2674
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2672
 		{
-			yyVAL.exprs = Exprs{yyDollar[1].expr}
+			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
 	case 511:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2678
 		{
-			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
 	case 512:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2684
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2682
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
 	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2688
 		{
-			yyVAL.expr = yyDollar[1].boolVal
+			yyVAL.expr = yyDollar[1].expr
 		}
 	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2692
 		{
-			yyVAL.expr = yyDollar[1].colName
+			yyVAL.expr = yyDollar[1].boolVal
 		}
 	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2696
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yyVAL.expr = yyDollar[1].colName
 		}
 	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2700
 		{
-			yyVAL.expr = yyDollar[1].subquery
+			yyVAL.expr = yyDollar[1].expr
 		}
 	case 517:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2704
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndOp, Right: yyDollar[3].expr}
+			yyVAL.expr = yyDollar[1].subquery
 		}
 	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2708
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndOp, Right: yyDollar[3].expr}
 		}
 	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2712
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrOp, Right: yyDollar[3].expr}
 		}
 	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2716
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorOp, Right: yyDollar[3].expr}
 		}
 	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2720
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusOp, Right: yyDollar[3].expr}
 		}
 	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2724
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusOp, Right: yyDollar[3].expr}
 		}
 	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2728
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultOp, Right: yyDollar[3].expr}
 		}
 	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2732
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivOp, Right: yyDollar[3].expr}
 		}
 	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2736
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivOp, Right: yyDollar[3].expr}
 		}
 	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -7101,65 +7068,71 @@ yydefault:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2744
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
 	case 528:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2748
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftOp, Right: yyDollar[3].expr}
 		}
 	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2752
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightOp, Right: yyDollar[3].expr}
 		}
 	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2756
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
 	case 531:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2760
 		{
-			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
 	case 532:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2764
 		{
-			yyVAL.expr = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].expr}
+			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
 	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2768
 		{
-			yyVAL.expr = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].expr}
+			yyVAL.expr = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].expr}
 		}
 	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2772
 		{
-			yyVAL.expr = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].expr}
+			yyVAL.expr = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].expr}
 		}
 	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2776
 		{
-			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].expr}
+			yyVAL.expr = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].expr}
 		}
 	case 536:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2780
 		{
-			yyVAL.expr = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].expr}
+			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].expr}
 		}
 	case 537:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2784
+		{
+			yyVAL.expr = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].expr}
+		}
+	case 538:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2788
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -7167,9 +7140,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 538:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2792
+//line sql.y:2796
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -7183,21 +7156,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 539:
+	case 540:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2806
+//line sql.y:2810
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].expr}
 		}
-	case 540:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2810
+//line sql.y:2814
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].expr}
 		}
-	case 541:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2814
+//line sql.y:2818
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -7205,17 +7178,11 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 546:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2832
-		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
-		}
 	case 547:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2836
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
 	case 548:
 		yyDollar = yyS[yypt-5 : yypt+1]
@@ -7224,28 +7191,28 @@ yydefault:
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
 	case 549:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:2844
 		{
-			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
 	case 550:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2854
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2848
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
 	case 551:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2858
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 552:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2862
 		{
-			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 553:
 		yyDollar = yyS[yypt-6 : yypt+1]
@@ -7257,13 +7224,13 @@ yydefault:
 		yyDollar = yyS[yypt-6 : yypt+1]
 //line sql.y:2870
 		{
-			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
 	case 555:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 //line sql.y:2874
 		{
-			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
 	case 556:
 		yyDollar = yyS[yypt-8 : yypt+1]
@@ -7275,7 +7242,7 @@ yydefault:
 		yyDollar = yyS[yypt-8 : yypt+1]
 //line sql.y:2882
 		{
-			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
 	case 558:
 		yyDollar = yyS[yypt-8 : yypt+1]
@@ -7284,166 +7251,166 @@ yydefault:
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
 	case 559:
-		yyDollar = yyS[yypt-9 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 //line sql.y:2890
 		{
-			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].matchExprOption}
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
 	case 560:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-9 : yypt+1]
 //line sql.y:2894
 		{
-			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].boolean, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
+			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].matchExprOption}
 		}
 	case 561:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 //line sql.y:2898
 		{
-			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].boolean, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
 	case 562:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:2902
 		{
-			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
 	case 563:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2912
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2906
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
+			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
 	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2916
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
 	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2920
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
 	case 566:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2925
+//line sql.y:2924
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
 	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2930
+//line sql.y:2929
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2935
+//line sql.y:2934
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
 	case 569:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2941
+//line sql.y:2939
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
 	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2946
+//line sql.y:2945
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2951
+//line sql.y:2950
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
 	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2955
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
 	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2959
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
+		}
+	case 574:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2963
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 574:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2964
+//line sql.y:2968
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 575:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2969
+//line sql.y:2973
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 576:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2974
+//line sql.y:2978
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 577:
+	case 578:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2978
+//line sql.y:2982
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 578:
+	case 579:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2982
+//line sql.y:2986
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 581:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2992
+//line sql.y:2996
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 582:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3002
-		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
-		}
 	case 583:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:3006
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:3010
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 585:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:3014
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 586:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:3018
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 587:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:3022
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 588:
 		yyDollar = yyS[yypt-4 : yypt+1]
@@ -7452,105 +7419,105 @@ yydefault:
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 589:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3032
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3030
 		{
-			yyVAL.matchExprOption = NoOption
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 590:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:3036
 		{
-			yyVAL.matchExprOption = BooleanModeOpt
+			yyVAL.matchExprOption = NoOption
 		}
 	case 591:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3040
 		{
-			yyVAL.matchExprOption = NaturalLanguageModeOpt
+			yyVAL.matchExprOption = BooleanModeOpt
 		}
 	case 592:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:3044
 		{
-			yyVAL.matchExprOption = NaturalLanguageModeWithQueryExpansionOpt
+			yyVAL.matchExprOption = NaturalLanguageModeOpt
 		}
 	case 593:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-7 : yypt+1]
 //line sql.y:3048
 		{
-			yyVAL.matchExprOption = QueryExpansionOpt
+			yyVAL.matchExprOption = NaturalLanguageModeWithQueryExpansionOpt
 		}
 	case 594:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3054
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3052
 		{
-			yyVAL.str = string(yyDollar[1].colIdent.String())
+			yyVAL.matchExprOption = QueryExpansionOpt
 		}
 	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3058
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
 	case 596:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3064
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3062
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 597:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3068
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Operator: CharacterSetOp}
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3072
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: string(yyDollar[3].colIdent.String())}
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 	case 599:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3076
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: string(yyDollar[3].colIdent.String())}
 		}
 	case 600:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3080
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 601:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3084
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
-			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 602:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3090
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3088
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 603:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3094
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 604:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3098
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 605:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3102
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
@@ -7559,143 +7526,149 @@ yydefault:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3106
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 607:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3110
 		{
-			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 608:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3114
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 609:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3118
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 610:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3119
+//line sql.y:3123
 		{
 			yyVAL.expr = nil
 		}
-	case 610:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3123
+//line sql.y:3127
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 611:
+	case 612:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3128
+//line sql.y:3132
 		{
 			yyVAL.str = string("")
 		}
-	case 612:
+	case 613:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3132
+//line sql.y:3136
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 613:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3138
+//line sql.y:3142
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 614:
+	case 615:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3142
+//line sql.y:3146
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 615:
+	case 616:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3148
+//line sql.y:3152
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 616:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3153
+//line sql.y:3157
 		{
 			yyVAL.expr = nil
 		}
-	case 617:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3157
+//line sql.y:3161
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 618:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3163
+//line sql.y:3167
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 619:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3167
+//line sql.y:3171
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 620:
+	case 621:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3171
+//line sql.y:3175
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 621:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3177
-		{
-			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
-		}
 	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3181
 		{
-			yyVAL.expr = NewHexLiteral(yyDollar[1].bytes)
+			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
 	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3185
 		{
-			yyVAL.expr = NewBitLiteral(yyDollar[1].bytes)
+			yyVAL.expr = NewHexLiteral(yyDollar[1].bytes)
 		}
 	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3189
 		{
-			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
+			yyVAL.expr = NewBitLiteral(yyDollar[1].bytes)
 		}
 	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3193
 		{
-			yyVAL.expr = NewFloatLiteral(yyDollar[1].bytes)
+			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
 	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3197
 		{
-			yyVAL.expr = NewHexNumLiteral(yyDollar[1].bytes)
+			yyVAL.expr = NewFloatLiteral(yyDollar[1].bytes)
 		}
 	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3201
 		{
-			yyVAL.expr = NewArgument(yyDollar[1].bytes)
+			yyVAL.expr = NewHexNumLiteral(yyDollar[1].bytes)
 		}
 	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3205
 		{
-			yyVAL.expr = &NullVal{}
+			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
 	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3211
+//line sql.y:3209
+		{
+			yyVAL.expr = &NullVal{}
+		}
+	case 630:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3215
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -7704,411 +7677,411 @@ yydefault:
 			}
 			yyVAL.expr = NewIntLiteral([]byte("1"))
 		}
-	case 630:
+	case 631:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3220
+//line sql.y:3224
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 631:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3224
+//line sql.y:3228
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 632:
+	case 633:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3229
+//line sql.y:3233
 		{
 			yyVAL.exprs = nil
 		}
-	case 633:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3233
+//line sql.y:3237
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 634:
+	case 635:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3238
+//line sql.y:3242
 		{
 			yyVAL.expr = nil
 		}
-	case 635:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3242
+//line sql.y:3246
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 636:
+	case 637:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3247
+//line sql.y:3251
 		{
 			yyVAL.orderBy = nil
 		}
-	case 637:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3251
+//line sql.y:3255
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 638:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3257
+//line sql.y:3261
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 639:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3261
+//line sql.y:3265
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 640:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3267
+//line sql.y:3271
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].orderDirection}
 		}
-	case 641:
+	case 642:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3272
+//line sql.y:3276
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 642:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3276
+//line sql.y:3280
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 643:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3280
+//line sql.y:3284
 		{
 			yyVAL.orderDirection = DescOrder
 		}
-	case 644:
+	case 645:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3285
+//line sql.y:3289
 		{
 			yyVAL.limit = nil
 		}
-	case 645:
+	case 646:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3289
+//line sql.y:3293
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 646:
+	case 647:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3293
+//line sql.y:3297
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 647:
+	case 648:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3297
+//line sql.y:3301
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 648:
+	case 649:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3302
+//line sql.y:3306
 		{
 			yyVAL.lock = NoLock
 		}
-	case 649:
+	case 650:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3306
+//line sql.y:3310
 		{
 			yyVAL.lock = ForUpdateLock
 		}
-	case 650:
+	case 651:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3310
+//line sql.y:3314
 		{
 			yyVAL.lock = ShareModeLock
 		}
-	case 651:
+	case 652:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3315
+//line sql.y:3319
 		{
 			yyVAL.selectInto = nil
 		}
-	case 652:
+	case 653:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3319
+//line sql.y:3323
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfileS3, FileName: string(yyDollar[4].bytes), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
-	case 653:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3323
+//line sql.y:3327
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoDumpfile, FileName: string(yyDollar[3].bytes), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
-	case 654:
+	case 655:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3327
+//line sql.y:3331
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfile, FileName: string(yyDollar[3].bytes), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
-	case 655:
+	case 656:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3332
+//line sql.y:3336
 		{
 			yyVAL.str = ""
 		}
-	case 656:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3336
+//line sql.y:3340
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 657:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3340
+//line sql.y:3344
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 658:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3345
+//line sql.y:3349
 		{
 			yyVAL.str = ""
 		}
-	case 659:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3349
+//line sql.y:3353
 		{
 			yyVAL.str = " header"
 		}
-	case 660:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3354
+//line sql.y:3358
 		{
 			yyVAL.str = ""
 		}
-	case 661:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3358
+//line sql.y:3362
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 662:
+	case 663:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3362
+//line sql.y:3366
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 663:
+	case 664:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3367
+//line sql.y:3371
 		{
 			yyVAL.str = ""
 		}
-	case 664:
+	case 665:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3371
+//line sql.y:3375
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 665:
+	case 666:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3375
+//line sql.y:3379
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 666:
+	case 667:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3381
+//line sql.y:3385
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 667:
+	case 668:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3386
+//line sql.y:3390
 		{
 			yyVAL.str = ""
 		}
-	case 668:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3390
+//line sql.y:3394
 		{
 			yyVAL.str = " lines" + yyDollar[2].str + yyDollar[3].str
 		}
-	case 669:
+	case 670:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3395
+//line sql.y:3399
 		{
 			yyVAL.str = ""
 		}
-	case 670:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3399
+//line sql.y:3403
 		{
 			yyVAL.str = " starting by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 671:
+	case 672:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3404
+//line sql.y:3408
 		{
 			yyVAL.str = ""
 		}
-	case 672:
+	case 673:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3408
+//line sql.y:3412
 		{
 			yyVAL.str = " terminated by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 673:
+	case 674:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3413
+//line sql.y:3417
 		{
 			yyVAL.str = ""
 		}
-	case 674:
+	case 675:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3417
+//line sql.y:3421
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str + yyDollar[3].str + yyDollar[4].str
 		}
-	case 675:
+	case 676:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3422
+//line sql.y:3426
 		{
 			yyVAL.str = ""
 		}
-	case 676:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3426
+//line sql.y:3430
 		{
 			yyVAL.str = " escaped by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 677:
+	case 678:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3431
+//line sql.y:3435
 		{
 			yyVAL.str = ""
 		}
-	case 678:
+	case 679:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3435
+//line sql.y:3439
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by '" + string(yyDollar[4].bytes) + "'"
 		}
-	case 679:
+	case 680:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3440
+//line sql.y:3444
 		{
 			yyVAL.str = ""
 		}
-	case 680:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3444
+//line sql.y:3448
 		{
 			yyVAL.str = " optionally"
 		}
-	case 681:
+	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3457
+//line sql.y:3461
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 682:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3461
+//line sql.y:3465
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 683:
+	case 684:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3465
+//line sql.y:3469
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 684:
+	case 685:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3469
+//line sql.y:3473
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[4].values}
 		}
-	case 685:
+	case 686:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3473
+//line sql.y:3477
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 686:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3479
+//line sql.y:3483
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 687:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3483
+//line sql.y:3487
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 688:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3487
+//line sql.y:3491
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 689:
+	case 690:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3491
+//line sql.y:3495
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 690:
+	case 691:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3496
+//line sql.y:3500
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 691:
+	case 692:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3500
+//line sql.y:3504
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 692:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3506
+//line sql.y:3510
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 693:
+	case 694:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3510
+//line sql.y:3514
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 694:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3516
+//line sql.y:3520
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 695:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3520
+//line sql.y:3524
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 696:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3526
+//line sql.y:3530
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 697:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3532
+//line sql.y:3536
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -8116,342 +8089,348 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 698:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3542
+//line sql.y:3546
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 699:
+	case 700:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3546
+//line sql.y:3550
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 700:
+	case 701:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3552
+//line sql.y:3556
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 701:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3558
-		{
-			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
-		}
 	case 702:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3562
 		{
-			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
+			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
 	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3568
+//line sql.y:3566
 		{
-			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("on"))}
+			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
 	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3572
 		{
-			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("off"))}
+			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("on"))}
 		}
 	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3576
 		{
-			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].expr}
+			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("off"))}
 		}
 	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3580
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Scope: ImplicitScope, Expr: yyDollar[2].expr}
+			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].expr}
 		}
 	case 707:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3584
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Scope: ImplicitScope, Expr: yyDollar[2].expr}
+		}
+	case 708:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3588
 		{
 			yyDollar[2].setExpr.Scope = yyDollar[1].scope
 			yyVAL.setExpr = yyDollar[2].setExpr
 		}
-	case 709:
+	case 710:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3592
+//line sql.y:3596
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 711:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3599
+//line sql.y:3603
 		{
 			yyVAL.expr = NewStrLiteral([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 712:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3603
+//line sql.y:3607
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 713:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3607
+//line sql.y:3611
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 716:
+	case 717:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3616
+//line sql.y:3620
 		{
 			yyVAL.boolean = false
 		}
-	case 717:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3618
+//line sql.y:3622
 		{
 			yyVAL.boolean = true
 		}
-	case 718:
+	case 719:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3621
+//line sql.y:3625
 		{
 			yyVAL.boolean = false
 		}
-	case 719:
+	case 720:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3623
+//line sql.y:3627
 		{
 			yyVAL.boolean = true
 		}
-	case 720:
+	case 721:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3626
+//line sql.y:3630
 		{
 			yyVAL.ignore = false
 		}
-	case 721:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3628
-		{
-			yyVAL.ignore = true
-		}
 	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3632
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.ignore = true
 		}
 	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3634
+//line sql.y:3636
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3636
+//line sql.y:3638
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3638
+//line sql.y:3640
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3640
+//line sql.y:3642
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3642
+//line sql.y:3644
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3644
+//line sql.y:3646
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 729:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3646
+//line sql.y:3648
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3648
+//line sql.y:3650
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3650
+//line sql.y:3652
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 732:
-		yyDollar = yyS[yypt-0 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3654
 		{
-			yyVAL.OnlineDDLHint = &OnlineDDLHint{}
+			yyVAL.empty = struct{}{}
 		}
 	case 733:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:3658
+		{
+			yyVAL.OnlineDDLHint = &OnlineDDLHint{}
+		}
+	case 734:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3662
+		{
+			yyVAL.OnlineDDLHint = yyDollar[1].OnlineDDLHint
+		}
+	case 735:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3668
 		{
 			yyVAL.OnlineDDLHint = &OnlineDDLHint{
 				Strategy: DDLStrategy(yyDollar[2].bytes),
 			}
 		}
-	case 734:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3664
+//line sql.y:3674
 		{
 			yyVAL.OnlineDDLHint = &OnlineDDLHint{
 				Strategy: DDLStrategy(yyDollar[2].bytes),
 				Options:  string(yyDollar[3].bytes),
 			}
 		}
-	case 735:
+	case 737:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3672
+//line sql.y:3682
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 736:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3674
+//line sql.y:3684
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 737:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3676
+//line sql.y:3686
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 738:
+	case 740:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3680
+//line sql.y:3690
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 739:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3682
+//line sql.y:3692
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 740:
+	case 742:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3685
+//line sql.y:3695
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 741:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3687
+//line sql.y:3697
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 742:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3689
+//line sql.y:3699
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 743:
+	case 745:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3692
+//line sql.y:3702
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 744:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3694
+//line sql.y:3704
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 745:
+	case 747:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3698
+//line sql.y:3708
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 746:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3702
+//line sql.y:3712
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 748:
+	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3709
+//line sql.y:3719
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 749:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3715
+//line sql.y:3725
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 750:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3719
+//line sql.y:3729
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 752:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3726
+//line sql.y:3736
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 1066:
+	case 1068:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4065
+//line sql.y:4075
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1067:
+	case 1069:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4074
+//line sql.y:4084
 		{
 			decNesting(yylex)
 		}
-	case 1068:
+	case 1070:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4079
+//line sql.y:4089
 		{
 			skipToEnd(yylex)
 		}
-	case 1069:
+	case 1071:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4084
+//line sql.y:4094
 		{
 			skipToEnd(yylex)
 		}
-	case 1070:
+	case 1072:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4088
+//line sql.y:4098
 		{
 			skipToEnd(yylex)
 		}
-	case 1071:
+	case 1073:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4092
+//line sql.y:4102
 		{
 			skipToEnd(yylex)
 		}
@@ -306,7 +306,7 @@ func skipToEnd(yylex interface{}) {
 %type <bytes> for_from
 %type <str> default_opt
 %type <ignore> ignore_opt
-%type <OnlineDDLHint> online_hint_opt
+%type <OnlineDDLHint> online_hint_opt online_hint
 %type <str> full_opt from_database_opt tables_or_processlist columns_or_fields extended_opt
 %type <showFilter> like_or_where_opt like_opt
 %type <boolean> exists_opt not_exists_opt null_opt enforced_opt
@@ -1631,6 +1631,10 @@ drop_statement:
   {
     $$ = &DDL{Action: DropDDLAction, FromTables: $4, IfExists: $3}
   }
+| DROP online_hint TABLE exists_opt table_name_list
+  {
+    $$ = &DDL{Action: DropDDLAction, OnlineHint: $2, FromTables: $5, IfExists: $4}
+  }
 | DROP INDEX id_or_var ON table_name ddl_skip_to_end
   {
     // Change this to an alter statement
@@ -3654,7 +3658,13 @@ online_hint_opt:
   {
     $$ = &OnlineDDLHint{}
   }
-| WITH STRING
+| online_hint
+  {
+    $$ = $1
+  }
+
+online_hint:
+  WITH STRING
   {
     $$ = &OnlineDDLHint{
         Strategy: DDLStrategy($2),
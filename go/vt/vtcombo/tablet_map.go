@@ -41,6 +41,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/wrangler"
@@ -96,6 +97,7 @@ func CreateTablet(ctx context.Context, ts *topo.Server, cell string, uid uint32,
 		MysqlDaemon:         mysqld,
 		DBConfigs:           dbcfgs,
 		QueryServiceControl: controller,
+		VDiffEngine:         vdiff.NewEngine(ts, cell),
 	}
 	tablet := &topodatapb.Tablet{
 		Alias: alias,
@@ -557,6 +559,14 @@ func (itmc *internalTabletManagerClient) GetSchema(ctx context.Context, tablet *
 	return t.tm.GetSchema(ctx, tables, excludeTables, includeViews)
 }
 
+func (itmc *internalTabletManagerClient) GetSchemaHistory(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHistoryRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.GetSchemaHistory(ctx, request)
+}
+
 func (itmc *internalTabletManagerClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -700,7 +710,11 @@ func (itmc *internalTabletManagerClient) GetSlaves(ctx context.Context, tablet *
 }
 
 func (itmc *internalTabletManagerClient) VExec(ctx context.Context, tablet *topodatapb.Tablet, query, workflow, keyspace string) (*querypb.QueryResult, error) {
-	return nil, fmt.Errorf("not implemented in vtcombo")
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.VExec(ctx, query, workflow, keyspace)
 }
 
 func (itmc *internalTabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
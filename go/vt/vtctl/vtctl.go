@@ -117,6 +117,7 @@ import (
 	"vitess.io/vitess/go/vt/wrangler"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -315,11 +316,14 @@ var commands = []commandGroup{
 				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-skip_schema_copy] <keyspace.workflow> <source_shards> <target_shards>",
 				"Start a Resharding process. Example: Reshard -cells='zone1,alias1' -tablet_types='master,replica,rdonly'  ks.workflow001 '0' '-80,80-'"},
 			{"MoveTables", commandMoveTables,
-				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
-				`Move table(s) to another keyspace, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{"column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{"column": "id2", "name": "hash"}]}}'.  In the case of an unsharded target keyspace the vschema for each table may be empty. Example: '{"t1":{}, "t2":{}}'.`},
+				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-external_mysql=<name>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
+				`Move table(s) to another keyspace, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{"column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{"column": "id2", "name": "hash"}]}}'.  In the case of an unsharded target keyspace the vschema for each table may be empty. Example: '{"t1":{}, "t2":{}}'. With -external_mysql, the tables are moved from an external mysql configured on the target tablets, with or without GTIDs, and must already exist in the target keyspace.`},
+			{"Merge", commandMerge,
+				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> -on_conflict=<fail|skip|last_writer_wins> <source_keyspaces> <target_keyspace> <tables>",
+				"Merge table(s) from several keyspaces into another keyspace. source_keyspaces and tables are comma-separated lists. Rows with the same primary key in different source keyspaces are resolved according to on_conflict, and are recorded in the _vt.vreplication_conflicts table of the target tablets. Example: Merge -workflow=merge -on_conflict=fail 'us,eu' global 't1,t2'"},
 			{"DropSources", commandDropSources,
 				"[-dry_run] [-rename_tables] <keyspace.workflow>",
-				"After a MoveTables or Resharding workflow cleanup unused artifacts like source tables, source shards and blacklists"},
+				"After a MoveTables, Materialize or Resharding workflow cleanup unused artifacts like source tables, source shards and blacklists"},
 			{"CreateLookupVindex", commandCreateLookupVindex,
 				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] <keyspace> <json_spec>",
 				`Create and backfill a lookup vindex. the json_spec must contain the vindex and colvindex specs for the new lookup.`},
@@ -327,8 +331,8 @@ var commands = []commandGroup{
 				"<keyspace>.<vindex>",
 				`Externalize a backfilled vindex.`},
 			{"Materialize", commandMaterialize,
-				`<json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
-				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
+				`[-sink=<url>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
+				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL. If the source expressions only select and rename columns, SwitchReads and SwitchWrites can cut over to the materialized tables. With -sink, the changes are written to the sink by the target masters instead of being applied to the target tables, which are not created. Example sinks: file:///data/customer.json, https://host/path."},
 			{"SplitClone", commandSplitClone,
 				"<keyspace> <from_shards> <to_shards>",
 				"Start the SplitClone process to perform horizontal resharding. Example: SplitClone ks '0' '-80,80-'"},
@@ -338,6 +342,14 @@ var commands = []commandGroup{
 			{"VDiff", commandVDiff,
 				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
 				"Perform a diff of all tables in the workflow"},
+			{"TabletVDiff", commandTabletVDiff,
+				"[-tables=<table1>,<table2>,...] [-recheck=<vdiff_uuid>] <keyspace.workflow> <create|stop|resume|show|delete> [<vdiff_uuid>]",
+				"Operates on vdiffs run by the target tablets of the workflow. A tablet-side vdiff checkpoints its progress, and can be stopped and resumed. Examples:" +
+					" \nvtctl TabletVDiff commerce.wf create" +
+					" \nvtctl TabletVDiff -recheck=82fa54ac_e83e_11ea_96b7_f875a4d24e90 commerce.wf create" +
+					" \nvtctl TabletVDiff commerce.wf stop 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl TabletVDiff commerce.wf show" +
+					" \nvtctl TabletVDiff commerce.wf show 82fa54ac_e83e_11ea_96b7_f875a4d24e90"},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-filtered_replication_wait_time=30s] [-reverse_replication=false] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -388,8 +400,11 @@ var commands = []commandGroup{
 	{
 		"Schema, Version, Permissions", []command{
 			{"GetSchema", commandGetSchema,
-				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] <tablet alias>",
-				"Displays the full schema for a tablet, or just the schema for the specified tables in that tablet."},
+				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-as_of=<position or time>] <tablet alias>",
+				"Displays the full schema for a tablet, or just the schema for the specified tables in that tablet. If -as_of is set, the schema is reconstructed as of the given replication position or RFC 3339 time from the schema versions recorded by the tablet's schema tracker (see -track_schema_versions), and only holds the tables' columns and primary keys."},
+			{"GetSchemaHistory", commandGetSchemaHistory,
+				"[-limit=<n>] <keyspace/shard> <table>",
+				"Displays every definition of the table recorded by the schema tracker of the shard's master, with the replication position, DDL and time of the change. If -limit is set, only the n most recent definitions are displayed."},
 			{"ReloadSchema", commandReloadSchema,
 				"<tablet alias>",
 				"Reloads the schema on a remote tablet."},
@@ -405,9 +420,12 @@ var commands = []commandGroup{
 			{"ValidateSchemaKeyspace", commandValidateSchemaKeyspace,
 				"[-exclude_tables=''] [-include-views] [-skip-no-master] <keyspace name>",
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
+			{"RepairSchemaKeyspace", commandRepairSchemaKeyspace,
+				"-reference_shard=<shard> [-exclude_tables=''] [-ddl_strategy=<strategy>] [-apply] [-allow_drops] <keyspace name>",
+				"Prints the statements which converge the schema of the shard masters of the keyspace to the schema of the master of the reference shard. Views and internal tables are ignored. If -apply is set, the statements are also applied: missing tables are created directly on the drifted shards, while ALTER and DROP TABLE statements are submitted to the drifted shards only, as online DDL migrations with -ddl_strategy, or applied directly when it is empty. Nothing is applied if a table would be dropped, unless -allow_drops is set."},
 			{"ApplySchema", commandApplySchema,
-				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected."},
+				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-declarative] [-allow_drops] [-dry_run] [-ddl_strategy=<strategy>] [-migration_context=<context>] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. If -declarative is set, the SQL is the complete desired set of CREATE TABLE statements for the keyspace, and the CREATE, ALTER and DROP TABLE statements which bring the current schema to it are applied instead; ALTER TABLE and DROP TABLE statements are submitted with -ddl_strategy. Tables missing from the desired schema are only dropped if -allow_drops is set. All shards of the keyspace must need the same statements. If -dry_run is set, the statements are not applied. Instead, each is printed with an impact analysis: whether MySQL applies it instantly, in place or by copying the table, its estimated duration, and whether it breaks a vindex column, a routing rule or a running vreplication stream. The analysis reads the schema and MySQL version of every master of the keyspace, and the vreplication streams of the masters of every keyspace. Online DDL migrations submitted with -migration_context succeed or fail together: on every shard, each of them waits to cut over until all the others are ready to, and once any of them fails or is cancelled, the rest are cancelled. ALTER TABLE migrations with a context must use the online strategy, and must each be on a different table."},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-skip-verify] [-wait_replicas_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -417,10 +435,14 @@ var commands = []commandGroup{
 					" \nvtctl OnlineDDL test_keyspace show 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
 					" \nvtctl OnlineDDL test_keyspace show all" +
 					" \nvtctl OnlineDDL test_keyspace show running" +
+					" \nvtctl OnlineDDL test_keyspace show ready_to_complete" +
 					" \nvtctl OnlineDDL test_keyspace show complete" +
 					" \nvtctl OnlineDDL test_keyspace show failed" +
 					" \nvtctl OnlineDDL test_keyspace retry 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
-					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
+					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace complete 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace revert 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace undrop 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
 			},
 
 			{"ValidateVersionShard", commandValidateVersionShard,
@@ -444,8 +466,8 @@ var commands = []commandGroup{
 				"<keyspace>",
 				"Displays the VTGate routing schema."},
 			{"ApplyVSchema", commandApplyVSchema,
-				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace>",
-				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application."},
+				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-skip_validation] [-dry-run] <keyspace>",
+				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application. Unless -skip_validation is set, the VTGate routing schema is first validated against the schema of the master of the first shard of each keyspace involved: its tables and vindex columns must exist, lookup vindex backing tables must exist with a unique key, and sequences must be sequence tables. Errors prevent the application, warnings do not."},
 			{"GetRoutingRules", commandGetRoutingRules,
 				"",
 				"Displays the VSchema routing rules."},
@@ -494,6 +516,10 @@ var commands = []commandGroup{
 				"<ks.workflow> <action> --dry-run",
 				"Start/Stop/Delete/Show/ListAll Workflow on all target tablets in workflow. Example: Workflow merchant.morders Start",
 			},
+			{"SetWorkflowSchemaChangePolicy", commandSetWorkflowSchemaChangePolicy,
+				"[-dry_run] <ks.workflow> <policy>",
+				"Sets the policy of the streams of the workflow for the DDLs of the source tables, and restarts them. With apply_additive, additive changes are applied to target tables that mirror the source tables, and the streams stop on any other change. An empty policy handles DDLs according to the on_ddl setting of the streams. Example: SetWorkflowSchemaChangePolicy merchant.morders apply_additive",
+			},
 		},
 	},
 }
@@ -1909,6 +1935,7 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	workflow := subFlags.String("workflow", "", "Workflow name. Can be any descriptive string. Will be used to later migrate traffic via SwitchReads/SwitchWrites.")
 	cells := subFlags.String("cells", "", "Cell(s) or CellAlias(es) (comma-separated) to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from (e.g. master, replica, rdonly). Defaults to -vreplication_tablet_type parameter value for the tablet, which has the default value of replica.")
+	externalMysql := subFlags.String("external_mysql", "", "Name of the external mysql to move the tables from, as configured in the externalConnections of the target tablets.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1921,7 +1948,7 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	source := subFlags.Arg(0)
 	target := subFlags.Arg(1)
 	tableSpecs := subFlags.Arg(2)
-	return wr.MoveTables(ctx, *workflow, source, target, tableSpecs, *cells, *tabletTypes)
+	return wr.MoveTables(ctx, *workflow, source, target, tableSpecs, *cells, *tabletTypes, *externalMysql)
 }
 
 func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -1951,7 +1978,28 @@ func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return wr.ExternalizeVindex(ctx, subFlags.Arg(0))
 }
 
+func commandMerge(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	workflow := subFlags.String("workflow", "", "Workflow name. Can be any descriptive string.")
+	onConflict := subFlags.String("on_conflict", "", "How to resolve rows with the same primary key in different source keyspaces: fail stops the workflow, skip keeps the existing row, last_writer_wins overwrites it.")
+	cells := subFlags.String("cells", "", "Cell(s) or CellAlias(es) (comma-separated) to replicate from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from (e.g. master, replica, rdonly). Defaults to -vreplication_tablet_type parameter value for the tablet, which has the default value of replica.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if *workflow == "" {
+		return fmt.Errorf("a workflow name must be specified")
+	}
+	if subFlags.NArg() != 3 {
+		return fmt.Errorf("three arguments are required: source_keyspaces, target_keyspace, tables")
+	}
+	sources := strings.Split(subFlags.Arg(0), ",")
+	target := subFlags.Arg(1)
+	tables := subFlags.Arg(2)
+	return wr.Merge(ctx, *workflow, sources, target, tables, *cells, *tabletTypes, *onConflict)
+}
+
 func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	sink := subFlags.String("sink", "", "If set, the URL of a sink that the changes are written to instead of the target tables, e.g. file:///data/changes.json or https://host/path")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1962,6 +2010,9 @@ func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if err := json2.Unmarshal([]byte(subFlags.Arg(0)), ms); err != nil {
 		return err
 	}
+	if *sink != "" {
+		return wr.MaterializeToSink(ctx, ms, *sink)
+	}
 	return wr.Materialize(ctx, ms)
 }
 
@@ -2024,6 +2075,79 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	return err
 }
 
+func commandTabletVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	tables := subFlags.String("tables", "", "With create: only diff these tables of the workflow")
+	recheck := subFlags.String("recheck", "", "With create: only diff the rows reported as mismatched by this previous vdiff")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 2 {
+		return fmt.Errorf("the <keyspace.workflow> and <command> arguments are required for the TabletVDiff command")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	command := subFlags.Arg(1)
+	uuid := subFlags.Arg(2)
+	where := ""
+	if uuid != "" {
+		where = fmt.Sprintf(" where vdiff_uuid=%s", sqlparser.String(sqlparser.NewStrLiteral([]byte(uuid))))
+	}
+
+	query := ""
+	switch command {
+	case "create":
+		if uuid == "" {
+			if uuid, err = schema.CreateUUID(); err != nil {
+				return err
+			}
+		}
+		// These are the options of the tablet-side vdiff, see vdiff.Options.
+		options := struct {
+			Tables  []string `json:",omitempty"`
+			Recheck string   `json:",omitempty"`
+		}{
+			Recheck: *recheck,
+		}
+		if *tables != "" {
+			options.Tables = strings.Split(*tables, ",")
+		}
+		optionsJSON, err := json.Marshal(options)
+		if err != nil {
+			return err
+		}
+		query = fmt.Sprintf("insert into _vt.vdiff (vdiff_uuid, workflow, options) values (%s, %s, %s)",
+			sqlparser.String(sqlparser.NewStrLiteral([]byte(uuid))),
+			sqlparser.String(sqlparser.NewStrLiteral([]byte(workflow))),
+			sqlparser.String(sqlparser.NewStrLiteral(optionsJSON)))
+	case "stop":
+		query = "update _vt.vdiff set state='stopped'" + where
+	case "resume":
+		query = "update _vt.vdiff set state='pending'" + where
+	case "delete":
+		query = "delete from _vt.vdiff" + where
+	case "show":
+		if uuid == "" {
+			query = "select vdiff_uuid, state, options, created_timestamp, started_timestamp, completed_timestamp, last_error from _vt.vdiff"
+		} else {
+			query = "select vdiff_uuid, table_name, state, rows_compared, mismatch, report from _vt.vdiff_table" + where
+		}
+	default:
+		return fmt.Errorf("Unknown TabletVDiff command: %s", command)
+	}
+
+	qr, err := wr.VExecResult(ctx, workflow, keyspace, query, false)
+	if err != nil {
+		return err
+	}
+	if command == "create" {
+		wr.Logger().Printf("VDiff %s created\n", uuid)
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, qr)
+	return nil
+}
+
 func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
 	splits := strings.Split(in, ".")
 	if len(splits) != 2 {
@@ -2301,6 +2425,7 @@ func commandGetSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
 	includeViews := subFlags.Bool("include-views", false, "Includes views in the output")
 	tableNamesOnly := subFlags.Bool("table_names_only", false, "Only displays table names that match")
+	asOf := subFlags.String("as_of", "", "Reconstructs the schema as of a replication position, or an RFC 3339 time, from the recorded schema versions")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2320,7 +2445,12 @@ func commandGetSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 		excludeTableArray = strings.Split(*excludeTables, ",")
 	}
 
-	sd, err := wr.GetSchema(ctx, tabletAlias, tableArray, excludeTableArray, *includeViews)
+	var sd *tabletmanagerdatapb.SchemaDefinition
+	if *asOf != "" {
+		sd, err = wr.GetSchemaAsOf(ctx, tabletAlias, *asOf, tableArray, excludeTableArray, *includeViews)
+	} else {
+		sd, err = wr.GetSchema(ctx, tabletAlias, tableArray, excludeTableArray, *includeViews)
+	}
 	if err != nil {
 		return err
 	}
@@ -2333,6 +2463,28 @@ func commandGetSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 	return printJSON(wr.Logger(), sd)
 }
 
+func commandGetSchemaHistory(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	limit := subFlags.Int64("limit", 0, "Only displays the n most recent definitions of the table")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace/shard> and <table> arguments are required for the GetSchemaHistory command")
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	versions, err := wr.GetSchemaHistory(ctx, keyspace, shard, &tabletmanagerdatapb.GetSchemaHistoryRequest{
+		Tables: []string{subFlags.Arg(1)},
+		Limit:  *limit,
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), versions)
+}
+
 func commandReloadSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -2418,6 +2570,34 @@ func commandValidateSchemaKeyspace(ctx context.Context, wr *wrangler.Wrangler, s
 	return wr.ValidateSchemaKeyspace(ctx, keyspace, excludeTableArray, *includeViews, *skipNoMaster)
 }
 
+func commandRepairSchemaKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	referenceShard := subFlags.String("reference_shard", "", "The shard whose schema the other shards are converged to")
+	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
+	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyNormal), "The online DDL strategy for ALTER and DROP TABLE statements: gh-ost, pt-osc or online. Empty applies them directly.")
+	apply := subFlags.Bool("apply", false, "Apply the statements which converge the drifted shards, rather than only print them.")
+	allowDrops := subFlags.Bool("allow_drops", false, "With -apply, drop the tables which are missing from the reference shard.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace name> argument is required for the RepairSchemaKeyspace command")
+	}
+	if *referenceShard == "" {
+		return fmt.Errorf("the -reference_shard flag is required for the RepairSchemaKeyspace command")
+	}
+	strategy, err := schema.ValidateDDLStrategy(*ddlStrategy)
+	if err != nil {
+		return err
+	}
+
+	keyspace := subFlags.Arg(0)
+	var excludeTableArray []string
+	if *excludeTables != "" {
+		excludeTableArray = strings.Split(*excludeTables, ",")
+	}
+	return wr.RepairSchemaKeyspace(ctx, keyspace, *referenceShard, excludeTableArray, strategy, *apply, *allowDrops)
+}
+
 func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
@@ -2425,6 +2605,11 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	// for backwards compatibility
 	deprecatedTimeout := subFlags.Duration("wait_slave_timeout", wrangler.DefaultWaitReplicasTimeout, "DEPRECATED -- use -wait_replicas_timeout")
 	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
+	declarative := subFlags.Bool("declarative", false, "Treat the SQL as the complete desired schema of the keyspace, and apply the statements which bring the current schema to it.")
+	dryRun := subFlags.Bool("dry_run", false, "Only print the statements which would be applied, with an analysis of their impact. The analysis queries the masters of all keyspaces for their vreplication streams.")
+	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyNormal), "With -declarative, the online DDL strategy for ALTER TABLE and DROP TABLE statements: normal, gh-ost, pt-osc or online.")
+	allowDrops := subFlags.Bool("allow_drops", false, "With -declarative, drop the tables which are missing from the desired schema.")
+	migrationContext := subFlags.String("migration_context", "", "A context for the submitted online DDL migrations, which then succeed or fail together.")
 	if *deprecatedTimeout != wrangler.DefaultWaitReplicasTimeout {
 		*waitReplicasTimeout = *deprecatedTimeout
 	}
@@ -2441,13 +2626,41 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return err
 	}
 
+	var controller schemamanager.Controller = schemamanager.NewPlainController(change, keyspace)
+	if *declarative {
+		strategy, err := schema.ValidateDDLStrategy(*ddlStrategy)
+		if err != nil {
+			return err
+		}
+		controller = schemamanager.NewDeclarativeController(controller, wr, strategy, *allowDrops)
+	}
+
 	executor := schemamanager.NewTabletExecutor(wr, *waitReplicasTimeout)
+	if *dryRun {
+		sqls, err := controller.Read(ctx)
+		if err != nil {
+			return err
+		}
+		if err := executor.Open(ctx, keyspace); err != nil {
+			return err
+		}
+		defer executor.Close()
+		reports, err := executor.AnalyzeImpact(ctx, sqls)
+		if err != nil {
+			return err
+		}
+		for _, report := range reports {
+			wr.Logger().Printf("%s", report)
+		}
+		return nil
+	}
 	if *allowLongUnavailability {
 		executor.AllowBigSchemaChange()
 	}
+	executor.SetMigrationContext(*migrationContext)
 	return schemamanager.Run(
 		ctx,
-		schemamanager.NewPlainController(change, keyspace),
+		controller,
 		executor,
 	)
 }
@@ -2484,15 +2697,17 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 				string(schema.OnlineDDLStatusQueued),
 				string(schema.OnlineDDLStatusReady),
 				string(schema.OnlineDDLStatusRunning),
+				string(schema.OnlineDDLStatusReadyToComplete),
 				string(schema.OnlineDDLStatusComplete),
-				string(schema.OnlineDDLStatusFailed):
+				string(schema.OnlineDDLStatusFailed),
+				string(schema.OnlineDDLStatusUndropped):
 				condition = fmt.Sprintf("migration_status='%s'", arg)
 			default:
 				uuid = arg
 				condition = fmt.Sprintf("migration_uuid='%s'", uuid)
 			}
 			query = fmt.Sprintf(`select
-				shard, mysql_schema, mysql_table, migration_uuid, strategy, started_timestamp, completed_timestamp, migration_status 
+				shard, mysql_schema, mysql_table, migration_uuid, strategy, started_timestamp, completed_timestamp, migration_status, migration_context 
 				from _vt.schema_migrations where %s`, condition)
 		}
 	case "retry":
@@ -2511,6 +2726,45 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 			uuid = arg
 			query = fmt.Sprintf(`update _vt.schema_migrations set migration_status='cancel' where migration_uuid='%s'`, uuid)
 		}
+	case "complete":
+		{
+			if arg == "" {
+				return fmt.Errorf("UUID required")
+			}
+			uuid = arg
+			query = fmt.Sprintf(`update _vt.schema_migrations set migration_status='complete' where migration_uuid='%s'`, uuid)
+		}
+	case "undrop":
+		{
+			if arg == "" {
+				return fmt.Errorf("UUID required")
+			}
+			uuid = arg
+			query = fmt.Sprintf(`update _vt.schema_migrations set migration_status='undrop' where migration_uuid='%s'`, uuid)
+		}
+	case "revert":
+		{
+			if arg == "" {
+				return fmt.Errorf("UUID required")
+			}
+			if !schema.IsOnlineDDLUUID(arg) {
+				return fmt.Errorf("Invalid UUID: %s", arg)
+			}
+			// A revert is a migration of its own, submitted just like any other migration.
+			onlineDDL, err := schema.NewOnlineDDL(keyspace, "", schema.RevertStatement(arg), schema.DDLStrategyOnline, "")
+			if err != nil {
+				return err
+			}
+			conn, err := wr.TopoServer().ConnForCell(ctx, topo.GlobalCell)
+			if err != nil {
+				return err
+			}
+			if err := onlineDDL.WriteTopo(ctx, conn, schema.MigrationRequestsPath()); err != nil {
+				return err
+			}
+			wr.Logger().Printf("%s\n", onlineDDL.UUID)
+			return nil
+		}
 	default:
 		return fmt.Errorf("Unknown OnlineDDL command: %s", command)
 	}
@@ -2693,6 +2947,7 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	sqlFile := subFlags.String("sql_file", "", "A vschema ddl SQL statement (e.g. `add vindex`, `alter table t add vindex hash(id)`, etc)")
 	dryRun := subFlags.Bool("dry-run", false, "If set, do not save the altered vschema, simply echo to console.")
 	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	skipValidation := subFlags.Bool("skip_validation", false, "If set, do not validate the vschema against the schema of the tablets.")
 	var cells flagutil.StringListValue
 	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
 
@@ -2777,6 +3032,19 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		wr.Logger().Printf("New VSchema object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", b)
 	}
 
+	if !*skipValidation {
+		errs, warnings := wr.ValidateVSchema(ctx, keyspace, vs)
+		for _, warning := range warnings {
+			wr.Logger().Warningf("VSchema validation: %s", warning)
+		}
+		for _, e := range errs {
+			wr.Logger().Errorf("VSchema validation: %s", e)
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("VSchema validation failed with %d error(s), use -skip_validation to apply it anyway", len(errs))
+		}
+	}
+
 	if *dryRun {
 		wr.Logger().Printf("Dry run: Skipping update of VSchema\n")
 		return nil
@@ -3024,6 +3292,33 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 	return nil
 }
 
+func commandSetWorkflowSchemaChangePolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	dryRun := subFlags.Bool("dry_run", false, "Only reports the query and the list of masters on which it would be applied")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <ks.workflow> and <policy> arguments are required for the SetWorkflowSchemaChangePolicy command")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	results, err := wr.SetSchemaChangePolicy(ctx, workflow, keyspace, subFlags.Arg(1), *dryRun)
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		return nil
+	}
+	if len(results) == 0 {
+		wr.Logger().Printf("no result returned\n")
+		return nil
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, wr.QueryResultForRowsAffected(results))
+	return nil
+}
+
 func commandGenerateShardRanges(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	numShards := subFlags.Int("num_shards", 2, "Number of shards to generate shard ranges for.")
 
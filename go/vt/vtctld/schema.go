@@ -24,14 +24,11 @@ import (
 
 	"golang.org/x/net/context"
 
-	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
-	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/servenv"
-	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/wrangler"
@@ -85,46 +82,7 @@ func reviewMigrationRequest(ctx context.Context, ts *topo.Server, tmClient tmcli
 	logstream := logutil.NewMemoryLogger()
 	wr := wrangler.New(logstream, ts, tmClient)
 
-	sqlInsertSchemaMigration := `INSERT IGNORE INTO %s.schema_migrations (
-		migration_uuid,
-		keyspace,
-		shard,
-		mysql_schema,
-		mysql_table,
-		migration_statement,
-		strategy,
-		options,
-		requested_timestamp,
-		migration_status
-	) VALUES (
-		%a, %a, %a, %a, %a, %a, %a, %a, FROM_UNIXTIME(%a), %a
-	)`
-	parsed := sqlparser.BuildParsedQuery(sqlInsertSchemaMigration, "_vt",
-		":migration_uuid",
-		":keyspace",
-		":shard",
-		":mysql_schema",
-		":mysql_table",
-		":migration_statement",
-		":strategy",
-		":options",
-		":requested_timestamp",
-		":migration_status",
-	)
-	bindVars := map[string]*querypb.BindVariable{
-		"migration_uuid":      sqltypes.StringBindVariable(onlineDDL.UUID),
-		"keyspace":            sqltypes.StringBindVariable(onlineDDL.Keyspace),
-		"shard":               sqltypes.StringBindVariable(""),
-		"mysql_schema":        sqltypes.StringBindVariable(""),
-		"mysql_table":         sqltypes.StringBindVariable(onlineDDL.Table),
-		"migration_statement": sqltypes.StringBindVariable(onlineDDL.SQL),
-		"strategy":            sqltypes.StringBindVariable(string(onlineDDL.Strategy)),
-		"options":             sqltypes.StringBindVariable(onlineDDL.Options),
-		"requested_timestamp": sqltypes.Int64BindVariable(onlineDDL.RequestTimeSeconds()),
-		"migration_status":    sqltypes.StringBindVariable(string(onlineDDL.Status)),
-	}
-
-	bound, err := parsed.GenerateQuery(bindVars, nil)
+	bound, err := onlineDDL.InsertQuery()
 	if err != nil {
 		return err
 	}
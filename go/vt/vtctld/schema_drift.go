@@ -0,0 +1,201 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"flag"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	schemaDriftCheckInterval = flag.Duration("schema_drift_check_interval", 0, "interval between checks of the schema consistency of the shards of each keyspace. 0 disables the checks")
+	schemaDriftReferences    flagutil.StringListValue
+)
+
+func init() {
+	flag.Var(&schemaDriftReferences, "schema_drift_reference_shards", "comma separated list of keyspace/shard, the reference shard against which the schema of the other shards of the keyspace is checked. Defaults to the first shard of each keyspace")
+}
+
+const schemaDriftTemplate = `
+{{if .Checked.IsZero}}
+Schema consistency has not been checked yet.
+{{else}}
+Last checked at {{.Checked}}.
+<table>
+  <tr>
+    <th>Keyspace</th>
+    <th>Shard</th>
+    <th>Master</th>
+    <th>Differences</th>
+    <th>Repair DDL</th>
+  </tr>
+  {{range .Drifts}}
+  <tr>
+    <td>{{.Keyspace}}</td>
+    <td>{{.Shard}}</td>
+    <td>{{.Master}}</td>
+    <td>{{range .Differences}}{{.}}<br>{{end}}</td>
+    <td>{{range .RepairSQL}}{{.}}<br>{{end}}</td>
+  </tr>
+  {{else}}
+  <tr><td colspan="5">All shards have the schema of their reference shard.</td></tr>
+  {{end}}
+</table>
+{{range $keyspace, $err := .Errors}}
+<p>Cannot check keyspace {{$keyspace}}: {{$err}}</p>
+{{end}}
+{{end}}
+`
+
+// schemaDriftStatus is the result of the last schema consistency check.
+type schemaDriftStatus struct {
+	mu      sync.Mutex
+	checked time.Time
+	drifts  []*wrangler.ShardSchemaDrift
+	errors  map[string]string
+}
+
+// schemaDriftStatusView is the schema drift section of the status page.
+type schemaDriftStatusView struct {
+	Checked time.Time
+	Drifts  []*schemaDriftView
+	Errors  map[string]string
+}
+
+type schemaDriftView struct {
+	*wrangler.ShardSchemaDrift
+	Master string
+}
+
+var (
+	schemaDrift           = &schemaDriftStatus{}
+	schemaDriftCheckTicks *timer.Timer
+)
+
+func initSchemaDriftChecker(ts *topo.Server) {
+	if *schemaDriftCheckInterval == 0 {
+		return
+	}
+	references, err := parseSchemaDriftReferences(schemaDriftReferences)
+	if err != nil {
+		log.Exitf("invalid -schema_drift_reference_shards: %v", err)
+	}
+	tmClient := tmclient.NewTabletManagerClient()
+
+	stats.NewGaugesFuncWithMultiLabels(
+		"SchemaDrift",
+		"Number of schema differences between a shard and the reference shard of its keyspace",
+		[]string{"Keyspace", "Shard"},
+		schemaDrift.differenceCounts)
+	servenv.AddStatusPart("Schema Drift", schemaDriftTemplate, func() interface{} {
+		return schemaDrift.statusView()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	schemaDriftCheckTicks = timer.NewTimer(*schemaDriftCheckInterval)
+	schemaDriftCheckTicks.Start(func() { checkSchemaDrift(ctx, ts, tmClient, references) })
+	servenv.OnTermSync(func() {
+		cancel()
+		schemaDriftCheckTicks.Stop()
+	})
+}
+
+// parseSchemaDriftReferences maps keyspaces to their reference shard.
+func parseSchemaDriftReferences(values []string) (map[string]string, error) {
+	references := make(map[string]string, len(values))
+	for _, value := range values {
+		keyspace, shard, err := topoproto.ParseKeyspaceShard(value)
+		if err != nil {
+			return nil, err
+		}
+		references[keyspace] = shard
+	}
+	return references, nil
+}
+
+func checkSchemaDrift(ctx context.Context, ts *topo.Server, tmClient tmclient.TabletManagerClient, references map[string]string) {
+	keyspaces, err := ts.GetKeyspaces(ctx)
+	if err != nil {
+		log.Errorf("vtctld.checkSchemaDrift GetKeyspaces error: %s", err.Error())
+		return
+	}
+	sort.Strings(keyspaces)
+
+	wr := wrangler.New(logutil.NewMemoryLogger(), ts, tmClient)
+	var drifts []*wrangler.ShardSchemaDrift
+	errors := make(map[string]string)
+	for _, keyspace := range keyspaces {
+		keyspaceDrifts, err := wr.DiffSchemaKeyspace(ctx, keyspace, references[keyspace], nil)
+		if err != nil {
+			log.Errorf("vtctld.checkSchemaDrift keyspace %s error: %s", keyspace, err.Error())
+			errors[keyspace] = err.Error()
+			continue
+		}
+		for _, drift := range keyspaceDrifts {
+			log.Warningf("vtctld.checkSchemaDrift: shard %s/%s differs from its reference shard: %s", drift.Keyspace, drift.Shard, strings.Join(drift.Differences, "; "))
+		}
+		drifts = append(drifts, keyspaceDrifts...)
+	}
+
+	schemaDrift.mu.Lock()
+	defer schemaDrift.mu.Unlock()
+	schemaDrift.checked = time.Now()
+	schemaDrift.drifts = drifts
+	schemaDrift.errors = errors
+}
+
+func (s *schemaDriftStatus) differenceCounts() map[string]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counts := make(map[string]int64, len(s.drifts))
+	for _, drift := range s.drifts {
+		counts[drift.Keyspace+"."+drift.Shard] = int64(len(drift.Differences))
+	}
+	return counts
+}
+
+func (s *schemaDriftStatus) statusView() *schemaDriftStatusView {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	view := &schemaDriftStatusView{
+		Checked: s.checked,
+		Errors:  s.errors,
+	}
+	for _, drift := range s.drifts {
+		view.Drifts = append(view.Drifts, &schemaDriftView{
+			ShardSchemaDrift: drift,
+			Master:           topoproto.TabletAliasString(drift.MasterAlias),
+		})
+	}
+	return view
+}
@@ -182,6 +182,9 @@ func InitVtctld(ts *topo.Server) {
 	// Init online DDL schema manager
 	initSchemaManager(ts)
 
+	// Start the schema drift checker, if enabled.
+	initSchemaDriftChecker(ts)
+
 	// Setup reverse proxy for all vttablets through /vttablet/.
 	initVTTabletRedirection(ts)
 }
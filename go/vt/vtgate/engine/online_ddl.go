@@ -64,7 +64,7 @@ func (v *OnlineDDL) GetKeyspaceName() string {
 
 //GetTableName implements the Primitive interface
 func (v *OnlineDDL) GetTableName() string {
-	return v.DDL.Table.Name.String()
+	return v.DDL.AffectedTables()[0].Name.String()
 }
 
 //Execute implements the Primitive interface
@@ -17,9 +17,14 @@ limitations under the License.
 package evalengine
 
 import (
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -56,3 +61,174 @@ func (e *EvalResult) ToBooleanStrict() (bool, error) {
 	}
 	return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "is not a boolean")
 }
+
+// ConvertExpr is a CAST(expr AS type), CONVERT(expr, type)
+// or CONVERT(expr USING charset).
+type ConvertExpr struct {
+	Inner Expr
+	// TypeName is the target type of a CAST, like "signed" or "char".
+	// It's empty for a CONVERT USING.
+	TypeName string
+	// Length is the length of a CHAR, BINARY or DECIMAL, or -1.
+	Length int
+	// Scale is the number of decimals of a DECIMAL, or -1.
+	Scale int
+	// Using is the charset of a CONVERT USING.
+	Using string
+}
+
+var _ Expr = (*ConvertExpr)(nil)
+
+// convertTypes maps the target types of a CAST to the type of the result.
+var convertTypes = map[string]querypb.Type{
+	"binary":   sqltypes.VarBinary,
+	"char":     sqltypes.VarBinary,
+	"nchar":    sqltypes.VarBinary,
+	"date":     sqltypes.Date,
+	"datetime": sqltypes.Datetime,
+	"time":     sqltypes.Time,
+	"decimal":  sqltypes.Decimal,
+	"json":     sqltypes.TypeJSON,
+	"signed":   sqltypes.Int64,
+	"unsigned": sqltypes.Uint64,
+}
+
+// NewConvertExpr returns a CAST of inner to the type. length and scale are -1
+// if they're not specified.
+func NewConvertExpr(inner Expr, typeName string, length, scale int) (Expr, error) {
+	typeName = strings.ToLower(typeName)
+	if _, ok := convertTypes[typeName]; !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported cast type: %s", typeName)
+	}
+	return &ConvertExpr{Inner: inner, TypeName: typeName, Length: length, Scale: scale}, nil
+}
+
+// NewConvertUsingExpr returns a CONVERT of inner to the charset.
+// Strings are not transcoded: the value is returned as is.
+func NewConvertUsingExpr(inner Expr, charset string) Expr {
+	return &ConvertExpr{Inner: inner, Length: -1, Scale: -1, Using: charset}
+}
+
+//Evaluate implements the Expr interface
+func (c *ConvertExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := c.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if val.isNull() {
+		return resultNull, nil
+	}
+	switch c.TypeName {
+	case "", "char", "nchar":
+		b := val.toBytes()
+		if c.Length >= 0 && utf8.RuneCount(b) > c.Length {
+			b = []byte(string([]rune(string(b))[:c.Length]))
+		}
+		return EvalResult{typ: sqltypes.VarBinary, bytes: b}, nil
+	case "binary":
+		b := val.toBytes()
+		if c.Length >= 0 {
+			padded := make([]byte, c.Length)
+			copy(padded, b)
+			b = padded
+		}
+		return EvalResult{typ: sqltypes.VarBinary, bytes: b}, nil
+	case "signed":
+		return EvalResult{typ: sqltypes.Int64, ival: castToInt(val)}, nil
+	case "unsigned":
+		return EvalResult{typ: sqltypes.Uint64, uval: uint64(castToInt(val))}, nil
+	case "decimal":
+		// Like in newEvalResult, decimals are evaluated as floats.
+		num := makeNumeric(val)
+		f := num.fval
+		switch num.typ {
+		case sqltypes.Int64:
+			f = float64(num.ival)
+		case sqltypes.Uint64:
+			f = float64(num.uval)
+		}
+		scale := c.Scale
+		if scale < 0 {
+			scale = 0
+		}
+		pow := math.Pow10(scale)
+		return EvalResult{typ: sqltypes.Float64, fval: math.Round(f*pow) / pow}, nil
+	case "date", "datetime":
+		dt, ok := parseDatetime(val.toBytes())
+		if !ok {
+			return resultNull, nil
+		}
+		if c.TypeName == "date" {
+			return EvalResult{typ: sqltypes.Date, bytes: []byte(fmt.Sprintf("%04d-%02d-%02d", dt.year, dt.month, dt.day))}, nil
+		}
+		return EvalResult{typ: sqltypes.Datetime, bytes: []byte(fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", dt.year, dt.month, dt.day, dt.hour, dt.minute, dt.second))}, nil
+	case "time":
+		b := val.toBytes()
+		if dt, ok := parseDatetime(b); ok {
+			return EvalResult{typ: sqltypes.Time, bytes: []byte(fmt.Sprintf("%02d:%02d:%02d", dt.hour, dt.minute, dt.second))}, nil
+		}
+		if dt, ok := parseDatetime(append([]byte("0000-01-01 "), b...)); ok {
+			return EvalResult{typ: sqltypes.Time, bytes: []byte(fmt.Sprintf("%02d:%02d:%02d", dt.hour, dt.minute, dt.second))}, nil
+		}
+		return resultNull, nil
+	case "json":
+		if sqltypes.IsNumber(val.typ) || val.typ == sqltypes.TypeJSON {
+			return EvalResult{typ: sqltypes.TypeJSON, bytes: val.toBytes()}, nil
+		}
+		doc, err := parseJSON(val.toBytes())
+		if err != nil {
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid JSON text in argument 1 to function cast_as_json: %v", err)
+		}
+		return EvalResult{typ: sqltypes.TypeJSON, bytes: formatJSON(doc)}, nil
+	}
+	// Unreachable: the type is validated by NewConvertExpr.
+	return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unsupported cast type: %s", c.TypeName)
+}
+
+// castToInt converts a result to an integer. Like in MySQL, numbers are
+// rounded, and strings are truncated to their integral part.
+func castToInt(val EvalResult) int64 {
+	if !sqltypes.IsNumber(val.typ) {
+		s := strings.TrimSpace(string(val.bytes))
+		if i := strings.IndexAny(s, ".eE"); i >= 0 {
+			s = s[:i]
+		}
+		if ival, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return ival
+		}
+		if uval, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return int64(uval)
+		}
+		val = makeNumeric(val)
+	}
+	switch val.typ {
+	case sqltypes.Uint64:
+		return int64(val.uval)
+	case sqltypes.Float64:
+		return int64(math.Round(val.fval))
+	}
+	return val.ival
+}
+
+//Type implements the Expr interface
+func (c *ConvertExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	if c.TypeName == "" {
+		return sqltypes.VarBinary, nil
+	}
+	return convertTypes[c.TypeName], nil
+}
+
+//String implements the Expr interface
+func (c *ConvertExpr) String() string {
+	if c.TypeName == "" {
+		return "convert(" + c.Inner.String() + " using " + c.Using + ")"
+	}
+	typ := c.TypeName
+	switch {
+	case c.Length >= 0 && c.Scale >= 0:
+		typ += fmt.Sprintf("(%d, %d)", c.Length, c.Scale)
+	case c.Length >= 0:
+		typ += fmt.Sprintf("(%d)", c.Length)
+	}
+	return "convert(" + c.Inner.String() + ", " + typ + ")"
+}
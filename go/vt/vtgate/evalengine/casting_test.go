@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 func TestEvalResultToBooleanStrict(t *testing.T) {
@@ -82,3 +84,119 @@ func TestEvalResultToBooleanStrict(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertExpr(t *testing.T) {
+	str := sqltypes.NewVarBinary
+	typed := func(typ querypb.Type, s string) sqltypes.Value {
+		return sqltypes.MakeTrusted(typ, []byte(s))
+	}
+	tests := []struct {
+		in            sqltypes.Value
+		typeName      string
+		length, scale int
+		expected      sqltypes.Value
+		err           string
+	}{
+		{in: sqltypes.NewVarChar("aé€"), typeName: "char", length: -1, scale: -1, expected: str("aé€")},
+		{in: sqltypes.NewVarChar("aé€"), typeName: "char", length: 2, scale: -1, expected: str("aé")},
+		{in: sqltypes.NewVarChar("aé€"), typeName: "nchar", length: 1, scale: -1, expected: str("a")},
+		{in: sqltypes.NewInt64(-12), typeName: "char", length: -1, scale: -1, expected: str("-12")},
+		{in: sqltypes.NewVarChar("ab"), typeName: "binary", length: -1, scale: -1, expected: str("ab")},
+		{in: sqltypes.NewVarChar("ab"), typeName: "binary", length: 4, scale: -1, expected: str("ab\x00\x00")},
+		{in: sqltypes.NewVarChar("abc"), typeName: "binary", length: 2, scale: -1, expected: str("ab")},
+		{in: sqltypes.NewVarChar(" -12.7 "), typeName: "signed", length: -1, scale: -1, expected: sqltypes.NewInt64(-12)},
+		{in: sqltypes.NewVarChar("1e3"), typeName: "signed", length: -1, scale: -1, expected: sqltypes.NewInt64(1)},
+		{in: sqltypes.NewVarChar("abc"), typeName: "signed", length: -1, scale: -1, expected: sqltypes.NewInt64(0)},
+		{in: sqltypes.NewFloat64(12.5), typeName: "signed", length: -1, scale: -1, expected: sqltypes.NewInt64(13)},
+		{in: sqltypes.NewFloat64(-12.5), typeName: "signed", length: -1, scale: -1, expected: sqltypes.NewInt64(-13)},
+		{in: sqltypes.NewUint64(18446744073709551615), typeName: "signed", length: -1, scale: -1, expected: sqltypes.NewInt64(-1)},
+		{in: sqltypes.NewInt64(-1), typeName: "unsigned", length: -1, scale: -1, expected: sqltypes.NewUint64(18446744073709551615)},
+		{in: sqltypes.NewVarChar("18446744073709551615"), typeName: "unsigned", length: -1, scale: -1, expected: sqltypes.NewUint64(18446744073709551615)},
+		{in: sqltypes.NewFloat64(2.4), typeName: "unsigned", length: -1, scale: -1, expected: sqltypes.NewUint64(2)},
+		{in: sqltypes.NewVarChar("1.2345"), typeName: "decimal", length: 10, scale: 2, expected: sqltypes.NewFloat64(1.23)},
+		{in: sqltypes.NewFloat64(1.5), typeName: "decimal", length: -1, scale: -1, expected: sqltypes.NewFloat64(2)},
+		{in: sqltypes.NewInt64(7), typeName: "decimal", length: 10, scale: 2, expected: sqltypes.NewFloat64(7)},
+		{in: sqltypes.NewUint64(7), typeName: "decimal", length: -1, scale: -1, expected: sqltypes.NewFloat64(7)},
+		{in: sqltypes.NewVarChar("2020-01-02 15:04:05"), typeName: "date", length: -1, scale: -1, expected: typed(sqltypes.Date, "2020-01-02")},
+		{in: sqltypes.NewVarChar("2020-01-02"), typeName: "datetime", length: -1, scale: -1, expected: typed(sqltypes.Datetime, "2020-01-02 00:00:00")},
+		{in: sqltypes.NewVarChar("2020-01-02T15:04:05.5"), typeName: "datetime", length: -1, scale: -1, expected: typed(sqltypes.Datetime, "2020-01-02 15:04:05")},
+		{in: sqltypes.NewVarChar("yesterday"), typeName: "date", length: -1, scale: -1, expected: sqltypes.NULL},
+		{in: sqltypes.NewVarChar("2020-01-02 15:04:05"), typeName: "time", length: -1, scale: -1, expected: typed(sqltypes.Time, "15:04:05")},
+		{in: sqltypes.NewVarChar("15:04:05"), typeName: "time", length: -1, scale: -1, expected: typed(sqltypes.Time, "15:04:05")},
+		{in: sqltypes.NewVarChar("25:04:05"), typeName: "time", length: -1, scale: -1, expected: sqltypes.NULL},
+		{in: sqltypes.NewVarChar(`{"b": 1, "a": [true]}`), typeName: "json", length: -1, scale: -1, expected: typed(sqltypes.TypeJSON, `{"a": [true], "b": 1}`)},
+		{in: sqltypes.NewInt64(12), typeName: "json", length: -1, scale: -1, expected: typed(sqltypes.TypeJSON, "12")},
+		{in: typed(sqltypes.TypeJSON, `{"b":1}`), typeName: "json", length: -1, scale: -1, expected: typed(sqltypes.TypeJSON, `{"b":1}`)},
+		{in: sqltypes.NewVarChar("abc"), typeName: "json", length: -1, scale: -1, err: "invalid JSON text in argument 1 to function cast_as_json: invalid character 'a' looking for beginning of value"},
+		{in: sqltypes.NULL, typeName: "signed", length: -1, scale: -1, expected: sqltypes.NULL},
+		{in: sqltypes.NULL, typeName: "json", length: -1, scale: -1, expected: sqltypes.NULL},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s as %s(%d, %d)", tc.in.String(), tc.typeName, tc.length, tc.scale), func(t *testing.T) {
+			expr, err := NewConvertExpr(NewColumn(0), tc.typeName, tc.length, tc.scale)
+			require.NoError(t, err)
+			r, err := expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{tc.in}, Filter: true})
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, r.Value())
+		})
+	}
+}
+
+func TestConvertUsingExpr(t *testing.T) {
+	expr := NewConvertUsingExpr(NewColumn(0), "utf8mb4")
+	tests := []struct {
+		in, expected sqltypes.Value
+	}{
+		{sqltypes.NewVarChar("aé€"), sqltypes.NewVarBinary("aé€")},
+		{sqltypes.NewInt64(12), sqltypes.NewVarBinary("12")},
+		{sqltypes.NULL, sqltypes.NULL},
+	}
+	for _, tc := range tests {
+		r, err := expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{tc.in}, Filter: true})
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, r.Value(), tc.in.String())
+	}
+}
+
+func TestNewConvertExpr(t *testing.T) {
+	_, err := NewConvertExpr(NewColumn(0), "float", -1, -1)
+	require.EqualError(t, err, "unsupported cast type: float")
+
+	tests := []struct {
+		typeName      string
+		length, scale int
+		typ           querypb.Type
+		str           string
+	}{
+		{"BINARY", 4, -1, sqltypes.VarBinary, "convert(:a, binary(4))"},
+		{"char", -1, -1, sqltypes.VarBinary, "convert(:a, char)"},
+		{"nchar", 2, -1, sqltypes.VarBinary, "convert(:a, nchar(2))"},
+		{"date", -1, -1, sqltypes.Date, "convert(:a, date)"},
+		{"datetime", -1, -1, sqltypes.Datetime, "convert(:a, datetime)"},
+		{"time", -1, -1, sqltypes.Time, "convert(:a, time)"},
+		{"decimal", 10, 2, sqltypes.Decimal, "convert(:a, decimal(10, 2))"},
+		{"json", -1, -1, sqltypes.TypeJSON, "convert(:a, json)"},
+		{"Signed", -1, -1, sqltypes.Int64, "convert(:a, signed)"},
+		{"unsigned", -1, -1, sqltypes.Uint64, "convert(:a, unsigned)"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.str, func(t *testing.T) {
+			expr, err := NewConvertExpr(NewBindVar("a"), tc.typeName, tc.length, tc.scale)
+			require.NoError(t, err)
+			typ, err := expr.Type(ExpressionEnv{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.typ, typ)
+			assert.Equal(t, tc.str, expr.String())
+		})
+	}
+
+	using := NewConvertUsingExpr(NewBindVar("a"), "utf8mb4")
+	typ, err := using.Type(ExpressionEnv{})
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.VarBinary, typ)
+	assert.Equal(t, "convert(:a using utf8mb4)", using.String())
+}
@@ -0,0 +1,427 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// NotExpr negates the truth value of an expression
+	NotExpr struct {
+		Inner Expr
+	}
+
+	// IsExpr is an IS [NOT] NULL, IS [NOT] TRUE or IS [NOT] FALSE check
+	IsExpr struct {
+		Op    IsOp
+		Inner Expr
+	}
+
+	// IsOp is the check performed by an IsExpr
+	IsOp int
+
+	// InExpr checks whether an expression is equal to one of a list of expressions
+	InExpr struct {
+		Left   Expr
+		List   []Expr
+		Negate bool
+	}
+
+	// Comparison ops
+	Equal         struct{}
+	NotEqual      struct{}
+	NullSafeEqual struct{}
+	LessThan      struct{}
+	LessEqual     struct{}
+	GreaterThan   struct{}
+	GreaterEqual  struct{}
+
+	// Logical ops
+	And struct{}
+	Or  struct{}
+	Xor struct{}
+)
+
+// The checks of an IsExpr
+const (
+	IsNullOp = IsOp(iota)
+	IsNotNullOp
+	IsTrueOp
+	IsNotTrueOp
+	IsFalseOp
+	IsNotFalseOp
+)
+
+var _ Expr = (*NotExpr)(nil)
+var _ Expr = (*IsExpr)(nil)
+var _ Expr = (*InExpr)(nil)
+
+var _ BinaryExpr = (*Equal)(nil)
+var _ BinaryExpr = (*NotEqual)(nil)
+var _ BinaryExpr = (*NullSafeEqual)(nil)
+var _ BinaryExpr = (*LessThan)(nil)
+var _ BinaryExpr = (*LessEqual)(nil)
+var _ BinaryExpr = (*GreaterThan)(nil)
+var _ BinaryExpr = (*GreaterEqual)(nil)
+var _ BinaryExpr = (*And)(nil)
+var _ BinaryExpr = (*Or)(nil)
+var _ BinaryExpr = (*Xor)(nil)
+
+var resultNull = EvalResult{typ: sqltypes.Null}
+
+// IsTruthy returns true if the result is true when used as a condition.
+// NULL is not true.
+func (e EvalResult) IsTruthy() bool {
+	return !e.isNull() && !e.isZero()
+}
+
+func (e EvalResult) isNull() bool {
+	return e.typ == sqltypes.Null
+}
+
+// isZero returns true if the numeric value of the result is 0.
+// Like in MySQL, strings are converted to numbers first.
+func (e EvalResult) isZero() bool {
+	v := makeNumeric(e)
+	switch v.typ {
+	case sqltypes.Uint64:
+		return v.uval == 0
+	case sqltypes.Float64:
+		return v.fval == 0
+	}
+	return v.ival == 0
+}
+
+func boolResult(b bool) EvalResult {
+	if b {
+		return EvalResult{typ: sqltypes.Int64, ival: 1}
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: 0}
+}
+
+// compareResults compares two non-NULL results. If any of them is a number,
+// the comparison is numeric. Otherwise, it's a binary comparison.
+func compareResults(left, right EvalResult) (int, error) {
+	if sqltypes.IsNumber(left.typ) || sqltypes.IsNumber(right.typ) {
+		return compareNumeric(makeNumeric(left), makeNumeric(right))
+	}
+	return bytes.Compare(left.bytes, right.bytes), nil
+}
+
+func compareWith(left, right EvalResult, cond func(int) bool) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	cmp, err := compareResults(left, right)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return boolResult(cond(cmp)), nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (e *Equal) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp == 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (e *NotEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp != 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (e *NullSafeEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return boolResult(left.isNull() && right.isNull()), nil
+	}
+	return compareWith(left, right, func(cmp int) bool { return cmp == 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (e *LessThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp < 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (e *LessEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp <= 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (e *GreaterThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp > 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (e *GreaterEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp >= 0 })
+}
+
+//Evaluate implements the BinaryExpr interface
+func (a *And) Evaluate(left, right EvalResult) (EvalResult, error) {
+	switch {
+	case !left.isNull() && left.isZero(), !right.isNull() && right.isZero():
+		return boolResult(false), nil
+	case left.isNull() || right.isNull():
+		return resultNull, nil
+	}
+	return boolResult(true), nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (o *Or) Evaluate(left, right EvalResult) (EvalResult, error) {
+	switch {
+	case left.IsTruthy() || right.IsTruthy():
+		return boolResult(true), nil
+	case left.isNull() || right.isNull():
+		return resultNull, nil
+	}
+	return boolResult(false), nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (x *Xor) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	return boolResult(left.isZero() != right.isZero()), nil
+}
+
+//Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := n.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if val.isNull() {
+		return resultNull, nil
+	}
+	return boolResult(val.isZero()), nil
+}
+
+//Evaluate implements the Expr interface
+func (i *IsExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := i.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	switch i.Op {
+	case IsNullOp:
+		return boolResult(val.isNull()), nil
+	case IsNotNullOp:
+		return boolResult(!val.isNull()), nil
+	case IsTrueOp:
+		return boolResult(val.IsTruthy()), nil
+	case IsNotTrueOp:
+		return boolResult(!val.IsTruthy()), nil
+	case IsFalseOp:
+		return boolResult(!val.isNull() && val.isZero()), nil
+	}
+	return boolResult(val.isNull() || !val.isZero()), nil
+}
+
+//Evaluate implements the Expr interface
+func (i *InExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := i.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.isNull() {
+		return resultNull, nil
+	}
+	sawNull := false
+	for _, expr := range i.List {
+		val, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if val.isNull() {
+			sawNull = true
+			continue
+		}
+		cmp, err := compareResults(left, val)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if cmp == 0 {
+			return boolResult(!i.Negate), nil
+		}
+	}
+	if sawNull {
+		return resultNull, nil
+	}
+	return boolResult(i.Negate), nil
+}
+
+//Type implements the BinaryExpr interface
+func (e *Equal) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (e *NotEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (e *NullSafeEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (e *LessThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (e *LessEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (e *GreaterThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (e *GreaterEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (a *And) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (o *Or) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (x *Xor) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the Expr interface
+func (n *NotExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//Type implements the Expr interface
+func (i *IsExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//Type implements the Expr interface
+func (i *InExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the BinaryExpr interface
+func (e *Equal) String() string {
+	return "="
+}
+
+//String implements the BinaryExpr interface
+func (e *NotEqual) String() string {
+	return "!="
+}
+
+//String implements the BinaryExpr interface
+func (e *NullSafeEqual) String() string {
+	return "<=>"
+}
+
+//String implements the BinaryExpr interface
+func (e *LessThan) String() string {
+	return "<"
+}
+
+//String implements the BinaryExpr interface
+func (e *LessEqual) String() string {
+	return "<="
+}
+
+//String implements the BinaryExpr interface
+func (e *GreaterThan) String() string {
+	return ">"
+}
+
+//String implements the BinaryExpr interface
+func (e *GreaterEqual) String() string {
+	return ">="
+}
+
+//String implements the BinaryExpr interface
+func (a *And) String() string {
+	return "and"
+}
+
+//String implements the BinaryExpr interface
+func (o *Or) String() string {
+	return "or"
+}
+
+//String implements the BinaryExpr interface
+func (x *Xor) String() string {
+	return "xor"
+}
+
+//String implements the Expr interface
+func (n *NotExpr) String() string {
+	return "not " + n.Inner.String()
+}
+
+//String implements the Expr interface
+func (i *IsExpr) String() string {
+	var check string
+	switch i.Op {
+	case IsNullOp:
+		check = "is null"
+	case IsNotNullOp:
+		check = "is not null"
+	case IsTrueOp:
+		check = "is true"
+	case IsNotTrueOp:
+		check = "is not true"
+	case IsFalseOp:
+		check = "is false"
+	default:
+		check = "is not false"
+	}
+	return i.Inner.String() + " " + check
+}
+
+//String implements the Expr interface
+func (i *InExpr) String() string {
+	list := make([]string, len(i.List))
+	for j, expr := range i.List {
+		list[j] = expr.String()
+	}
+	op := " in "
+	if i.Negate {
+		op = " not in "
+	}
+	return i.Left.String() + op + "(" + strings.Join(list, ", ") + ")"
+}
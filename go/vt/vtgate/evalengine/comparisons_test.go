@@ -0,0 +1,287 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	vTrue  = sqltypes.NewInt64(1)
+	vFalse = sqltypes.NewInt64(0)
+)
+
+// evaluateRow evaluates the expression in a filter environment
+// in which the row holds the values.
+func evaluateRow(t *testing.T, expr Expr, values ...sqltypes.Value) sqltypes.Value {
+	t.Helper()
+	r, err := expr.Evaluate(ExpressionEnv{Row: values, Filter: true})
+	require.NoError(t, err)
+	return r.Value()
+}
+
+func TestComparisons(t *testing.T) {
+	tests := []struct {
+		op          BinaryExpr
+		left, right sqltypes.Value
+		expected    sqltypes.Value
+	}{
+		{&Equal{}, sqltypes.NewInt64(1), sqltypes.NewInt64(1), vTrue},
+		{&Equal{}, sqltypes.NewInt64(1), sqltypes.NewInt64(2), vFalse},
+		{&Equal{}, sqltypes.NewInt64(1), sqltypes.NewUint64(1), vTrue},
+		{&Equal{}, sqltypes.NewInt64(1), sqltypes.NewFloat64(1), vTrue},
+		{&Equal{}, sqltypes.NewInt64(1), sqltypes.NewVarChar("1"), vTrue},
+		{&Equal{}, sqltypes.NewVarChar("1.0"), sqltypes.NewInt64(1), vTrue},
+		{&Equal{}, sqltypes.NewVarChar("a"), sqltypes.NewVarChar("a"), vTrue},
+		{&Equal{}, sqltypes.NewVarChar("a"), sqltypes.NewVarChar("A"), vFalse},
+		{&Equal{}, sqltypes.NULL, sqltypes.NewInt64(1), sqltypes.NULL},
+		{&Equal{}, sqltypes.NewInt64(1), sqltypes.NULL, sqltypes.NULL},
+		{&Equal{}, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL},
+		{&NotEqual{}, sqltypes.NewInt64(1), sqltypes.NewInt64(2), vTrue},
+		{&NotEqual{}, sqltypes.NewVarChar("a"), sqltypes.NewVarChar("a"), vFalse},
+		{&NotEqual{}, sqltypes.NULL, sqltypes.NewInt64(1), sqltypes.NULL},
+		{&NullSafeEqual{}, sqltypes.NewInt64(1), sqltypes.NewInt64(1), vTrue},
+		{&NullSafeEqual{}, sqltypes.NewInt64(1), sqltypes.NewInt64(2), vFalse},
+		{&NullSafeEqual{}, sqltypes.NULL, sqltypes.NewInt64(1), vFalse},
+		{&NullSafeEqual{}, sqltypes.NewInt64(1), sqltypes.NULL, vFalse},
+		{&NullSafeEqual{}, sqltypes.NULL, sqltypes.NULL, vTrue},
+		{&LessThan{}, sqltypes.NewInt64(1), sqltypes.NewInt64(2), vTrue},
+		{&LessThan{}, sqltypes.NewInt64(2), sqltypes.NewInt64(2), vFalse},
+		{&LessThan{}, sqltypes.NewInt64(-1), sqltypes.NewUint64(0), vTrue},
+		{&LessThan{}, sqltypes.NewFloat64(1.5), sqltypes.NewInt64(2), vTrue},
+		// Strings are compared byte-wise, unless compared to a number.
+		{&LessThan{}, sqltypes.NewVarChar("10"), sqltypes.NewVarChar("9"), vTrue},
+		{&LessThan{}, sqltypes.NewInt64(10), sqltypes.NewVarChar("9"), vFalse},
+		{&LessThan{}, sqltypes.NULL, sqltypes.NewInt64(1), sqltypes.NULL},
+		{&LessEqual{}, sqltypes.NewInt64(2), sqltypes.NewInt64(2), vTrue},
+		{&LessEqual{}, sqltypes.NewInt64(3), sqltypes.NewInt64(2), vFalse},
+		{&LessEqual{}, sqltypes.NewVarChar("a"), sqltypes.NewVarChar("b"), vTrue},
+		{&LessEqual{}, sqltypes.NewInt64(1), sqltypes.NULL, sqltypes.NULL},
+		{&GreaterThan{}, sqltypes.NewInt64(3), sqltypes.NewInt64(2), vTrue},
+		{&GreaterThan{}, sqltypes.NewInt64(2), sqltypes.NewInt64(2), vFalse},
+		{&GreaterThan{}, sqltypes.NewVarChar("b"), sqltypes.NewVarChar("a"), vTrue},
+		{&GreaterThan{}, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL},
+		{&GreaterEqual{}, sqltypes.NewInt64(2), sqltypes.NewInt64(2), vTrue},
+		{&GreaterEqual{}, sqltypes.NewInt64(1), sqltypes.NewFloat64(1.5), vFalse},
+		{&GreaterEqual{}, sqltypes.NewVarChar("abc"), sqltypes.NewVarChar("ab"), vTrue},
+		{&GreaterEqual{}, sqltypes.NewInt64(1), sqltypes.NULL, sqltypes.NULL},
+	}
+	for _, tc := range tests {
+		name := fmt.Sprintf("%s %s %s", tc.left.String(), tc.op.String(), tc.right.String())
+		t.Run(name, func(t *testing.T) {
+			expr := &BinaryOp{Expr: tc.op, Left: NewColumn(0), Right: NewColumn(1)}
+			assert.Equal(t, tc.expected, evaluateRow(t, expr, tc.left, tc.right))
+		})
+	}
+}
+
+func TestLogicalOps(t *testing.T) {
+	tests := []struct {
+		op          BinaryExpr
+		left, right sqltypes.Value
+		expected    sqltypes.Value
+	}{
+		{&And{}, vTrue, vTrue, vTrue},
+		{&And{}, vTrue, vFalse, vFalse},
+		{&And{}, vFalse, vFalse, vFalse},
+		{&And{}, vTrue, sqltypes.NULL, sqltypes.NULL},
+		{&And{}, sqltypes.NULL, vTrue, sqltypes.NULL},
+		{&And{}, vFalse, sqltypes.NULL, vFalse},
+		{&And{}, sqltypes.NULL, vFalse, vFalse},
+		{&And{}, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL},
+		{&And{}, sqltypes.NewVarChar("a"), vTrue, vFalse},
+		{&And{}, sqltypes.NewFloat64(0.5), sqltypes.NewVarChar("2"), vTrue},
+		{&Or{}, vTrue, vFalse, vTrue},
+		{&Or{}, vFalse, vFalse, vFalse},
+		{&Or{}, sqltypes.NULL, vTrue, vTrue},
+		{&Or{}, vTrue, sqltypes.NULL, vTrue},
+		{&Or{}, vFalse, sqltypes.NULL, sqltypes.NULL},
+		{&Or{}, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL},
+		{&Xor{}, vTrue, vFalse, vTrue},
+		{&Xor{}, vTrue, vTrue, vFalse},
+		{&Xor{}, vFalse, vFalse, vFalse},
+		{&Xor{}, sqltypes.NULL, vTrue, sqltypes.NULL},
+		{&Xor{}, vFalse, sqltypes.NULL, sqltypes.NULL},
+	}
+	for _, tc := range tests {
+		name := fmt.Sprintf("%s %s %s", tc.left.String(), tc.op.String(), tc.right.String())
+		t.Run(name, func(t *testing.T) {
+			expr := &BinaryOp{Expr: tc.op, Left: NewColumn(0), Right: NewColumn(1)}
+			assert.Equal(t, tc.expected, evaluateRow(t, expr, tc.left, tc.right))
+		})
+	}
+}
+
+func TestNotExpr(t *testing.T) {
+	tests := []struct {
+		val, expected sqltypes.Value
+	}{
+		{vTrue, vFalse},
+		{vFalse, vTrue},
+		{sqltypes.NewFloat64(2.5), vFalse},
+		{sqltypes.NewVarChar("0.0"), vTrue},
+		{sqltypes.NewVarChar("abc"), vTrue},
+		{sqltypes.NULL, sqltypes.NULL},
+	}
+	for _, tc := range tests {
+		t.Run(tc.val.String(), func(t *testing.T) {
+			assert.Equal(t, tc.expected, evaluateRow(t, &NotExpr{Inner: NewColumn(0)}, tc.val))
+		})
+	}
+}
+
+func TestIsExpr(t *testing.T) {
+	// The expected results for 1, 0 and NULL.
+	tests := []struct {
+		op       IsOp
+		expected []sqltypes.Value
+	}{
+		{IsNullOp, []sqltypes.Value{vFalse, vFalse, vTrue}},
+		{IsNotNullOp, []sqltypes.Value{vTrue, vTrue, vFalse}},
+		{IsTrueOp, []sqltypes.Value{vTrue, vFalse, vFalse}},
+		{IsNotTrueOp, []sqltypes.Value{vFalse, vTrue, vTrue}},
+		{IsFalseOp, []sqltypes.Value{vFalse, vTrue, vFalse}},
+		{IsNotFalseOp, []sqltypes.Value{vTrue, vFalse, vTrue}},
+	}
+	vals := []sqltypes.Value{vTrue, vFalse, sqltypes.NULL}
+	for _, tc := range tests {
+		expr := &IsExpr{Op: tc.op, Inner: NewColumn(0)}
+		t.Run(expr.String(), func(t *testing.T) {
+			for i, val := range vals {
+				assert.Equal(t, tc.expected[i], evaluateRow(t, expr, val), "%s", val.String())
+			}
+		})
+	}
+}
+
+func TestInExpr(t *testing.T) {
+	tests := []struct {
+		left      sqltypes.Value
+		list      []sqltypes.Value
+		in, notIn sqltypes.Value
+	}{
+		{sqltypes.NewInt64(1), []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, vTrue, vFalse},
+		{sqltypes.NewInt64(3), []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, vFalse, vTrue},
+		{sqltypes.NewVarChar("1"), []sqltypes.Value{sqltypes.NewInt64(1)}, vTrue, vFalse},
+		{sqltypes.NewVarChar("a"), []sqltypes.Value{sqltypes.NewVarChar("A"), sqltypes.NewVarChar("a")}, vTrue, vFalse},
+		// A NULL in the list makes a miss NULL, but not a match.
+		{sqltypes.NewInt64(1), []sqltypes.Value{sqltypes.NULL, sqltypes.NewInt64(1)}, vTrue, vFalse},
+		{sqltypes.NewInt64(3), []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NULL}, sqltypes.NULL, sqltypes.NULL},
+		{sqltypes.NULL, []sqltypes.Value{sqltypes.NewInt64(1)}, sqltypes.NULL, sqltypes.NULL},
+	}
+	for _, tc := range tests {
+		row := append([]sqltypes.Value{tc.left}, tc.list...)
+		list := make([]Expr, len(tc.list))
+		for i := range tc.list {
+			list[i] = NewColumn(i + 1)
+		}
+		t.Run(fmt.Sprintf("%s in %v", tc.left.String(), tc.list), func(t *testing.T) {
+			assert.Equal(t, tc.in, evaluateRow(t, &InExpr{Left: NewColumn(0), List: list}, row...))
+			assert.Equal(t, tc.notIn, evaluateRow(t, &InExpr{Left: NewColumn(0), List: list, Negate: true}, row...))
+		})
+	}
+}
+
+func TestIsTruthy(t *testing.T) {
+	tests := []struct {
+		val      EvalResult
+		expected bool
+	}{
+		{EvalResult{typ: sqltypes.Int64, ival: 1}, true},
+		{EvalResult{typ: sqltypes.Int64, ival: -1}, true},
+		{EvalResult{typ: sqltypes.Int64, ival: 0}, false},
+		{EvalResult{typ: sqltypes.Uint64, uval: 2}, true},
+		{EvalResult{typ: sqltypes.Uint64, uval: 0}, false},
+		{EvalResult{typ: sqltypes.Float64, fval: 0.1}, true},
+		{EvalResult{typ: sqltypes.Float64, fval: 0}, false},
+		{EvalResult{typ: sqltypes.VarBinary, bytes: []byte("1")}, true},
+		{EvalResult{typ: sqltypes.VarBinary, bytes: []byte("0.0")}, false},
+		{EvalResult{typ: sqltypes.VarBinary, bytes: []byte("abc")}, false},
+		{resultNull, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.val.debugString(), func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.val.IsTruthy())
+		})
+	}
+}
+
+func TestComparisonTypes(t *testing.T) {
+	ops := []BinaryExpr{
+		&Equal{}, &NotEqual{}, &NullSafeEqual{}, &LessThan{}, &LessEqual{},
+		&GreaterThan{}, &GreaterEqual{}, &And{}, &Or{}, &Xor{},
+	}
+	for _, op := range ops {
+		t.Run(reflect.TypeOf(op).String(), func(t *testing.T) {
+			for _, typ := range []querypb.Type{sqltypes.Int64, sqltypes.Float64, sqltypes.VarBinary, sqltypes.Null} {
+				assert.Equal(t, sqltypes.Int64, op.Type(typ))
+			}
+		})
+	}
+	exprs := []Expr{
+		&NotExpr{Inner: NewLiteralString([]byte("a"))},
+		&IsExpr{Op: IsNullOp, Inner: NewLiteralString([]byte("a"))},
+		&InExpr{Left: NewLiteralString([]byte("a")), List: []Expr{NewLiteralInt(1)}},
+	}
+	for _, expr := range exprs {
+		t.Run(expr.String(), func(t *testing.T) {
+			typ, err := expr.Type(ExpressionEnv{Filter: true})
+			require.NoError(t, err)
+			assert.Equal(t, sqltypes.Int64, typ)
+		})
+	}
+}
+
+func TestComparisonStrings(t *testing.T) {
+	a, b := NewBindVar("a"), NewLiteralInt(1)
+	tests := []struct {
+		expr     Expr
+		expected string
+	}{
+		{&BinaryOp{Expr: &Equal{}, Left: a, Right: b}, ":a = INT64(1)"},
+		{&BinaryOp{Expr: &NotEqual{}, Left: a, Right: b}, ":a != INT64(1)"},
+		{&BinaryOp{Expr: &NullSafeEqual{}, Left: a, Right: b}, ":a <=> INT64(1)"},
+		{&BinaryOp{Expr: &LessThan{}, Left: a, Right: b}, ":a < INT64(1)"},
+		{&BinaryOp{Expr: &LessEqual{}, Left: a, Right: b}, ":a <= INT64(1)"},
+		{&BinaryOp{Expr: &GreaterThan{}, Left: a, Right: b}, ":a > INT64(1)"},
+		{&BinaryOp{Expr: &GreaterEqual{}, Left: a, Right: b}, ":a >= INT64(1)"},
+		{&BinaryOp{Expr: &And{}, Left: a, Right: b}, ":a and INT64(1)"},
+		{&BinaryOp{Expr: &Or{}, Left: a, Right: b}, ":a or INT64(1)"},
+		{&BinaryOp{Expr: &Xor{}, Left: a, Right: b}, ":a xor INT64(1)"},
+		{&NotExpr{Inner: a}, "not :a"},
+		{&IsExpr{Op: IsNullOp, Inner: a}, ":a is null"},
+		{&IsExpr{Op: IsNotNullOp, Inner: a}, ":a is not null"},
+		{&IsExpr{Op: IsTrueOp, Inner: a}, ":a is true"},
+		{&IsExpr{Op: IsNotTrueOp, Inner: a}, ":a is not true"},
+		{&IsExpr{Op: IsFalseOp, Inner: a}, ":a is false"},
+		{&IsExpr{Op: IsNotFalseOp, Inner: a}, ":a is not false"},
+		{&InExpr{Left: a, List: []Expr{b, b}}, ":a in (INT64(1), INT64(1))"},
+		{&InExpr{Left: a, List: []Expr{b}, Negate: true}, ":a not in (INT64(1))"},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, tc.expr.String())
+	}
+}
@@ -40,6 +40,11 @@ type (
 	ExpressionEnv struct {
 		BindVars map[string]*querypb.BindVariable
 		Row      []sqltypes.Value
+		// Filter is set when evaluating the filters of vreplication
+		// streams, which follow MySQL more closely: arithmetic on NULL
+		// is NULL, non-numeric operands of arithmetic are floats, and
+		// columns have the type of their value in Row.
+		Filter bool
 	}
 
 	// Expr is the interface that all evaluating expressions must implement
@@ -100,11 +105,16 @@ func NewLiteralFloat(val []byte) (Expr, error) {
 	return &Literal{EvalResult{typ: sqltypes.Float64, fval: fval}}, nil
 }
 
-//NewLiteralFloat returns a literal expression
+//NewLiteralString returns a literal expression
 func NewLiteralString(val []byte) Expr {
 	return &Literal{EvalResult{typ: sqltypes.VarBinary, bytes: val}}
 }
 
+//NewLiteralNull returns a NULL literal
+func NewLiteralNull() Expr {
+	return &Literal{EvalResult{typ: sqltypes.Null}}
+}
+
 //NewBindVar returns a bind variable
 func NewBindVar(key string) Expr {
 	return &BindVariable{Key: key}
@@ -137,6 +147,9 @@ func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	if err != nil {
 		return EvalResult{}, err
 	}
+	if env.Filter && isArithmetic(b.Expr) && (lVal.isNull() || rVal.isNull()) {
+		return resultNull, nil
+	}
 	return b.Expr.Evaluate(lVal, rVal)
 }
 
@@ -211,10 +224,34 @@ func (b *BinaryOp) Type(env ExpressionEnv) (querypb.Type, error) {
 	if err != nil {
 		return 0, err
 	}
+	if env.Filter {
+		ltype, rtype = numericType(ltype), numericType(rtype)
+	}
 	typ := mergeNumericalTypes(ltype, rtype)
 	return b.Expr.Type(typ), nil
 }
 
+func isArithmetic(expr BinaryExpr) bool {
+	switch expr.(type) {
+	case *Addition, *Subtraction, *Multiplication, *Division:
+		return true
+	}
+	return false
+}
+
+// numericType returns the type that a value of the given type has in
+// an arithmetic operation. Like in MySQL, non-numeric values are
+// converted to floating point numbers.
+func numericType(typ querypb.Type) querypb.Type {
+	switch {
+	case sqltypes.IsSigned(typ):
+		return sqltypes.Int64
+	case sqltypes.IsUnsigned(typ):
+		return sqltypes.Uint64
+	}
+	return sqltypes.Float64
+}
+
 //Type implements the Expr interface
 func (b *BindVariable) Type(env ExpressionEnv) (querypb.Type, error) {
 	e := env.BindVars
@@ -231,7 +268,10 @@ func (l *Literal) Type(ExpressionEnv) (querypb.Type, error) {
 }
 
 //Type implements the Expr interface
-func (c *Column) Type(ExpressionEnv) (querypb.Type, error) {
+func (c *Column) Type(env ExpressionEnv) (querypb.Type, error) {
+	if env.Filter && c.Offset < len(env.Row) {
+		return env.Row[c.Offset].Type(), nil
+	}
 	return sqltypes.Float64, nil
 }
 
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 
@@ -105,3 +106,58 @@ func TestBinaryOpTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestFilterEnv(t *testing.T) {
+	row := []sqltypes.Value{sqltypes.NewInt32(2), sqltypes.NewVarChar("1.5"), sqltypes.NULL}
+	add := func(l, r int) Expr {
+		return &BinaryOp{Expr: &Addition{}, Left: NewColumn(l), Right: NewColumn(r)}
+	}
+	tests := []struct {
+		expr                       Expr
+		evaluated, filterEvaluated sqltypes.Value
+		typ, filterTyp             querypb.Type
+	}{{
+		expr:            NewColumn(0),
+		evaluated:       sqltypes.NewInt64(2),
+		filterEvaluated: sqltypes.NewInt64(2),
+		typ:             sqltypes.Float64,
+		filterTyp:       sqltypes.Int32,
+	}, {
+		expr:            add(0, 0),
+		evaluated:       sqltypes.NewInt64(4),
+		filterEvaluated: sqltypes.NewInt64(4),
+		typ:             sqltypes.Float64,
+		filterTyp:       sqltypes.Int64,
+	}, {
+		// Non-numeric operands of arithmetic are floats in filters.
+		expr:            add(0, 1),
+		evaluated:       sqltypes.NewFloat64(3.5),
+		filterEvaluated: sqltypes.NewFloat64(3.5),
+		typ:             sqltypes.Float64,
+		filterTyp:       sqltypes.Float64,
+	}, {
+		// Arithmetic on NULL is NULL in filters.
+		expr:            add(0, 2),
+		evaluated:       sqltypes.NewInt64(2),
+		filterEvaluated: sqltypes.NULL,
+		typ:             sqltypes.Float64,
+		filterTyp:       sqltypes.Float64,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.expr.String(), func(t *testing.T) {
+			for _, filter := range []bool{false, true} {
+				env := ExpressionEnv{Row: row, Filter: filter}
+				evaluated, typ := tc.evaluated, tc.typ
+				if filter {
+					evaluated, typ = tc.filterEvaluated, tc.filterTyp
+				}
+				r, err := tc.expr.Evaluate(env)
+				require.NoError(t, err)
+				assert.Equal(t, evaluated, r.Value(), "filter: %v", filter)
+				gotTyp, err := tc.expr.Type(env)
+				require.NoError(t, err)
+				assert.Equal(t, typ, gotTyp, "filter: %v", filter)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// FuncExpr is a call to one of the builtin functions.
+// Only deterministic functions are builtin: the result of a call
+// depends on nothing but its arguments.
+type FuncExpr struct {
+	Name string
+	Args []Expr
+	fn   *builtinFunc
+}
+
+var _ Expr = (*FuncExpr)(nil)
+
+type builtinFunc struct {
+	minArgs, maxArgs int
+	// nullable functions are called with NULL arguments. For the
+	// other functions, any NULL argument makes the result NULL.
+	nullable bool
+	typ      func(args []querypb.Type) querypb.Type
+	call     func(args []EvalResult) (EvalResult, error)
+}
+
+var builtinFuncs map[string]*builtinFunc
+
+func init() {
+	str := func(f func([]byte) []byte) *builtinFunc {
+		return &builtinFunc{
+			minArgs: 1,
+			maxArgs: 1,
+			typ:     fixedType(sqltypes.VarBinary),
+			call: func(args []EvalResult) (EvalResult, error) {
+				return EvalResult{typ: sqltypes.VarBinary, bytes: f(args[0].toBytes())}, nil
+			},
+		}
+	}
+	strLen := func(f func([]byte) int) *builtinFunc {
+		return &builtinFunc{
+			minArgs: 1,
+			maxArgs: 1,
+			typ:     fixedType(sqltypes.Int64),
+			call: func(args []EvalResult) (EvalResult, error) {
+				return EvalResult{typ: sqltypes.Int64, ival: int64(f(args[0].toBytes()))}, nil
+			},
+		}
+	}
+	substr := func(fromLeft bool) *builtinFunc {
+		return &builtinFunc{
+			minArgs: 2,
+			maxArgs: 2,
+			typ:     fixedType(sqltypes.VarBinary),
+			call: func(args []EvalResult) (EvalResult, error) {
+				runes := []rune(string(args[0].toBytes()))
+				n := int(makeNumeric(args[1]).toInt64())
+				switch {
+				case n < 0:
+					n = 0
+				case n > len(runes):
+					n = len(runes)
+				}
+				if fromLeft {
+					runes = runes[:n]
+				} else {
+					runes = runes[len(runes)-n:]
+				}
+				return EvalResult{typ: sqltypes.VarBinary, bytes: []byte(string(runes))}, nil
+			},
+		}
+	}
+	datePart := func(part func(dt datetime) int) *builtinFunc {
+		return &builtinFunc{
+			minArgs: 1,
+			maxArgs: 1,
+			typ:     fixedType(sqltypes.Int64),
+			call: func(args []EvalResult) (EvalResult, error) {
+				dt, ok := parseDatetime(args[0].toBytes())
+				if !ok {
+					return resultNull, nil
+				}
+				return EvalResult{typ: sqltypes.Int64, ival: int64(part(dt))}, nil
+			},
+		}
+	}
+	coalesce := &builtinFunc{
+		minArgs:  1,
+		maxArgs:  -1,
+		nullable: true,
+		typ:      firstArgType,
+		call: func(args []EvalResult) (EvalResult, error) {
+			for _, arg := range args {
+				if !arg.isNull() {
+					return arg, nil
+				}
+			}
+			return resultNull, nil
+		},
+	}
+
+	builtinFuncs = map[string]*builtinFunc{
+		"lower": str(bytes.ToLower),
+		"lcase": str(bytes.ToLower),
+		"upper": str(bytes.ToUpper),
+		"ucase": str(bytes.ToUpper),
+		"ltrim": str(func(b []byte) []byte { return bytes.TrimLeft(b, " ") }),
+		"rtrim": str(func(b []byte) []byte { return bytes.TrimRight(b, " ") }),
+		"trim":  str(func(b []byte) []byte { return bytes.Trim(b, " ") }),
+		"reverse": str(func(b []byte) []byte {
+			runes := []rune(string(b))
+			for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+				runes[i], runes[j] = runes[j], runes[i]
+			}
+			return []byte(string(runes))
+		}),
+		"length":           strLen(func(b []byte) int { return len(b) }),
+		"octet_length":     strLen(func(b []byte) int { return len(b) }),
+		"char_length":      strLen(utf8.RuneCount),
+		"character_length": strLen(utf8.RuneCount),
+		"left":             substr(true),
+		"right":            substr(false),
+		"ifnull": {
+			minArgs:  2,
+			maxArgs:  2,
+			nullable: true,
+			typ:      firstArgType,
+			call:     coalesce.call,
+		},
+		"coalesce": coalesce,
+		"concat": {
+			minArgs: 1,
+			maxArgs: -1,
+			typ:     fixedType(sqltypes.VarBinary),
+			call: func(args []EvalResult) (EvalResult, error) {
+				var buf bytes.Buffer
+				for _, arg := range args {
+					buf.Write(arg.toBytes())
+				}
+				return EvalResult{typ: sqltypes.VarBinary, bytes: buf.Bytes()}, nil
+			},
+		},
+		"concat_ws": {
+			minArgs:  2,
+			maxArgs:  -1,
+			nullable: true,
+			typ:      fixedType(sqltypes.VarBinary),
+			call: func(args []EvalResult) (EvalResult, error) {
+				if args[0].isNull() {
+					return resultNull, nil
+				}
+				var parts [][]byte
+				for _, arg := range args[1:] {
+					if !arg.isNull() {
+						parts = append(parts, arg.toBytes())
+					}
+				}
+				return EvalResult{typ: sqltypes.VarBinary, bytes: bytes.Join(parts, args[0].toBytes())}, nil
+			},
+		},
+		"substring_index": {
+			minArgs: 3,
+			maxArgs: 3,
+			typ:     fixedType(sqltypes.VarBinary),
+			call: func(args []EvalResult) (EvalResult, error) {
+				str, delim := args[0].toBytes(), args[1].toBytes()
+				count := makeNumeric(args[2]).toInt64()
+				if len(delim) == 0 || count == 0 {
+					return EvalResult{typ: sqltypes.VarBinary, bytes: []byte{}}, nil
+				}
+				parts := bytes.Split(str, delim)
+				switch {
+				case count > 0 && int(count) < len(parts):
+					parts = parts[:count]
+				case count < 0 && int(-count) < len(parts):
+					parts = parts[len(parts)+int(count):]
+				}
+				return EvalResult{typ: sqltypes.VarBinary, bytes: bytes.Join(parts, delim)}, nil
+			},
+		},
+		"json_extract": {
+			minArgs: 2,
+			maxArgs: -1,
+			typ:     fixedType(sqltypes.TypeJSON),
+			call:    jsonExtract,
+		},
+		"json_unquote": {
+			minArgs: 1,
+			maxArgs: 1,
+			typ:     fixedType(sqltypes.VarBinary),
+			call:    jsonUnquote,
+		},
+		"date": {
+			minArgs: 1,
+			maxArgs: 1,
+			typ:     fixedType(sqltypes.Date),
+			call: func(args []EvalResult) (EvalResult, error) {
+				dt, ok := parseDatetime(args[0].toBytes())
+				if !ok {
+					return resultNull, nil
+				}
+				return EvalResult{typ: sqltypes.Date, bytes: []byte(fmt.Sprintf("%04d-%02d-%02d", dt.year, dt.month, dt.day))}, nil
+			},
+		},
+		"year":       datePart(func(dt datetime) int { return dt.year }),
+		"month":      datePart(func(dt datetime) int { return dt.month }),
+		"day":        datePart(func(dt datetime) int { return dt.day }),
+		"dayofmonth": datePart(func(dt datetime) int { return dt.day }),
+		"hour":       datePart(func(dt datetime) int { return dt.hour }),
+		"minute":     datePart(func(dt datetime) int { return dt.minute }),
+		"second":     datePart(func(dt datetime) int { return dt.second }),
+	}
+}
+
+func fixedType(typ querypb.Type) func([]querypb.Type) querypb.Type {
+	return func([]querypb.Type) querypb.Type {
+		return typ
+	}
+}
+
+func firstArgType(args []querypb.Type) querypb.Type {
+	return args[0]
+}
+
+// IsBuiltinFunc returns true if the function can be evaluated by the engine.
+func IsBuiltinFunc(name string) bool {
+	_, ok := builtinFuncs[strings.ToLower(name)]
+	return ok
+}
+
+// NewFuncExpr returns a call to a builtin function
+func NewFuncExpr(name string, args []Expr) (Expr, error) {
+	name = strings.ToLower(name)
+	fn, ok := builtinFuncs[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported function: %s", name)
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect parameter count in the call to %s", name)
+	}
+	return &FuncExpr{Name: name, Args: args, fn: fn}, nil
+}
+
+//Evaluate implements the Expr interface
+func (f *FuncExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	args := make([]EvalResult, len(f.Args))
+	for i, arg := range f.Args {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if val.isNull() && !f.fn.nullable {
+			return resultNull, nil
+		}
+		args[i] = val
+	}
+	return f.fn.call(args)
+}
+
+//Type implements the Expr interface
+func (f *FuncExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	types := make([]querypb.Type, len(f.Args))
+	for i, arg := range f.Args {
+		typ, err := arg.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types[i] = typ
+	}
+	return f.fn.typ(types), nil
+}
+
+//String implements the Expr interface
+func (f *FuncExpr) String() string {
+	args := make([]string, len(f.Args))
+	for i, arg := range f.Args {
+		args[i] = arg.String()
+	}
+	return f.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// toBytes returns the string representation of the result.
+func (e EvalResult) toBytes() []byte {
+	if sqltypes.IsNumber(e.typ) {
+		return e.Value().ToBytes()
+	}
+	return e.bytes
+}
+
+// toInt64 returns the integral value of a numeric result.
+func (e EvalResult) toInt64() int64 {
+	switch e.typ {
+	case sqltypes.Uint64:
+		return int64(e.uval)
+	case sqltypes.Float64:
+		return int64(e.fval)
+	}
+	return e.ival
+}
+
+type datetime struct {
+	year, month, day, hour, minute, second int
+}
+
+// parseDatetime parses the MySQL representation of a DATE, DATETIME
+// or TIMESTAMP, like '2020-01-02' or '2020-01-02 15:04:05.000000'.
+func parseDatetime(b []byte) (dt datetime, ok bool) {
+	s := string(b)
+	datePart, timePart := s, ""
+	if i := strings.IndexAny(s, " T"); i >= 0 {
+		datePart, timePart = s[:i], s[i+1:]
+	}
+	dateFields := strings.Split(datePart, "-")
+	if len(dateFields) != 3 {
+		return dt, false
+	}
+	parts := []*int{&dt.year, &dt.month, &dt.day}
+	if timePart != "" {
+		if i := strings.IndexByte(timePart, '.'); i >= 0 {
+			timePart = timePart[:i]
+		}
+		timeFields := strings.Split(timePart, ":")
+		if len(timeFields) != 3 {
+			return dt, false
+		}
+		dateFields = append(dateFields, timeFields...)
+		parts = append(parts, &dt.hour, &dt.minute, &dt.second)
+	}
+	for i, field := range dateFields {
+		v, err := strconv.Atoi(field)
+		if err != nil || v < 0 {
+			return dt, false
+		}
+		*parts[i] = v
+	}
+	if dt.month > 12 || dt.day > 31 || dt.hour > 23 || dt.minute > 59 || dt.second > 59 {
+		return dt, false
+	}
+	return dt, true
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// callFunc calls the function with arguments that are read from a row
+// holding the values.
+func callFunc(t *testing.T, name string, args ...sqltypes.Value) (sqltypes.Value, error) {
+	t.Helper()
+	exprs := make([]Expr, len(args))
+	for i := range args {
+		exprs[i] = NewColumn(i)
+	}
+	expr, err := NewFuncExpr(name, exprs)
+	require.NoError(t, err)
+	r, err := expr.Evaluate(ExpressionEnv{Row: args, Filter: true})
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return r.Value(), nil
+}
+
+func TestBuiltinFuncs(t *testing.T) {
+	str := sqltypes.NewVarBinary
+	num := sqltypes.NewInt64
+	tests := []struct {
+		name     string
+		args     []sqltypes.Value
+		expected sqltypes.Value
+	}{
+		{"lower", []sqltypes.Value{sqltypes.NewVarChar("AbC")}, str("abc")},
+		{"lcase", []sqltypes.Value{sqltypes.NewVarChar("AbC")}, str("abc")},
+		{"upper", []sqltypes.Value{sqltypes.NewVarChar("AbC")}, str("ABC")},
+		{"ucase", []sqltypes.Value{sqltypes.NewVarChar("AbC")}, str("ABC")},
+		{"upper", []sqltypes.Value{num(12)}, str("12")},
+		{"ltrim", []sqltypes.Value{sqltypes.NewVarChar("  a  ")}, str("a  ")},
+		{"rtrim", []sqltypes.Value{sqltypes.NewVarChar("  a  ")}, str("  a")},
+		{"trim", []sqltypes.Value{sqltypes.NewVarChar("  a  ")}, str("a")},
+		{"reverse", []sqltypes.Value{sqltypes.NewVarChar("aé€")}, str("€éa")},
+		{"length", []sqltypes.Value{sqltypes.NewVarChar("aé€")}, num(6)},
+		{"octet_length", []sqltypes.Value{sqltypes.NewVarChar("aé€")}, num(6)},
+		{"char_length", []sqltypes.Value{sqltypes.NewVarChar("aé€")}, num(3)},
+		{"character_length", []sqltypes.Value{sqltypes.NewVarChar("aé€")}, num(3)},
+		{"length", []sqltypes.Value{num(-12)}, num(3)},
+		{"left", []sqltypes.Value{sqltypes.NewVarChar("aé€b"), num(2)}, str("aé")},
+		{"left", []sqltypes.Value{sqltypes.NewVarChar("abc"), num(5)}, str("abc")},
+		{"left", []sqltypes.Value{sqltypes.NewVarChar("abc"), num(-1)}, str("")},
+		{"right", []sqltypes.Value{sqltypes.NewVarChar("aé€b"), num(2)}, str("€b")},
+		{"right", []sqltypes.Value{sqltypes.NewVarChar("abc"), sqltypes.NewVarChar("5")}, str("abc")},
+		{"right", []sqltypes.Value{sqltypes.NewVarChar("abc"), num(0)}, str("")},
+		{"ifnull", []sqltypes.Value{num(1), num(2)}, num(1)},
+		{"ifnull", []sqltypes.Value{sqltypes.NULL, num(2)}, num(2)},
+		{"ifnull", []sqltypes.Value{sqltypes.NULL, sqltypes.NULL}, sqltypes.NULL},
+		{"coalesce", []sqltypes.Value{sqltypes.NULL, sqltypes.NULL, num(3)}, num(3)},
+		{"coalesce", []sqltypes.Value{sqltypes.NULL}, sqltypes.NULL},
+		{"concat", []sqltypes.Value{sqltypes.NewVarChar("a"), num(1), sqltypes.NewFloat64(1.5)}, str("a11.5")},
+		{"concat", []sqltypes.Value{sqltypes.NewVarChar("a"), sqltypes.NULL}, sqltypes.NULL},
+		{"concat_ws", []sqltypes.Value{sqltypes.NewVarChar(","), sqltypes.NewVarChar("a"), sqltypes.NULL, num(1)}, str("a,1")},
+		{"concat_ws", []sqltypes.Value{sqltypes.NULL, sqltypes.NewVarChar("a")}, sqltypes.NULL},
+		{"substring_index", []sqltypes.Value{sqltypes.NewVarChar("a.b.c"), sqltypes.NewVarChar("."), num(2)}, str("a.b")},
+		{"substring_index", []sqltypes.Value{sqltypes.NewVarChar("a.b.c"), sqltypes.NewVarChar("."), num(-2)}, str("b.c")},
+		{"substring_index", []sqltypes.Value{sqltypes.NewVarChar("a.b.c"), sqltypes.NewVarChar("."), num(5)}, str("a.b.c")},
+		{"substring_index", []sqltypes.Value{sqltypes.NewVarChar("a.b.c"), sqltypes.NewVarChar("."), num(0)}, str("")},
+		{"substring_index", []sqltypes.Value{sqltypes.NewVarChar("a.b.c"), sqltypes.NewVarChar(""), num(1)}, str("")},
+		{"substring_index", []sqltypes.Value{sqltypes.NewVarChar("a.b.c"), sqltypes.NULL, num(1)}, sqltypes.NULL},
+		{"date", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02 15:04:05")}, sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-01-02"))},
+		{"date", []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-01-02"))}, sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-01-02"))},
+		{"date", []sqltypes.Value{sqltypes.NewVarChar("not a date")}, sqltypes.NULL},
+		{"year", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02 15:04:05.123456")}, num(2020)},
+		{"month", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02 15:04:05")}, num(1)},
+		{"day", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02 15:04:05")}, num(2)},
+		{"dayofmonth", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02T15:04:05")}, num(2)},
+		{"hour", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02 15:04:05")}, num(15)},
+		{"minute", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02 15:04:05")}, num(4)},
+		{"second", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02 15:04:05")}, num(5)},
+		{"hour", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02")}, num(0)},
+		{"month", []sqltypes.Value{sqltypes.NewVarChar("2020-13-02")}, sqltypes.NULL},
+		{"second", []sqltypes.Value{sqltypes.NewVarChar("2020-01-02 15:04")}, sqltypes.NULL},
+		{"year", []sqltypes.Value{sqltypes.NULL}, sqltypes.NULL},
+		// Names are case insensitive.
+		{"LOWER", []sqltypes.Value{sqltypes.NewVarChar("A")}, str("a")},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s%v", tc.name, tc.args), func(t *testing.T) {
+			got, err := callFunc(t, tc.name, tc.args...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestBuiltinFuncsNull(t *testing.T) {
+	// Except for the nullable functions, any NULL argument makes the result NULL.
+	nullable := map[string]bool{"ifnull": true, "coalesce": true, "concat_ws": true}
+	for name, fn := range builtinFuncs {
+		if nullable[name] {
+			continue
+		}
+		args := []sqltypes.Value{sqltypes.NewVarChar("2020-01-02"), sqltypes.NewVarChar("$"), sqltypes.NewInt64(1)}[:fn.minArgs]
+		for i := range args {
+			nullArgs := append([]sqltypes.Value(nil), args...)
+			nullArgs[i] = sqltypes.NULL
+			t.Run(fmt.Sprintf("%s%v", name, nullArgs), func(t *testing.T) {
+				got, err := callFunc(t, name, nullArgs...)
+				require.NoError(t, err)
+				assert.Equal(t, sqltypes.NULL, got)
+			})
+		}
+	}
+}
+
+func TestNewFuncExpr(t *testing.T) {
+	arg := NewLiteralInt(1)
+	tests := []struct {
+		name string
+		args []Expr
+		err  string
+	}{
+		{"lower", []Expr{arg}, ""},
+		{"lower", nil, "incorrect parameter count in the call to lower"},
+		{"lower", []Expr{arg, arg}, "incorrect parameter count in the call to lower"},
+		{"left", []Expr{arg}, "incorrect parameter count in the call to left"},
+		{"substring_index", []Expr{arg, arg}, "incorrect parameter count in the call to substring_index"},
+		{"concat", []Expr{arg, arg, arg, arg}, ""},
+		{"concat_ws", []Expr{arg}, "incorrect parameter count in the call to concat_ws"},
+		{"coalesce", nil, "incorrect parameter count in the call to coalesce"},
+		{"json_extract", []Expr{arg, arg, arg}, ""},
+		{"now", nil, "unsupported function: now"},
+		{"RAND", []Expr{arg}, "unsupported function: rand"},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s/%d", tc.name, len(tc.args)), func(t *testing.T) {
+			_, err := NewFuncExpr(tc.name, tc.args)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.err)
+		})
+	}
+	assert.True(t, IsBuiltinFunc("Lower"))
+	assert.False(t, IsBuiltinFunc("now"))
+}
+
+func TestFuncExprType(t *testing.T) {
+	env := ExpressionEnv{
+		Row:    []sqltypes.Value{sqltypes.NewInt32(1), sqltypes.NewVarChar("a")},
+		Filter: true,
+	}
+	tests := []struct {
+		name     string
+		args     []Expr
+		expected querypb.Type
+	}{
+		{"lower", []Expr{NewColumn(0)}, sqltypes.VarBinary},
+		{"reverse", []Expr{NewColumn(1)}, sqltypes.VarBinary},
+		{"char_length", []Expr{NewColumn(1)}, sqltypes.Int64},
+		{"left", []Expr{NewColumn(1), NewColumn(0)}, sqltypes.VarBinary},
+		{"ifnull", []Expr{NewColumn(0), NewColumn(1)}, sqltypes.Int32},
+		{"coalesce", []Expr{NewColumn(1), NewColumn(0)}, sqltypes.VarChar},
+		{"concat", []Expr{NewColumn(0), NewColumn(1)}, sqltypes.VarBinary},
+		{"concat_ws", []Expr{NewColumn(1), NewColumn(0)}, sqltypes.VarBinary},
+		{"substring_index", []Expr{NewColumn(1), NewColumn(1), NewColumn(0)}, sqltypes.VarBinary},
+		{"json_extract", []Expr{NewColumn(1), NewColumn(1)}, sqltypes.TypeJSON},
+		{"json_unquote", []Expr{NewColumn(1)}, sqltypes.VarBinary},
+		{"date", []Expr{NewColumn(1)}, sqltypes.Date},
+		{"year", []Expr{NewColumn(1)}, sqltypes.Int64},
+		{"second", []Expr{NewColumn(1)}, sqltypes.Int64},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := NewFuncExpr(tc.name, tc.args)
+			require.NoError(t, err)
+			typ, err := expr.Type(env)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, typ)
+		})
+	}
+}
+
+func TestFuncExprString(t *testing.T) {
+	expr, err := NewFuncExpr("IFNULL", []Expr{NewBindVar("a"), NewLiteralInt(1)})
+	require.NoError(t, err)
+	assert.Equal(t, "ifnull(:a, INT64(1))", expr.String())
+}
+
+func TestParseDatetime(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected datetime
+		ok       bool
+	}{
+		{"2020-01-02", datetime{year: 2020, month: 1, day: 2}, true},
+		{"2020-01-02 15:04:05", datetime{2020, 1, 2, 15, 4, 5}, true},
+		{"2020-01-02T15:04:05", datetime{2020, 1, 2, 15, 4, 5}, true},
+		{"2020-01-02 15:04:05.999999", datetime{2020, 1, 2, 15, 4, 5}, true},
+		{"0000-00-00 00:00:00", datetime{}, true},
+		{"2020-01", datetime{}, false},
+		{"2020-01-02 15:04", datetime{}, false},
+		{"2020-01-32", datetime{}, false},
+		{"2020-01-02 24:00:00", datetime{}, false},
+		{"2020-01-02 23:60:00", datetime{}, false},
+		{"2020-01-02 23:59:60", datetime{}, false},
+		{"2020--1-02", datetime{}, false},
+		{"abcd-01-02", datetime{}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			dt, ok := parseDatetime([]byte(tc.in))
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.Equal(t, tc.expected, dt)
+			}
+		})
+	}
+}
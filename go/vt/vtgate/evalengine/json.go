@@ -0,0 +1,248 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// parseJSON parses a JSON document. Numbers are kept as json.Number.
+func parseJSON(b []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	var extra interface{}
+	if err := dec.Decode(&extra); err != io.EOF {
+		return nil, fmt.Errorf("the document root must not be followed by other values")
+	}
+	return doc, nil
+}
+
+// formatJSON formats a JSON document the way MySQL does: object keys are
+// sorted by length, then alphabetically, and separators are followed by a space.
+func formatJSON(doc interface{}) []byte {
+	buf := &bytes.Buffer{}
+	writeJSON(buf, doc)
+	return buf.Bytes()
+}
+
+func writeJSON(buf *bytes.Buffer, doc interface{}) {
+	switch doc := doc.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(doc))
+	case json.Number:
+		buf.WriteString(doc.String())
+	case string:
+		writeJSONString(buf, doc)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, v := range doc {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeJSON(buf, v)
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(doc))
+		for k := range doc {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if len(keys[i]) != len(keys[j]) {
+				return len(keys[i]) < len(keys[j])
+			}
+			return keys[i] < keys[j]
+		})
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeJSONString(buf, k)
+			buf.WriteString(": ")
+			writeJSON(buf, doc[k])
+		}
+		buf.WriteByte('}')
+	}
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	// Encode appends a newline.
+	buf.Truncate(buf.Len() - 1)
+}
+
+// jsonPathLeg is a member name or an array index of a JSON path.
+type jsonPathLeg struct {
+	key   string
+	index int
+	isKey bool
+}
+
+// parseJSONPath parses a path like $.a."b c"[2]. Wildcards are not supported.
+func parseJSONPath(path string) ([]jsonPathLeg, error) {
+	invalid := vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid JSON path expression: %s", path)
+	s := strings.TrimSpace(path)
+	if !strings.HasPrefix(s, "$") {
+		return nil, invalid
+	}
+	s = s[1:]
+	var legs []jsonPathLeg
+	for s != "" {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			if strings.HasPrefix(s, "\"") {
+				end := 1
+				for end < len(s) && (s[end] != '"' || s[end-1] == '\\') {
+					end++
+				}
+				if end == len(s) {
+					return nil, invalid
+				}
+				key, err := strconv.Unquote(s[:end+1])
+				if err != nil {
+					return nil, invalid
+				}
+				legs = append(legs, jsonPathLeg{key: key, isKey: true})
+				s = s[end+1:]
+				continue
+			}
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			key := s[:end]
+			if key == "" || strings.ContainsAny(key, "* \"") {
+				return nil, invalid
+			}
+			legs = append(legs, jsonPathLeg{key: key, isKey: true})
+			s = s[end:]
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return nil, invalid
+			}
+			index, err := strconv.Atoi(strings.TrimSpace(s[1:end]))
+			if err != nil || index < 0 {
+				return nil, invalid
+			}
+			legs = append(legs, jsonPathLeg{index: index})
+			s = s[end+1:]
+		default:
+			return nil, invalid
+		}
+	}
+	return legs, nil
+}
+
+// extractJSON returns the value at the path, if there's one.
+func extractJSON(doc interface{}, legs []jsonPathLeg) (interface{}, bool) {
+	for _, leg := range legs {
+		if leg.isKey {
+			obj, ok := doc.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if doc, ok = obj[leg.key]; !ok {
+				return nil, false
+			}
+			continue
+		}
+		arr, ok := doc.([]interface{})
+		if !ok {
+			// Like in MySQL, a scalar is an array of one element.
+			if leg.index != 0 {
+				return nil, false
+			}
+			continue
+		}
+		if leg.index >= len(arr) {
+			return nil, false
+		}
+		doc = arr[leg.index]
+	}
+	return doc, true
+}
+
+// jsonArg parses the JSON document of a function argument.
+func jsonArg(fname string, arg EvalResult) (interface{}, error) {
+	doc, err := parseJSON(arg.toBytes())
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid JSON text in argument 1 to function %s: %v", fname, err)
+	}
+	return doc, nil
+}
+
+// jsonExtract implements JSON_EXTRACT(doc, path, ...). With more than one
+// path, the values found are returned in an array.
+func jsonExtract(args []EvalResult) (EvalResult, error) {
+	doc, err := jsonArg("json_extract", args[0])
+	if err != nil {
+		return EvalResult{}, err
+	}
+	var found []interface{}
+	for _, arg := range args[1:] {
+		legs, err := parseJSONPath(string(arg.toBytes()))
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if v, ok := extractJSON(doc, legs); ok {
+			found = append(found, v)
+		}
+	}
+	switch {
+	case len(found) == 0:
+		return resultNull, nil
+	case len(args) == 2:
+		return EvalResult{typ: sqltypes.TypeJSON, bytes: formatJSON(found[0])}, nil
+	}
+	return EvalResult{typ: sqltypes.TypeJSON, bytes: formatJSON(found)}, nil
+}
+
+// jsonUnquote implements JSON_UNQUOTE(val). Values that are not JSON
+// strings are returned as is.
+func jsonUnquote(args []EvalResult) (EvalResult, error) {
+	b := args[0].toBytes()
+	if len(b) < 2 || b[0] != '"' || b[len(b)-1] != '"' {
+		return EvalResult{typ: sqltypes.VarBinary, bytes: b}, nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid JSON text in argument 1 to function json_unquote: %v", err)
+	}
+	return EvalResult{typ: sqltypes.VarBinary, bytes: []byte(s)}, nil
+}
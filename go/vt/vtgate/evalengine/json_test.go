@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestFormatJSON(t *testing.T) {
+	tests := []struct {
+		in, expected string
+	}{
+		{`null`, `null`},
+		{`true`, `true`},
+		{`12.50`, `12.50`},
+		{`"a<b>\"c"`, `"a<b>\"c"`},
+		{`[1,"a",[]]`, `[1, "a", []]`},
+		// Keys are sorted by length, then alphabetically.
+		{`{"bb":1,"a":{"d":2,"c":3},"ab":null}`, `{"a": {"c": 3, "d": 2}, "ab": null, "bb": 1}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			doc, err := parseJSON([]byte(tc.in))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(formatJSON(doc)))
+		})
+	}
+	for _, in := range []string{``, `{`, `[1] [2]`, `{"a":1}x`} {
+		_, err := parseJSON([]byte(in))
+		assert.Error(t, err, in)
+	}
+}
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected []jsonPathLeg
+		err      bool
+	}{
+		{path: "$"},
+		{path: " $ "},
+		{path: "$.a", expected: []jsonPathLeg{{key: "a", isKey: true}}},
+		{path: "$.a.b[2]", expected: []jsonPathLeg{{key: "a", isKey: true}, {key: "b", isKey: true}, {index: 2}}},
+		{path: `$."a b".c`, expected: []jsonPathLeg{{key: "a b", isKey: true}, {key: "c", isKey: true}}},
+		{path: `$."a\"b"`, expected: []jsonPathLeg{{key: `a"b`, isKey: true}}},
+		{path: "$[ 1 ][0]", expected: []jsonPathLeg{{index: 1}, {index: 0}}},
+		{path: "a", err: true},
+		{path: "$.", err: true},
+		{path: "$.*", err: true},
+		{path: "$[*]", err: true},
+		{path: "$[-1]", err: true},
+		{path: "$[1", err: true},
+		{path: `$."a`, err: true},
+		{path: "$a", err: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			legs, err := parseJSONPath(tc.path)
+			if tc.err {
+				require.EqualError(t, err, "invalid JSON path expression: "+tc.path)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, legs)
+		})
+	}
+}
+
+func TestJSONExtract(t *testing.T) {
+	doc := sqltypes.NewVarChar(`{"a": {"b": [10, 20, {"c": "x"}]}, "d e": "y", "n": null}`)
+	json := func(s string) sqltypes.Value {
+		return sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(s))
+	}
+	tests := []struct {
+		args     []sqltypes.Value
+		expected sqltypes.Value
+		err      string
+	}{
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$")}, expected: json(`{"a": {"b": [10, 20, {"c": "x"}]}, "n": null, "d e": "y"}`)},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.a.b[1]")}, expected: json(`20`)},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.a.b[2].c")}, expected: json(`"x"`)},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar(`$."d e"`)}, expected: json(`"y"`)},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.n")}, expected: json(`null`)},
+		// A scalar is an array of one element.
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.a.b[1][0]")}, expected: json(`20`)},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.a.b[1][1]")}, expected: sqltypes.NULL},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.a.b[3]")}, expected: sqltypes.NULL},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.a.c")}, expected: sqltypes.NULL},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.a.b.c")}, expected: sqltypes.NULL},
+		// With more than one path, the values found are in an array.
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.a.b[0]"), sqltypes.NewVarChar("$.x"), sqltypes.NewVarChar(`$."d e"`)}, expected: json(`[10, "y"]`)},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.a.b[1]"), sqltypes.NewVarChar("$.x")}, expected: json(`[20]`)},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.x"), sqltypes.NewVarChar("$.y")}, expected: sqltypes.NULL},
+		{args: []sqltypes.Value{sqltypes.NewInt64(12), sqltypes.NewVarChar("$")}, expected: json(`12`)},
+		{args: []sqltypes.Value{doc, sqltypes.NULL}, expected: sqltypes.NULL},
+		{args: []sqltypes.Value{sqltypes.NULL, sqltypes.NewVarChar("$")}, expected: sqltypes.NULL},
+		{args: []sqltypes.Value{sqltypes.NewVarChar("{"), sqltypes.NewVarChar("$")}, err: "invalid JSON text in argument 1 to function json_extract: unexpected EOF"},
+		{args: []sqltypes.Value{doc, sqltypes.NewVarChar("$.*")}, err: "invalid JSON path expression: $.*"},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%v", tc.args[1:]), func(t *testing.T) {
+			got, err := callFunc(t, "json_extract", tc.args...)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestJSONUnquote(t *testing.T) {
+	tests := []struct {
+		in       sqltypes.Value
+		expected sqltypes.Value
+		err      string
+	}{
+		{in: sqltypes.NewVarChar(`"abc"`), expected: sqltypes.NewVarBinary("abc")},
+		{in: sqltypes.NewVarChar(`"a\"bé\n"`), expected: sqltypes.NewVarBinary("a\"bé\n")},
+		{in: sqltypes.NewVarChar(`""`), expected: sqltypes.NewVarBinary("")},
+		{in: sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`"x"`)), expected: sqltypes.NewVarBinary("x")},
+		// Values that are not JSON strings are returned as is.
+		{in: sqltypes.NewVarChar(`abc`), expected: sqltypes.NewVarBinary("abc")},
+		{in: sqltypes.NewVarChar(`[1, 2]`), expected: sqltypes.NewVarBinary("[1, 2]")},
+		{in: sqltypes.NewVarChar(`"`), expected: sqltypes.NewVarBinary(`"`)},
+		{in: sqltypes.NewInt64(12), expected: sqltypes.NewVarBinary("12")},
+		{in: sqltypes.NULL, expected: sqltypes.NULL},
+		{in: sqltypes.NewVarChar(`"a"b"`), err: "invalid JSON text in argument 1 to function json_unquote: invalid character 'b' after top-level value"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in.String(), func(t *testing.T) {
+			got, err := callFunc(t, "json_unquote", tc.in)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -31,7 +31,11 @@ func buildDDLPlan(sql string, in sqlparser.Statement, vschema ContextVSchema) (e
 }
 
 func buildOnlineDDLPlan(query string, stmt *sqlparser.DDL, vschema ContextVSchema) (engine.Primitive, error) {
-	_, keyspace, _, err := vschema.TargetDestination(stmt.Table.Qualifier.String())
+	tables := stmt.AffectedTables()
+	if len(tables) != 1 {
+		return nil, fmt.Errorf("Online DDL must apply to a single table: %s", query)
+	}
+	_, keyspace, _, err := vschema.TargetDestination(tables[0].Qualifier.String())
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +43,7 @@ func buildOnlineDDLPlan(query string, stmt *sqlparser.DDL, vschema ContextVSchem
 		return nil, fmt.Errorf("Not an online DDL: %s", query)
 	}
 	switch stmt.OnlineHint.Strategy {
-	case schema.DDLStrategyGhost, schema.DDLStrategyPTOSC: // OK, do nothing
+	case schema.DDLStrategyGhost, schema.DDLStrategyPTOSC, schema.DDLStrategyOnline: // OK, do nothing
 	case schema.DDLStrategyNormal:
 		return nil, fmt.Errorf("Not an online DDL strategy")
 	default:
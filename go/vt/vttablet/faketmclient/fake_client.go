@@ -88,6 +88,11 @@ func (client *FakeTabletManagerClient) GetSchema(ctx context.Context, tablet *to
 	return client.tmc.GetSchema(ctx, tablet, tables, excludeTables, includeViews)
 }
 
+// GetSchemaHistory is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetSchemaHistory(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHistoryRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	return nil, nil
+}
+
 // GetPermissions is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	return &tabletmanagerdatapb.Permissions{}, nil
@@ -202,6 +202,20 @@ func (client *Client) GetSchema(ctx context.Context, tablet *topodatapb.Tablet,
 	return response.SchemaDefinition, nil
 }
 
+// GetSchemaHistory is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetSchemaHistory(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHistoryRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetSchemaHistory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return response.SchemaVersions, nil
+}
+
 // GetPermissions is part of the tmclient.TabletManagerClient interface.
 func (client *Client) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
 	cc, c, err := client.dial(tablet)
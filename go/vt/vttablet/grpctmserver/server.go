@@ -84,6 +84,17 @@ func (s *server) GetSchema(ctx context.Context, request *tabletmanagerdatapb.Get
 	return response, err
 }
 
+func (s *server) GetSchemaHistory(ctx context.Context, request *tabletmanagerdatapb.GetSchemaHistoryRequest) (response *tabletmanagerdatapb.GetSchemaHistoryResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetSchemaHistory", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetSchemaHistoryResponse{}
+	schemaVersions, err := s.tm.GetSchemaHistory(ctx, request)
+	if err == nil {
+		response.SchemaVersions = schemaVersions
+	}
+	return response, err
+}
+
 func (s *server) GetPermissions(ctx context.Context, request *tabletmanagerdatapb.GetPermissionsRequest) (response *tabletmanagerdatapb.GetPermissionsResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "GetPermissions", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
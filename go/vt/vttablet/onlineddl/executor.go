@@ -28,7 +28,6 @@ import (
 	"strconv"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -51,8 +50,6 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
-
-	"github.com/google/shlex"
 )
 
 var (
@@ -84,6 +81,21 @@ var vexecInsertTemplates = []string{
 	) VALUES (
 		'val', 'val', 'val', 'val', 'val', 'val', 'val', 'val', FROM_UNIXTIME(0), 'val'
 	)`,
+	`INSERT IGNORE INTO _vt.schema_migrations (
+		migration_uuid,
+		keyspace,
+		shard,
+		mysql_schema,
+		mysql_table,
+		migration_statement,
+		strategy,
+		options,
+		requested_timestamp,
+		migration_context,
+		migration_status
+	) VALUES (
+		'val', 'val', 'val', 'val', 'val', 'val', 'val', 'val', FROM_UNIXTIME(0), 'val', 'val'
+	)`,
 }
 
 var emptyResult = &sqltypes.Result{
@@ -93,6 +105,9 @@ var emptyResult = &sqltypes.Result{
 var ghostOverridePath = flag.String("gh-ost-path", "", "override default gh-ost binary full path")
 var ptOSCOverridePath = flag.String("pt-osc-path", "", "override default pt-online-schema-change binary full path")
 var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Minute, "Interval between migration checks")
+var migrationCutOverWindow = flag.String("migration_cut_over_window", "", "Daily UTC time window, e.g. 02:00-05:00, in which online migrations may cut over. Migrations ready to cut over outside the window wait in ready_to_complete state. Empty means any time")
+var migrationConcurrency = flag.Int("migration_concurrency", 1, "Maximum number of online DDL migrations running concurrently. Migrations on the same table always run one at a time, and so do gh-ost and pt-osc migrations")
+var migrationRevertRetention = flag.Duration("migration_revert_retention", 24*time.Hour, "Duration for which a completed online migration can be reverted, and a table dropped by an online DROP TABLE can be undropped. Reverse replication runs, and dropped tables are held, for that duration")
 
 const (
 	maxPasswordLength     = 32 // MySQL's *replication* password may not exceed 32 characters
@@ -117,10 +132,10 @@ type Executor struct {
 	shard    string
 	dbName   string
 
-	initMutex         sync.Mutex
-	migrationMutex    sync.Mutex
-	migrationRunning  int64
-	lastMigrationUUID string
+	initMutex      sync.Mutex
+	migrationMutex sync.Mutex
+	// ownedRunningMigrations maps the UUIDs of migrations run by this executor to their *schema.OnlineDDL
+	ownedRunningMigrations sync.Map
 
 	ticks             *timer.Timer
 	isOpen            bool
@@ -356,10 +371,6 @@ func (e *Executor) ExecuteWithGhost(ctx context.Context, onlineDDL *schema.Onlin
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	if atomic.LoadInt64(&e.migrationRunning) > 0 {
-		return ErrExecutorMigrationAlreadyRunning
-	}
-
 	if e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
 		return ErrExecutorNotWritableTablet
 	}
@@ -501,17 +512,15 @@ curl -s 'http://localhost:%d/schema-migration/report-status?uuid=%s&status=%s&dr
 			fmt.Sprintf(`--panic-flag-file=%s`, e.ghostPanicFlagFileName(onlineDDL.UUID)),
 			fmt.Sprintf(`--execute=%t`, execute),
 		}
-		opts, _ := shlex.Split(onlineDDL.Options)
-		args = append(args, opts...)
+		args = append(args, onlineDDL.RuntimeOptions()...)
 		_, err := execCmd("bash", args, os.Environ(), "/tmp", nil, nil)
 		return err
 	}
 
-	atomic.StoreInt64(&e.migrationRunning, 1)
-	e.lastMigrationUUID = onlineDDL.UUID
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
 
 	go func() error {
-		defer atomic.StoreInt64(&e.migrationRunning, 0)
+		defer e.ownedRunningMigrations.Delete(onlineDDL.UUID)
 		defer e.dropOnlineDDLUser(ctx)
 		defer e.gcArtifacts(ctx)
 
@@ -549,10 +558,6 @@ func (e *Executor) ExecuteWithPTOSC(ctx context.Context, onlineDDL *schema.Onlin
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	if atomic.LoadInt64(&e.migrationRunning) > 0 {
-		return ErrExecutorMigrationAlreadyRunning
-	}
-
 	if e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
 		return ErrExecutorNotWritableTablet
 	}
@@ -729,17 +734,15 @@ export MYSQL_PWD
 				`--no-drop-old-table`,
 			)
 		}
-		opts, _ := shlex.Split(onlineDDL.Options)
-		args = append(args, opts...)
+		args = append(args, onlineDDL.RuntimeOptions()...)
 		_, err = execCmd("bash", args, os.Environ(), "/tmp", nil, nil)
 		return err
 	}
 
-	atomic.StoreInt64(&e.migrationRunning, 1)
-	e.lastMigrationUUID = onlineDDL.UUID
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
 
 	go func() error {
-		defer atomic.StoreInt64(&e.migrationRunning, 0)
+		defer e.ownedRunningMigrations.Delete(onlineDDL.UUID)
 		defer e.dropOnlineDDLUser(ctx)
 		defer e.gcArtifacts(ctx)
 
@@ -803,18 +806,60 @@ func (e *Executor) readMigration(ctx context.Context, uuid string) (onlineDDL *s
 		Status:      schema.OnlineDDLStatus(row["migration_status"].ToString()),
 		Retries:     row.AsInt64("retries", 0),
 		TabletAlias: row["tablet"].ToString(),
+
+		MigrationContext: row["migration_context"].ToString(),
 	}
 	return onlineDDL, nil
 }
 
+// readMigrationRow reads a migration's row, including columns which are not part of schema.OnlineDDL
+func (e *Executor) readMigrationRow(ctx context.Context, uuid string) (row sqltypes.RowNamedValues, err error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectMigration, "_vt", ":migration_uuid")
+	bindVars := map[string]*querypb.BindVariable{
+		"migration_uuid": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return nil, err
+	}
+	row = r.Named().Row()
+	if row == nil {
+		return nil, ErrMigrationNotFound
+	}
+	return row, nil
+}
+
+// readRevertibleTable returns the table kept for reverting given migration, or empty if the migration is not revertible
+func (e *Executor) readRevertibleTable(ctx context.Context, uuid string) (revertibleTable string, err error) {
+	row, err := e.readMigrationRow(ctx, uuid)
+	if err != nil {
+		return "", err
+	}
+	return row["revertible_table"].ToString(), nil
+}
+
+// isCompletionRequested returns true when the user has requested given migration to complete
+func (e *Executor) isCompletionRequested(ctx context.Context, uuid string) (bool, error) {
+	row, err := e.readMigrationRow(ctx, uuid)
+	if err != nil {
+		return false, err
+	}
+	return row.AsInt64("completion_requested", 0) != 0, nil
+}
+
 // terminateMigration attempts to interrupt and hard-stop a running migration
-func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.OnlineDDL, lastMigrationUUID string) (foundRunning bool, err error) {
-	if atomic.LoadInt64(&e.migrationRunning) > 0 {
-		// double check: is the running migration the very same one we wish to cancel?
-		if onlineDDL.UUID == lastMigrationUUID {
-			// assuming all goes well in next steps, we can already report that there has indeed been a migration
-			foundRunning = true
-		}
+func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.OnlineDDL) (foundRunning bool, err error) {
+	if _, ok := e.ownedRunningMigrations.Load(onlineDDL.UUID); ok {
+		// assuming all goes well in next steps, we can already report that there has indeed been a migration
+		foundRunning = true
+	}
+	if _, isDrop := onlineDDL.DropTableStatement(); isDrop {
+		// A lazy drop runs no tool and no stream
+		return foundRunning, nil
 	}
 	switch onlineDDL.Strategy {
 	case schema.DDLStrategyPTOSC:
@@ -842,6 +887,22 @@ func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.Onl
 		if err := e.createGhostPanicFlagFile(onlineDDL.UUID); err != nil {
 			return foundRunning, fmt.Errorf("Error cancelling migration, flag file error: %+v", err)
 		}
+	case schema.DDLStrategyOnline:
+		if onlineDDL.Status != schema.OnlineDDLStatusRunning && onlineDDL.Status != schema.OnlineDDLStatusReadyToComplete {
+			// A complete migration's stream is its reverse replication, which must be kept.
+			break
+		}
+		if _, isRevert := onlineDDL.RevertedUUID(); isRevert {
+			// A revert runs on the reverted migration's stream. It terminates once it sees it's no longer running.
+			if err := e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed); err != nil {
+				return foundRunning, fmt.Errorf("Error cancelling migration: %+v", err)
+			}
+			break
+		}
+		// vreplication migrations terminate once their stream is gone.
+		if err := e.deleteVReplStream(ctx, onlineDDL.UUID); err != nil {
+			return foundRunning, fmt.Errorf("Error cancelling migration, vreplication error: %+v", err)
+		}
 	}
 	return foundRunning, nil
 }
@@ -864,10 +925,18 @@ func (e *Executor) cancelMigration(ctx context.Context, uuid string, terminateRu
 			return nil, err
 		}
 		rowsAffected = 1
+	case schema.OnlineDDLStatusReadyToComplete:
+		if _, isDrop := onlineDDL.DropTableStatement(); isDrop {
+			// A drop which waits for its migration context has not run yet
+			if err := e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusCancelled); err != nil {
+				return nil, err
+			}
+			rowsAffected = 1
+		}
 	}
 
 	if terminateRunningMigration {
-		migrationFound, err := e.terminateMigration(ctx, onlineDDL, e.lastMigrationUUID)
+		migrationFound, err := e.terminateMigration(ctx, onlineDDL)
 		if migrationFound {
 			rowsAffected = 1
 		}
@@ -883,55 +952,236 @@ func (e *Executor) cancelMigration(ctx context.Context, uuid string, terminateRu
 	return result, nil
 }
 
-// scheduleNextMigration attemps to schedule a single migration to run next.
-// possibly there's no migrations to run. Possibly there's a migration running right now,
-// in which cases nothing happens.
-func (e *Executor) scheduleNextMigration(ctx context.Context) error {
-	e.migrationMutex.Lock()
-	defer e.migrationMutex.Unlock()
+// completeMigration requests a migration whose completion is postponed to complete. The migration
+// cuts over as soon as it is ready to, regardless of its options or of the cut-over window.
+func (e *Executor) completeMigration(ctx context.Context, uuid string) (result *sqltypes.Result, err error) {
+	parsed := sqlparser.BuildParsedQuery(sqlUpdateCompletionRequested, "_vt", ":migration_uuid")
+	bindVars := map[string]*querypb.BindVariable{
+		"migration_uuid": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	return e.execQuery(ctx, bound)
+}
 
-	if atomic.LoadInt64(&e.migrationRunning) > 0 {
-		return ErrExecutorMigrationAlreadyRunning
+// countOwnedRunningMigrations returns the number of migrations run by this executor, excluding
+// those which are ready to complete and wait for the user to complete them
+func (e *Executor) countOwnedRunningMigrations() (count int) {
+	e.ownedRunningMigrations.Range(func(_, value interface{}) bool {
+		if value.(*schema.OnlineDDL).Status != schema.OnlineDDLStatusReadyToComplete {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+// setOwnedMigrationStatus records the status of a migration run by this executor
+func (e *Executor) setOwnedMigrationStatus(onlineDDL *schema.OnlineDDL, status schema.OnlineDDLStatus) {
+	owned := *onlineDDL
+	owned.Status = status
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, &owned)
+}
+
+// isExternalToolMigration returns true when given migration is run by gh-ost or pt-osc
+func isExternalToolMigration(onlineDDL *schema.OnlineDDL) bool {
+	if _, isRevert := onlineDDL.RevertedUUID(); isRevert {
+		return false
+	}
+	if _, isDrop := onlineDDL.DropTableStatement(); isDrop {
+		return false
+	}
+	return onlineDDL.Strategy == schema.DDLStrategyGhost || onlineDDL.Strategy == schema.DDLStrategyPTOSC
+}
+
+// isExternalToolMigrationRunning returns true when this executor runs a gh-ost or pt-osc migration.
+// These share the online DDL user, and therefore run one at a time.
+func (e *Executor) isExternalToolMigrationRunning() (isRunning bool) {
+	e.ownedRunningMigrations.Range(func(_, value interface{}) bool {
+		isRunning = isExternalToolMigration(value.(*schema.OnlineDDL))
+		return !isRunning
+	})
+	return isRunning
+}
+
+// reviewDependencies reviews the migrations which given migration depends on. satisfied is true
+// when they are all complete. failedDependency is the UUID of a dependency which can no longer
+// complete, if any.
+func (e *Executor) reviewDependencies(ctx context.Context, onlineDDL *schema.OnlineDDL) (satisfied bool, failedDependency string, err error) {
+	for _, uuid := range onlineDDL.DependsOn() {
+		dependency, err := e.readMigration(ctx, uuid)
+		if err == ErrMigrationNotFound {
+			return false, uuid, nil
+		}
+		if err != nil {
+			return false, "", err
+		}
+		switch dependency.Status {
+		case schema.OnlineDDLStatusComplete:
+			continue
+		case schema.OnlineDDLStatusFailed, schema.OnlineDDLStatusCancelled, schema.OnlineDDLStatusUndropped:
+			return false, uuid, nil
+		default:
+			return false, "", nil
+		}
 	}
+	return true, "", nil
+}
+
+// isMigrationContextReady returns true when all other migrations in given migration's context are
+// ready to complete, or complete. Migrations in a context wait for this before they cut over, so
+// that they succeed or fail together.
+func (e *Executor) isMigrationContextReady(ctx context.Context, onlineDDL *schema.OnlineDDL) (bool, error) {
+	if onlineDDL.MigrationContext == "" {
+		return true, nil
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlSelectUnreadyContextMigrations, "_vt", ":migration_context", ":migration_uuid")
+	bindVars := map[string]*querypb.BindVariable{
+		"migration_context": sqltypes.StringBindVariable(onlineDDL.MigrationContext),
+		"migration_uuid":    sqltypes.StringBindVariable(onlineDDL.UUID),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return false, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return false, err
+	}
+	return len(r.Rows) == 0, nil
+}
+
+// scheduleNextMigrations makes queued migrations ready to run, in queue order, while fewer than
+// -migration_concurrency migrations are ready or running. Migrations whose completion is postponed
+// and which are ready to complete are not counted.
+// Migrations on the same table run strictly in order: a migration is only scheduled when no other
+// migration on its table is ready or running, and no earlier migration on its table is queued.
+// A migration is also only scheduled when the migrations it depends on are complete, and is
+// cancelled when any of them fails.
+// Since migrations in a context wait for each other to cut over, a migration in a context fails
+// when it's run by gh-ost or pt-osc, which cut over on their own, or when it's on the same table
+// as another migration in its context.
+func (e *Executor) scheduleNextMigrations(ctx context.Context) error {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
 
+	busyTables := map[string]bool{}
+	busyTableContexts := map[string]string{}
+	countActive := 0
 	{
-		parsed := sqlparser.BuildParsedQuery(sqlSelectCountReadyMigrations, "_vt")
+		parsed := sqlparser.BuildParsedQuery(sqlSelectActiveMigrations, "_vt")
 		r, err := e.execQuery(ctx, parsed.Query)
 		if err != nil {
 			return err
 		}
-		row := r.Named().Row()
-		countReady, err := row.ToInt64("count_ready")
+		for _, row := range r.Named().Rows {
+			busyTables[row["mysql_table"].ToString()] = true
+			busyTableContexts[row["mysql_table"].ToString()] = row["migration_context"].ToString()
+			// A migration waiting to be completed keeps its table busy, but does not hold back others.
+			if schema.OnlineDDLStatus(row["migration_status"].ToString()) != schema.OnlineDDLStatusReadyToComplete {
+				countActive++
+			}
+		}
+	}
+
+	parsed := sqlparser.BuildParsedQuery(sqlSelectQueuedMigrations, "_vt")
+	r, err := e.execQuery(ctx, parsed.Query)
+	if err != nil {
+		return err
+	}
+	for _, row := range r.Named().Rows {
+		if countActive >= *migrationConcurrency {
+			break
+		}
+		onlineDDL := &schema.OnlineDDL{
+			UUID:     row["migration_uuid"].ToString(),
+			Table:    row["mysql_table"].ToString(),
+			SQL:      row["migration_statement"].ToString(),
+			Strategy: sqlparser.DDLStrategy(row["strategy"].ToString()),
+			Options:  row["options"].ToString(),
+
+			MigrationContext: row["migration_context"].ToString(),
+		}
+		revertedUUID, isRevert := onlineDDL.RevertedUUID()
+		if isRevert {
+			// A revert runs on the table of the migration it reverts
+			if revertedDDL, err := e.readMigration(ctx, revertedUUID); err == nil {
+				onlineDDL.Table = revertedDDL.Table
+			}
+		}
+		if onlineDDL.MigrationContext != "" && isExternalToolMigration(onlineDDL) {
+			log.Infof("Executor.scheduleNextMigrations: failing migration %s, since %s cannot wait for its context to cut over", onlineDDL.UUID, onlineDDL.Strategy)
+			if err := e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed); err != nil {
+				return err
+			}
+			continue
+		}
+		if busyTables[onlineDDL.Table] {
+			if onlineDDL.MigrationContext != "" && busyTableContexts[onlineDDL.Table] == onlineDDL.MigrationContext {
+				// It would wait for a migration which in turn waits for it to be ready to cut over
+				log.Infof("Executor.scheduleNextMigrations: failing migration %s, since another migration in its context is on table %s", onlineDDL.UUID, onlineDDL.Table)
+				if err := e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		satisfied, failedDependency, err := e.reviewDependencies(ctx, onlineDDL)
 		if err != nil {
 			return err
 		}
-		if countReady > 0 {
-			// seems like there's already one migration that's good to go
-			return nil
+		if failedDependency != "" {
+			log.Infof("Executor.scheduleNextMigrations: cancelling migration %s, since migration %s it depends on cannot complete", onlineDDL.UUID, failedDependency)
+			if err := e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusCancelled); err != nil {
+				return err
+			}
+			continue
 		}
-	} // Cool, seems like no migration is ready. Let's try and make a single 'queued' migration 'ready'
-
-	parsed := sqlparser.BuildParsedQuery(sqlScheduleSingleMigration, "_vt")
-	_, err := e.execQuery(ctx, parsed.Query)
-
-	return err
+		// Whether or not this migration is scheduled, later migrations on its table wait for it.
+		busyTables[onlineDDL.Table] = true
+		busyTableContexts[onlineDDL.Table] = onlineDDL.MigrationContext
+		if !satisfied {
+			continue
+		}
+		if isRevert {
+			if err := e.updateMigrationTable(ctx, onlineDDL.UUID, onlineDDL.Table); err != nil {
+				return err
+			}
+		}
+		parsed := sqlparser.BuildParsedQuery(sqlScheduleMigration, "_vt", ":migration_uuid")
+		bindVars := map[string]*querypb.BindVariable{
+			"migration_uuid": sqltypes.StringBindVariable(onlineDDL.UUID),
+		}
+		bound, err := parsed.GenerateQuery(bindVars, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := e.execQuery(ctx, bound); err != nil {
+			return err
+		}
+		countActive++
+	}
+	return nil
 }
 
-func (e *Executor) runNextMigration(ctx context.Context) error {
+// runNextMigrations runs ready migrations, in order, while this executor runs fewer than
+// -migration_concurrency migrations. A drop in a migration context completes as soon as it runs,
+// so it waits in ready_to_complete state until the rest of its context is ready to complete.
+func (e *Executor) runNextMigrations(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	if atomic.LoadInt64(&e.migrationRunning) > 0 {
-		return ErrExecutorMigrationAlreadyRunning
-	}
-
-	parsed := sqlparser.BuildParsedQuery(sqlSelectReadyMigration, "_vt")
+	parsed := sqlparser.BuildParsedQuery(sqlSelectReadyMigrations, "_vt")
 	r, err := e.execQuery(ctx, parsed.Query)
 	if err != nil {
 		return err
 	}
-	named := r.Named()
-	for i, row := range named.Rows {
+	for _, row := range r.Named().Rows {
+		if e.countOwnedRunningMigrations() >= *migrationConcurrency {
+			break
+		}
 		onlineDDL := &schema.OnlineDDL{
 			Keyspace: row["keyspace"].ToString(),
 			Table:    row["mysql_table"].ToString(),
@@ -941,32 +1191,76 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 			Strategy: sqlparser.DDLStrategy(row["strategy"].ToString()),
 			Options:  row["options"].ToString(),
 			Status:   schema.OnlineDDLStatus(row["migration_status"].ToString()),
+
+			MigrationContext: row["migration_context"].ToString(),
 		}
-		switch onlineDDL.Strategy {
-		case schema.DDLStrategyGhost:
-			go func() {
-				if err := e.ExecuteWithGhost(ctx, onlineDDL); err != nil {
-					_ = e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed)
-				}
-			}()
-		case schema.DDLStrategyPTOSC:
-			go func() {
-				if err := e.ExecuteWithPTOSC(ctx, onlineDDL); err != nil {
-					_ = e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed)
+		if _, ok := e.ownedRunningMigrations.Load(onlineDDL.UUID); ok {
+			// Already started by this executor
+			continue
+		}
+		_, isDrop := onlineDDL.DropTableStatement()
+		if onlineDDL.Status == schema.OnlineDDLStatusReadyToComplete && !isDrop {
+			// Migrations other than drops wait for their context while they run
+			continue
+		}
+		if isDrop {
+			contextReady, err := e.isMigrationContextReady(ctx, onlineDDL)
+			if err != nil {
+				return err
+			}
+			if !contextReady {
+				if err := e.OnSchemaMigrationStatus(ctx, onlineDDL.UUID, string(schema.OnlineDDLStatusReadyToComplete), "false", ""); err != nil {
+					return err
 				}
-			}()
-		default:
-			{
+				continue
+			}
+		}
+		if isExternalToolMigration(onlineDDL) && e.isExternalToolMigrationRunning() {
+			continue
+		}
+		if err := e.runMigration(ctx, onlineDDL); err != nil {
+			log.Errorf("Executor.runNextMigrations: error running migration %s: %+v", onlineDDL.UUID, err)
+		}
+	}
+	return nil
+}
+
+// runMigration runs a ready migration in the background. The executor's migrationMutex must be held.
+func (e *Executor) runMigration(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	var execute func() error
+	if revertedUUID, isRevert := onlineDDL.RevertedUUID(); isRevert {
+		execute = func() error { return e.ExecuteRevert(ctx, onlineDDL, revertedUUID) }
+	} else {
+		// Reverse replication of earlier migrations cannot survive a change to their table.
+		if err := e.expireRevertibleMigrations(ctx, sqlSelectTableRevertibleMigrations, ":mysql_table",
+			sqltypes.StringBindVariable(onlineDDL.Table)); err != nil {
+			return err
+		}
+		if _, isDrop := onlineDDL.DropTableStatement(); isDrop {
+			execute = func() error { return e.ExecuteLazyDrop(ctx, onlineDDL) }
+		} else {
+			switch onlineDDL.Strategy {
+			case schema.DDLStrategyGhost:
+				execute = func() error { return e.ExecuteWithGhost(ctx, onlineDDL) }
+			case schema.DDLStrategyPTOSC:
+				execute = func() error { return e.ExecuteWithPTOSC(ctx, onlineDDL) }
+			case schema.DDLStrategyOnline:
+				execute = func() error { return e.ExecuteWithVReplication(ctx, onlineDDL) }
+			default:
 				_ = e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed)
 				return fmt.Errorf("Unsupported strategy: %+v", onlineDDL.Strategy)
 			}
 		}
-		// the query should only ever return a single row at the most
-		// but let's make it also explicit here that we only run a single migration
-		if i == 0 {
-			break
-		}
 	}
+	// The migration is owned from now on, so that it counts against -migration_concurrency
+	// before it gets to actually run.
+	e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
+	go func() {
+		if err := execute(); err != nil {
+			e.ownedRunningMigrations.Delete(onlineDDL.UUID)
+			_ = e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed)
+		}
+	}()
 	return nil
 }
 
@@ -1095,6 +1389,12 @@ func (e *Executor) reviewStaleMigrations(ctx context.Context) error {
 				return err
 			}
 		}
+		// If this is a vreplication migration, then its stream may still be running.
+		if onlineDDL.Strategy == schema.DDLStrategyOnline {
+			if err := e.deleteVReplStream(ctx, onlineDDL.UUID); err != nil {
+				return err
+			}
+		}
 		if onlineDDL.TabletAlias != e.TabletAliasString() {
 			// This means another tablet started the migration, and the migration has failed due to the tablet failure (e.g. master failover)
 			if err := e.updateTabletFailure(ctx, onlineDDL.UUID); err != nil {
@@ -1109,6 +1409,58 @@ func (e *Executor) reviewStaleMigrations(ctx context.Context) error {
 	return nil
 }
 
+// reviewRevertibleMigrations ends reverse replication of migrations whose revert retention has passed
+func (e *Executor) reviewRevertibleMigrations(ctx context.Context) error {
+	return e.expireRevertibleMigrations(ctx, sqlSelectExpiredRevertibleMigrations, ":seconds",
+		sqltypes.Int64BindVariable(int64(migrationRevertRetention.Seconds())))
+}
+
+// expireRevertibleMigrations makes migrations selected by given query no longer revertible, by ending
+// their reverse replication. Their artifacts, which include the original table, are then garbage-collected.
+func (e *Executor) expireRevertibleMigrations(ctx context.Context, query string, bindVarName string, bindVar *querypb.BindVariable) error {
+	parsed := sqlparser.BuildParsedQuery(query, "_vt", bindVarName)
+	bindVars := map[string]*querypb.BindVariable{
+		strings.TrimPrefix(bindVarName, ":"): bindVar,
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return err
+	}
+	for _, row := range r.Named().Rows {
+		uuid := row["migration_uuid"].ToString()
+		if err := e.deleteVReplStream(ctx, uuid); err != nil {
+			return err
+		}
+		if err := e.updateRevertibleTable(ctx, uuid, ""); err != nil {
+			return err
+		}
+		log.Infof("Executor.expireRevertibleMigrations: migration %s is no longer revertible", uuid)
+	}
+	return nil
+}
+
+// reviewFailedMigrationContexts cancels migrations which share their context with a failed or cancelled
+// migration. Migrations of the context which already completed are left as they are.
+func (e *Executor) reviewFailedMigrationContexts(ctx context.Context) error {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectFailedContextMigrations, "_vt", "_vt")
+	r, err := e.execQuery(ctx, parsed.Query)
+	if err != nil {
+		return err
+	}
+	for _, row := range r.Named().Rows {
+		uuid := row["migration_uuid"].ToString()
+		log.Infof("Executor.reviewFailedMigrationContexts: cancelling migration %s, since a migration in its context has failed", uuid)
+		if _, err := e.cancelMigration(ctx, uuid, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // retryTabletFailureMigrations looks for migrations failed by tablet failure (e.g. by failover)
 // and retry them (put them back in the queue)
 func (e *Executor) retryTabletFailureMigrations(ctx context.Context) error {
@@ -1185,10 +1537,13 @@ func (e *Executor) onMigrationCheckTick() {
 	if err := e.retryTabletFailureMigrations(ctx); err != nil {
 		log.Error(err)
 	}
-	if err := e.scheduleNextMigration(ctx); err != nil {
+	if err := e.reviewFailedMigrationContexts(ctx); err != nil {
+		log.Error(err)
+	}
+	if err := e.scheduleNextMigrations(ctx); err != nil {
 		log.Error(err)
 	}
-	if err := e.runNextMigration(ctx); err != nil {
+	if err := e.runNextMigrations(ctx); err != nil {
 		log.Error(err)
 	}
 	if _, err := e.reviewRunningMigrations(ctx); err != nil {
@@ -1197,6 +1552,9 @@ func (e *Executor) onMigrationCheckTick() {
 	if err := e.reviewStaleMigrations(ctx); err != nil {
 		log.Error(err)
 	}
+	if err := e.reviewRevertibleMigrations(ctx); err != nil {
+		log.Error(err)
+	}
 	if err := e.gcArtifacts(ctx); err != nil {
 		log.Error(err)
 	}
@@ -1269,6 +1627,55 @@ func (e *Executor) updateArtifacts(ctx context.Context, uuid string, artifacts .
 }
 
 // updateTabletFailure marks a given migration as "tablet_failed"
+func (e *Executor) clearArtifacts(ctx context.Context, uuid string) error {
+	parsed := sqlparser.BuildParsedQuery(sqlClearArtifacts, "_vt",
+		":migration_uuid",
+	)
+	bindVars := map[string]*querypb.BindVariable{
+		"migration_uuid": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, bound)
+	return err
+}
+
+func (e *Executor) updateMigrationTable(ctx context.Context, uuid string, table string) error {
+	parsed := sqlparser.BuildParsedQuery(sqlUpdateMigrationTable, "_vt",
+		":mysql_table",
+		":migration_uuid",
+	)
+	bindVars := map[string]*querypb.BindVariable{
+		"mysql_table":    sqltypes.StringBindVariable(table),
+		"migration_uuid": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, bound)
+	return err
+}
+
+func (e *Executor) updateRevertibleTable(ctx context.Context, uuid string, revertibleTable string) error {
+	parsed := sqlparser.BuildParsedQuery(sqlUpdateRevertibleTable, "_vt",
+		":revertible_table",
+		":migration_uuid",
+	)
+	bindVars := map[string]*querypb.BindVariable{
+		"revertible_table": sqltypes.StringBindVariable(revertibleTable),
+		"migration_uuid":   sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, bound)
+	return err
+}
+
 func (e *Executor) updateTabletFailure(ctx context.Context, uuid string) error {
 	parsed := sqlparser.BuildParsedQuery(sqlUpdateTabletFailure, "_vt",
 		":migration_uuid",
@@ -1354,7 +1761,7 @@ func (e *Executor) OnSchemaMigrationStatus(ctx context.Context, uuidParam, statu
 		{
 			err = e.updateMigrationTimestamp(ctx, "ready_timestamp", uuidParam)
 		}
-	case schema.OnlineDDLStatusRunning:
+	case schema.OnlineDDLStatusRunning, schema.OnlineDDLStatusReadyToComplete:
 		{
 			_ = e.updateMigrationStartedTimestamp(ctx, uuidParam)
 			err = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuidParam)
@@ -1439,9 +1846,21 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 				return nil, err
 			}
 			return response(e.cancelMigration(ctx, uuid, true))
+		case completeMigrationHint:
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			return response(e.completeMigration(ctx, uuid))
+		case undropMigrationHint:
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			return response(e.undropMigration(ctx, uuid))
 		default:
-			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s",
-				statusVal, retryMigrationHint, cancelMigrationHint)
+			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s, %s, %s",
+				statusVal, retryMigrationHint, cancelMigrationHint, completeMigrationHint, undropMigrationHint)
 		}
 	default:
 		return nil, fmt.Errorf("No handler for this query: %s", vx.Query)
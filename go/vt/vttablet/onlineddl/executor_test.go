@@ -0,0 +1,304 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// newTestExecutor returns an executor of a master tablet, which runs its queries on a fake database
+func newTestExecutor(t *testing.T) (*Executor, *fakesqldb.DB) {
+	db := fakesqldb.New(t)
+	params, err := db.ConnParams().MysqlParams()
+	require.NoError(t, err)
+	config := tabletenv.NewDefaultConfig()
+	config.DB = dbconfigs.NewTestDBConfigs(*params, *params, "")
+	env := tabletenv.NewEnv(config, "OnlineDDLTest")
+	e := &Executor{
+		env:            env,
+		pool:           connpool.NewPool(env, "ExecutorPool", tabletenv.ConnPoolConfig{Size: 1}),
+		tabletTypeFunc: func() topodatapb.TabletType { return topodatapb.TabletType_MASTER },
+		tabletAlias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		keyspace:       "ks",
+		shard:          "0",
+		dbName:         "vt_ks",
+	}
+	e.pool.Open(config.DB.AppWithDB(), config.DB.DbaWithDB(), config.DB.AppDebugWithDB())
+	t.Cleanup(func() {
+		e.pool.Close()
+		db.Close()
+	})
+	return e, db
+}
+
+// addMigrations makes the fake database return given migrations, as
+// migration_uuid|mysql_table|migration_statement|options|migration_status|strategy|migration_context rows,
+// to the given query, which selects migrations by their status
+func addMigrations(db *fakesqldb.DB, query string, rows ...string) {
+	db.AddQuery(sqlparser.BuildParsedQuery(query, "_vt").Query, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"migration_uuid|mysql_table|migration_statement|options|migration_status|strategy|migration_context",
+			"varchar|varchar|varchar|varchar|varchar|varchar|varchar"),
+		rows...,
+	))
+}
+
+// addMigration makes the fake database return given migration when it's read by its UUID
+func addMigration(db *fakesqldb.DB, uuid, table, status string) {
+	db.AddQueryPattern(fmt.Sprintf("(?is)^select.*from _vt\\.schema_migrations\\s+where\\s+migration_uuid='%s'.*", uuid), sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"migration_uuid|mysql_table|migration_statement|strategy|options|migration_status|migration_context",
+			"varchar|varchar|varchar|varchar|varchar|varchar|varchar"),
+		fmt.Sprintf("%s|%s|alter table %s add column i int|online||%s|ctx", uuid, table, table, status),
+	))
+}
+
+var statusUpdateRegexp = regexp.MustCompile(`^update _vt\.schema_migrations set migration_status='(\w+)'.* where migration_uuid='(\w+)'`)
+
+// statusUpdates returns the migration statuses set by the executor, as uuid:status
+func statusUpdates(db *fakesqldb.DB) (updates []string) {
+	for _, query := range strings.Split(db.QueryLog(), ";") {
+		if submatch := statusUpdateRegexp.FindStringSubmatch(strings.Join(strings.Fields(query), " ")); submatch != nil {
+			updates = append(updates, submatch[2]+":"+submatch[1])
+		}
+	}
+	return updates
+}
+
+func withMigrationConcurrency(t *testing.T, concurrency int) {
+	saved := *migrationConcurrency
+	*migrationConcurrency = concurrency
+	t.Cleanup(func() { *migrationConcurrency = saved })
+}
+
+func TestScheduleNextMigrations(t *testing.T) {
+	withMigrationConcurrency(t, 2)
+	e, db := newTestExecutor(t)
+	db.AddQueryPattern("(?is)^update _vt\\.schema_migrations.*", &sqltypes.Result{})
+	addMigrations(db, sqlSelectActiveMigrations,
+		"u1|t1|alter table t1 add column i int||running|online|",
+		// A postponed migration keeps its table busy, but doesn't count against the concurrency.
+		"u2|t2|alter table t2 add column i int||ready_to_complete|online|",
+	)
+	addMigrations(db, sqlSelectQueuedMigrations,
+		"u3|t1|alter table t1 add column j int||queued|online|",
+		"u4|t2|alter table t2 add column j int||queued|online|",
+		"u5|t3|alter table t3 add column i int||queued|online|",
+		// Migrations on the same table run in order.
+		"u6|t3|alter table t3 add column j int||queued|online|",
+		"u7|t4|alter table t4 add column i int||queued|online|",
+	)
+
+	require.NoError(t, e.scheduleNextMigrations(context.Background()))
+	assert.Equal(t, []string{"u5:ready"}, statusUpdates(db))
+
+	// Once the running migration is done, the next queued migrations run, up to the concurrency.
+	addMigrations(db, sqlSelectActiveMigrations,
+		"u2|t2|alter table t2 add column i int||ready_to_complete|online|",
+		"u5|t3|alter table t3 add column i int||running|online|",
+	)
+	addMigrations(db, sqlSelectQueuedMigrations,
+		"u3|t1|alter table t1 add column j int||queued|online|",
+		"u4|t2|alter table t2 add column j int||queued|online|",
+		"u6|t3|alter table t3 add column j int||queued|online|",
+		"u7|t4|alter table t4 add column i int||queued|online|",
+	)
+	db.ResetQueryLog()
+	require.NoError(t, e.scheduleNextMigrations(context.Background()))
+	assert.Equal(t, []string{"u3:ready"}, statusUpdates(db))
+}
+
+func TestScheduleNextMigrationsDependencies(t *testing.T) {
+	withMigrationConcurrency(t, 3)
+	e, db := newTestExecutor(t)
+	db.AddQueryPattern("(?is)^update _vt\\.schema_migrations.*", &sqltypes.Result{})
+	addMigrations(db, sqlSelectActiveMigrations)
+	addMigrations(db, sqlSelectQueuedMigrations,
+		"u1|t1|alter table t1 add column i int|--depends-on=d1|queued|online|",
+		"u2|t2|alter table t2 add column i int|--depends-on=d1,d2|queued|online|",
+		// A migration waiting for its dependencies holds back later migrations on its table.
+		"u3|t2|alter table t2 add column j int||queued|online|",
+		"u4|t3|alter table t3 add column i int|--depends-on=d3|queued|online|",
+		"u5|t4|alter table t4 add column i int|--depends-on=d4|queued|online|",
+	)
+	addMigration(db, "d1", "t5", "complete")
+	addMigration(db, "d2", "t5", "running")
+	addMigration(db, "d3", "t5", "failed")
+	db.AddQueryPattern("(?is)^select.*from _vt\\.schema_migrations\\s+where\\s+migration_uuid='d4'.*", &sqltypes.Result{})
+
+	require.NoError(t, e.scheduleNextMigrations(context.Background()))
+	// u4 depends on a failed migration, and u5 on a missing one.
+	assert.Equal(t, []string{"u1:ready", "u4:cancelled", "u5:cancelled"}, statusUpdates(db))
+}
+
+func TestReviewFailedMigrationContexts(t *testing.T) {
+	e, db := newTestExecutor(t)
+	db.AddQueryPattern("(?is)^update _vt\\.schema_migrations.*", &sqltypes.Result{})
+	db.AddQueryPattern("(?is)^select.*migration_context in \\(.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("migration_uuid", "varchar"),
+		"u1",
+		"u2",
+	))
+	addMigration(db, "u1", "t1", "queued")
+	addMigration(db, "u2", "t2", "ready")
+
+	require.NoError(t, e.reviewFailedMigrationContexts(context.Background()))
+	assert.Equal(t, []string{"u1:cancelled", "u2:cancelled"}, statusUpdates(db))
+	// Only migrations which are not complete are cancelled, and completed migrations are not reverted.
+	assert.Contains(t, db.QueryLog(), "migration_status in ('queued', 'ready', 'running', 'ready_to_complete')")
+}
+
+// addUnreadyContextMigrations makes the fake database return given migrations as those of the context
+// of the given migration which are not ready to complete
+func addUnreadyContextMigrations(t *testing.T, db *fakesqldb.DB, uuid, migrationContext string, uuids ...string) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectUnreadyContextMigrations, "_vt", ":migration_context", ":migration_uuid")
+	bound, err := parsed.GenerateQuery(map[string]*querypb.BindVariable{
+		"migration_context": sqltypes.StringBindVariable(migrationContext),
+		"migration_uuid":    sqltypes.StringBindVariable(uuid),
+	}, nil)
+	require.NoError(t, err)
+	db.AddQuery(bound, sqltypes.MakeTestResult(sqltypes.MakeTestFields("migration_uuid", "varchar"), uuids...))
+}
+
+func TestScheduleNextMigrationsContexts(t *testing.T) {
+	withMigrationConcurrency(t, 3)
+	e, db := newTestExecutor(t)
+	db.AddQueryPattern("(?is)^update _vt\\.schema_migrations.*", &sqltypes.Result{})
+	addMigrations(db, sqlSelectActiveMigrations,
+		"u1|t1|alter table t1 add column i int||ready_to_complete|online|c1",
+	)
+	addMigrations(db, sqlSelectQueuedMigrations,
+		// u1 waits for u2 to be ready to cut over, but u2 waits for u1 to complete.
+		"u2|t1|alter table t1 add column j int||queued|online|c1",
+		// gh-ost cuts over on its own.
+		"u3|t2|alter table t2 add column i int||queued|gh-ost|c1",
+		"u4|t3|drop table t3||queued|gh-ost|c1",
+		"u5|t1|alter table t1 add column k int||queued|online|c2",
+	)
+
+	require.NoError(t, e.scheduleNextMigrations(context.Background()))
+	assert.Equal(t, []string{"u2:failed", "u3:failed", "u4:ready"}, statusUpdates(db))
+}
+
+func TestMigrationContextCutOver(t *testing.T) {
+	e, db := newTestExecutor(t)
+	db.AddQueryPattern("(?is)^update _vt\\.schema_migrations.*", &sqltypes.Result{})
+	addMigration(db, "u1", "t1", "ready_to_complete")
+	v := &vreplMigration{e: e, onlineDDL: &schema.OnlineDDL{UUID: "u1", MigrationContext: "c1"}}
+
+	// u1 holds its cut-over while u2 is still copying.
+	addUnreadyContextMigrations(t, db, "u1", "c1", "u2")
+	postponed, err := v.isCompletionPostponed(context.Background())
+	require.NoError(t, err)
+	assert.True(t, postponed)
+
+	// A completion request doesn't override the context.
+	db.AddQueryPattern("(?is)^select.*from _vt\\.schema_migrations\\s+where\\s+migration_uuid='u1'.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("migration_uuid|completion_requested", "varchar|int64"),
+		"u1|1",
+	))
+	postponed, err = v.isCompletionPostponed(context.Background())
+	require.NoError(t, err)
+	assert.True(t, postponed)
+
+	// Once every migration in the context is ready to complete, u1 cuts over.
+	addUnreadyContextMigrations(t, db, "u1", "c1")
+	postponed, err = v.isCompletionPostponed(context.Background())
+	require.NoError(t, err)
+	assert.False(t, postponed)
+}
+
+func TestRunNextMigrationsContextDrop(t *testing.T) {
+	e, db := newTestExecutor(t)
+	db.AddQueryPattern("(?is)^update _vt\\.schema_migrations.*", &sqltypes.Result{})
+	db.AddQuery(sqlparser.BuildParsedQuery(sqlSelectReadyMigrations, "_vt").Query, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"migration_uuid|mysql_table|migration_statement|strategy|options|migration_status|migration_context",
+			"varchar|varchar|varchar|varchar|varchar|varchar|varchar"),
+		"u1|t1|drop table t1|online||ready|c1",
+		// A migration waiting for its context to cut over is not run again.
+		"u2|t2|alter table t2 add column i int|online||ready_to_complete|c1",
+	))
+	addUnreadyContextMigrations(t, db, "u1", "c1", "u3")
+
+	// A drop completes as soon as it runs, so it waits for the rest of its context.
+	require.NoError(t, e.runNextMigrations(context.Background()))
+	assert.Equal(t, []string{"u1:ready_to_complete"}, statusUpdates(db))
+	_, ok := e.ownedRunningMigrations.Load("u1")
+	assert.False(t, ok)
+	_, ok = e.ownedRunningMigrations.Load("u2")
+	assert.False(t, ok)
+
+	// It's cancelled along with its context.
+	db.AddQueryPattern("(?is)^select.*from _vt\\.schema_migrations\\s+where\\s+migration_uuid='u1'.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("migration_uuid|mysql_table|migration_statement|strategy|migration_status|migration_context", "varchar|varchar|varchar|varchar|varchar|varchar"),
+		"u1|t1|drop table t1|online|ready_to_complete|c1",
+	))
+	db.ResetQueryLog()
+	_, err := e.cancelMigration(context.Background(), "u1", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u1:cancelled"}, statusUpdates(db))
+}
+
+func TestCountOwnedRunningMigrations(t *testing.T) {
+	e := &Executor{}
+	e.ownedRunningMigrations.Store("u1", &schema.OnlineDDL{UUID: "u1", Status: schema.OnlineDDLStatusRunning})
+	e.ownedRunningMigrations.Store("u2", &schema.OnlineDDL{UUID: "u2", Status: schema.OnlineDDLStatusReady})
+	e.setOwnedMigrationStatus(&schema.OnlineDDL{UUID: "u3"}, schema.OnlineDDLStatusReadyToComplete)
+	assert.Equal(t, 2, e.countOwnedRunningMigrations())
+
+	e.setOwnedMigrationStatus(&schema.OnlineDDL{UUID: "u3"}, schema.OnlineDDLStatusRunning)
+	assert.Equal(t, 3, e.countOwnedRunningMigrations())
+}
+
+func TestIsExternalToolMigration(t *testing.T) {
+	tt := []struct {
+		strategy sqlparser.DDLStrategy
+		sql      string
+		expected bool
+	}{
+		{strategy: schema.DDLStrategyGhost, sql: "alter table t add column i int", expected: true},
+		{strategy: schema.DDLStrategyPTOSC, sql: "alter table t add column i int", expected: true},
+		{strategy: schema.DDLStrategyOnline, sql: "alter table t add column i int"},
+		{strategy: schema.DDLStrategyGhost, sql: "drop table t"},
+		{strategy: schema.DDLStrategyPTOSC, sql: "revert 1876a01a_1aba_11eb_9ea5_f875a4d24e90"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.sql, func(t *testing.T) {
+			onlineDDL := &schema.OnlineDDL{Strategy: tc.strategy, SQL: tc.sql}
+			assert.Equal(t, tc.expected, isExternalToolMigration(onlineDDL))
+		})
+	}
+}
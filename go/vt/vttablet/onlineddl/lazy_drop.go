@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ExecuteLazyDrop runs a DROP TABLE migration. Rather than dropping the table, which may stall MySQL
+// for a big table, it renames the table into the table GC's HOLD state. The table GC then purges and
+// drops the table, throttled. The table is held for -migration_revert_retention, during which the
+// migration can be undone with an undrop.
+func (e *Executor) ExecuteLazyDrop(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+	// A lazy drop is over as soon as its table is renamed away
+	defer e.ownedRunningMigrations.Delete(onlineDDL.UUID)
+
+	if e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
+		return ErrExecutorNotWritableTablet
+	}
+	ddl, isDrop := onlineDDL.DropTableStatement()
+	if !isDrop {
+		return fmt.Errorf("Migration %s does not drop a table: %s", onlineDDL.UUID, onlineDDL.SQL)
+	}
+	if err := e.OnSchemaMigrationStatus(ctx, onlineDDL.UUID, string(schema.OnlineDDLStatusRunning), "false", ""); err != nil {
+		return err
+	}
+	tableExists, err := e.tableExists(ctx, onlineDDL.Table)
+	if err != nil {
+		return err
+	}
+	if tableExists {
+		renameStatement, holdTableName, err := schema.GenerateRenameStatement(onlineDDL.Table, schema.HoldTableGCState, time.Now().UTC().Add(*migrationRevertRetention))
+		if err != nil {
+			return err
+		}
+		if _, err := e.execQuery(ctx, renameStatement); err != nil {
+			return err
+		}
+		log.Infof("Executor.ExecuteLazyDrop: migration %s renamed table %s to %s", onlineDDL.UUID, onlineDDL.Table, holdTableName)
+		if err := e.updateRevertibleTable(ctx, onlineDDL.UUID, holdTableName); err != nil {
+			return err
+		}
+	} else if !ddl.IfExists {
+		return fmt.Errorf("Table %s does not exist", onlineDDL.Table)
+	}
+	return e.OnSchemaMigrationStatus(ctx, onlineDDL.UUID, string(schema.OnlineDDLStatusComplete), "false", "100")
+}
+
+// undropMigration restores the table dropped by a DROP TABLE migration, by renaming it back from the
+// table GC's HOLD state. This is only possible while the migration is revertible, and while the
+// table has not yet moved on to be purged.
+func (e *Executor) undropMigration(ctx context.Context, uuid string) (result *sqltypes.Result, err error) {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	onlineDDL, err := e.readMigration(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if _, isDrop := onlineDDL.DropTableStatement(); !isDrop {
+		return nil, fmt.Errorf("Migration %s does not drop a table", uuid)
+	}
+	if onlineDDL.Status != schema.OnlineDDLStatusComplete {
+		return nil, fmt.Errorf("Migration %s is %s, only complete migrations can be undropped", uuid, onlineDDL.Status)
+	}
+	holdTableName, err := e.readRevertibleTable(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if holdTableName == "" {
+		return nil, fmt.Errorf("Migration %s can no longer be undropped", uuid)
+	}
+	holdTableExists, err := e.tableExists(ctx, holdTableName)
+	if err != nil {
+		return nil, err
+	}
+	if !holdTableExists {
+		return nil, fmt.Errorf("Table %s is no longer held, migration %s can no longer be undropped", holdTableName, uuid)
+	}
+	tableExists, err := e.tableExists(ctx, onlineDDL.Table)
+	if err != nil {
+		return nil, err
+	}
+	if tableExists {
+		return nil, fmt.Errorf("Table %s exists, migration %s cannot be undropped", onlineDDL.Table, uuid)
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlRenameTable, holdTableName, onlineDDL.Table)
+	if _, err := e.execQuery(ctx, parsed.Query); err != nil {
+		return nil, err
+	}
+	log.Infof("Executor.undropMigration: migration %s renamed table %s back to %s", uuid, holdTableName, onlineDDL.Table)
+	if err := e.updateRevertibleTable(ctx, uuid, ""); err != nil {
+		return nil, err
+	}
+	if err := e.updateMigrationStatus(ctx, uuid, schema.OnlineDDLStatusUndropped); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{RowsAffected: 1}, nil
+}
@@ -56,16 +56,17 @@ const (
 	alterSchemaMigrationsTableTabletFailure      = "ALTER TABLE %s.schema_migrations add column tablet_failure tinyint unsigned NOT NULL DEFAULT 0"
 	alterSchemaMigrationsTableTabletFailureIndex = "ALTER TABLE %s.schema_migrations add KEY tablet_failure_idx (tablet_failure, migration_status, retries)"
 	alterSchemaMigrationsTableProgress           = "ALTER TABLE %s.schema_migrations add column progress float NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableRevertibleTable    = "ALTER TABLE %s.schema_migrations add column revertible_table varchar(128) NOT NULL DEFAULT ''"
+	alterSchemaMigrationsTableCompletionRequest  = "ALTER TABLE %s.schema_migrations add column completion_requested tinyint unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableContext            = "ALTER TABLE %s.schema_migrations add column migration_context varchar(1024) NOT NULL DEFAULT ''"
 
-	sqlScheduleSingleMigration = `UPDATE %s.schema_migrations
+	sqlScheduleMigration = `UPDATE %s.schema_migrations
 		SET
 			migration_status='ready',
 			ready_timestamp=NOW()
 		WHERE
-			migration_status='queued'
-		ORDER BY
-			requested_timestamp ASC
-		LIMIT 1
+			migration_uuid=%a
+			AND migration_status='queued'
 	`
 	sqlUpdateMigrationStatus = `UPDATE %s.schema_migrations
 			SET migration_status=%a
@@ -97,6 +98,27 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlClearArtifacts = `UPDATE %s.schema_migrations
+			SET artifacts=''
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationTable = `UPDATE %s.schema_migrations
+			SET mysql_table=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateRevertibleTable = `UPDATE %s.schema_migrations
+			SET revertible_table=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateCompletionRequested = `UPDATE %s.schema_migrations
+			SET completion_requested=1
+		WHERE
+			migration_uuid=%a
+			AND migration_status IN ('queued', 'ready', 'running', 'ready_to_complete')
+	`
 	sqlUpdateTabletFailure = `UPDATE %s.schema_migrations
 			SET tablet_failure=1
 		WHERE
@@ -130,17 +152,55 @@ const (
 			migration_status='running'
 			AND strategy=%a
 	`
-	sqlSelectCountReadyMigrations = `SELECT
-			count(*) as count_ready
+	sqlSelectQueuedMigrations = `SELECT
+			migration_uuid,
+			mysql_table,
+			migration_statement,
+			strategy,
+			options,
+			migration_context
+		FROM %s.schema_migrations
+		WHERE
+			migration_status='queued'
+		ORDER BY
+			requested_timestamp ASC,
+			id ASC
+	`
+	sqlSelectActiveMigrations = `SELECT
+			migration_uuid,
+			mysql_table,
+			migration_status,
+			migration_context
 		FROM %s.schema_migrations
 		WHERE
-			migration_status='ready'
+			migration_status IN ('ready', 'running', 'ready_to_complete')
+	`
+	sqlSelectUnreadyContextMigrations = `SELECT
+			migration_uuid
+		FROM %s.schema_migrations
+		WHERE
+			migration_context=%a
+			AND migration_uuid!=%a
+			AND migration_status NOT IN ('ready_to_complete', 'complete')
+	`
+	sqlSelectFailedContextMigrations = `SELECT
+			migration_uuid
+		FROM %s.schema_migrations
+		WHERE
+			migration_status IN ('queued', 'ready', 'running', 'ready_to_complete')
+			AND migration_context IN (
+				SELECT migration_context
+				FROM %s.schema_migrations
+				WHERE
+					migration_status IN ('failed', 'cancelled')
+					AND migration_context!=''
+			)
 	`
 	sqlSelectStaleMigrations = `SELECT
 			migration_uuid
 		FROM %s.schema_migrations
 		WHERE
-			migration_status='running'
+			migration_status IN ('running', 'ready_to_complete')
 			AND liveness_timestamp < NOW() - INTERVAL %a MINUTE
 	`
 	sqlSelectUncollectedArtifacts = `SELECT
@@ -150,6 +210,21 @@ const (
 		WHERE
 			migration_status IN ('complete', 'failed')
 			AND cleanup_timestamp IS NULL
+			AND revertible_table=''
+	`
+	sqlSelectExpiredRevertibleMigrations = `SELECT
+			migration_uuid
+		FROM %s.schema_migrations
+		WHERE
+			revertible_table!=''
+			AND completed_timestamp < NOW() - INTERVAL %a SECOND
+	`
+	sqlSelectTableRevertibleMigrations = `SELECT
+			migration_uuid
+		FROM %s.schema_migrations
+		WHERE
+			revertible_table!=''
+			AND mysql_table=%a
 	`
 	sqlSelectMigration = `SELECT
 			id,
@@ -169,12 +244,15 @@ const (
 			migration_status,
 			log_path,
 			retries,
-			tablet
+			tablet,
+			revertible_table,
+			completion_requested,
+			migration_context
 		FROM %s.schema_migrations
 		WHERE
 			migration_uuid=%a
 	`
-	sqlSelectReadyMigration = `SELECT
+	sqlSelectReadyMigrations = `SELECT
 			id,
 			migration_uuid,
 			keyspace,
@@ -192,11 +270,15 @@ const (
 			migration_status,
 			log_path,
 			retries,
-			tablet
+			tablet,
+			migration_context
 		FROM %s.schema_migrations
 		WHERE
 			migration_status='ready'
-		LIMIT 1
+			OR (migration_status='ready_to_complete' AND migration_context!='')
+		ORDER BY
+			ready_timestamp ASC,
+			id ASC
 	`
 	sqlSelectPTOSCMigrationTriggers = `SELECT
 			TRIGGER_SCHEMA as trigger_schema,
@@ -210,11 +292,72 @@ const (
 		`
 	sqlDropTrigger    = "DROP TRIGGER IF EXISTS `%a`.`%a`"
 	sqlShowTablesLike = "SHOW TABLES LIKE '%a'"
+
+	sqlCreateTableLike    = "CREATE TABLE `%a` LIKE `%a`"
+	sqlAlterTableOptions  = "ALTER TABLE `%a` %s"
+	sqlSelectTableColumns = `SELECT
+			COLUMN_NAME as column_name
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+			AND EXTRA NOT IN ('VIRTUAL GENERATED', 'STORED GENERATED')
+		ORDER BY
+			ORDINAL_POSITION
+	`
+	sqlSelectTableRows = `SELECT
+			IFNULL(TABLE_ROWS, 0) as table_rows
+		FROM INFORMATION_SCHEMA.TABLES
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+	`
+	sqlSelectVReplStream = `SELECT
+			id,
+			workflow,
+			pos,
+			state,
+			message
+		FROM %s.vreplication
+		WHERE
+			workflow=%a
+			AND db_name=%a
+	`
+	sqlSelectCountCopyState = `SELECT
+			count(*) as count_copy_state
+		FROM %s.copy_state
+		WHERE
+			vrepl_id=%a
+	`
+	sqlUpdateVReplStreamSource = `UPDATE %s.vreplication
+			SET cell=%a, tablet_types=%a, state='Running'
+		WHERE
+			id=%a
+	`
+	sqlDeleteVReplStream = `DELETE FROM %s.vreplication
+		WHERE
+			workflow=%a
+			AND db_name=%a
+	`
+	sqlSelectProcessWaitingForLock = `SELECT
+			ID as id
+		FROM INFORMATION_SCHEMA.PROCESSLIST
+		WHERE
+			ID=%a
+			AND STATE='Waiting for table metadata lock'
+	`
+	sqlLockTableWrite = "LOCK TABLES `%a` WRITE"
+	sqlUnlockTables   = "UNLOCK TABLES"
+	sqlSwapTables     = "RENAME TABLE `%a` TO `%a`, `%a` TO `%a`, `%a` TO `%a`"
+	sqlKillQuery      = "KILL QUERY %a"
+	sqlRenameTable    = "RENAME TABLE `%a` TO `%a`"
 )
 
 const (
-	retryMigrationHint  = "retry"
-	cancelMigrationHint = "cancel"
+	retryMigrationHint    = "retry"
+	cancelMigrationHint   = "cancel"
+	completeMigrationHint = "complete"
+	undropMigrationHint   = "undrop"
 )
 
 var (
@@ -238,4 +381,7 @@ var applyDDL = []string{
 	fmt.Sprintf(alterSchemaMigrationsTableTabletFailure, "_vt"),
 	fmt.Sprintf(alterSchemaMigrationsTableTabletFailureIndex, "_vt"),
 	fmt.Sprintf(alterSchemaMigrationsTableProgress, "_vt"),
+	fmt.Sprintf(alterSchemaMigrationsTableRevertibleTable, "_vt"),
+	fmt.Sprintf(alterSchemaMigrationsTableCompletionRequest, "_vt"),
+	fmt.Sprintf(alterSchemaMigrationsTableContext, "_vt"),
 }
@@ -0,0 +1,869 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// vreplCheckInterval is the interval between two reviews of a running vreplication migration
+	vreplCheckInterval = 5 * time.Second
+	// vreplCutOverThreshold is the maximum time writes to the migrated table are blocked during cut-over
+	vreplCutOverThreshold = 5 * time.Second
+	// vreplRenameCheckInterval is the interval between two checks that the cut-over RENAME is blocked on the lock
+	vreplRenameCheckInterval = 100 * time.Millisecond
+)
+
+// errVReplNotCaughtUp is returned when the vreplication stream is too far behind to cut over
+var errVReplNotCaughtUp = errors.New("vreplication stream has not caught up")
+
+// vreplStream is the state of a migration's stream, as found in _vt.vreplication
+type vreplStream struct {
+	id       uint32
+	workflow string
+	pos      string
+	state    string
+	message  string
+}
+
+// vreplMigration runs a single migration with the "online" strategy. The migrated table is
+// populated by a vreplication stream running on this very tablet, and is swapped with the
+// original table once the stream has caught up. The original table is then kept, and
+// populated by reverse replication, so that the migration can be reverted.
+// A revert is a vreplMigration as well, which runs on the reverse stream of the reverted migration.
+type vreplMigration struct {
+	e         *Executor
+	onlineDDL *schema.OnlineDDL
+	tmClient  tmclient.TabletManagerClient
+	tablet    *topodatapb.Tablet
+
+	// workflow is the workflow of the stream populating vreplTableName
+	workflow       string
+	revertedUUID   string
+	vreplTableName string
+	swapTableName  string
+	streamID       uint32
+	// renames maps the lowercase names of the columns renamed by the migration
+	// to their new names. The columns of the original table are renamed in the
+	// migrated table, and back again by reverse replication.
+	renames map[string]string
+}
+
+// ExecuteWithVReplication validates and runs a migration using the built-in vreplication engine.
+// A shadow table is created with the new schema and populated by a vreplication stream, which
+// copies existing rows and then replays ongoing changes, respecting the tablet throttler.
+// Once the stream catches up, the shadow table is atomically swapped with the original table.
+func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	if e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
+		return ErrExecutorNotWritableTablet
+	}
+	tabletInfo, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	forceTableNames := fmt.Sprintf("%s_%s", onlineDDL.UUID, ReadableTimestamp())
+	v := &vreplMigration{
+		e:              e,
+		onlineDDL:      onlineDDL,
+		tmClient:       tmclient.NewTabletManagerClient(),
+		tablet:         tabletInfo.Tablet,
+		workflow:       onlineDDL.UUID,
+		vreplTableName: fmt.Sprintf("_%s_vrepl", forceTableNames),
+		swapTableName:  fmt.Sprintf("_%s_swap", forceTableNames),
+	}
+	if err := v.prepare(ctx); err != nil {
+		log.Errorf("Error preparing vreplication migration %s: %+v", onlineDDL.UUID, err)
+		_ = v.deleteStream(ctx)
+		v.tmClient.Close()
+		return err
+	}
+	v.start(ctx)
+	return nil
+}
+
+// ExecuteRevert reverts a completed "online" migration. Since the migration's cut-over, its
+// original table has been kept up to date by reverse replication, so the revert only needs to
+// swap the tables back. The revert is itself revertible.
+func (e *Executor) ExecuteRevert(ctx context.Context, onlineDDL *schema.OnlineDDL, revertedUUID string) error {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	if e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
+		return ErrExecutorNotWritableTablet
+	}
+	revertedDDL, err := e.readMigration(ctx, revertedUUID)
+	if err != nil {
+		return err
+	}
+	if revertedDDL.Status != schema.OnlineDDLStatusComplete {
+		return fmt.Errorf("Migration %s is %s, only complete migrations can be reverted", revertedUUID, revertedDDL.Status)
+	}
+	if _, isDrop := revertedDDL.DropTableStatement(); isDrop {
+		return fmt.Errorf("Migration %s drops a table, and is reverted by undrop", revertedUUID)
+	}
+	revertibleTable, err := e.readRevertibleTable(ctx, revertedUUID)
+	if err != nil {
+		return err
+	}
+	if revertibleTable == "" {
+		return fmt.Errorf("Migration %s is not revertible", revertedUUID)
+	}
+	onlineDDL.Table = revertedDDL.Table
+	if err := e.updateMigrationTable(ctx, onlineDDL.UUID, onlineDDL.Table); err != nil {
+		return err
+	}
+	tabletInfo, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	v := &vreplMigration{
+		e:              e,
+		onlineDDL:      onlineDDL,
+		tmClient:       tmclient.NewTabletManagerClient(),
+		tablet:         tabletInfo.Tablet,
+		workflow:       revertedUUID,
+		revertedUUID:   revertedUUID,
+		vreplTableName: revertibleTable,
+		swapTableName:  fmt.Sprintf("_%s_%s_swap", onlineDDL.UUID, ReadableTimestamp()),
+	}
+	if v.renames, err = e.readColumnRenames(ctx, onlineDDL); err != nil {
+		v.tmClient.Close()
+		return err
+	}
+	s, err := v.readStream(ctx)
+	if err != nil {
+		v.tmClient.Close()
+		return err
+	}
+	v.streamID = s.id
+	if err := e.OnSchemaMigrationStatus(ctx, onlineDDL.UUID, string(schema.OnlineDDLStatusRunning), "false", ""); err != nil {
+		v.tmClient.Close()
+		return err
+	}
+	v.start(ctx)
+	return nil
+}
+
+// start runs the migration in the background. The executor's migrationMutex must be held.
+func (v *vreplMigration) start(ctx context.Context) {
+	e := v.e
+	uuid := v.onlineDDL.UUID
+
+	e.ownedRunningMigrations.Store(uuid, v.onlineDDL)
+
+	go func() error {
+		defer e.ownedRunningMigrations.Delete(uuid)
+		defer v.tmClient.Close()
+		defer e.gcArtifacts(ctx)
+
+		startedMigrations.Add(1)
+		if err := v.run(ctx); err != nil {
+			_ = e.OnSchemaMigrationStatus(ctx, uuid, string(schema.OnlineDDLStatusFailed), "false", "")
+			if v.revertedUUID == "" {
+				// A failed revert leaves the reverted migration as it was.
+				_ = v.deleteStream(ctx)
+			}
+			failedMigrations.Add(1)
+			log.Errorf("Error running vreplication migration %s: %+v", uuid, err)
+			return err
+		}
+		successfulMigrations.Add(1)
+		log.Infof("+ OK")
+		return nil
+	}()
+}
+
+// prepare creates the shadow table and a vreplication stream to populate it. The stream
+// reads from this tablet only, so that it can be waited on while the original table is locked.
+func (v *vreplMigration) prepare(ctx context.Context) error {
+	e := v.e
+	if err := e.updateArtifacts(ctx, v.onlineDDL.UUID, v.vreplTableName); err != nil {
+		return err
+	}
+	{
+		parsed := sqlparser.BuildParsedQuery(sqlCreateTableLike, v.vreplTableName, v.onlineDDL.Table)
+		if _, err := e.execQuery(ctx, parsed.Query); err != nil {
+			return err
+		}
+	}
+	{
+		// See ExecuteWithGhost: sqlparser does not fully parse ALTER TABLE yet.
+		_, _, alterOptions := schema.ParseAlterTableOptions(v.onlineDDL.SQL)
+		renames, err := parseColumnRenames(alterOptions)
+		if err != nil {
+			return err
+		}
+		v.renames = renames
+		parsed := sqlparser.BuildParsedQuery(sqlAlterTableOptions, v.vreplTableName, alterOptions)
+		if _, err := e.execQuery(ctx, parsed.Query); err != nil {
+			return err
+		}
+	}
+	sourceColumns, err := e.readTableColumns(ctx, v.onlineDDL.Table)
+	if err != nil {
+		return err
+	}
+	targetColumns, err := e.readTableColumns(ctx, v.vreplTableName)
+	if err != nil {
+		return err
+	}
+	columns, mappedColumns := sharedColumns(sourceColumns, targetColumns, v.renames)
+	if len(columns) == 0 {
+		return fmt.Errorf("Found no shared columns between %s and the migrated table", v.onlineDDL.Table)
+	}
+	if v.streamID, err = v.createStream(ctx, v.workflow, v.onlineDDL.Table, v.vreplTableName, columns, mappedColumns, ""); err != nil {
+		return err
+	}
+	return e.OnSchemaMigrationStatus(ctx, v.onlineDDL.UUID, string(schema.OnlineDDLStatusRunning), "false", "")
+}
+
+// createStream creates a stream which replicates the given columns from one table into the mapped
+// columns of another, starting at the given position, or with a copy of the table if no position is given.
+func (v *vreplMigration) createStream(ctx context.Context, workflow, fromTableName, toTableName string, columns, mappedColumns []string, pos string) (streamID uint32, err error) {
+	e := v.e
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: e.keyspace,
+		Shard:    e.shard,
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  toTableName,
+				Filter: vreplFilterQuery(fromTableName, columns, mappedColumns),
+			}},
+		},
+	}
+	// The stream is created stopped, and only starts once it is pinned to this tablet.
+	qr, err := v.tmClient.VReplicationExec(ctx, v.tablet, binlogplayer.CreateVReplicationState(workflow, bls, pos, binlogplayer.BlpStopped, e.dbName))
+	if err != nil {
+		return 0, err
+	}
+	streamID = uint32(qr.InsertId)
+
+	parsed := sqlparser.BuildParsedQuery(sqlUpdateVReplStreamSource, "_vt", ":cell", ":tablet_types", ":id")
+	bindVars := map[string]*querypb.BindVariable{
+		"cell":         sqltypes.StringBindVariable(e.tabletAlias.Cell),
+		"tablet_types": sqltypes.StringBindVariable(topodatapb.TabletType_MASTER.String()),
+		"id":           sqltypes.Uint64BindVariable(uint64(streamID)),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return streamID, err
+	}
+	_, err = v.tmClient.VReplicationExec(ctx, v.tablet, bound)
+	return streamID, err
+}
+
+// createReverseStream keeps the original table, now named vreplTableName, up to date
+// with the migrated table, so that the migration can be reverted.
+func (v *vreplMigration) createReverseStream(ctx context.Context, pos string) error {
+	e := v.e
+	sourceColumns, err := e.readTableColumns(ctx, v.onlineDDL.Table)
+	if err != nil {
+		return err
+	}
+	targetColumns, err := e.readTableColumns(ctx, v.vreplTableName)
+	if err != nil {
+		return err
+	}
+	columns, mappedColumns := sharedColumns(sourceColumns, targetColumns, invertColumnRenames(v.renames))
+	if len(columns) == 0 {
+		return fmt.Errorf("Found no shared columns between %s and the original table", v.onlineDDL.Table)
+	}
+	if _, err := v.createStream(ctx, v.onlineDDL.UUID, v.onlineDDL.Table, v.vreplTableName, columns, mappedColumns, pos); err != nil {
+		return err
+	}
+	return e.updateRevertibleTable(ctx, v.onlineDDL.UUID, v.vreplTableName)
+}
+
+// run reviews the migration periodically until it either completes or fails
+func (v *vreplMigration) run(ctx context.Context) error {
+	ticker := time.NewTicker(vreplCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if v.e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
+			return ErrExecutorNotWritableTablet
+		}
+		complete, err := v.review(ctx)
+		if err != nil {
+			return err
+		}
+		if complete {
+			return nil
+		}
+	}
+}
+
+// review reports the progress of the migration, and cuts over once the copy is done.
+func (v *vreplMigration) review(ctx context.Context) (complete bool, err error) {
+	e := v.e
+	onlineDDL, err := e.readMigration(ctx, v.onlineDDL.UUID)
+	if err != nil {
+		return false, err
+	}
+	if onlineDDL.Status != schema.OnlineDDLStatusRunning && onlineDDL.Status != schema.OnlineDDLStatusReadyToComplete {
+		return false, fmt.Errorf("Migration %s is %s", onlineDDL.UUID, onlineDDL.Status)
+	}
+	s, err := v.readStream(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch s.state {
+	case binlogplayer.BlpError, binlogplayer.BlpStopped:
+		return false, fmt.Errorf("vreplication stream %d is %s: %s", s.id, s.state, s.message)
+	}
+	copying, err := v.isCopying(ctx, s)
+	if err != nil {
+		return false, err
+	}
+	if copying {
+		progress, err := v.copyProgress(ctx)
+		if err != nil {
+			return false, err
+		}
+		return false, e.OnSchemaMigrationStatus(ctx, v.onlineDDL.UUID, string(schema.OnlineDDLStatusRunning), "false", fmt.Sprintf("%f", progress))
+	}
+	if v.revertedUUID == "" {
+		postponed, err := v.isCompletionPostponed(ctx)
+		if err != nil {
+			return false, err
+		}
+		if postponed {
+			// The stream keeps the migrated table in sync meanwhile. The migration does not count
+			// against -migration_concurrency until it resumes.
+			e.setOwnedMigrationStatus(v.onlineDDL, schema.OnlineDDLStatusReadyToComplete)
+			return false, e.OnSchemaMigrationStatus(ctx, v.onlineDDL.UUID, string(schema.OnlineDDLStatusReadyToComplete), "false", "100")
+		}
+	}
+	e.setOwnedMigrationStatus(v.onlineDDL, schema.OnlineDDLStatusRunning)
+	if err := e.OnSchemaMigrationStatus(ctx, v.onlineDDL.UUID, string(schema.OnlineDDLStatusRunning), "false", "100"); err != nil {
+		return false, err
+	}
+	pos, err := v.cutOver(ctx)
+	if err != nil {
+		if err == errVReplNotCaughtUp {
+			log.Infof("vreplication migration %s: stream is not caught up, postponing cut-over", v.onlineDDL.UUID)
+			return false, nil
+		}
+		return false, err
+	}
+	if err := v.deleteStream(ctx); err != nil {
+		return false, err
+	}
+	if v.revertedUUID != "" {
+		// The original table of the reverted migration now belongs to the revert.
+		if err := e.updateRevertibleTable(ctx, v.revertedUUID, ""); err != nil {
+			return false, err
+		}
+		if err := e.clearArtifacts(ctx, v.revertedUUID); err != nil {
+			return false, err
+		}
+		if err := e.updateArtifacts(ctx, v.onlineDDL.UUID, v.vreplTableName); err != nil {
+			return false, err
+		}
+	}
+	// The tables are swapped at this point, so the migration is complete even if it can't be reverted.
+	if err := v.createReverseStream(ctx, pos); err != nil {
+		log.Errorf("vreplication migration %s: cannot create reverse replication, migration will not be revertible: %+v", v.onlineDDL.UUID, err)
+		_ = v.deleteStreamOf(ctx, v.onlineDDL.UUID)
+	}
+	return true, e.OnSchemaMigrationStatus(ctx, v.onlineDDL.UUID, string(schema.OnlineDDLStatusComplete), "false", "100")
+}
+
+// isCompletionPostponed returns true when the migration must not cut over yet, either because other
+// migrations in its context are not ready to, because its options postpone completion until the user
+// requests it, or because it's outside the cut-over window. A completion request by the user overrides
+// the latter two.
+func (v *vreplMigration) isCompletionPostponed(ctx context.Context) (bool, error) {
+	contextReady, err := v.e.isMigrationContextReady(ctx, v.onlineDDL)
+	if err != nil {
+		return false, err
+	}
+	if !contextReady {
+		return true, nil
+	}
+	completionRequested, err := v.e.isCompletionRequested(ctx, v.onlineDDL.UUID)
+	if err != nil || completionRequested {
+		return false, err
+	}
+	if v.onlineDDL.IsPostponeCompletion() {
+		return true, nil
+	}
+	inWindow, err := inCutOverWindow(*migrationCutOverWindow, time.Now().UTC())
+	if err != nil {
+		return false, err
+	}
+	return !inWindow, nil
+}
+
+// cutOver swaps the shadow table with the original table. Writes to the original table are
+// blocked while the stream applies the remaining changes; the swap itself is queued behind the
+// lock, and so takes precedence over any writes blocked by it. cutOver returns the position
+// at which the tables were swapped.
+func (v *vreplMigration) cutOver(ctx context.Context) (pos string, err error) {
+	e := v.e
+	// There's no point in blocking writes if the stream can't even catch up while they're allowed.
+	if _, err := v.waitForPos(ctx); err != nil {
+		return "", errVReplNotCaughtUp
+	}
+
+	lockConn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return "", err
+	}
+	defer lockConn.Close()
+	renameConn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return "", err
+	}
+	defer renameConn.Close()
+	// checkConn watches and possibly kills the RENAME, which requires privileges beyond the executor pool's
+	checkConn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return "", err
+	}
+	defer checkConn.Close()
+
+	lockParsed := sqlparser.BuildParsedQuery(sqlLockTableWrite, v.onlineDDL.Table)
+	if _, err := lockConn.ExecuteFetch(lockParsed.Query, 0, false); err != nil {
+		return "", err
+	}
+	locked := true
+	unlock := func() error {
+		if !locked {
+			return nil
+		}
+		locked = false
+		_, err := lockConn.ExecuteFetch(sqlUnlockTables, 0, false)
+		return err
+	}
+	defer unlock()
+
+	pos, err = v.waitForPos(ctx)
+	if err != nil {
+		return "", errVReplNotCaughtUp
+	}
+	if _, err := v.tmClient.VReplicationExec(ctx, v.tablet, binlogplayer.StopVReplication(v.streamID, "stopped for online DDL cut-over")); err != nil {
+		return "", err
+	}
+	swapped := false
+	defer func() {
+		if !swapped {
+			// Resume the stream, the cut-over will be attempted again
+			_, _ = v.tmClient.VReplicationExec(ctx, v.tablet, binlogplayer.StartVReplication(v.streamID))
+		}
+	}()
+
+	swapParsed := sqlparser.BuildParsedQuery(sqlSwapTables,
+		v.onlineDDL.Table, v.swapTableName,
+		v.vreplTableName, v.onlineDDL.Table,
+		v.swapTableName, v.vreplTableName,
+	)
+	renameErr := make(chan error, 1)
+	go func() {
+		_, err := renameConn.ExecuteFetch(swapParsed.Query, 0, false)
+		renameErr <- err
+	}()
+	if err := v.waitForRenameBlocked(ctx, checkConn, renameConn.ID(), renameErr); err != nil {
+		// The RENAME must not run once the lock is released.
+		killParsed := sqlparser.BuildParsedQuery(sqlKillQuery, strconv.FormatInt(renameConn.ID(), 10))
+		_, _ = checkConn.ExecuteFetch(killParsed.Query, 0, false)
+		if <-renameErr == nil {
+			swapped = true
+			return pos, nil
+		}
+		return "", err
+	}
+	if err := unlock(); err != nil {
+		return "", err
+	}
+	if err := <-renameErr; err != nil {
+		return "", err
+	}
+	swapped = true
+	return pos, nil
+}
+
+// waitForRenameBlocked waits until the cut-over RENAME is seen waiting on the table lock
+func (v *vreplMigration) waitForRenameBlocked(ctx context.Context, checkConn *dbconnpool.DBConnection, connID int64, renameErr chan error) error {
+	timeout := time.After(vreplCutOverThreshold)
+	for {
+		parsed := sqlparser.BuildParsedQuery(sqlSelectProcessWaitingForLock, ":id")
+		bindVars := map[string]*querypb.BindVariable{
+			"id": sqltypes.Int64BindVariable(connID),
+		}
+		bound, err := parsed.GenerateQuery(bindVars, nil)
+		if err != nil {
+			return err
+		}
+		r, err := checkConn.ExecuteFetch(bound, 1, false)
+		if err != nil {
+			return err
+		}
+		if len(r.Rows) > 0 {
+			return nil
+		}
+		select {
+		case err := <-renameErr:
+			// put it back for the caller
+			renameErr <- err
+			return fmt.Errorf("RENAME returned while the table was locked: %v", err)
+		case <-timeout:
+			return fmt.Errorf("timeout waiting for RENAME to block on the table lock")
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(vreplRenameCheckInterval):
+		}
+	}
+}
+
+// waitForPos waits for the stream to reach the current position of this tablet, and returns that position
+func (v *vreplMigration) waitForPos(ctx context.Context) (pos string, err error) {
+	pos, err = v.tmClient.MasterPosition(ctx, v.tablet)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, vreplCutOverThreshold)
+	defer cancel()
+	return pos, v.tmClient.VReplicationWaitForPos(ctx, v.tablet, int(v.streamID), pos)
+}
+
+// readStream reads the migration's stream. A missing stream means the migration was cancelled.
+func (v *vreplMigration) readStream(ctx context.Context) (*vreplStream, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectVReplStream, "_vt", ":workflow", ":db_name")
+	bindVars := map[string]*querypb.BindVariable{
+		"workflow": sqltypes.StringBindVariable(v.workflow),
+		"db_name":  sqltypes.StringBindVariable(v.e.dbName),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	r, err := v.e.execQuery(ctx, bound)
+	if err != nil {
+		return nil, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return nil, fmt.Errorf("vreplication stream %s not found", v.workflow)
+	}
+	return &vreplStream{
+		id:       uint32(row.AsInt64("id", 0)),
+		workflow: row.AsString("workflow", ""),
+		pos:      row.AsString("pos", ""),
+		state:    row.AsString("state", ""),
+		message:  row.AsString("message", ""),
+	}, nil
+}
+
+// isCopying returns true while the stream is still copying existing rows
+func (v *vreplMigration) isCopying(ctx context.Context, s *vreplStream) (bool, error) {
+	if s.pos == "" {
+		return true, nil
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlSelectCountCopyState, "_vt", ":vrepl_id")
+	bindVars := map[string]*querypb.BindVariable{
+		"vrepl_id": sqltypes.Uint64BindVariable(uint64(s.id)),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return false, err
+	}
+	r, err := v.e.execQuery(ctx, bound)
+	if err != nil {
+		return false, err
+	}
+	count, err := r.Named().Row().ToInt64("count_copy_state")
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// copyProgress estimates the progress of the copy by comparing table row estimates
+func (v *vreplMigration) copyProgress(ctx context.Context) (float64, error) {
+	sourceRows, err := v.e.readTableRows(ctx, v.onlineDDL.Table)
+	if err != nil {
+		return 0, err
+	}
+	targetRows, err := v.e.readTableRows(ctx, v.vreplTableName)
+	if err != nil {
+		return 0, err
+	}
+	return vreplCopyProgress(sourceRows, targetRows), nil
+}
+
+// deleteStream removes the stream populating vreplTableName
+func (v *vreplMigration) deleteStream(ctx context.Context) error {
+	return v.deleteStreamOf(ctx, v.workflow)
+}
+
+// deleteStreamOf removes the stream of the given workflow
+func (v *vreplMigration) deleteStreamOf(ctx context.Context, workflow string) error {
+	query, err := v.e.deleteVReplStreamQuery(workflow)
+	if err != nil {
+		return err
+	}
+	_, err = v.tmClient.VReplicationExec(ctx, v.tablet, query)
+	return err
+}
+
+// deleteVReplStream removes the stream of an "online" migration, if any. This terminates the migration.
+func (e *Executor) deleteVReplStream(ctx context.Context, uuid string) error {
+	query, err := e.deleteVReplStreamQuery(uuid)
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	tmClient := tmclient.NewTabletManagerClient()
+	defer tmClient.Close()
+
+	_, err = tmClient.VReplicationExec(ctx, tabletInfo.Tablet, query)
+	return err
+}
+
+func (e *Executor) deleteVReplStreamQuery(uuid string) (string, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlDeleteVReplStream, "_vt", ":workflow", ":db_name")
+	bindVars := map[string]*querypb.BindVariable{
+		"workflow": sqltypes.StringBindVariable(uuid),
+		"db_name":  sqltypes.StringBindVariable(e.dbName),
+	}
+	return parsed.GenerateQuery(bindVars, nil)
+}
+
+// readTableColumns returns the names of the non-generated columns of a table
+func (e *Executor) readTableColumns(ctx context.Context, tableName string) (columns []string, err error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectTableColumns, ":table_schema", ":table_name")
+	bindVars := map[string]*querypb.BindVariable{
+		"table_schema": sqltypes.StringBindVariable(e.dbName),
+		"table_name":   sqltypes.StringBindVariable(tableName),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range r.Named().Rows {
+		columns = append(columns, row.AsString("column_name", ""))
+	}
+	return columns, nil
+}
+
+// readTableRows returns the estimated number of rows in a table
+func (e *Executor) readTableRows(ctx context.Context, tableName string) (int64, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectTableRows, ":table_schema", ":table_name")
+	bindVars := map[string]*querypb.BindVariable{
+		"table_schema": sqltypes.StringBindVariable(e.dbName),
+		"table_name":   sqltypes.StringBindVariable(tableName),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return 0, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return 0, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return 0, nil
+	}
+	return row.AsInt64("table_rows", 0), nil
+}
+
+// sharedColumns returns the source columns which also exist in the target, in source order, along
+// with their names in the target. renames maps lowercase source column names to their names in the
+// target. Column names are case insensitive.
+func sharedColumns(sourceColumns, targetColumns []string, renames map[string]string) (shared, mapped []string) {
+	targetColumnsMap := map[string]string{}
+	for _, column := range targetColumns {
+		targetColumnsMap[strings.ToLower(column)] = column
+	}
+	for _, column := range sourceColumns {
+		targetColumn := column
+		if renamed, ok := renames[strings.ToLower(column)]; ok {
+			targetColumn = renamed
+		}
+		if targetColumn, ok := targetColumnsMap[strings.ToLower(targetColumn)]; ok {
+			shared = append(shared, column)
+			mapped = append(mapped, targetColumn)
+		}
+	}
+	return shared, mapped
+}
+
+// parseColumnRenames returns the columns renamed by the options of an ALTER TABLE, as a map of
+// their lowercase names to their new names. Columns are renamed by CHANGE [COLUMN] and by
+// RENAME COLUMN ... TO.
+func parseColumnRenames(alterOptions string) (map[string]string, error) {
+	renames := map[string]string{}
+	tokenizer := sqlparser.NewStringTokenizer(alterOptions)
+	var clause []string
+	addRename := func() {
+		if len(clause) > 0 && strings.EqualFold(clause[0], "change") {
+			clause = clause[1:]
+			if len(clause) > 0 && strings.EqualFold(clause[0], "column") {
+				clause = clause[1:]
+			}
+			if len(clause) >= 2 && !strings.EqualFold(clause[0], clause[1]) {
+				renames[strings.ToLower(clause[0])] = clause[1]
+			}
+		}
+		if len(clause) >= 5 && strings.EqualFold(clause[0], "rename") && strings.EqualFold(clause[1], "column") && strings.EqualFold(clause[3], "to") {
+			renames[strings.ToLower(clause[2])] = clause[4]
+		}
+		clause = nil
+	}
+	depth := 0
+	for {
+		typ, val := tokenizer.Scan()
+		switch {
+		case typ == 0:
+			addRename()
+			return renames, nil
+		case typ == sqlparser.LEX_ERROR:
+			return nil, fmt.Errorf("cannot parse ALTER TABLE options: %s", alterOptions)
+		case typ == '(':
+			depth++
+		case typ == ')':
+			depth--
+		case typ == ',' && depth == 0:
+			addRename()
+			continue
+		}
+		if depth == 0 && typ != sqlparser.STRING {
+			clause = append(clause, string(val))
+		} else {
+			// Only the leading words of a clause matter.
+			clause = append(clause, "")
+		}
+	}
+}
+
+// invertColumnRenames returns the renames which undo the given renames
+func invertColumnRenames(renames map[string]string) map[string]string {
+	inverted := make(map[string]string, len(renames))
+	for from, to := range renames {
+		inverted[strings.ToLower(to)] = from
+	}
+	return inverted
+}
+
+// readColumnRenames returns the columns renamed by a migration. A revert renames back
+// the columns renamed by the migration it reverts.
+func (e *Executor) readColumnRenames(ctx context.Context, onlineDDL *schema.OnlineDDL) (map[string]string, error) {
+	if revertedUUID, isRevert := onlineDDL.RevertedUUID(); isRevert {
+		revertedDDL, err := e.readMigration(ctx, revertedUUID)
+		if err != nil {
+			return nil, err
+		}
+		renames, err := e.readColumnRenames(ctx, revertedDDL)
+		if err != nil {
+			return nil, err
+		}
+		return invertColumnRenames(renames), nil
+	}
+	_, _, alterOptions := schema.ParseAlterTableOptions(onlineDDL.SQL)
+	return parseColumnRenames(alterOptions)
+}
+
+// vreplFilterQuery returns the vreplication filter which selects the given columns of a table,
+// aliased to the mapped column names where they differ.
+func vreplFilterQuery(tableName string, columns, mappedColumns []string) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for i, column := range columns {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(column))
+		if !strings.EqualFold(column, mappedColumns[i]) {
+			buf.Myprintf(" as %v", sqlparser.NewColIdent(mappedColumns[i]))
+		}
+	}
+	buf.Myprintf(" from %v", sqlparser.NewTableIdent(tableName))
+	return buf.String()
+}
+
+// vreplCopyProgress returns the copy progress, in percent, based on row estimates.
+// Estimates may be off, so the result is capped.
+func vreplCopyProgress(sourceRows, targetRows int64) float64 {
+	if sourceRows <= 0 || targetRows <= 0 {
+		return 0
+	}
+	progress := 100.0 * float64(targetRows) / float64(sourceRows)
+	if progress > 99 {
+		// 100 is only reported once the copy is actually done
+		progress = 99
+	}
+	return progress
+}
+
+// inCutOverWindow returns true when the given time is within the given daily window, formatted as
+// HH:MM-HH:MM. The window may wrap around midnight. An empty window includes any time.
+func inCutOverWindow(window string, t time.Time) (bool, error) {
+	if window == "" {
+		return true, nil
+	}
+	bounds := strings.Split(window, "-")
+	if len(bounds) != 2 {
+		return false, fmt.Errorf("invalid cut-over window %q, expected HH:MM-HH:MM", window)
+	}
+	var minutes [2]int
+	for i, bound := range bounds {
+		parsed, err := time.Parse("15:04", strings.TrimSpace(bound))
+		if err != nil {
+			return false, fmt.Errorf("invalid cut-over window %q, expected HH:MM-HH:MM", window)
+		}
+		minutes[i] = parsed.Hour()*60 + parsed.Minute()
+	}
+	now := t.Hour()*60 + t.Minute()
+	if minutes[0] <= minutes[1] {
+		return now >= minutes[0] && now < minutes[1], nil
+	}
+	return now >= minutes[0] || now < minutes[1], nil
+}
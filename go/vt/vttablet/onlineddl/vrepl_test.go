@@ -0,0 +1,305 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const testVReplUUID = "a1b2c3d4_e5f6_11ea_8a2f_0242ac110002"
+
+func TestSharedColumns(t *testing.T) {
+	source := []string{"id", "Name", "dropped", "val"}
+	target := []string{"ID", "val", "name", "added"}
+	shared, mapped := sharedColumns(source, target, nil)
+	assert.Equal(t, []string{"id", "Name", "val"}, shared)
+	assert.Equal(t, []string{"ID", "name", "val"}, mapped)
+	shared, _ = sharedColumns(source, []string{"other"}, nil)
+	assert.Empty(t, shared)
+
+	// Renamed columns are mapped to their new names, even if a column of the old name exists.
+	shared, mapped = sharedColumns([]string{"id", "a", "b"}, []string{"id", "a", "b"}, map[string]string{"a": "b", "b": "a"})
+	assert.Equal(t, []string{"id", "a", "b"}, shared)
+	assert.Equal(t, []string{"id", "b", "a"}, mapped)
+}
+
+func TestVReplFilterQuery(t *testing.T) {
+	assert.Equal(t, "select id, name, val from t1", vreplFilterQuery("t1", []string{"id", "name", "val"}, []string{"id", "Name", "val"}))
+	assert.Equal(t, "select `select` from `order`", vreplFilterQuery("order", []string{"select"}, []string{"select"}))
+	assert.Equal(t, "select id, name as full_name from t1", vreplFilterQuery("t1", []string{"id", "name"}, []string{"id", "full_name"}))
+}
+
+func TestParseColumnRenames(t *testing.T) {
+	tt := []struct {
+		alterOptions string
+		renames      map[string]string
+	}{
+		{"add column c int", map[string]string{}},
+		{"change name full_name varchar(128)", map[string]string{"name": "full_name"}},
+		{"CHANGE COLUMN `Name` `full_name` varchar(128) default 'a, change b c'", map[string]string{"name": "full_name"}},
+		{"change name name varchar(128), modify c int", map[string]string{}},
+		{"rename column a to b, add key (c, d), change column c d int", map[string]string{"a": "b", "c": "d"}},
+		{"rename to t2, rename index a to b", map[string]string{}},
+	}
+	for _, tc := range tt {
+		renames, err := parseColumnRenames(tc.alterOptions)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.renames, renames, tc.alterOptions)
+	}
+	assert.Equal(t, map[string]string{"full_name": "name"}, invertColumnRenames(map[string]string{"name": "Full_Name"}))
+}
+
+// fakeVReplTMClient is the tablet manager of the tablet running the migration
+type fakeVReplTMClient struct {
+	tmclient.TabletManagerClient
+
+	mu    sync.Mutex
+	calls []string
+	// waitErr is returned by VReplicationWaitForPos
+	waitErr error
+}
+
+func (tmc *fakeVReplTMClient) record(call string) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.calls = append(tmc.calls, call)
+}
+
+func (tmc *fakeVReplTMClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	tmc.record("MasterPosition")
+	return "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10", nil
+}
+
+func (tmc *fakeVReplTMClient) VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error {
+	tmc.record(fmt.Sprintf("VReplicationWaitForPos %d %s", id, pos))
+	return tmc.waitErr
+}
+
+func (tmc *fakeVReplTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	tmc.record(query)
+	return &querypb.QueryResult{InsertId: 1}, nil
+}
+
+func (tmc *fakeVReplTMClient) Close() {
+}
+
+// assertCalls checks that the calls received start with the given prefixes
+func (tmc *fakeVReplTMClient) assertCalls(t *testing.T, prefixes ...string) {
+	t.Helper()
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	require.Equal(t, len(prefixes), len(tmc.calls), "%v", tmc.calls)
+	for i, prefix := range prefixes {
+		assert.True(t, strings.HasPrefix(tmc.calls[i], prefix), "call %d: %s, want prefix %s", i, tmc.calls[i], prefix)
+	}
+}
+
+// newTestVReplMigration returns a migration of table t1 on a fake database
+func newTestVReplMigration(t *testing.T, sql string) (*vreplMigration, *fakesqldb.DB, *fakeVReplTMClient) {
+	e, db := newTestExecutor(t)
+	tmc := &fakeVReplTMClient{}
+	v := &vreplMigration{
+		e: e,
+		onlineDDL: &schema.OnlineDDL{
+			UUID:     testVReplUUID,
+			Table:    "t1",
+			SQL:      sql,
+			Strategy: schema.DDLStrategyOnline,
+		},
+		tmClient:       tmc,
+		tablet:         &topodatapb.Tablet{Alias: e.tabletAlias},
+		workflow:       testVReplUUID,
+		vreplTableName: "_" + testVReplUUID + "_vrepl",
+		swapTableName:  "_" + testVReplUUID + "_swap",
+	}
+	db.AddQueryPattern("(?is)^update _vt\\.schema_migrations.*", &sqltypes.Result{})
+	return v, db, tmc
+}
+
+func addTableColumns(db *fakesqldb.DB, table string, columns ...string) {
+	db.AddQueryPattern(fmt.Sprintf("(?is)^select\\s+column_name.*table_name='%s'.*", table), sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("column_name", "varchar"),
+		columns...,
+	))
+}
+
+func TestVReplMigrationPrepare(t *testing.T) {
+	v, db, tmc := newTestVReplMigration(t, "alter table t1 change name full_name varchar(128)")
+	db.AddQuery("CREATE TABLE `"+v.vreplTableName+"` LIKE `t1`", &sqltypes.Result{})
+	db.AddQuery("ALTER TABLE `"+v.vreplTableName+"` change name full_name varchar(128)", &sqltypes.Result{})
+	addTableColumns(db, "t1", "id", "name")
+	addTableColumns(db, v.vreplTableName, "id", "full_name")
+
+	require.NoError(t, v.prepare(context.Background()))
+	assert.Equal(t, map[string]string{"name": "full_name"}, v.renames)
+	assert.EqualValues(t, 1, v.streamID)
+	tmc.assertCalls(t,
+		"insert into _vt.vreplication",
+		"UPDATE _vt.vreplication",
+	)
+	// The renamed column is copied into its new name, rather than dropped.
+	assert.Contains(t, tmc.calls[0], "select id, name as full_name from t1")
+	assert.Contains(t, tmc.calls[0], "'Stopped'")
+}
+
+func TestVReplMigrationReview(t *testing.T) {
+	v, db, tmc := newTestVReplMigration(t, "alter table t1 change name full_name varchar(128)")
+	v.streamID = 1
+	v.renames = map[string]string{"name": "full_name"}
+	db.AddQueryPattern("(?is)^select.*from _vt\\.schema_migrations.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"migration_uuid|mysql_table|migration_statement|strategy|options|migration_status|completion_requested",
+			"varchar|varchar|varchar|varchar|varchar|varchar|int64"),
+		testVReplUUID+"|t1|alter table t1 change name full_name varchar(128)|online||running|0",
+	))
+	db.AddQueryPattern("(?is)^select.*from _vt\\.vreplication.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|workflow|pos|state|message", "int64|varchar|varchar|varchar|varchar"),
+		"1|"+testVReplUUID+"|MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-5|Running|",
+	))
+	db.AddQueryPattern("(?is)^select.*from _vt\\.copy_state.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("count_copy_state", "int64"),
+		"0",
+	))
+	db.AddQueryPattern("(?is)^select.*from information_schema\\.processlist.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id", "int64"),
+		"1",
+	))
+	lockQuery := "LOCK TABLES `t1` WRITE"
+	swapQuery := fmt.Sprintf("RENAME TABLE `t1` TO `%s`, `%s` TO `t1`, `%s` TO `%s`", v.swapTableName, v.vreplTableName, v.swapTableName, v.vreplTableName)
+	db.AddQuery(lockQuery, &sqltypes.Result{})
+	db.AddQuery(swapQuery, &sqltypes.Result{})
+	db.AddQuery("UNLOCK TABLES", &sqltypes.Result{})
+	// The tables are swapped by the time reverse replication is set up.
+	addTableColumns(db, "t1", "id", "full_name")
+	addTableColumns(db, v.vreplTableName, "id", "name")
+
+	complete, err := v.review(context.Background())
+	require.NoError(t, err)
+	assert.True(t, complete)
+
+	pos := "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10"
+	deleteQuery, err := v.e.deleteVReplStreamQuery(testVReplUUID)
+	require.NoError(t, err)
+	tmc.assertCalls(t,
+		// The stream catches up before the table is locked, and again while it's locked.
+		"MasterPosition",
+		"VReplicationWaitForPos 1 "+pos,
+		"MasterPosition",
+		"VReplicationWaitForPos 1 "+pos,
+		"update _vt.vreplication set state='Stopped', message='stopped for online DDL cut-over' where id=1",
+		deleteQuery,
+		"insert into _vt.vreplication",
+		"UPDATE _vt.vreplication",
+	)
+	// Reverse replication renames the column back.
+	assert.Contains(t, tmc.calls[6], "select id, full_name as name from t1")
+	assert.Contains(t, tmc.calls[6], pos)
+
+	assert.Equal(t, 1, db.GetQueryCalledNum(lockQuery))
+	assert.Equal(t, 1, db.GetQueryCalledNum(swapQuery))
+	assert.Equal(t, 1, db.GetQueryCalledNum("UNLOCK TABLES"))
+	log := db.QueryLog()
+	assert.Less(t, strings.Index(log, strings.ToLower(lockQuery)), strings.Index(log, "unlock tables"))
+}
+
+func TestVReplMigrationCutOverNotCaughtUp(t *testing.T) {
+	v, db, tmc := newTestVReplMigration(t, "alter table t1 add column c int")
+	v.streamID = 1
+	tmc.waitErr = fmt.Errorf("timeout")
+	lockQuery := "LOCK TABLES `t1` WRITE"
+	db.AddQuery(lockQuery, &sqltypes.Result{})
+
+	_, err := v.cutOver(context.Background())
+	assert.Equal(t, errVReplNotCaughtUp, err)
+	tmc.assertCalls(t,
+		"MasterPosition",
+		"VReplicationWaitForPos 1 MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10",
+	)
+	// Writes are not blocked if the stream can't catch up.
+	assert.Equal(t, 0, db.GetQueryCalledNum(lockQuery))
+}
+
+func TestReadColumnRenames(t *testing.T) {
+	v, db, _ := newTestVReplMigration(t, "alter table t1 change name full_name varchar(128)")
+	db.AddQueryPattern("(?is)^select.*from _vt\\.schema_migrations.*", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("migration_uuid|mysql_table|migration_statement", "varchar|varchar|varchar"),
+		testVReplUUID+"|t1|alter table t1 change name full_name varchar(128)",
+	))
+	renames, err := v.e.readColumnRenames(context.Background(), v.onlineDDL)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "full_name"}, renames)
+
+	// A revert renames the columns back.
+	revert := &schema.OnlineDDL{UUID: "b1b2c3d4_e5f6_11ea_8a2f_0242ac110002", SQL: "revert " + testVReplUUID}
+	renames, err = v.e.readColumnRenames(context.Background(), revert)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"full_name": "name"}, renames)
+}
+
+func TestVReplCopyProgress(t *testing.T) {
+	assert.Equal(t, float64(0), vreplCopyProgress(0, 10))
+	assert.Equal(t, float64(0), vreplCopyProgress(10, 0))
+	assert.Equal(t, float64(50), vreplCopyProgress(10, 5))
+	assert.Equal(t, float64(99), vreplCopyProgress(10, 10))
+	assert.Equal(t, float64(99), vreplCopyProgress(10, 20))
+}
+
+func TestInCutOverWindow(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2020, 10, 1, hour, minute, 0, 0, time.UTC)
+	}
+	tt := []struct {
+		window   string
+		t        time.Time
+		expected bool
+	}{
+		{"", at(12, 0), true},
+		{"02:00-05:00", at(1, 59), false},
+		{"02:00-05:00", at(2, 0), true},
+		{"02:00-05:00", at(4, 59), true},
+		{"02:00-05:00", at(5, 0), false},
+		{"23:30-01:00", at(23, 45), true},
+		{"23:30-01:00", at(0, 30), true},
+		{"23:30-01:00", at(12, 0), false},
+	}
+	for _, tc := range tt {
+		inWindow, err := inCutOverWindow(tc.window, tc.t)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, inWindow, "%s at %v", tc.window, tc.t)
+	}
+
+	for _, window := range []string{"02:00", "2am-5am", "02:00-05:00-06:00"} {
+		_, err := inCutOverWindow(window, at(12, 0))
+		assert.Error(t, err, window)
+	}
+}
@@ -42,6 +42,8 @@ type RPCTM interface {
 
 	GetSchema(ctx context.Context, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error)
 
+	GetSchemaHistory(ctx context.Context, request *tabletmanagerdatapb.GetSchemaHistoryRequest) ([]*tabletmanagerdatapb.SchemaVersion, error)
+
 	GetPermissions(ctx context.Context) (*tabletmanagerdatapb.Permissions, error)
 
 	// Various read-write methods
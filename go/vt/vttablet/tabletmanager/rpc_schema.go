@@ -17,8 +17,12 @@ limitations under the License.
 package tabletmanager
 
 import (
+	"fmt"
+	"strings"
+
 	"vitess.io/vitess/go/vt/vterrors"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/mysql"
@@ -26,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
@@ -34,6 +39,76 @@ func (tm *TabletManager) GetSchema(ctx context.Context, tables, excludeTables []
 	return tm.MysqlDaemon.GetSchema(ctx, topoproto.TabletDbName(tm.Tablet()), tables, excludeTables, includeViews)
 }
 
+// GetSchemaHistory returns the schema versions recorded by the schema tracker
+// in _vt.schema_version that match the request, oldest first.
+func (tm *TabletManager) GetSchemaHistory(ctx context.Context, request *tabletmanagerdatapb.GetSchemaHistoryRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	withSchema := !request.ExcludeTableDefinitions || len(request.Tables) > 0
+	qr, err := tm.MysqlDaemon.FetchSuperQuery(ctx, schemaVersionsQuery(request, withSchema))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetSchemaHistory: can't read _vt.schema_version, which requires -track_schema_versions")
+	}
+	// The rows are read most recent first, so that the limit can be
+	// applied by MySQL.
+	versions := make([]*tabletmanagerdatapb.SchemaVersion, len(qr.Rows))
+	for i, row := range qr.Rows {
+		id, err := row[0].ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		timeUpdated, err := row[3].ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		schema := &binlogdatapb.MinimalSchema{}
+		if withSchema {
+			if err := proto.Unmarshal(row[4].ToBytes(), schema); err != nil {
+				return nil, vterrors.Wrapf(err, "GetSchemaHistory: can't parse schema version %d", id)
+			}
+		}
+		versions[len(qr.Rows)-1-i] = tmutils.NewSchemaVersion(id, row[1].ToString(), row[2].ToString(), timeUpdated, schema)
+	}
+	versions = tmutils.FilterSchemaVersions(versions, request.Tables)
+	if request.Limit > 0 && int64(len(versions)) > request.Limit {
+		versions = versions[int64(len(versions))-request.Limit:]
+	}
+	if request.ExcludeTableDefinitions {
+		for _, version := range versions {
+			version.TableDefinitions = nil
+		}
+	}
+	return versions, nil
+}
+
+// schemaVersionsQuery returns the query that reads the schema versions of a
+// GetSchemaHistory request, most recent first. The schemas are only read if
+// withSchema is set. The limit can only be applied by MySQL when the
+// versions are not filtered by table afterwards.
+func schemaVersionsQuery(request *tabletmanagerdatapb.GetSchemaHistoryRequest, withSchema bool) string {
+	columns := "id, pos, ddl, time_updated"
+	if withSchema {
+		columns += ", schemax"
+	}
+	var conditions []string
+	if request.MinId > 0 {
+		conditions = append(conditions, fmt.Sprintf("id >= %d", request.MinId))
+	}
+	if request.MaxId > 0 {
+		conditions = append(conditions, fmt.Sprintf("id <= %d", request.MaxId))
+	}
+	if request.MaxTimeUpdated > 0 {
+		conditions = append(conditions, fmt.Sprintf("time_updated <= %d", request.MaxTimeUpdated))
+	}
+	query := fmt.Sprintf("select %s from _vt.schema_version", columns)
+	if len(conditions) > 0 {
+		query += " where " + strings.Join(conditions, " and ")
+	}
+	query += " order by id desc"
+	if request.Limit > 0 && len(request.Tables) == 0 {
+		query += fmt.Sprintf(" limit %d", request.Limit)
+	}
+	return query
+}
+
 // ReloadSchema will reload the schema
 // This doesn't need the action mutex because periodic schema reloads happen
 // in the background anyway.
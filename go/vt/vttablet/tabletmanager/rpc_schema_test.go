@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// schemaVersionRow returns a _vt.schema_version row in which the schema
+// holds the given tables, each with an id column.
+func schemaVersionRow(t *testing.T, id int64, ddl string, timeUpdated int64, tables ...string) []sqltypes.Value {
+	schema := &binlogdatapb.MinimalSchema{}
+	for _, table := range tables {
+		schema.Tables = append(schema.Tables, &binlogdatapb.MinimalTable{
+			Name:      table,
+			Fields:    []*querypb.Field{{Name: "id", Type: querypb.Type_INT64}},
+			PKColumns: []int64{0},
+		})
+	}
+	schemax, err := proto.Marshal(schema)
+	require.NoError(t, err)
+	return []sqltypes.Value{
+		sqltypes.NewInt64(id),
+		sqltypes.NewVarChar(fmt.Sprintf("MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-%d", id*10)),
+		sqltypes.NewVarChar(ddl),
+		sqltypes.NewInt64(timeUpdated),
+		sqltypes.NewVarBinary(string(schemax)),
+	}
+}
+
+func TestGetSchemaHistory(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+	fmd := fakemysqldaemon.NewFakeMysqlDaemon(db)
+	tm := &TabletManager{MysqlDaemon: fmd}
+
+	// Most recent first, like the query.
+	rows := [][]sqltypes.Value{
+		schemaVersionRow(t, 3, "create table t3", 300, "t1", "t2", "t3"),
+		schemaVersionRow(t, 2, "create table t2", 200, "t1", "t2"),
+		schemaVersionRow(t, 1, "create table t1", 100, "t1"),
+	}
+	withoutSchemas := func(rows [][]sqltypes.Value) [][]sqltypes.Value {
+		var stripped [][]sqltypes.Value
+		for _, row := range rows {
+			stripped = append(stripped, row[:4])
+		}
+		return stripped
+	}
+
+	testcases := []struct {
+		request *tabletmanagerdatapb.GetSchemaHistoryRequest
+		query   string
+		rows    [][]sqltypes.Value
+		ids     []int64
+		tables  []int
+	}{{
+		request: &tabletmanagerdatapb.GetSchemaHistoryRequest{},
+		query:   "select id, pos, ddl, time_updated, schemax from _vt.schema_version order by id desc",
+		rows:    rows,
+		ids:     []int64{1, 2, 3},
+		tables:  []int{1, 2, 3},
+	}, {
+		request: &tabletmanagerdatapb.GetSchemaHistoryRequest{MaxTimeUpdated: 250, Limit: 1},
+		query:   "select id, pos, ddl, time_updated, schemax from _vt.schema_version where time_updated <= 250 order by id desc limit 1",
+		rows:    rows[1:2],
+		ids:     []int64{2},
+		tables:  []int{2},
+	}, {
+		request: &tabletmanagerdatapb.GetSchemaHistoryRequest{MinId: 2, MaxId: 3, ExcludeTableDefinitions: true},
+		query:   "select id, pos, ddl, time_updated from _vt.schema_version where id >= 2 and id <= 3 order by id desc",
+		rows:    withoutSchemas(rows[:2]),
+		ids:     []int64{2, 3},
+		tables:  []int{0, 0},
+	}, {
+		// The limit applies to the versions in which t2 changed, so it
+		// can't be applied by MySQL, and the schemas are needed to filter
+		// the versions even though they're not returned.
+		request: &tabletmanagerdatapb.GetSchemaHistoryRequest{Tables: []string{"t2"}, Limit: 1, ExcludeTableDefinitions: true},
+		query:   "select id, pos, ddl, time_updated, schemax from _vt.schema_version order by id desc",
+		rows:    rows,
+		ids:     []int64{2},
+		tables:  []int{0},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			fmd.FetchSuperQueryMap = map[string]*sqltypes.Result{
+				tcase.query: {Rows: tcase.rows},
+			}
+			versions, err := tm.GetSchemaHistory(ctx, tcase.request)
+			require.NoError(t, err)
+			var ids []int64
+			var tables []int
+			for _, version := range versions {
+				ids = append(ids, version.Id)
+				tables = append(tables, len(version.TableDefinitions))
+			}
+			assert.Equal(t, tcase.ids, ids)
+			assert.Equal(t, tcase.tables, tables)
+		})
+	}
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// controller runs one vdiff.
+type controller struct {
+	vde      *Engine
+	uuid     string
+	workflow string
+	options  *Options
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newController(ctx context.Context, vde *Engine, uuid, workflow, options string) (*controller, error) {
+	opts, err := parseOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	ct := &controller{
+		vde:      vde,
+		uuid:     uuid,
+		workflow: workflow,
+		options:  opts,
+		done:     make(chan struct{}),
+	}
+	ctx, ct.cancel = context.WithCancel(ctx)
+	go ct.run(ctx)
+	return ct, nil
+}
+
+// Stop stops the controller and waits for it to exit.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+func (ct *controller) isDone() bool {
+	select {
+	case <-ct.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("VDiff %s: could not connect to the database: %v", ct.uuid, err)
+		return
+	}
+	defer dbClient.Close()
+
+	err := ct.runDiff(ctx, dbClient)
+	switch {
+	case err == nil:
+		log.Infof("VDiff %s of workflow %s completed", ct.uuid, ct.workflow)
+		_, err = dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateVDiffDone, encodeString(ct.uuid)), 1)
+	case ctx.Err() != nil:
+		// The vdiff was stopped, or the engine was closed. The state
+		// of the vdiff tells whether it'll be resumed.
+		log.Infof("VDiff %s of workflow %s interrupted: %v", ct.uuid, ct.workflow, err)
+		return
+	default:
+		log.Errorf("VDiff %s of workflow %s failed: %v", ct.uuid, ct.workflow, err)
+		_, err = dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateVDiffState, encodeString(string(StateError)), encodeString(binlogplayer.MessageTruncate(err.Error())), encodeString(ct.uuid)), 1)
+	}
+	if err != nil {
+		log.Errorf("VDiff %s: could not update state: %v", ct.uuid, err)
+	}
+}
+
+func (ct *controller) runDiff(ctx context.Context, dbClient binlogplayer.DBClient) error {
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateVDiffStarted, encodeString(ct.uuid)), 1); err != nil {
+		return err
+	}
+	streams, err := ct.readStreams(dbClient)
+	if err != nil {
+		return err
+	}
+	tables, err := ct.selectTables(dbClient, streams)
+	if err != nil {
+		return err
+	}
+	var previous map[string]*TableReport
+	if ct.options.Recheck != "" {
+		if previous, err = ct.readMismatches(dbClient); err != nil {
+			return err
+		}
+	}
+	for _, table := range tables {
+		td := &tableDiffer{
+			table:      table,
+			dbClient:   dbClient,
+			streamRows: ct.vde.streamRows,
+		}
+		if ct.options.Recheck != "" && previous[table] == nil {
+			continue
+		}
+		for _, st := range streams {
+			query, err := sourceQuery(table, st)
+			if err != nil {
+				return err
+			}
+			if query == "" {
+				continue
+			}
+			td.sources = append(td.sources, &sourceStream{id: st.id, source: st.source, query: query})
+		}
+		if err := ct.diffTable(ctx, dbClient, td, previous[table]); err != nil {
+			return fmt.Errorf("table %s: %v", table, err)
+		}
+	}
+	return nil
+}
+
+// readStreams reads the streams of the workflow. They must all be running,
+// and done copying, for the diff to make sense.
+func (ct *controller) readStreams(dbClient binlogplayer.DBClient) ([]*sourceStream, error) {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlSelectStreams, encodeString(ct.workflow), encodeString(ct.vde.dbName)), 10000)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("no streams found for workflow %s", ct.workflow)
+	}
+	var streams []*sourceStream
+	for _, row := range qr.Rows {
+		id, err := evalengine.ToInt64(row[0])
+		if err != nil {
+			return nil, err
+		}
+		st := &sourceStream{id: id, source: &binlogdatapb.BinlogSource{}}
+		if err := proto.UnmarshalText(row[1].ToString(), st.source); err != nil {
+			return nil, err
+		}
+		if state := row[2].ToString(); state != binlogplayer.BlpRunning {
+			return nil, fmt.Errorf("stream %d of workflow %s is not running: state: %s, message: %s", id, ct.workflow, state, row[3].ToString())
+		}
+		cs, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlSelectCopyState, id), 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(cs.Rows) != 0 {
+			return nil, fmt.Errorf("stream %d of workflow %s is still copying", id, ct.workflow)
+		}
+		streams = append(streams, st)
+	}
+	return streams, nil
+}
+
+// selectTables returns the tables of the workflow that the vdiff compares.
+func (ct *controller) selectTables(dbClient binlogplayer.DBClient, streams []*sourceStream) ([]string, error) {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlSelectTables, encodeString(ct.vde.dbName)), 10000)
+	if err != nil {
+		return nil, err
+	}
+	inWorkflow := make(map[string]bool)
+	var tables []string
+	for _, row := range qr.Rows {
+		table := row[0].ToString()
+		rule, err := vreplication.MatchTable(table, streams[0].source.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == vreplication.ExcludeStr {
+			continue
+		}
+		inWorkflow[table] = true
+		tables = append(tables, table)
+	}
+	if len(ct.options.Tables) != 0 {
+		tables = nil
+		for _, table := range ct.options.Tables {
+			if !inWorkflow[table] {
+				return nil, fmt.Errorf("table %s is not part of workflow %s", table, ct.workflow)
+			}
+			tables = append(tables, table)
+		}
+	}
+	sort.Strings(tables)
+	return tables, nil
+}
+
+// readMismatches reads the reports of the tables with mismatches from the vdiff to recheck.
+func (ct *controller) readMismatches(dbClient binlogplayer.DBClient) (map[string]*TableReport, error) {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlSelectMismatches, encodeString(ct.options.Recheck)), 10000)
+	if err != nil {
+		return nil, err
+	}
+	reports := make(map[string]*TableReport)
+	for _, row := range qr.Rows {
+		report := &TableReport{}
+		if err := json.Unmarshal(row[1].ToBytes(), report); err != nil {
+			return nil, err
+		}
+		reports[row[0].ToString()] = report
+	}
+	return reports, nil
+}
+
+// diffTable diffs one table, resuming from its last checkpoint.
+func (ct *controller) diffTable(ctx context.Context, dbClient binlogplayer.DBClient, td *tableDiffer, previous *TableReport) error {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlSelectVDiffTable, encodeString(ct.uuid), encodeString(td.table)), 1)
+	if err != nil {
+		return err
+	}
+	report := &TableReport{TableName: td.table}
+	var lastpk []sqltypes.Value
+	if len(qr.Rows) == 0 {
+		query := fmt.Sprintf(sqlInsertVDiffTable, encodeString(ct.uuid), encodeString(td.table), encodeString(ct.workflow), encodeString(ct.vde.dbName))
+		if _, err := dbClient.ExecuteFetch(query, 1); err != nil {
+			return err
+		}
+	} else {
+		row := qr.Rows[0]
+		if State(row[0].ToString()) == StateCompleted {
+			return nil
+		}
+		// A recheck is short: it's redone from scratch.
+		if previous == nil && !row[1].IsNull() {
+			var lpk querypb.QueryResult
+			if err := proto.UnmarshalText(row[1].ToString(), &lpk); err != nil {
+				return err
+			}
+			td.pkFields = lpk.Fields
+			lastpk = sqltypes.MakeRowTrusted(lpk.Fields, lpk.Rows[0])
+			if err := json.Unmarshal(row[2].ToBytes(), report); err != nil {
+				return err
+			}
+		}
+	}
+
+	if previous != nil {
+		if err := td.recheck(ctx, previous, report); err != nil {
+			return err
+		}
+	} else {
+		checkpoint := func(lastpk []sqltypes.Value) error {
+			return ct.updateTable(dbClient, td, StateStarted, lastpk, report)
+		}
+		if err := td.diff(ctx, lastpk, report, checkpoint); err != nil {
+			return err
+		}
+	}
+	log.Infof("VDiff %s: table %s: %d rows processed, %d mismatched, %d extra on source, %d extra on target",
+		ct.uuid, td.table, report.ProcessedRows, report.MismatchedRows, report.ExtraRowsSource, report.ExtraRowsTarget)
+	return ct.updateTable(dbClient, td, StateCompleted, nil, report)
+}
+
+// updateTable checkpoints the progress and the report of a table.
+func (ct *controller) updateTable(dbClient binlogplayer.DBClient, td *tableDiffer, state State, lastpk []sqltypes.Value, report *TableReport) error {
+	encodedLastpk := "null"
+	if lastpk != nil {
+		var buf bytes.Buffer
+		err := proto.CompactText(&buf, &querypb.QueryResult{
+			Fields: td.pkFields,
+			Rows:   []*querypb.Row{sqltypes.RowToProto3(lastpk)},
+		})
+		if err != nil {
+			return err
+		}
+		encodedLastpk = encodeString(buf.String())
+	}
+	encodedReport, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	mismatch := 0
+	if report.hasMismatch() {
+		mismatch = 1
+	}
+	query := fmt.Sprintf(sqlUpdateVDiffTable, encodeString(string(state)), encodedLastpk, report.ProcessedRows, mismatch,
+		encodeString(string(encodedReport)), encodeString(ct.uuid), encodeString(td.table))
+	_, err = dbClient.ExecuteFetch(query, 1)
+	return err
+}
+
+// sourceQuery returns the query that selects the source rows of the table
+// replicated by the stream. It's empty if the stream doesn't replicate the table.
+func sourceQuery(table string, st *sourceStream) (string, error) {
+	rule, err := vreplication.MatchTable(table, st.source.Filter)
+	if err != nil || rule == nil || rule.Filter == vreplication.ExcludeStr {
+		return "", err
+	}
+	// Like vreplication, generate the equivalent select statement
+	// if the filter is empty or a keyrange.
+	buf := sqlparser.NewTrackedBuffer(nil)
+	switch {
+	case rule.Filter == "":
+		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+	case key.IsKeyRange(rule.Filter):
+		buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(table), sqlparser.NewStrLiteral([]byte(rule.Filter)))
+	default:
+		return rule.Filter, nil
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vdiff runs the diffs of vreplication workflows on the target
+// tablets. A vdiff is created, stopped and resumed by changing its row in
+// _vt.vdiff through VExec. The engine runs the vdiffs of the local database,
+// and checkpoints the progress of every table in _vt.vdiff_table, so that an
+// interrupted vdiff resumes where it left off.
+package vdiff
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+	"vitess.io/vitess/go/vt/withddl"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	chunkSize                   = flag.Int("vdiff_chunk_size", 10000, "number of rows read from every source of a tablet-side vdiff between checkpoints")
+	maxRechecks                 = flag.Int("vdiff_max_rechecks", 3, "number of times a tablet-side vdiff diffs a chunk again before reporting its differences")
+	filteredReplicationWaitTime = flag.Duration("vdiff_filtered_replication_wait_time", 30*time.Second, "max time a tablet-side vdiff waits for the target streams to catch up with a source snapshot")
+	tabletTypesStr              = flag.String("vdiff_tablet_types", "REPLICA", "comma separated list of tablet types used as a source by tablet-side vdiffs")
+)
+
+// waitRetryTime and openRetryInterval can be changed to smaller values for tests.
+var (
+	waitRetryTime     = 100 * time.Millisecond
+	openRetryInterval = 1 * time.Second
+)
+
+var withDDL = withddl.New([]string{sqlCreateVDiffTable, sqlCreateVDiffTableTable})
+
+var vexecInsertTemplates = []string{
+	`insert into _vt.vdiff (vdiff_uuid, workflow, options) values ('val', 'val', 'val')`,
+}
+
+// Engine runs the vdiffs of the local database. It's only open on masters.
+type Engine struct {
+	// mu synchronizes isOpen and controllers.
+	mu          sync.Mutex
+	isOpen      bool
+	controllers map[string]*controller
+
+	// ctx is the root context for all controllers.
+	ctx context.Context
+	// cancel will cancel the root context, thereby all controllers.
+	cancel context.CancelFunc
+
+	ts              *topo.Server
+	cell            string
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+	streamRows      streamRowsFunc
+}
+
+// NewEngine creates a new Engine.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, cell string) *Engine {
+	vde := &Engine{
+		controllers: make(map[string]*controller),
+		ts:          ts,
+		cell:        cell,
+	}
+	vde.streamRows = vde.streamFromTablet
+	return vde
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, cell string, dbClientFactory func() binlogplayer.DBClient, dbName string, streamRows streamRowsFunc) *Engine {
+	return &Engine{
+		controllers:     make(map[string]*controller),
+		ts:              ts,
+		cell:            cell,
+		dbClientFactory: dbClientFactory,
+		dbName:          dbName,
+		streamRows:      streamRows,
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initilized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.FilteredWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// Open starts the Engine, and resumes the vdiffs that were running.
+func (vde *Engine) Open(ctx context.Context) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.isOpen = true
+	go vde.retryReconcile(vde.ctx)
+}
+
+// IsOpen returns true if Engine is open.
+func (vde *Engine) IsOpen() bool {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.isOpen
+}
+
+// Close stops all vdiffs. Their state is left as is, and
+// they'll be resumed the next time the Engine is opened.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		return
+	}
+	vde.cancel()
+	for _, ct := range vde.controllers {
+		ct.Stop()
+	}
+	vde.controllers = make(map[string]*controller)
+	vde.isOpen = false
+	log.Infof("VDiff Engine: closed")
+}
+
+// retryReconcile keeps trying to start the vdiffs
+// until it succeeds, or until the engine is closed.
+func (vde *Engine) retryReconcile(ctx context.Context) {
+	for {
+		err := vde.reconcile()
+		if err == nil {
+			return
+		}
+		log.Errorf("Error starting vdiffs: %v, will keep retrying.", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(openRetryInterval):
+		}
+	}
+}
+
+// reconcile starts a controller for every pending or started vdiff,
+// and stops the controllers of the other vdiffs.
+func (vde *Engine) reconcile() error {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		return nil
+	}
+	qr, err := vde.exec(vde.ctx, fmt.Sprintf(sqlSelectActiveVDiffs, encodeString(vde.dbName)))
+	if err != nil {
+		return err
+	}
+	active := make(map[string][]sqltypes.Value)
+	for _, row := range qr.Rows {
+		active[row[0].ToString()] = row
+	}
+	for uuid, ct := range vde.controllers {
+		if _, ok := active[uuid]; ok && !ct.isDone() {
+			continue
+		}
+		ct.Stop()
+		delete(vde.controllers, uuid)
+	}
+	for uuid, row := range active {
+		if _, ok := vde.controllers[uuid]; ok {
+			continue
+		}
+		ct, err := newController(vde.ctx, vde, uuid, row[1].ToString(), row[2].ToString())
+		if err != nil {
+			log.Errorf("VDiff %s could not be started: %v", uuid, err)
+			continue
+		}
+		vde.controllers[uuid] = ct
+	}
+	return nil
+}
+
+// VExec handles the VExec queries sent to _vt.vdiff and _vt.vdiff_table.
+// A vdiff is created by inserting a pending row, stopped and resumed
+// by setting its state to stopped or pending, and deleted with its report.
+func (vde *Engine) VExec(ctx context.Context, vx *vexec.TabletVExec) (*querypb.QueryResult, error) {
+	if !vde.IsOpen() {
+		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vdiff engine is closed")
+	}
+	response := func(result *sqltypes.Result, err error) (*querypb.QueryResult, error) {
+		if err != nil {
+			return nil, err
+		}
+		if err := vde.reconcile(); err != nil {
+			return nil, err
+		}
+		return sqltypes.ResultToProto3(result), nil
+	}
+
+	if _, ok := vx.Stmt.(*sqlparser.Select); ok {
+		qr, err := vde.exec(ctx, vx.Query)
+		if err != nil {
+			return nil, err
+		}
+		return sqltypes.ResultToProto3(qr), nil
+	}
+	if vx.TableName != fmt.Sprintf("%s.%s", vexec.TableQualifier, VDiffTableName) {
+		return nil, fmt.Errorf("only SELECT statements are supported for %s", vx.TableName)
+	}
+	switch stmt := vx.Stmt.(type) {
+	case *sqlparser.Insert:
+		match, err := sqlparser.QueryMatchesTemplates(vx.Query, vexecInsertTemplates)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			return nil, fmt.Errorf("Query must match one of these templates: %s", strings.Join(vexecInsertTemplates, "; "))
+		}
+		options, err := vx.ColumnStringVal(vx.InsertCols, "options")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := parseOptions(options); err != nil {
+			return nil, err
+		}
+		// Vexec runs outside the schema context. We fill in the database and the initial state.
+		if err := vx.AddOrReplaceInsertColumnVal("db_name", vx.ToStringVal(vde.dbName)); err != nil {
+			return nil, err
+		}
+		if err := vx.AddOrReplaceInsertColumnVal("state", vx.ToStringVal(string(StatePending))); err != nil {
+			return nil, err
+		}
+		return response(vde.exec(ctx, vx.Query))
+	case *sqlparser.Update:
+		if len(stmt.Exprs) != 1 || stmt.Where == nil {
+			return nil, fmt.Errorf("only the state of a vdiff can be updated: %s", vx.Query)
+		}
+		state, err := vx.ColumnStringVal(vx.UpdateCols, "state")
+		if err != nil {
+			return nil, err
+		}
+		// Only transitions that make sense are applied.
+		var from string
+		switch State(state) {
+		case StateStopped:
+			from = "'pending', 'started'"
+		case StatePending:
+			from = "'stopped', 'error'"
+		default:
+			return nil, fmt.Errorf("unexpected value for state: %v. Supported values are: %s, %s", state, StateStopped, StatePending)
+		}
+		query := fmt.Sprintf("update _vt.vdiff set state=%s, last_error='' where (%s) and state in (%s)",
+			encodeString(state), sqlparser.String(stmt.Where.Expr), from)
+		return response(vde.exec(ctx, query))
+	case *sqlparser.Delete:
+		qr, err := vde.exec(ctx, vx.Query)
+		if err != nil {
+			return nil, err
+		}
+		dbName := encodeString(vde.dbName)
+		if _, err := vde.exec(ctx, fmt.Sprintf(sqlDeleteOrphanTables, dbName, dbName)); err != nil {
+			return nil, err
+		}
+		return response(qr, nil)
+	default:
+		return nil, fmt.Errorf("No handler for this query: %s", vx.Query)
+	}
+}
+
+func (vde *Engine) exec(ctx context.Context, query string) (*sqltypes.Result, error) {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+	return withDDL.Exec(ctx, query, dbClient.ExecuteFetch)
+}
+
+// streamFromTablet streams rows from a tablet of the source shard.
+func (vde *Engine) streamFromTablet(ctx context.Context, source *binlogdatapb.BinlogSource, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	if source.ExternalMysql != "" {
+		return fmt.Errorf("vdiff is not supported for external sources: %s", source.ExternalMysql)
+	}
+	tp, err := discovery.NewTabletPicker(vde.ts, []string{vde.cell}, source.Keyspace, source.Shard, *tabletTypesStr)
+	if err != nil {
+		return err
+	}
+	tablet, err := tp.PickForStreaming(ctx)
+	if err != nil {
+		return err
+	}
+	qs, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(true))
+	if err != nil {
+		return err
+	}
+	defer qs.Close(ctx)
+	target := &querypb.Target{
+		Keyspace:   tablet.Keyspace,
+		Shard:      tablet.Shard,
+		TabletType: tablet.Type,
+	}
+	return qs.VStreamRows(ctx, target, query, lastpk, send)
+}
+
+func parseOptions(in string) (*Options, error) {
+	options := &Options{}
+	if in == "" {
+		return options, nil
+	}
+	if err := json.Unmarshal([]byte(in), options); err != nil {
+		return nil, fmt.Errorf("invalid vdiff options %s: %v", in, err)
+	}
+	return options, nil
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
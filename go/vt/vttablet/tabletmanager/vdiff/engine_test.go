@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+)
+
+const sqlSelectActive = "select vdiff_uuid, workflow, options from _vt.vdiff where db_name='vt_ks' and state in ('pending', 'started')"
+
+func TestEngineVExec(t *testing.T) {
+	ctx := context.Background()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vde := NewTestEngine(memorytopo.NewServer("cell1"), "cell1", func() binlogplayer.DBClient { return dbClient }, "vt_ks", fakeSource{}.streamRows)
+
+	vexecQuery := func(query string) error {
+		vx := vexec.NewTabletVExec("wf", "ks")
+		if err := vx.AnalyzeQuery(ctx, query); err != nil {
+			return err
+		}
+		_, err := vde.VExec(ctx, vx)
+		return err
+	}
+
+	assert.EqualError(t, vexecQuery("select * from _vt.vdiff"), "vdiff engine is closed")
+
+	dbClient.ExpectRequest(sqlSelectActive, &sqltypes.Result{}, nil)
+	vde.Open(ctx)
+	defer vde.Close()
+	dbClient.Wait()
+
+	dbClient.ExpectRequest("insert into _vt.vdiff(vdiff_uuid, workflow, options, db_name, state) values ('u1', 'wf', '{\\\"Tables\\\":[\\\"t1\\\"]}', 'vt_ks', 'pending')", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(sqlSelectActive, &sqltypes.Result{}, nil)
+	require.NoError(t, vexecQuery(`insert into _vt.vdiff (vdiff_uuid, workflow, options) values ('u1', 'wf', '{"Tables":["t1"]}')`))
+	dbClient.Wait()
+
+	assert.Error(t, vexecQuery(`insert into _vt.vdiff (vdiff_uuid, workflow, options) values ('u1', 'wf', 'not json')`))
+	assert.Error(t, vexecQuery(`insert into _vt.vdiff (vdiff_uuid, workflow) values ('u1', 'wf')`))
+
+	dbClient.ExpectRequest("update _vt.vdiff set state='stopped', last_error='' where (vdiff_uuid = 'u1' and db_name = 'vt_ks' and workflow = 'wf') and state in ('pending', 'started')", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(sqlSelectActive, &sqltypes.Result{}, nil)
+	require.NoError(t, vexecQuery("update _vt.vdiff set state='stopped' where vdiff_uuid='u1' and db_name='vt_ks' and workflow='wf'"))
+	dbClient.Wait()
+
+	dbClient.ExpectRequest("update _vt.vdiff set state='pending', last_error='' where (db_name = 'vt_ks' and workflow = 'wf') and state in ('stopped', 'error')", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(sqlSelectActive, &sqltypes.Result{}, nil)
+	require.NoError(t, vexecQuery("update _vt.vdiff set state='pending' where db_name='vt_ks' and workflow='wf'"))
+	dbClient.Wait()
+
+	assert.EqualError(t, vexecQuery("update _vt.vdiff set state='completed' where db_name='vt_ks' and workflow='wf'"),
+		"unexpected value for state: completed. Supported values are: stopped, pending")
+	assert.EqualError(t, vexecQuery("update _vt.vdiff_table set state='pending' where db_name='vt_ks' and workflow='wf'"),
+		"only SELECT statements are supported for _vt.vdiff_table")
+
+	dbClient.ExpectRequest("delete from _vt.vdiff where db_name='vt_ks' and workflow='wf'", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("delete from _vt.vdiff_table where db_name='vt_ks' and vdiff_uuid not in (select vdiff_uuid from _vt.vdiff where db_name='vt_ks')", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest(sqlSelectActive, &sqltypes.Result{}, nil)
+	require.NoError(t, vexecQuery("delete from _vt.vdiff where db_name='vt_ks' and workflow='wf'"))
+	dbClient.Wait()
+
+	dbClient.ExpectRequest("select * from _vt.vdiff_table where db_name='vt_ks' and workflow='wf'", &sqltypes.Result{}, nil)
+	require.NoError(t, vexecQuery("select * from _vt.vdiff_table where db_name='vt_ks' and workflow='wf'"))
+	dbClient.Wait()
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+const (
+	// VDiffTableName is used by VExec interceptor to call the correct handler
+	VDiffTableName = "vdiff"
+	// VDiffTableTableName holds the per-table progress and reports of a vdiff
+	VDiffTableTableName = "vdiff_table"
+
+	sqlCreateVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint unsigned not null auto_increment,
+  vdiff_uuid varchar(64) not null,
+  workflow varbinary(1000) not null,
+  db_name varbinary(255) not null,
+  state varbinary(64) not null,
+  options text not null,
+  created_timestamp timestamp not null default current_timestamp,
+  started_timestamp timestamp null default null,
+  completed_timestamp timestamp null default null,
+  last_error varbinary(1000) not null default '',
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key workflow_idx (db_name(64), workflow(64)))`
+
+	sqlCreateVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_uuid varchar(64) not null,
+  table_name varbinary(128) not null,
+  workflow varbinary(1000) not null,
+  db_name varbinary(255) not null,
+  state varbinary(64) not null,
+  lastpk varbinary(2000),
+  rows_compared bigint not null default 0,
+  mismatch tinyint(1) not null default 0,
+  report mediumblob,
+  updated_timestamp timestamp not null default current_timestamp on update current_timestamp,
+  primary key (vdiff_uuid, table_name))`
+
+	sqlSelectActiveVDiffs = "select vdiff_uuid, workflow, options from _vt.vdiff where db_name=%s and state in ('pending', 'started')"
+	sqlUpdateVDiffStarted = "update _vt.vdiff set state='started', started_timestamp=ifnull(started_timestamp, now()), last_error='' where vdiff_uuid=%s and state='pending'"
+	sqlUpdateVDiffState   = "update _vt.vdiff set state=%s, last_error=%s where vdiff_uuid=%s and state='started'"
+	sqlUpdateVDiffDone    = "update _vt.vdiff set state='completed', completed_timestamp=now() where vdiff_uuid=%s and state='started'"
+	sqlDeleteOrphanTables = "delete from _vt.vdiff_table where db_name=%s and vdiff_uuid not in (select vdiff_uuid from _vt.vdiff where db_name=%s)"
+
+	sqlSelectStreams   = "select id, source, state, message from _vt.vreplication where workflow=%s and db_name=%s"
+	sqlSelectCopyState = "select 1 from _vt.copy_state where vrepl_id=%d limit 1"
+	sqlSelectStreamPos = "select pos, state, message from _vt.vreplication where id=%d"
+	sqlSelectTables    = "select table_name from information_schema.tables where table_schema=%s and table_type='BASE TABLE'"
+	sqlSelectColumns   = "select column_name, character_set_name, collation_name from information_schema.columns where table_schema=database() and table_name=%s"
+
+	sqlSelectVDiffTable = "select state, lastpk, report from _vt.vdiff_table where vdiff_uuid=%s and table_name=%s"
+	sqlInsertVDiffTable = "insert into _vt.vdiff_table(vdiff_uuid, table_name, workflow, db_name, state) values (%s, %s, %s, %s, 'pending')"
+	sqlUpdateVDiffTable = "update _vt.vdiff_table set state=%s, lastpk=%s, rows_compared=%d, mismatch=%d, report=%s where vdiff_uuid=%s and table_name=%s"
+	sqlSelectMismatches = "select table_name, report from _vt.vdiff_table where vdiff_uuid=%s and mismatch=1"
+)
+
+// State is the state of a vdiff, or of one of its tables.
+type State string
+
+const (
+	// StatePending is a vdiff that was created or resumed, but not picked up yet.
+	StatePending = State("pending")
+	// StateStarted is a vdiff that is being run by the engine.
+	StateStarted = State("started")
+	// StateStopped is a vdiff that was stopped by the user. It can be resumed.
+	StateStopped = State("stopped")
+	// StateCompleted is a vdiff that compared all its tables.
+	StateCompleted = State("completed")
+	// StateError is a vdiff that failed. It can be resumed.
+	StateError = State("error")
+)
+
+// Options are the options of a vdiff, stored as JSON in _vt.vdiff.
+type Options struct {
+	// Tables restricts the diff to these tables of the workflow.
+	Tables []string `json:",omitempty"`
+	// Recheck is the uuid of a previous vdiff of the same workflow.
+	// If set, only the rows reported as mismatched by that vdiff are diffed again.
+	Recheck string `json:",omitempty"`
+}
@@ -0,0 +1,754 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// maxReportedMismatches caps the number of rows listed in a table report.
+const maxReportedMismatches = 100
+
+// maxWeightsPerQuery caps the number of values weighed by one query.
+const maxWeightsPerQuery = 500
+
+// The types of a Mismatch.
+const (
+	MismatchDifferent   = "different"
+	MismatchExtraSource = "extra_source"
+	MismatchExtraTarget = "extra_target"
+)
+
+// TableReport is the diff report of one table. It's stored as JSON
+// in _vt.vdiff_table, and is updated at every checkpoint.
+type TableReport struct {
+	TableName       string
+	ProcessedRows   int64
+	MatchingRows    int64
+	MismatchedRows  int64
+	ExtraRowsSource int64
+	ExtraRowsTarget int64
+	// PKFields describes the values of the primary keys below.
+	PKFields []*querypb.Field `json:",omitempty"`
+	// Mismatches lists up to maxReportedMismatches rows that differ.
+	Mismatches []*Mismatch `json:",omitempty"`
+}
+
+// Mismatch is a row that differs between the source and the target.
+type Mismatch struct {
+	Type string
+	PK   []string
+	// ChunkStart (exclusive) and ChunkEnd (inclusive) are the primary
+	// keys that bound the chunk the row was compared in. They're empty
+	// for the start and the end of the table. A recheck diffs the chunk
+	// again.
+	ChunkStart []string `json:",omitempty"`
+	ChunkEnd   []string `json:",omitempty"`
+}
+
+// hasMismatch returns true if the report found any difference.
+func (tr *TableReport) hasMismatch() bool {
+	return tr.MismatchedRows+tr.ExtraRowsSource+tr.ExtraRowsTarget != 0
+}
+
+// add accumulates the results of a chunk into the report.
+func (tr *TableReport) add(cr *chunkResult) {
+	tr.ProcessedRows += cr.processed
+	tr.MatchingRows += cr.matching
+	for _, rd := range cr.diffs {
+		switch rd.kind {
+		case MismatchDifferent:
+			tr.MismatchedRows++
+		case MismatchExtraSource:
+			tr.ExtraRowsSource++
+		case MismatchExtraTarget:
+			tr.ExtraRowsTarget++
+		}
+		if len(tr.Mismatches) >= maxReportedMismatches {
+			continue
+		}
+		tr.Mismatches = append(tr.Mismatches, &Mismatch{
+			Type:       rd.kind,
+			PK:         valuesToStrings(rd.pk),
+			ChunkStart: valuesToStrings(cr.start),
+			ChunkEnd:   valuesToStrings(cr.end),
+		})
+	}
+}
+
+// streamRowsFunc streams the rows of query from the source of a stream,
+// starting after lastpk. A nil lastpk streams from the start of the table.
+type streamRowsFunc func(ctx context.Context, source *binlogdatapb.BinlogSource, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error
+
+// sourceStream is a vreplication stream of the workflow, along with
+// the query that selects the rows it replicates into the table.
+type sourceStream struct {
+	id     int64
+	source *binlogdatapb.BinlogSource
+	query  string
+}
+
+// rowDiff is a row that differs between the source and the target.
+type rowDiff struct {
+	kind string
+	pk   []sqltypes.Value
+}
+
+// chunkResult is the outcome of the comparison of one chunk of a table.
+type chunkResult struct {
+	// start is exclusive and end is inclusive. Nil values
+	// stand for the start and the end of the table.
+	start, end []sqltypes.Value
+
+	processed int64
+	matching  int64
+	diffs     []*rowDiff
+}
+
+// tableDiffer diffs one table of the workflow, one chunk at a time.
+// The source rows of a chunk are read from a consistent snapshot of
+// every source. The target rows are read once the target streams have
+// caught up with those snapshots. The streams are never stopped: rows
+// that changed after the snapshot are re-read up to maxRechecks times
+// before they are reported.
+type tableDiffer struct {
+	table      string
+	sources    []*sourceStream
+	dbClient   binlogplayer.DBClient
+	streamRows streamRowsFunc
+
+	// fields and pkFields are learnt from the source streams.
+	// pkCols are the positions of pkFields in fields.
+	fields   []*querypb.Field
+	pkFields []*querypb.Field
+	pkCols   []int
+
+	// pkCollations are the collations of the text columns of pkFields
+	// in the target table, and are nil for the other columns. Text
+	// primary keys are compared by their weights in these collations,
+	// so that rows are merged and matched in the order MySQL sorts
+	// them. The weights are computed by the target MySQL, and cached
+	// in weights for the range being diffed.
+	pkCollations []*collation
+	weights      map[string][]byte
+}
+
+// collation is the collation of a text column.
+type collation struct {
+	charset string
+	name    string
+}
+
+// diff diffs the table starting after lastpk, and calls checkpoint after every chunk.
+// It returns once the whole table was diffed.
+func (td *tableDiffer) diff(ctx context.Context, lastpk []sqltypes.Value, report *TableReport, checkpoint func(lastpk []sqltypes.Value) error) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cr, err := td.diffRange(ctx, lastpk, nil, false)
+		if err != nil {
+			return err
+		}
+		report.PKFields = td.pkFields
+		report.add(cr)
+		if cr.end == nil {
+			return nil
+		}
+		lastpk = cr.end
+		if err := checkpoint(lastpk); err != nil {
+			return err
+		}
+	}
+}
+
+// recheck diffs again the rows reported as mismatched by a previous vdiff.
+func (td *tableDiffer) recheck(ctx context.Context, previous, report *TableReport) error {
+	td.pkFields = previous.PKFields
+	report.PKFields = previous.PKFields
+
+	// Rows are rechecked by diffing again the chunks they belong to.
+	pksByChunk := make(map[string]map[string]bool)
+	for _, m := range previous.Mismatches {
+		key := fmt.Sprintf("%q-%q", m.ChunkStart, m.ChunkEnd)
+		if pksByChunk[key] == nil {
+			pksByChunk[key] = make(map[string]bool)
+		}
+		pksByChunk[key][fmt.Sprintf("%q", m.PK)] = true
+	}
+	for _, m := range previous.Mismatches {
+		key := fmt.Sprintf("%q-%q", m.ChunkStart, m.ChunkEnd)
+		pks := pksByChunk[key]
+		if pks == nil {
+			continue
+		}
+		delete(pksByChunk, key)
+
+		start, err := td.stringsToValues(m.ChunkStart)
+		if err != nil {
+			return err
+		}
+		end, err := td.stringsToValues(m.ChunkEnd)
+		if err != nil {
+			return err
+		}
+		cr, err := td.diffRange(ctx, start, end, true)
+		if err != nil {
+			return err
+		}
+		// Only report on the rows that were rechecked.
+		rechecked := &chunkResult{
+			start:     cr.start,
+			end:       cr.end,
+			processed: int64(len(pks)),
+			matching:  int64(len(pks)),
+		}
+		for _, rd := range cr.diffs {
+			if !pks[fmt.Sprintf("%q", valuesToStrings(rd.pk))] {
+				continue
+			}
+			rechecked.matching--
+			rechecked.diffs = append(rechecked.diffs, rd)
+		}
+		report.add(rechecked)
+	}
+	return nil
+}
+
+// diffRange diffs the rows after start. If bounded is set, it diffs the
+// rows up to end, or up to the end of the table if end is nil. Otherwise,
+// the range ends after about chunkSize rows of every source.
+// Ranges with differences are diffed again, in case the target
+// changed after the source snapshot.
+func (td *tableDiffer) diffRange(ctx context.Context, start, end []sqltypes.Value, bounded bool) (*chunkResult, error) {
+	for attempt := 0; ; attempt++ {
+		td.weights = nil
+		sourceRows, upper, positions, err := td.readSource(ctx, start, end, bounded)
+		if err != nil {
+			return nil, err
+		}
+		if err := td.waitForPositions(ctx, positions); err != nil {
+			return nil, err
+		}
+		targetRows, err := td.readTarget(start, upper)
+		if err != nil {
+			return nil, err
+		}
+		cr, err := td.compareRows(sourceRows, targetRows)
+		if err != nil {
+			return nil, err
+		}
+		cr.start, cr.end = start, upper
+		if len(cr.diffs) == 0 || attempt >= *maxRechecks {
+			return cr, nil
+		}
+		end, bounded = upper, true
+	}
+}
+
+// readSource reads the rows of the range from all sources, and returns them
+// sorted by primary key. It also returns the upper bound of the range, and
+// the snapshot position of every source stream.
+func (td *tableDiffer) readSource(ctx context.Context, start, end []sqltypes.Value, bounded bool) (rows [][]sqltypes.Value, upper []sqltypes.Value, positions map[int64]string, err error) {
+	positions = make(map[int64]string)
+	if bounded {
+		upper = end
+	}
+	for _, src := range td.sources {
+		srcRows, last, gtid, err := td.streamSource(ctx, src, start, end, bounded)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		positions[src.id] = gtid
+		rows = append(rows, srcRows...)
+		if bounded || last == nil {
+			continue
+		}
+		// The range can only include rows that were read from all sources.
+		// Sources that reached the end of the table don't limit it.
+		if upper == nil {
+			upper = last
+			continue
+		}
+		c, err := td.comparePKs(last, upper)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if c < 0 {
+			upper = last
+		}
+	}
+	pks := make([][]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		pks[i] = td.pkOf(row)
+	}
+	if err := td.loadWeights(pks...); err != nil {
+		return nil, nil, nil, err
+	}
+	var sortErr error
+	if upper != nil {
+		filtered := rows[:0]
+		for _, row := range rows {
+			c, err := td.comparePKs(td.pkOf(row), upper)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			if c <= 0 {
+				filtered = append(filtered, row)
+			}
+		}
+		rows = filtered
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		c, err := td.comparePKs(td.pkOf(rows[i]), td.pkOf(rows[j]))
+		if err != nil && sortErr == nil {
+			sortErr = err
+		}
+		return c < 0
+	})
+	if sortErr != nil {
+		return nil, nil, nil, sortErr
+	}
+	return rows, upper, positions, nil
+}
+
+// streamSource reads the rows of the range from one source. It returns
+// the last primary key scanned by the source, which is nil if the source
+// reached the end of the table.
+func (td *tableDiffer) streamSource(ctx context.Context, src *sourceStream, start, end []sqltypes.Value, bounded bool) (rows [][]sqltypes.Value, last []sqltypes.Value, gtid string, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var lastpk *querypb.QueryResult
+	if start != nil {
+		lastpk = &querypb.QueryResult{
+			Fields: td.pkFields,
+			Rows:   []*querypb.Row{sqltypes.RowToProto3(start)},
+		}
+	}
+	done := false
+	err = td.streamRows(ctx, src.source, src.query, lastpk, func(response *binlogdatapb.VStreamRowsResponse) error {
+		if done {
+			return io.EOF
+		}
+		if response.Fields != nil {
+			if err := td.setFields(response.Fields, response.Pkfields); err != nil {
+				return err
+			}
+			gtid = response.Gtid
+		}
+		for _, row := range response.Rows {
+			rows = append(rows, sqltypes.MakeRowTrusted(td.fields, row))
+		}
+		if response.Lastpk == nil {
+			return nil
+		}
+		last = sqltypes.MakeRowTrusted(td.pkFields, response.Lastpk)
+		switch {
+		case !bounded:
+			done = len(rows) >= *chunkSize
+		case end != nil:
+			c, err := td.comparePKs(last, end)
+			if err != nil {
+				return err
+			}
+			done = c >= 0
+		}
+		if done {
+			// We have what we need. Cut the stream short.
+			cancel()
+		}
+		return nil
+	})
+	if done {
+		return rows, last, gtid, nil
+	}
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if gtid == "" {
+		return nil, nil, "", fmt.Errorf("table %s: no snapshot position received from stream %d", td.table, src.id)
+	}
+	return rows, nil, gtid, nil
+}
+
+// setFields validates the fields sent by a source, and remembers them.
+func (td *tableDiffer) setFields(fields, pkFields []*querypb.Field) error {
+	if td.fields != nil {
+		if len(fields) != len(td.fields) || len(pkFields) != len(td.pkFields) {
+			return fmt.Errorf("table %s: sources of the workflow return different columns: %v vs %v", td.table, fields, td.fields)
+		}
+		return nil
+	}
+	pkCols := make([]int, len(pkFields))
+	for i, pkField := range pkFields {
+		pkCols[i] = -1
+		for j, field := range fields {
+			if field.Name == pkField.Name {
+				pkCols[i] = j
+				break
+			}
+		}
+		if pkCols[i] == -1 {
+			return fmt.Errorf("table %s: primary key column %s is not selected by the filter", td.table, pkField.Name)
+		}
+	}
+	pkCollations, err := td.readPKCollations(pkFields)
+	if err != nil {
+		return err
+	}
+	td.fields, td.pkFields, td.pkCols, td.pkCollations = fields, pkFields, pkCols, pkCollations
+	return nil
+}
+
+// readPKCollations reads the collations of the text primary key columns
+// from the target table.
+func (td *tableDiffer) readPKCollations(pkFields []*querypb.Field) ([]*collation, error) {
+	hasText := false
+	for _, pkField := range pkFields {
+		hasText = hasText || sqltypes.IsText(pkField.Type)
+	}
+	if !hasText {
+		return nil, nil
+	}
+	qr, err := td.dbClient.ExecuteFetch(fmt.Sprintf(sqlSelectColumns, encodeString(td.table)), math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	collations := make([]*collation, len(pkFields))
+	for i, pkField := range pkFields {
+		if !sqltypes.IsText(pkField.Type) {
+			continue
+		}
+		for _, row := range qr.Rows {
+			if strings.EqualFold(row[0].ToString(), pkField.Name) && !row[2].IsNull() {
+				collations[i] = &collation{charset: row[1].ToString(), name: row[2].ToString()}
+				break
+			}
+		}
+		if collations[i] == nil {
+			return nil, fmt.Errorf("table %s: can't find the collation of primary key column %s", td.table, pkField.Name)
+		}
+	}
+	return collations, nil
+}
+
+// waitForPositions waits for the target streams to catch up with the source snapshots.
+func (td *tableDiffer) waitForPositions(ctx context.Context, positions map[int64]string) error {
+	ctx, cancel := context.WithTimeout(ctx, *filteredReplicationWaitTime)
+	defer cancel()
+	for _, src := range td.sources {
+		id, gtid := src.id, positions[src.id]
+		want, err := mysql.DecodePosition(gtid)
+		if err != nil {
+			return err
+		}
+		for {
+			qr, err := td.dbClient.ExecuteFetch(fmt.Sprintf(sqlSelectStreamPos, id), 1)
+			if err != nil {
+				return err
+			}
+			if len(qr.Rows) == 0 {
+				return fmt.Errorf("stream %d not found", id)
+			}
+			current, err := mysql.DecodePosition(qr.Rows[0][0].ToString())
+			if err != nil {
+				return err
+			}
+			if current.AtLeast(want) {
+				break
+			}
+			if state := qr.Rows[0][1].ToString(); state != binlogplayer.BlpRunning {
+				return fmt.Errorf("stream %d is not running: state: %s, message: %s", id, state, qr.Rows[0][2].ToString())
+			}
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("stream %d did not reach position %s: %v", id, gtid, ctx.Err())
+			case <-time.After(waitRetryTime):
+			}
+		}
+	}
+	return nil
+}
+
+// readTarget reads the target rows of the range, sorted by primary key.
+func (td *tableDiffer) readTarget(start, end []sqltypes.Value) ([][]sqltypes.Value, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	prefix := ""
+	for _, field := range td.fields {
+		buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(field.Name))
+		prefix = ", "
+	}
+	buf.Myprintf(" from %v", sqlparser.NewTableIdent(td.table))
+	prefix = " where "
+	if start != nil {
+		buf.WriteString(prefix)
+		td.writePKAfter(buf, start)
+		prefix = " and "
+	}
+	if end != nil {
+		buf.Myprintf("%snot ", prefix)
+		td.writePKAfter(buf, end)
+	}
+	buf.WriteString(" order by ")
+	prefix = ""
+	for _, pkField := range td.pkFields {
+		buf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(pkField.Name))
+		prefix = ", "
+	}
+	qr, err := td.dbClient.ExecuteFetch(buf.String(), math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	pks := make([][]sqltypes.Value, len(qr.Rows))
+	for i, row := range qr.Rows {
+		pks[i] = td.pkOf(row)
+	}
+	if err := td.loadWeights(pks...); err != nil {
+		return nil, err
+	}
+	return qr.Rows, nil
+}
+
+// writePKAfter writes a condition that selects the rows after pk.
+// Like the rowstreamer, it expands composite keys to:
+// (col1 = 1 and col2 > 2 or col1 > 1).
+func (td *tableDiffer) writePKAfter(buf *sqlparser.TrackedBuffer, pk []sqltypes.Value) {
+	buf.WriteString("(")
+	prefix := ""
+	for lastcol := len(td.pkFields) - 1; lastcol >= 0; lastcol-- {
+		buf.WriteString(prefix)
+		prefix = " or "
+		for i, pkField := range td.pkFields[:lastcol] {
+			buf.Myprintf("%v = ", sqlparser.NewColIdent(pkField.Name))
+			pk[i].EncodeSQL(buf)
+			buf.Myprintf(" and ")
+		}
+		buf.Myprintf("%v > ", sqlparser.NewColIdent(td.pkFields[lastcol].Name))
+		pk[lastcol].EncodeSQL(buf)
+	}
+	buf.WriteString(")")
+}
+
+// compareRows compares the source and target rows of a range. Both must be sorted by primary key.
+func (td *tableDiffer) compareRows(sourceRows, targetRows [][]sqltypes.Value) (*chunkResult, error) {
+	cr := &chunkResult{}
+	i, j := 0, 0
+	for i < len(sourceRows) || j < len(targetRows) {
+		cr.processed++
+		if j >= len(targetRows) {
+			cr.diffs = append(cr.diffs, &rowDiff{kind: MismatchExtraSource, pk: td.pkOf(sourceRows[i])})
+			i++
+			continue
+		}
+		if i >= len(sourceRows) {
+			cr.diffs = append(cr.diffs, &rowDiff{kind: MismatchExtraTarget, pk: td.pkOf(targetRows[j])})
+			j++
+			continue
+		}
+		c, err := td.comparePKs(td.pkOf(sourceRows[i]), td.pkOf(targetRows[j]))
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case c < 0:
+			cr.diffs = append(cr.diffs, &rowDiff{kind: MismatchExtraSource, pk: td.pkOf(sourceRows[i])})
+			i++
+			continue
+		case c > 0:
+			cr.diffs = append(cr.diffs, &rowDiff{kind: MismatchExtraTarget, pk: td.pkOf(targetRows[j])})
+			j++
+			continue
+		}
+		c, err = compareValues(sourceRows[i], targetRows[j])
+		if err != nil {
+			return nil, err
+		}
+		if c != 0 {
+			cr.diffs = append(cr.diffs, &rowDiff{kind: MismatchDifferent, pk: td.pkOf(sourceRows[i])})
+		} else {
+			cr.matching++
+		}
+		i++
+		j++
+	}
+	return cr, nil
+}
+
+func (td *tableDiffer) pkOf(row []sqltypes.Value) []sqltypes.Value {
+	pk := make([]sqltypes.Value, len(td.pkCols))
+	for i, col := range td.pkCols {
+		pk[i] = row[col]
+	}
+	return pk
+}
+
+// comparePKs compares text primary keys by their weights in the collation
+// of their column, and the others with compareValue.
+func (td *tableDiffer) comparePKs(pk1, pk2 []sqltypes.Value) (int, error) {
+	if err := td.loadWeights(pk1, pk2); err != nil {
+		return 0, err
+	}
+	for i := range pk1 {
+		var c int
+		if coll := td.pkCollation(i); coll != nil && !pk1[i].IsNull() && !pk2[i].IsNull() {
+			c = bytes.Compare(td.weights[weightKey(coll, pk1[i])], td.weights[weightKey(coll, pk2[i])])
+		} else {
+			var err error
+			if c, err = compareValue(pk1[i], pk2[i]); err != nil {
+				return 0, err
+			}
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+func (td *tableDiffer) pkCollation(col int) *collation {
+	if col >= len(td.pkCollations) {
+		return nil
+	}
+	return td.pkCollations[col]
+}
+
+// loadWeights has the target MySQL compute the weights of the text values
+// of the primary keys that are not cached yet.
+func (td *tableDiffer) loadWeights(pks ...[]sqltypes.Value) error {
+	if len(td.pkCollations) == 0 {
+		return nil
+	}
+	if td.weights == nil {
+		td.weights = make(map[string][]byte)
+	}
+	var keys []string
+	var buf strings.Builder
+	flush := func() error {
+		if len(keys) == 0 {
+			return nil
+		}
+		qr, err := td.dbClient.ExecuteFetch(buf.String(), 1)
+		if err != nil {
+			return err
+		}
+		if len(qr.Rows) != 1 || len(qr.Rows[0]) != len(keys) {
+			return fmt.Errorf("table %s: unexpected result for the weights of the primary keys: %v", td.table, qr.Rows)
+		}
+		for i, key := range keys {
+			td.weights[key] = qr.Rows[0][i].ToBytes()
+		}
+		keys = nil
+		buf.Reset()
+		return nil
+	}
+	for _, pk := range pks {
+		for i, val := range pk {
+			coll := td.pkCollation(i)
+			if coll == nil || val.IsNull() {
+				continue
+			}
+			key := weightKey(coll, val)
+			if _, ok := td.weights[key]; ok {
+				continue
+			}
+			// Mark the value as pending, so that it's only weighed once.
+			td.weights[key] = nil
+			if len(keys) == 0 {
+				buf.WriteString("select ")
+			} else {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(&buf, "weight_string(convert(X'%x' using %s) collate %s)", val.ToBytes(), coll.charset, coll.name)
+			keys = append(keys, key)
+			if len(keys) >= maxWeightsPerQuery {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return flush()
+}
+
+func weightKey(coll *collation, val sqltypes.Value) string {
+	return coll.name + ":" + val.ToString()
+}
+
+func (td *tableDiffer) stringsToValues(in []string) ([]sqltypes.Value, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if len(in) != len(td.pkFields) {
+		return nil, fmt.Errorf("table %s: primary key values don't match the primary key: %v vs %v", td.table, in, td.pkFields)
+	}
+	values := make([]sqltypes.Value, len(in))
+	for i, val := range in {
+		values[i] = sqltypes.MakeTrusted(td.pkFields[i].Type, []byte(val))
+	}
+	return values, nil
+}
+
+func compareValues(values1, values2 []sqltypes.Value) (int, error) {
+	for i := range values1 {
+		c, err := compareValue(values1[i], values2[i])
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// compareValue compares numbers by value, and everything else by bytes.
+func compareValue(v1, v2 sqltypes.Value) (int, error) {
+	if v1.IsNull() || v2.IsNull() || (sqltypes.IsNumber(v1.Type()) && sqltypes.IsNumber(v2.Type())) {
+		return evalengine.NullsafeCompare(v1, v2)
+	}
+	return bytes.Compare(v1.ToBytes(), v2.ToBytes()), nil
+}
+
+func valuesToStrings(values []sqltypes.Value) []string {
+	if values == nil {
+		return nil
+	}
+	out := make([]string, len(values))
+	for i, val := range values {
+		out[i] = val.ToString()
+	}
+	return out
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const (
+	testPos      = "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10"
+	testStalePos = "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+)
+
+var testFields = sqltypes.MakeTestFields("id|val", "int64|varchar")
+
+// fakeSource streams the rows of every shard one at a time,
+// the way the rowstreamer would for tiny packets.
+type fakeSource map[string][][]sqltypes.Value
+
+func (fs fakeSource) streamRows(ctx context.Context, source *binlogdatapb.BinlogSource, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	err := send(&binlogdatapb.VStreamRowsResponse{
+		Fields:   testFields,
+		Pkfields: testFields[:1],
+		Gtid:     testPos,
+	})
+	if err != nil {
+		return err
+	}
+	var after int64
+	if lastpk != nil {
+		after, _ = sqltypes.MakeRowTrusted(lastpk.Fields, lastpk.Rows[0])[0].ToInt64()
+	}
+	for _, row := range fs[source.Shard] {
+		if id, _ := row[0].ToInt64(); id <= after {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err := send(&binlogdatapb.VStreamRowsResponse{
+			Rows:   []*querypb.Row{sqltypes.RowToProto3(row)},
+			Lastpk: sqltypes.RowToProto3(row[:1]),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testRows(rows ...string) [][]sqltypes.Value {
+	return sqltypes.MakeTestResult(testFields, rows...).Rows
+}
+
+func expectPositions(dbClient *binlogplayer.MockDBClient) {
+	for _, id := range []int{1, 2} {
+		dbClient.ExpectRequest(fmt.Sprintf("select pos, state, message from _vt.vreplication where id=%d", id), sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("pos|state|message", "varchar|varchar|varchar"),
+			testPos+"|Running|",
+		), nil)
+	}
+}
+
+func newTestTableDiffer(dbClient binlogplayer.DBClient, source fakeSource) *tableDiffer {
+	return &tableDiffer{
+		table: "t1",
+		sources: []*sourceStream{
+			{id: 1, source: &binlogdatapb.BinlogSource{Keyspace: "ks", Shard: "-80"}, query: "select * from t1 where in_keyrange('-80')"},
+			{id: 2, source: &binlogdatapb.BinlogSource{Keyspace: "ks", Shard: "80-"}, query: "select * from t1 where in_keyrange('80-')"},
+		},
+		dbClient:   dbClient,
+		streamRows: source.streamRows,
+	}
+}
+
+func setTestFlags(t *testing.T) {
+	savedChunkSize, savedMaxRechecks, savedWaitRetryTime := *chunkSize, *maxRechecks, waitRetryTime
+	*chunkSize, *maxRechecks, waitRetryTime = 2, 1, time.Millisecond
+	t.Cleanup(func() {
+		*chunkSize, *maxRechecks, waitRetryTime = savedChunkSize, savedMaxRechecks, savedWaitRetryTime
+	})
+}
+
+func TestTableDifferDiff(t *testing.T) {
+	setTestFlags(t)
+	source := fakeSource{
+		"-80": testRows("1|a", "3|c", "5|e"),
+		"80-": testRows("2|b", "4|d", "6|f"),
+	}
+	dbClient := binlogplayer.NewMockDBClient(t)
+	td := newTestTableDiffer(dbClient, source)
+
+	// First chunk: the -80 source limits the range to 3.
+	// The target is lagging at first.
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=1", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("pos|state|message", "varchar|varchar|varchar"),
+		testStalePos+"|Running|",
+	), nil)
+	expectPositions(dbClient)
+	dbClient.ExpectRequest("select id, val from t1 where not (id > 3) order by id", sqltypes.MakeTestResult(testFields, "1|a", "2|b", "3|c"), nil)
+	// Second chunk: the row 5 differs, even after a recheck.
+	expectPositions(dbClient)
+	dbClient.ExpectRequest("select id, val from t1 where (id > 3) and not (id > 6) order by id", sqltypes.MakeTestResult(testFields, "4|d", "5|x", "6|f"), nil)
+	expectPositions(dbClient)
+	dbClient.ExpectRequest("select id, val from t1 where (id > 3) and not (id > 6) order by id", sqltypes.MakeTestResult(testFields, "4|d", "5|x", "6|f"), nil)
+	// Last chunk: an extra row on the target.
+	for i := 0; i < 2; i++ {
+		expectPositions(dbClient)
+		dbClient.ExpectRequest("select id, val from t1 where (id > 6) order by id", sqltypes.MakeTestResult(testFields, "7|g"), nil)
+	}
+
+	report := &TableReport{TableName: "t1"}
+	var checkpoints []string
+	err := td.diff(context.Background(), nil, report, func(lastpk []sqltypes.Value) error {
+		checkpoints = append(checkpoints, lastpk[0].ToString())
+		return nil
+	})
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	assert.Equal(t, []string{"3", "6"}, checkpoints)
+	assert.Equal(t, &TableReport{
+		TableName:       "t1",
+		ProcessedRows:   7,
+		MatchingRows:    5,
+		MismatchedRows:  1,
+		ExtraRowsTarget: 1,
+		PKFields:        testFields[:1],
+		Mismatches: []*Mismatch{
+			{Type: MismatchDifferent, PK: []string{"5"}, ChunkStart: []string{"3"}, ChunkEnd: []string{"6"}},
+			{Type: MismatchExtraTarget, PK: []string{"7"}, ChunkStart: []string{"6"}},
+		},
+	}, report)
+
+	// Once the target is fixed, a recheck only diffs the chunks of the mismatched rows.
+	dbClient = binlogplayer.NewMockDBClient(t)
+	td = newTestTableDiffer(dbClient, source)
+	expectPositions(dbClient)
+	dbClient.ExpectRequest("select id, val from t1 where (id > 3) and not (id > 6) order by id", sqltypes.MakeTestResult(testFields, "4|d", "5|e", "6|f"), nil)
+	expectPositions(dbClient)
+	dbClient.ExpectRequest("select id, val from t1 where (id > 6) order by id", sqltypes.MakeTestResult(testFields), nil)
+
+	recheck := &TableReport{TableName: "t1"}
+	require.NoError(t, td.recheck(context.Background(), report, recheck))
+	dbClient.Wait()
+	assert.Equal(t, &TableReport{
+		TableName:     "t1",
+		ProcessedRows: 2,
+		MatchingRows:  2,
+		PKFields:      testFields[:1],
+	}, recheck)
+}
+
+func TestTableDifferResume(t *testing.T) {
+	setTestFlags(t)
+	*chunkSize = 10
+	source := fakeSource{
+		"-80": testRows("1|a", "3|c", "5|e"),
+		"80-": testRows("2|b", "4|d", "6|f"),
+	}
+	dbClient := binlogplayer.NewMockDBClient(t)
+	td := newTestTableDiffer(dbClient, source)
+	td.pkFields = testFields[:1]
+
+	expectPositions(dbClient)
+	dbClient.ExpectRequest("select id, val from t1 where (id > 4) order by id", sqltypes.MakeTestResult(testFields, "5|e", "6|f"), nil)
+
+	report := &TableReport{TableName: "t1", ProcessedRows: 4, MatchingRows: 4}
+	err := td.diff(context.Background(), []sqltypes.Value{sqltypes.NewInt64(4)}, report, func(lastpk []sqltypes.Value) error {
+		t.Errorf("unexpected checkpoint: %v", lastpk)
+		return nil
+	})
+	require.NoError(t, err)
+	dbClient.Wait()
+	assert.Equal(t, int64(6), report.ProcessedRows)
+	assert.Equal(t, int64(6), report.MatchingRows)
+	assert.False(t, report.hasMismatch())
+}
+
+func TestTableDifferStreamNotRunning(t *testing.T) {
+	setTestFlags(t)
+	dbClient := binlogplayer.NewMockDBClient(t)
+	td := newTestTableDiffer(dbClient, fakeSource{})
+	dbClient.ExpectRequest("select pos, state, message from _vt.vreplication where id=1", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("pos|state|message", "varchar|varchar|varchar"),
+		testStalePos+"|Stopped|for cutover",
+	), nil)
+
+	err := td.diff(context.Background(), nil, &TableReport{}, func([]sqltypes.Value) error { return nil })
+	assert.EqualError(t, err, "stream 1 is not running: state: Stopped, message: for cutover")
+}
+
+func TestWritePKAfter(t *testing.T) {
+	td := &tableDiffer{
+		pkFields: sqltypes.MakeTestFields("a|b|c", "int64|varchar|int64"),
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	td.writePKAfter(buf, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("x"), sqltypes.NewInt64(3)})
+	assert.Equal(t, "(a = 1 and b = 'x' and c > 3 or a = 1 and b > 'x' or a > 1)", buf.String())
+}
+
+func TestTableDifferCollation(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	td := newTestTableDiffer(dbClient, fakeSource{})
+	fields := sqltypes.MakeTestFields("name|val", "varchar|int64")
+	dbClient.ExpectRequest("select column_name, character_set_name, collation_name from information_schema.columns where table_schema=database() and table_name='t1'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("column_name|character_set_name|collation_name", "varchar|varchar|varchar"),
+		"name|utf8mb4|utf8mb4_general_ci",
+		"val|NULL|NULL",
+	), nil)
+	require.NoError(t, td.setFields(fields, fields[:1]))
+
+	// In binary order, 'B' sorts before 'a'. In the collation of the
+	// column, it sorts after it, as it does on both sides.
+	dbClient.ExpectRequest("select weight_string(convert(X'61' using utf8mb4) collate utf8mb4_general_ci), weight_string(convert(X'42' using utf8mb4) collate utf8mb4_general_ci)", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("w1|w2", "varbinary|varbinary"),
+		"A|B",
+	), nil)
+	cr, err := td.compareRows(
+		sqltypes.MakeTestResult(fields, "a|1", "B|2").Rows,
+		sqltypes.MakeTestResult(fields, "B|2").Rows,
+	)
+	require.NoError(t, err)
+	dbClient.Wait()
+	assert.Equal(t, int64(2), cr.processed)
+	assert.Equal(t, int64(1), cr.matching)
+	assert.Equal(t, []*rowDiff{{kind: MismatchExtraSource, pk: []sqltypes.Value{sqltypes.NewVarChar("a")}}}, cr.diffs)
+}
@@ -0,0 +1,384 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// This file contains the detection and resolution of primary key conflicts.
+// Conflicts happen when several streams write to the same target table, like
+// the streams of a Merge workflow that consolidates multiple keyspaces: two
+// sources can have rows with the same primary key. The on_conflict column of
+// _vt.vreplication specifies how such conflicts are resolved. If it's empty,
+// the insert error fails the stream like any other error, and the stream is
+// retried. Every detected conflict is recorded in _vt.vreplication_conflicts.
+//
+// A stream that loses a conflict must not change the row it lost with its
+// later updates and deletes: the row belongs to another source. The keys
+// lost by each stream are tracked in _vt.vreplication_conflict_keys, and
+// updates and deletes of lost keys are skipped and recorded as conflicts.
+// A key is no longer lost once its row is deleted by the stream that owns it.
+// Keys are identified by the SHA-256 of their values encoded as SQL literals,
+// which fits in the primary key regardless of the size of the key. The
+// encoded values are kept alongside for inspection.
+
+// The supported conflict policies.
+const (
+	// ConflictFail records the conflict and stops the stream.
+	ConflictFail = "fail"
+	// ConflictSkip records the conflict and keeps the existing row.
+	ConflictSkip = "skip"
+	// ConflictLastWriterWins records the conflict and overwrites the
+	// existing row with the incoming one.
+	ConflictLastWriterWins = "last_writer_wins"
+)
+
+const createConflictsTable = `create table if not exists _vt.vreplication_conflicts (
+  id bigint auto_increment,
+  vrepl_id int,
+  table_name varbinary(128),
+  row_data blob,
+  resolution varbinary(32),
+  time_created bigint,
+  primary key (id),
+  key vrepl_id (vrepl_id))`
+
+const createConflictKeysTable = `create table if not exists _vt.vreplication_conflict_keys (
+  vrepl_id int,
+  table_name varbinary(128),
+  pk_hash varbinary(64),
+  pk_data blob,
+  primary key (table_name, pk_hash, vrepl_id))`
+
+// ValidateConflictPolicy returns an error if policy is not a valid value
+// for the on_conflict column of _vt.vreplication.
+func ValidateConflictPolicy(policy string) error {
+	switch policy {
+	case "", ConflictFail, ConflictSkip, ConflictLastWriterWins:
+		return nil
+	}
+	return fmt.Errorf("invalid on_conflict policy %q, must be one of %s, %s or %s", policy, ConflictFail, ConflictSkip, ConflictLastWriterWins)
+}
+
+// conflictResolver resolves the primary key conflicts of a stream
+// according to its policy.
+type conflictResolver struct {
+	vreplID uint32
+	policy  string
+	// workflow and dbName identify the other streams of the workflow,
+	// which lose the keys that this stream wins.
+	workflow string
+	dbName   string
+	// peers are the ids of the other streams of the workflow.
+	// They're loaded on the first conflict won by this stream.
+	peers       []uint32
+	peersLoaded bool
+	// failed is set to the conflict that stopped the stream
+	// if the policy is ConflictFail.
+	failed *conflictError
+}
+
+// conflictError is returned when a conflict stops a stream.
+type conflictError struct {
+	table string
+	row   string
+	err   error
+}
+
+func (ce *conflictError) Error() string {
+	return fmt.Sprintf("conflicting row in table %s: %s: %v", ce.table, ce.row, ce.err)
+}
+
+func isDupEntry(err error) bool {
+	sqlErr, ok := err.(*mysql.SQLError)
+	return ok && sqlErr.Number() == mysql.ERDupEntry
+}
+
+// applyInsert executes the insert of a row, and resolves the conflict
+// if the row already exists.
+func (tp *TablePlan) applyInsert(bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	qr, err := execParsedQuery(tp.Insert, bindvars, executor)
+	if tp.conflicts == nil || !isDupEntry(err) {
+		return qr, err
+	}
+	return tp.conflicts.resolve(tp, bindvars, err, executor)
+}
+
+// applyDelete executes the delete of a row, unless the row was lost
+// to another stream.
+func (tp *TablePlan) applyDelete(bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if tp.conflicts == nil {
+		return execParsedQuery(tp.Delete, bindvars, executor)
+	}
+	key := tp.conflictKey(bindvars, "b_")
+	if skipped, err := tp.conflicts.skipLostKey(tp, key, bindvars, "b_", executor); skipped || err != nil {
+		return &sqltypes.Result{}, err
+	}
+	qr, err := execParsedQuery(tp.Delete, bindvars, executor)
+	if err != nil {
+		return nil, err
+	}
+	if err := tp.conflicts.releaseKey(tp, key, executor); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+// applyUpdate executes the update of a row, unless the row was lost
+// to another stream.
+func (tp *TablePlan) applyUpdate(bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	if tp.conflicts != nil {
+		key := tp.conflictKey(bindvars, "b_")
+		if skipped, err := tp.conflicts.skipLostKey(tp, key, bindvars, "a_", executor); skipped || err != nil {
+			return &sqltypes.Result{}, err
+		}
+	}
+	return execParsedQuery(tp.Update, bindvars, executor)
+}
+
+// applyRowsWithConflicts inserts rows one by one. It's used by the copy
+// phase if a bulk insert failed because of a conflict, to find out which
+// rows are in conflict.
+func (tp *TablePlan) applyRowsWithConflicts(rows []*querypb.Row, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	for _, row := range rows {
+		bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
+		vals := sqltypes.MakeRowTrusted(tp.Fields, row)
+		for i, field := range tp.Fields {
+			bindvars["a_"+field.Name] = sqltypes.ValueBindVariable(vals[i])
+		}
+		qr, err := tp.applyInsert(bindvars, executor)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+	}
+	return result, nil
+}
+
+// resolve records the conflict caused by the insert of the row described
+// by bindvars, and applies the policy.
+func (cr *conflictResolver) resolve(tp *TablePlan, bindvars map[string]*querypb.BindVariable, insertErr error, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	row := conflictRow(tp.Fields, bindvars, "a_")
+	if cr.policy == ConflictFail {
+		// The conflict is recorded when the stream is stopped, because
+		// the current transaction will be rolled back.
+		cr.failed = &conflictError{table: tp.TargetName, row: row, err: insertErr}
+		return nil, cr.failed
+	}
+	log.Infof("stream %d: resolving conflict in table %s with %s: %s", cr.vreplID, tp.TargetName, cr.policy, row)
+	if _, err := executor(cr.recordQuery(tp.TargetName, row)); err != nil {
+		return nil, err
+	}
+	key := tp.conflictKey(bindvars, "a_")
+	if cr.policy == ConflictSkip {
+		if err := cr.loseKey(tp, key, executor); err != nil {
+			return nil, err
+		}
+		return &sqltypes.Result{}, nil
+	}
+	if err := cr.winKey(tp, key, executor); err != nil {
+		return nil, err
+	}
+	return execParsedQuery(tp.Replace, bindvars, executor)
+}
+
+// skipLostKey returns true if the key was lost by the stream. If so, the
+// update or delete of the row described by the bindvars with prefix is
+// recorded as a conflict, and must be skipped.
+func (cr *conflictResolver) skipLostKey(tp *TablePlan, key string, bindvars map[string]*querypb.BindVariable, prefix string, executor func(string) (*sqltypes.Result, error)) (bool, error) {
+	if key == "" || cr.policy == ConflictFail {
+		// Streams that fail on conflicts never lose keys.
+		return false, nil
+	}
+	qr, err := executor(fmt.Sprintf("select 1 from _vt.vreplication_conflict_keys where table_name=%s and pk_hash=%s and vrepl_id=%d",
+		encodeString(tp.TargetName), encodeString(conflictKeyHash(key)), cr.vreplID))
+	if err != nil {
+		return false, err
+	}
+	if len(qr.Rows) == 0 {
+		return false, nil
+	}
+	row := conflictRow(tp.Fields, bindvars, prefix)
+	log.Infof("stream %d: skipping change of row lost to another stream in table %s: %s", cr.vreplID, tp.TargetName, row)
+	if _, err := executor(cr.recordQuery(tp.TargetName, row)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// loseKey records that the key belongs to another stream.
+func (cr *conflictResolver) loseKey(tp *TablePlan, key string, executor func(string) (*sqltypes.Result, error)) error {
+	if key == "" {
+		return nil
+	}
+	_, err := executor(cr.loseKeyQuery(tp.TargetName, key, cr.vreplID))
+	return err
+}
+
+// winKey records that the key belongs to this stream, and that all the
+// other streams of the workflow lost it.
+func (cr *conflictResolver) winKey(tp *TablePlan, key string, executor func(string) (*sqltypes.Result, error)) error {
+	if key == "" {
+		return nil
+	}
+	if !cr.peersLoaded {
+		// This is a non-locking read, even inside the transaction.
+		qr, err := executor(fmt.Sprintf("select id from _vt.vreplication where workflow=%s and db_name=%s and id!=%d",
+			encodeString(cr.workflow), encodeString(cr.dbName), cr.vreplID))
+		if err != nil {
+			return err
+		}
+		for _, row := range qr.Rows {
+			id, err := evalengine.ToUint64(row[0])
+			if err != nil {
+				return err
+			}
+			cr.peers = append(cr.peers, uint32(id))
+		}
+		cr.peersLoaded = true
+	}
+	if _, err := executor(fmt.Sprintf("delete from _vt.vreplication_conflict_keys where table_name=%s and pk_hash=%s and vrepl_id=%d",
+		encodeString(tp.TargetName), encodeString(conflictKeyHash(key)), cr.vreplID)); err != nil {
+		return err
+	}
+	for _, peer := range cr.peers {
+		if _, err := executor(cr.loseKeyQuery(tp.TargetName, key, peer)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseKey forgets the streams that lost the key, because its
+// row was deleted by the stream that owns it.
+func (cr *conflictResolver) releaseKey(tp *TablePlan, key string, executor func(string) (*sqltypes.Result, error)) error {
+	if key == "" || cr.policy == ConflictFail {
+		return nil
+	}
+	_, err := executor(fmt.Sprintf("delete from _vt.vreplication_conflict_keys where table_name=%s and pk_hash=%s",
+		encodeString(tp.TargetName), encodeString(conflictKeyHash(key))))
+	return err
+}
+
+func (cr *conflictResolver) loseKeyQuery(table, key string, vreplID uint32) string {
+	return fmt.Sprintf("insert ignore into _vt.vreplication_conflict_keys(vrepl_id, table_name, pk_hash, pk_data) values (%d, %s, %s, %s)",
+		vreplID, encodeString(table), encodeString(conflictKeyHash(key)), encodeString(key))
+}
+
+// recordQuery returns the statement that records a conflict.
+func (cr *conflictResolver) recordQuery(table, row string) string {
+	return fmt.Sprintf("insert into _vt.vreplication_conflicts(vrepl_id, table_name, row_data, resolution, time_created) values (%d, %s, %s, %s, %d)",
+		cr.vreplID, encodeString(table), encodeString(row), encodeString(cr.policy), time.Now().Unix())
+}
+
+// conflictRow returns the JSON representation of the row described by
+// the bindvars with prefix: "a_" for the incoming row, "b_" for the
+// existing one.
+func conflictRow(fields []*querypb.Field, bindvars map[string]*querypb.BindVariable, prefix string) string {
+	row := make([]sqltypes.Value, len(fields))
+	for i, field := range fields {
+		row[i], _ = sqltypes.BindVariableToValue(bindvars[prefix+field.Name])
+	}
+	var buf bytes.Buffer
+	(&SinkChange{Fields: fields}).writeJSONRow(&buf, row)
+	return buf.String()
+}
+
+// conflictKey returns the primary key of the row described by the
+// bindvars with prefix, as the list of its values encoded as SQL
+// literals, e.g. (1,'a'). Unlike the JSON representation of conflictRow,
+// the encoding is lossless, so different keys never collide. It's empty
+// if the primary key is unknown.
+func (tp *TablePlan) conflictKey(bindvars map[string]*querypb.BindVariable, prefix string) string {
+	if len(tp.PKReferences) == 0 {
+		return ""
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteByte('(')
+	for i, pkref := range tp.PKReferences {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		bv, ok := bindvars[prefix+pkref]
+		if !ok {
+			return ""
+		}
+		val, err := sqltypes.BindVariableToValue(bv)
+		if err != nil {
+			return ""
+		}
+		val.EncodeSQL(buf)
+	}
+	buf.WriteByte(')')
+	return buf.String()
+}
+
+// conflictKeyHash returns the hash that identifies the key in
+// _vt.vreplication_conflict_keys.
+func conflictKeyHash(key string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(key)))
+}
+
+// stopOnConflict records the conflict that failed the stream,
+// and stops the stream.
+func (vr *vreplicator) stopOnConflict(cerr *conflictError) error {
+	vr.stats.ErrorCounts.Add([]string{"Conflict"}, 1)
+	if err := vr.dbClient.Rollback(); err != nil {
+		return err
+	}
+	if _, err := vr.dbClient.Execute(vr.conflicts.recordQuery(cerr.table, cerr.row)); err != nil {
+		return err
+	}
+	return vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stopped on conflict: %v", cerr))
+}
+
+// setConflictPolicy enables the resolution of conflicts with the
+// specified policy.
+func (vr *vreplicator) setConflictPolicy(policy string) {
+	if policy == "" {
+		return
+	}
+	vr.conflicts = &conflictResolver{
+		vreplID:  vr.id,
+		policy:   policy,
+		workflow: vr.workflow,
+	}
+	if vr.dbClient != nil {
+		vr.conflicts.dbName = vr.dbClient.DBName()
+	}
+}
+
+// setConflictResolver makes the table plans of rp resolve conflicts.
+func (rp *ReplicatorPlan) setConflictResolver(cr *conflictResolver) {
+	rp.conflicts = cr
+	for _, tp := range rp.TablePlans {
+		tp.conflicts = cr
+	}
+}
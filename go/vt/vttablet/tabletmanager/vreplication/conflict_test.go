@@ -0,0 +1,288 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The hashes of the keys (1) and (2) in _vt.vreplication_conflict_keys.
+const (
+	keyHash1 = "fd0ad9026eee596b7072a762941f60bef57e760a230edd450b3a634825685c2a"
+	keyHash2 = "0e77e68ba5473d98840c3212f4a8cb801226494f1162c8001a9f4ed7b00cbaa8"
+)
+
+func TestConflictResolution(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|val", "int64|varchar")
+	row := sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "1|aa").Rows[0])
+
+	testcases := []struct {
+		policy  string
+		change  *binlogdatapb.RowChange
+		err     string
+		queries []string
+	}{{
+		policy: "",
+		change: &binlogdatapb.RowChange{After: row},
+		err:    "Duplicate entry '1' for key 'PRIMARY' (errno 1062) (sqlstate 23000)",
+		queries: []string{
+			"insert into t1(id,val) values (1,'aa')",
+		},
+	}, {
+		policy: ConflictFail,
+		change: &binlogdatapb.RowChange{After: row},
+		err:    `conflicting row in table t1: {"id":1,"val":"aa"}: Duplicate entry '1' for key 'PRIMARY' (errno 1062) (sqlstate 23000)`,
+		queries: []string{
+			"insert into t1(id,val) values (1,'aa')",
+		},
+	}, {
+		policy: ConflictSkip,
+		change: &binlogdatapb.RowChange{After: row},
+		queries: []string{
+			"insert into t1(id,val) values (1,'aa')",
+			`insert into _vt.vreplication_conflicts(vrepl_id, table_name, row_data, resolution, time_created) values (3, 't1', '{\"id\":1,\"val\":\"aa\"}', 'skip'`,
+			`insert ignore into _vt.vreplication_conflict_keys(vrepl_id, table_name, pk_hash, pk_data) values (3, 't1', '` + keyHash1 + `', '(1)')`,
+		},
+	}, {
+		policy: ConflictLastWriterWins,
+		change: &binlogdatapb.RowChange{After: row},
+		queries: []string{
+			"insert into t1(id,val) values (1,'aa')",
+			`insert into _vt.vreplication_conflicts(vrepl_id, table_name, row_data, resolution, time_created) values (3, 't1', '{\"id\":1,\"val\":\"aa\"}', 'last_writer_wins'`,
+			"select id from _vt.vreplication where workflow='wf' and db_name='db' and id!=3",
+			`delete from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash1 + `' and vrepl_id=3`,
+			`insert ignore into _vt.vreplication_conflict_keys(vrepl_id, table_name, pk_hash, pk_data) values (4, 't1', '` + keyHash1 + `', '(1)')`,
+			"replace into t1(id,val) values (1,'aa')",
+		},
+	}, {
+		// A primary key change can also conflict.
+		policy: ConflictLastWriterWins,
+		change: &binlogdatapb.RowChange{
+			Before: sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "2|aa").Rows[0]),
+			After:  row,
+		},
+		queries: []string{
+			`select 1 from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash2 + `' and vrepl_id=3`,
+			"delete from t1 where id=2",
+			`delete from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash2 + `'`,
+			"insert into t1(id,val) values (1,'aa')",
+			`insert into _vt.vreplication_conflicts(vrepl_id, table_name, row_data, resolution, time_created) values (3, 't1', '{\"id\":1,\"val\":\"aa\"}', 'last_writer_wins'`,
+			"select id from _vt.vreplication where workflow='wf' and db_name='db' and id!=3",
+			`delete from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash1 + `' and vrepl_id=3`,
+			`insert ignore into _vt.vreplication_conflict_keys(vrepl_id, table_name, pk_hash, pk_data) values (4, 't1', '` + keyHash1 + `', '(1)')`,
+			"replace into t1(id,val) values (1,'aa')",
+		},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.policy, func(t *testing.T) {
+			tp := conflictTestPlan(t, tcase.policy, fields)
+			var queries []string
+			_, err := tp.applyChange(tcase.change, conflictTestExecutor(&queries))
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.Equal(t, len(tcase.queries), len(queries), "%v", queries)
+			for i, want := range tcase.queries {
+				assert.True(t, strings.HasPrefix(queries[i], want), "query %d: %s, want prefix %s", i, queries[i], want)
+			}
+		})
+	}
+}
+
+// TestConflictOwnership checks that the updates and deletes of a row lost
+// to another stream are skipped, and that the updates of a row won by the
+// stream are applied.
+func TestConflictOwnership(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|val", "int64|varchar")
+	insert := &binlogdatapb.RowChange{
+		After: sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "1|aa").Rows[0]),
+	}
+	update := &binlogdatapb.RowChange{
+		Before: sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "1|aa").Rows[0]),
+		After:  sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "1|bb").Rows[0]),
+	}
+	del := &binlogdatapb.RowChange{
+		Before: sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "1|bb").Rows[0]),
+	}
+
+	testcases := []struct {
+		policy  string
+		queries []string
+	}{{
+		policy: ConflictSkip,
+		queries: []string{
+			"insert into t1(id,val) values (1,'aa')",
+			`insert into _vt.vreplication_conflicts(vrepl_id, table_name, row_data, resolution, time_created) values (3, 't1', '{\"id\":1,\"val\":\"aa\"}', 'skip'`,
+			`insert ignore into _vt.vreplication_conflict_keys(vrepl_id, table_name, pk_hash, pk_data) values (3, 't1', '` + keyHash1 + `', '(1)')`,
+			`select 1 from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash1 + `' and vrepl_id=3`,
+			`insert into _vt.vreplication_conflicts(vrepl_id, table_name, row_data, resolution, time_created) values (3, 't1', '{\"id\":1,\"val\":\"bb\"}', 'skip'`,
+			`select 1 from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash1 + `' and vrepl_id=3`,
+			`insert into _vt.vreplication_conflicts(vrepl_id, table_name, row_data, resolution, time_created) values (3, 't1', '{\"id\":1,\"val\":\"bb\"}', 'skip'`,
+		},
+	}, {
+		policy: ConflictLastWriterWins,
+		queries: []string{
+			"insert into t1(id,val) values (1,'aa')",
+			`insert into _vt.vreplication_conflicts(vrepl_id, table_name, row_data, resolution, time_created) values (3, 't1', '{\"id\":1,\"val\":\"aa\"}', 'last_writer_wins'`,
+			"select id from _vt.vreplication where workflow='wf' and db_name='db' and id!=3",
+			`delete from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash1 + `' and vrepl_id=3`,
+			`insert ignore into _vt.vreplication_conflict_keys(vrepl_id, table_name, pk_hash, pk_data) values (4, 't1', '` + keyHash1 + `', '(1)')`,
+			"replace into t1(id,val) values (1,'aa')",
+			`select 1 from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash1 + `' and vrepl_id=3`,
+			"update t1 set val='bb' where id=1",
+			`select 1 from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash1 + `' and vrepl_id=3`,
+			"delete from t1 where id=1",
+			`delete from _vt.vreplication_conflict_keys where table_name='t1' and pk_hash='` + keyHash1 + `'`,
+		},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.policy, func(t *testing.T) {
+			tp := conflictTestPlan(t, tcase.policy, fields)
+			var queries []string
+			executor := conflictTestExecutor(&queries)
+			for _, change := range []*binlogdatapb.RowChange{insert, update, del} {
+				_, err := tp.applyChange(change, executor)
+				require.NoError(t, err)
+			}
+			require.Equal(t, len(tcase.queries), len(queries), "%v", queries)
+			for i, want := range tcase.queries {
+				assert.True(t, strings.HasPrefix(queries[i], want), "query %d: %s, want prefix %s", i, queries[i], want)
+			}
+		})
+	}
+}
+
+func TestConflictResolutionBulkInsert(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|val", "int64|varchar")
+	tp := conflictTestPlan(t, ConflictSkip, fields)
+	rows := &binlogdatapb.VStreamRowsResponse{
+		Rows: []*querypb.Row{
+			sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "1|aa").Rows[0]),
+			sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, "2|bb").Rows[0]),
+		},
+	}
+	var queries []string
+	qr, err := tp.applyBulkInsert(rows, conflictTestExecutor(&queries))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, qr.RowsAffected)
+	want := []string{
+		"insert into t1(id,val) values (1,'aa'), (2,'bb')",
+		"insert into t1(id,val) values (1,'aa')",
+		"insert into _vt.vreplication_conflicts",
+		"insert ignore into _vt.vreplication_conflict_keys",
+		"insert into t1(id,val) values (2,'bb')",
+	}
+	require.Equal(t, len(want), len(queries), "%v", queries)
+	for i := range want {
+		assert.True(t, strings.HasPrefix(queries[i], want[i]), "query %d: %s, want prefix %s", i, queries[i], want[i])
+	}
+}
+
+func TestConflictKey(t *testing.T) {
+	tp := &TablePlan{PKReferences: []string{"id", "name"}}
+	key := func(id, name sqltypes.Value) string {
+		return tp.conflictKey(map[string]*querypb.BindVariable{
+			"a_id":   sqltypes.ValueBindVariable(id),
+			"a_name": sqltypes.ValueBindVariable(name),
+		}, "a_")
+	}
+
+	assert.Equal(t, `(1,'a\'b')`, key(sqltypes.NewInt64(1), sqltypes.NewVarChar("a'b")))
+	// Binary values that aren't valid UTF-8 keep all their bytes.
+	key1 := key(sqltypes.NewInt64(1), sqltypes.NewVarBinary("\xff"))
+	key2 := key(sqltypes.NewInt64(1), sqltypes.NewVarBinary("\xfe"))
+	assert.Equal(t, "(1,'\xff')", key1)
+	assert.NotEqual(t, key1, key2)
+	assert.NotEqual(t, conflictKeyHash(key1), conflictKeyHash(key2))
+	assert.Len(t, conflictKeyHash(key1), 64)
+
+	// The hash of a long composite key has the same size.
+	long := key(sqltypes.NewInt64(1), sqltypes.NewVarChar(strings.Repeat("a", 4096)))
+	assert.Len(t, conflictKeyHash(long), 64)
+
+	// The key is unknown if a primary key column is missing.
+	assert.Empty(t, tp.conflictKey(map[string]*querypb.BindVariable{"a_id": sqltypes.Int64BindVariable(1)}, "a_"))
+	assert.Empty(t, (&TablePlan{}).conflictKey(nil, "a_"))
+}
+
+func TestValidateConflictPolicy(t *testing.T) {
+	assert.NoError(t, ValidateConflictPolicy(""))
+	assert.NoError(t, ValidateConflictPolicy(ConflictLastWriterWins))
+	assert.EqualError(t, ValidateConflictPolicy("first_writer_wins"), `invalid on_conflict policy "first_writer_wins", must be one of fail, skip or last_writer_wins`)
+}
+
+func conflictTestPlan(t *testing.T, policy string, fields []*querypb.Field) *TablePlan {
+	t.Helper()
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id, val from t1",
+		}},
+	}
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {&PrimaryKeyInfo{Name: "id"}},
+	}
+	plan, err := buildReplicatorPlan(filter, pkInfos, nil)
+	require.NoError(t, err)
+	vr := &vreplicator{id: 3, workflow: "wf", dbClient: newVDBClient(binlogplayer.NewMockDBClient(t), nil)}
+	vr.setConflictPolicy(policy)
+	if vr.conflicts != nil {
+		plan.setConflictResolver(vr.conflicts)
+	}
+	tp, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t1", Fields: fields})
+	require.NoError(t, err)
+	return tp
+}
+
+// conflictTestExecutor returns an executor that fails the inserts of the
+// row with id 1 with a duplicate key error. It tracks whether stream 3
+// lost that row, and reports stream 4 as the other stream of the workflow.
+func conflictTestExecutor(queries *[]string) func(string) (*sqltypes.Result, error) {
+	lost := false
+	return func(sql string) (*sqltypes.Result, error) {
+		*queries = append(*queries, sql)
+		switch {
+		case strings.HasPrefix(sql, "insert into t1") && strings.Contains(sql, "(1,"):
+			return nil, mysql.NewSQLError(mysql.ERDupEntry, mysql.SSDupKey, "Duplicate entry '1' for key 'PRIMARY'")
+		case strings.HasPrefix(sql, "insert ignore into _vt.vreplication_conflict_keys") && strings.Contains(sql, "values (3, "):
+			lost = true
+		case strings.HasPrefix(sql, "delete from _vt.vreplication_conflict_keys"):
+			lost = false
+		case strings.HasPrefix(sql, "select 1 from _vt.vreplication_conflict_keys"):
+			if lost {
+				return sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1"), nil
+			}
+			return &sqltypes.Result{}, nil
+		case strings.HasPrefix(sql, "select id from _vt.vreplication"):
+			return sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "4"), nil
+		}
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	}
+}
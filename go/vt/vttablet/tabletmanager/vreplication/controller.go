@@ -57,11 +57,15 @@ type controller struct {
 	mysqld          mysqlctl.MysqlDaemon
 	blpStats        *binlogplayer.Stats
 
-	id           uint32
-	workflow     string
-	source       binlogdatapb.BinlogSource
-	stopPos      string
-	tabletPicker *discovery.TabletPicker
+	id         uint32
+	workflow   string
+	source     binlogdatapb.BinlogSource
+	stopPos    string
+	sink       string
+	onConflict string
+	// onSchemaChange is the policy for the DDLs of the source.
+	onSchemaChange string
+	tabletPicker   *discovery.TabletPicker
 
 	cancel context.CancelFunc
 	done   chan struct{}
@@ -106,6 +110,15 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 		return nil, err
 	}
 	ct.stopPos = params["stop_pos"]
+	ct.sink = params["sink"]
+	ct.onConflict = params["on_conflict"]
+	if err := ValidateConflictPolicy(ct.onConflict); err != nil {
+		return nil, err
+	}
+	ct.onSchemaChange = params["on_schema_change"]
+	if err := ValidateSchemaChangePolicy(ct.onSchemaChange); err != nil {
+		return nil, err
+	}
 
 	if ct.source.GetExternalMysql() == "" {
 		// tabletPicker
@@ -208,6 +221,19 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 		ct.sourceTablet.Set(tablet.Alias.String())
 	}
 
+	if ct.sink != "" && ct.source.Filter == nil {
+		ct.blpStats.ErrorCounts.Add([]string{"Invalid Source"}, 1)
+		return fmt.Errorf("sinks are only supported for streams with a filter")
+	}
+	if ct.sink != "" && ct.onConflict != "" {
+		ct.blpStats.ErrorCounts.Add([]string{"Invalid Source"}, 1)
+		return fmt.Errorf("conflicts can't be resolved for streams that write to a sink")
+	}
+	if ct.sink != "" && ct.onSchemaChange != "" {
+		ct.blpStats.ErrorCounts.Add([]string{"Invalid Source"}, 1)
+		return fmt.Errorf("schema change policies are not supported for streams that write to a sink")
+	}
+
 	switch {
 	case len(ct.source.Tables) > 0:
 		// Table names can have search patterns. Resolve them against the schema.
@@ -249,7 +275,18 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 		}
 		defer vsClient.Close(ctx)
 
-		vr := newVReplicator(ct.id, &ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
+		vr := newVReplicator(ct.id, ct.workflow, &ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
+		if ct.sink != "" {
+			sink, err := newSink(ct.sink)
+			if err != nil {
+				ct.blpStats.ErrorCounts.Add([]string{"Invalid Sink"}, 1)
+				return err
+			}
+			defer sink.Close()
+			vr.setSink(sink)
+		}
+		vr.setConflictPolicy(ct.onConflict)
+		vr.setSchemaChangePolicy(ct.onSchemaChange)
 
 		return vr.Replicate(ctx)
 	}
@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/withddl"
 
 	"golang.org/x/net/context"
@@ -108,6 +109,9 @@ type Engine struct {
 
 	journaler map[string]*journalEvent
 	ec        *externalConnector
+
+	// lagThrottler is checked by all streams before writing to the target.
+	lagThrottler *throttle.Throttler
 }
 
 type journalEvent struct {
@@ -118,14 +122,15 @@ type journalEvent struct {
 
 // NewEngine creates a new Engine.
 // A nil ts means that the Engine is disabled.
-func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon) *Engine {
+func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, lagThrottler *throttle.Throttler) *Engine {
 	vre := &Engine{
-		controllers: make(map[int]*controller),
-		ts:          ts,
-		cell:        cell,
-		mysqld:      mysqld,
-		journaler:   make(map[string]*journalEvent),
-		ec:          newExternalConnector(config.ExternalConnections),
+		controllers:  make(map[int]*controller),
+		ts:           ts,
+		cell:         cell,
+		mysqld:       mysqld,
+		journaler:    make(map[string]*journalEvent),
+		ec:           newExternalConnector(config.ExternalConnections),
+		lagThrottler: lagThrottler,
 	}
 	return vre
 }
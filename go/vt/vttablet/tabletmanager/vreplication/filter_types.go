@@ -0,0 +1,208 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// CheckFilterTypes verifies that the values computed by the filter of a
+// rule can be stored in the columns of the target table. sourceFields and
+// targetFields describe the columns of the source and target tables.
+// It's meant to be called when a workflow is created, so that errors are
+// reported up front instead of when rows are applied.
+// Expressions whose type can't be inferred are only checked for the
+// existence of the columns they reference.
+func CheckFilterTypes(targetTable, filter string, sourceFields, targetFields []*querypb.Field) error {
+	sel, fromTable, err := analyzeSelectFrom(filter)
+	if err != nil {
+		return err
+	}
+	source := make(map[string]int)
+	for i, field := range sourceFields {
+		source[strings.ToLower(field.Name)] = i
+	}
+	target := make(map[string]*querypb.Field)
+	for _, field := range targetFields {
+		target[strings.ToLower(field.Name)] = field
+	}
+	findSourceColumn := func(col *sqlparser.ColName) (int, error) {
+		i, ok := source[col.Name.Lowered()]
+		if !ok {
+			return 0, fmt.Errorf("column %s not found in source table %s", sqlparser.String(col), fromTable)
+		}
+		return i, nil
+	}
+	findTargetColumn := func(name sqlparser.ColIdent) (*querypb.Field, error) {
+		field, ok := target[name.Lowered()]
+		if !ok {
+			return nil, fmt.Errorf("column %s not found in target table %s", name.String(), targetTable)
+		}
+		return field, nil
+	}
+
+	if _, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); ok {
+		for _, field := range sourceFields {
+			tfield, err := findTargetColumn(sqlparser.NewColIdent(field.Name))
+			if err != nil {
+				return err
+			}
+			if err := checkAssignable(field.Name, field.Type, tfield); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Columns are typed by their source type, which is all the type
+	// inference of the evalengine needs.
+	env := evalengine.ExpressionEnv{Row: make([]sqltypes.Value, len(sourceFields)), Filter: true}
+	for i, field := range sourceFields {
+		env.Row[i] = sqltypes.MakeTrusted(field.Type, nil)
+	}
+	for _, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return fmt.Errorf("unexpected: %v", sqlparser.String(selExpr))
+		}
+		as := aliased.As
+		if as.IsEmpty() {
+			col, ok := aliased.Expr.(*sqlparser.ColName)
+			if !ok {
+				return fmt.Errorf("expression needs an alias: %v", sqlparser.String(aliased))
+			}
+			as = col.Name
+		}
+		tfield, err := findTargetColumn(as)
+		if err != nil {
+			return err
+		}
+		typ, err := inferFilterType(aliased.Expr, env, findSourceColumn)
+		if err != nil {
+			return err
+		}
+		if err := checkAssignable(sqlparser.String(aliased.Expr), typ, tfield); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inferFilterType returns the type of a select expression of a filter,
+// or NULL_TYPE if it can't be inferred.
+func inferFilterType(expr sqlparser.Expr, env evalengine.ExpressionEnv, findColumn func(col *sqlparser.ColName) (int, error)) (querypb.Type, error) {
+	if fexpr, ok := expr.(*sqlparser.FuncExpr); ok {
+		switch fexpr.Name.Lowered() {
+		case "count":
+			return sqltypes.Int64, nil
+		case "sum":
+			return sqltypes.Decimal, walkFilterColumns(fexpr, findColumn)
+		case "keyspace_id":
+			return sqltypes.VarBinary, nil
+		}
+	}
+	eexpr, err := sqlparser.ConvertVReplicationExpr(expr, findColumn)
+	if err == sqlparser.ErrExprNotSupported {
+		return sqltypes.Null, walkFilterColumns(expr, findColumn)
+	}
+	if err != nil {
+		return sqltypes.Null, err
+	}
+	return eexpr.Type(env)
+}
+
+func walkFilterColumns(node sqlparser.SQLNode, findColumn func(col *sqlparser.ColName) (int, error)) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			if _, err := findColumn(col); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}, node)
+}
+
+// typeClass is a family of MySQL types that can be stored in one another.
+type typeClass int
+
+const (
+	classOther = typeClass(iota)
+	classNumber
+	classString
+	classTemporal
+	classJSON
+)
+
+func classOf(typ querypb.Type) typeClass {
+	switch {
+	case typ == sqltypes.TypeJSON:
+		return classJSON
+	case sqltypes.IsNumber(typ) || typ == sqltypes.Year:
+		return classNumber
+	case typ == sqltypes.Date || typ == sqltypes.Time || typ == sqltypes.Datetime || typ == sqltypes.Timestamp:
+		return classTemporal
+	case sqltypes.IsText(typ) || sqltypes.IsBinary(typ) || typ == sqltypes.Enum || typ == sqltypes.Set:
+		return classString
+	}
+	return classOther
+}
+
+// checkAssignable returns an error if a value of type typ would be
+// rejected or silently corrupted by MySQL when stored in the column
+// described by field. Strings can't be stored in numeric, temporal or
+// JSON columns without an explicit conversion.
+func checkAssignable(expr string, typ querypb.Type, field *querypb.Field) error {
+	from, to := classOf(typ), classOf(field.Type)
+	if from == to || from == classOther || to == classOther {
+		return nil
+	}
+	if to == classString {
+		return nil
+	}
+	if from == classString {
+		return fmt.Errorf("%s of type %s can't be stored in column %s of type %s without an explicit cast, like cast(%s as %s)",
+			expr, typ, field.Name, field.Type, expr, castTypeName(field.Type))
+	}
+	return fmt.Errorf("%s of type %s can't be stored in column %s of type %s", expr, typ, field.Name, field.Type)
+}
+
+// castTypeName returns the name of the cast type that converts a string
+// to a value of type typ.
+func castTypeName(typ querypb.Type) string {
+	switch {
+	case typ == sqltypes.TypeJSON:
+		return "json"
+	case sqltypes.IsUnsigned(typ):
+		return "unsigned"
+	case sqltypes.IsIntegral(typ):
+		return "signed"
+	case sqltypes.IsFloat(typ) || typ == sqltypes.Decimal:
+		return "decimal"
+	case typ == sqltypes.Date:
+		return "date"
+	case typ == sqltypes.Time:
+		return "time"
+	}
+	return "datetime"
+}
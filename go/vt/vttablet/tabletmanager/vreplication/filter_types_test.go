@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestCheckFilterTypes(t *testing.T) {
+	sourceFields := sqltypes.MakeTestFields(
+		"id|name|price|doc|created",
+		"int64|varchar|decimal|varchar|datetime",
+	)
+	targetFields := sqltypes.MakeTestFields(
+		"id|label|amount|data|created|day|total",
+		"int64|varchar|float64|json|datetime|date|int64",
+	)
+	testcases := []struct {
+		filter string
+		err    string
+	}{{
+		filter: "select id, name as label, price as amount, created from t1",
+	}, {
+		filter: "select id, concat(name, '-', id) as label, cast(doc as json) as data from t1",
+	}, {
+		filter: "select id, json_extract(doc, '$.a') as data, json_unquote(json_extract(doc, '$.b')) as label from t1",
+	}, {
+		filter: "select id, cast(created as date) as day, price * 2 as amount, count(*) as total from t1 group by id",
+	}, {
+		// The type of unsupported expressions is not checked.
+		filter: "select id, md5(doc) as total from t1",
+	}, {
+		filter: "select id, md5(nothing) as total from t1",
+		err:    "column nothing not found in source table t1",
+	}, {
+		filter: "select id, name as nothing from t1",
+		err:    "column nothing not found in target table t2",
+	}, {
+		filter: "select id, upper(name) from t1",
+		err:    "expression needs an alias: upper(name)",
+	}, {
+		filter: "select id, doc as data from t1",
+		err:    "doc of type VARCHAR can't be stored in column data of type JSON without an explicit cast, like cast(doc as json)",
+	}, {
+		filter: "select id, name as amount from t1",
+		err:    "name of type VARCHAR can't be stored in column amount of type FLOAT64 without an explicit cast, like cast(name as decimal)",
+	}, {
+		filter: "select id, created as total from t1",
+		err:    "created of type DATETIME can't be stored in column total of type INT64",
+	}, {
+		filter: "select id, cast(doc as json) as total from t1",
+		err:    "convert(doc, json) of type JSON can't be stored in column total of type INT64",
+	}, {
+		filter: "select * from t1",
+		err:    "column name not found in target table t2",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			err := CheckFilterTypes("t2", tcase.filter, sourceFields, targetFields)
+			if tcase.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tcase.err)
+		})
+	}
+}
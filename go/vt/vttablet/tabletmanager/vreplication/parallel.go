@@ -0,0 +1,481 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	parallelApplyWorkers         = flag.Int("vreplication_parallel_apply_workers", 0, "number of workers used by vreplication to apply transactions that touch different rows in parallel. 0 or 1 applies transactions one at a time")
+	parallelApplyLockWaitTimeout = flag.Int("vreplication_parallel_apply_lock_wait_timeout", 1, "innodb_lock_wait_timeout, in seconds, of the workers that apply transactions in parallel. A transaction that waits longer for a row lock is applied again one at a time")
+)
+
+// parallelApplier applies the transactions received by a vplayer
+// with multiple workers. Transactions that don't touch the same
+// primary keys are applied concurrently. A transaction that depends
+// on a previous one is applied only after that one is committed.
+// Irrespective of dependencies, transactions are committed in the
+// order of the binlog, each along with its own position. This way,
+// the saved position is always safe to resume from.
+//
+// Only transactions that contain nothing but row events are applied
+// in parallel. All other events are applied by the vplayer, after
+// the transactions received before them are committed. If a
+// transaction fails, it and all the ones after it are rolled back
+// and applied again one at a time.
+//
+// Dependencies are computed from primary keys only. Transactions that
+// touch the same unique key values through different primary keys can
+// be applied out of order. Such a transaction either fails with a
+// duplicate key error, or waits for a row lock held by a later one,
+// which itself waits for the earlier one to commit. The lock wait of
+// the workers is bounded by -vreplication_parallel_apply_lock_wait_timeout
+// for this reason: on timeout, the transaction and all the ones after it
+// are rolled back and applied again in order.
+type parallelApplier struct {
+	vp      *vplayer
+	workers []*parallelWorker
+	// throttleMu serializes the throttler checks of the workers,
+	// which share the throttler client of the vreplicator.
+	throttleMu sync.Mutex
+}
+
+// parallelWorker applies transactions on its own connection.
+type parallelWorker struct {
+	name     string
+	dbClient *vdbClient
+}
+
+// parallelTxn is a transaction that can be applied in parallel.
+type parallelTxn struct {
+	// events are the events of the transaction. They're
+	// used to apply the transaction again if it fails.
+	events    []*binlogdatapb.VEvent
+	changes   []*parallelChange
+	keys      []string
+	pos       mysql.Position
+	timestamp int64
+
+	// deps are the previous transactions that touch the same rows.
+	deps []*parallelTxn
+	// prev is the transaction that must be committed before this one.
+	prev *parallelTxn
+	// done is closed after the transaction is committed or rolled back.
+	done      chan struct{}
+	committed bool
+	err       error
+}
+
+// parallelChange is a row change along with the plan to apply it.
+type parallelChange struct {
+	tplan  *TablePlan
+	change *binlogdatapb.RowChange
+}
+
+// newParallelApplier returns a parallelApplier for the vplayer, or nil
+// if the transactions of the vplayer must be applied one at a time.
+// This is the case during copy and catchup, when a stop position is
+// set, and for streams that write to a sink or resolve conflicts.
+func newParallelApplier(vp *vplayer, workers int) (*parallelApplier, error) {
+	if workers <= 1 || vp.copyState != nil || !vp.stopPos.IsZero() || vp.vr.sink != nil || vp.vr.conflicts != nil || vp.vr.vre == nil {
+		return nil, nil
+	}
+	pa := &parallelApplier{vp: vp}
+	for i := 0; i < workers; i++ {
+		dbClient := vp.vr.vre.dbClientFactory()
+		if err := dbClient.Connect(); err != nil {
+			pa.close()
+			return nil, vterrors.Wrap(err, "can't connect to database")
+		}
+		pa.workers = append(pa.workers, &parallelWorker{
+			name:     fmt.Sprintf("%d", i),
+			dbClient: newVDBClient(dbClient, vp.vr.stats),
+		})
+		// The session must be set up the same way as the one of the controller.
+		for _, query := range []string{
+			"set @@session.time_zone = '+00:00'",
+			"set names binary",
+			fmt.Sprintf("set @@session.innodb_lock_wait_timeout = %d", *parallelApplyLockWaitTimeout),
+		} {
+			if _, err := dbClient.ExecuteFetch(query, 10000); err != nil {
+				pa.close()
+				return nil, err
+			}
+		}
+	}
+	vp.vr.stats.StartParallelApply(workers)
+	log.Infof("VReplication player id: %v applies transactions with %d workers", vp.vr.id, workers)
+	return pa, nil
+}
+
+func (pa *parallelApplier) close() {
+	for _, worker := range pa.workers {
+		worker.dbClient.Rollback()
+		worker.dbClient.Close()
+	}
+	pa.vp.vr.stats.StartParallelApply(0)
+}
+
+// applyItems applies the events fetched from the relay log. The row-only
+// transactions that follow each other are accumulated into a batch, which
+// is applied by the workers before any other event is applied.
+func (pa *parallelApplier) applyItems(ctx context.Context, items [][]*binlogdatapb.VEvent) error {
+	vp := pa.vp
+	var batch []*parallelTxn
+	var txn *parallelTxn
+	// empty is the commit of the last empty transaction. Its position
+	// is remembered only after the batch before it is committed.
+	var empty *binlogdatapb.VEvent
+	var emptyPos mysql.Position
+
+	flush := func() error {
+		if err := pa.applyBatch(ctx, batch); err != nil {
+			return err
+		}
+		batch = nil
+		if empty != nil {
+			vp.pos = emptyPos
+			vp.unsavedEvent = empty
+			empty = nil
+		}
+		return nil
+	}
+	// applySerially applies the events of the current
+	// transaction followed by the specified event.
+	applySerially := func(event *binlogdatapb.VEvent) error {
+		if err := flush(); err != nil {
+			return err
+		}
+		var events []*binlogdatapb.VEvent
+		if txn != nil {
+			events = txn.events
+			txn = nil
+		}
+		if event != nil {
+			events = append(events, event)
+		}
+		for _, event := range events {
+			if err := vp.applyEvent(ctx, event, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, events := range items {
+		for _, event := range events {
+			// A transaction that started in a previous fetch, or one
+			// that can't be applied in parallel, is completed serially.
+			if vp.vr.dbClient.InTransaction {
+				if err := vp.applyEvent(ctx, event, false); err != nil {
+					return err
+				}
+				continue
+			}
+			if txn == nil {
+				if event.Type == binlogdatapb.VEventType_BEGIN {
+					txn = &parallelTxn{events: []*binlogdatapb.VEvent{event}}
+					continue
+				}
+				if err := applySerially(event); err != nil {
+					return err
+				}
+				continue
+			}
+			switch event.Type {
+			case binlogdatapb.VEventType_FIELD:
+				tplan, err := vp.replicatorPlan.buildExecutionPlan(event.FieldEvent)
+				if err != nil {
+					return err
+				}
+				vp.tablePlans[event.FieldEvent.TableName] = tplan
+				txn.events = append(txn.events, event)
+			case binlogdatapb.VEventType_ROW:
+				tplan := vp.tablePlans[event.RowEvent.TableName]
+				if tplan == nil {
+					return fmt.Errorf("unexpected event on table %s", event.RowEvent.TableName)
+				}
+				for _, change := range event.RowEvent.RowChanges {
+					txn.changes = append(txn.changes, &parallelChange{tplan: tplan, change: change})
+					txn.keys = append(txn.keys, rowKeys(tplan, change)...)
+				}
+				txn.events = append(txn.events, event)
+			case binlogdatapb.VEventType_GTID:
+				pos, err := mysql.DecodePosition(event.Gtid)
+				if err != nil {
+					return err
+				}
+				txn.pos = pos
+				txn.events = append(txn.events, event)
+			case binlogdatapb.VEventType_COMMIT:
+				if len(txn.changes) == 0 {
+					empty, emptyPos = event, txn.pos
+				} else {
+					txn.timestamp = event.Timestamp
+					txn.events = append(txn.events, event)
+					batch = append(batch, txn)
+					// The position of the transaction supersedes
+					// the one of a previous empty transaction.
+					empty = nil
+				}
+				txn = nil
+			default:
+				// Statements, savepoints and any other event
+				// inside a transaction are applied serially.
+				if err := applySerially(event); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	// A partial transaction is started serially. The rest
+	// of it will be applied serially in the next fetch.
+	return applySerially(nil)
+}
+
+// applyBatch applies a batch of transactions with the workers.
+// If a transaction fails, the remaining ones are applied serially.
+func (pa *parallelApplier) applyBatch(ctx context.Context, batch []*parallelTxn) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	vp := pa.vp
+	setDependencies(batch)
+
+	txns := make(chan *parallelTxn, len(batch))
+	for _, txn := range batch {
+		txns <- txn
+	}
+	close(txns)
+	var wg sync.WaitGroup
+	for _, worker := range pa.workers {
+		wg.Add(1)
+		go func(worker *parallelWorker) {
+			defer wg.Done()
+			// Transactions are picked up in order. So, the ones
+			// a worker waits for are always being applied.
+			for txn := range txns {
+				worker.apply(ctx, pa, txn)
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	failed := -1
+	for i, txn := range batch {
+		if !txn.committed {
+			failed = i
+			break
+		}
+		vp.pos = txn.pos
+	}
+	if failed != 0 {
+		vp.unsavedEvent = nil
+		vp.timeLastSaved = time.Now()
+		vp.vr.stats.SetLastPosition(vp.pos)
+	}
+	if failed == -1 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return io.EOF
+	default:
+	}
+
+	vp.vr.stats.ParallelApplyFallbacks.Add(1)
+	log.Infof("VReplication player id: %v could not apply transaction %v in parallel: %v, applying the remaining %d transactions serially", vp.vr.id, mysql.EncodePosition(batch[failed].pos), batch[failed].err, len(batch)-failed)
+	for _, txn := range batch[failed:] {
+		for _, event := range txn.events {
+			if err := vp.applyEvent(ctx, event, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// apply applies a transaction and commits it along with its position.
+// The transaction is rolled back if it or a previous one fails.
+func (worker *parallelWorker) apply(ctx context.Context, pa *parallelApplier, txn *parallelTxn) {
+	vp := pa.vp
+	defer close(txn.done)
+	for _, dep := range txn.deps {
+		<-dep.done
+		if !dep.committed {
+			txn.err = fmt.Errorf("transaction %v failed", mysql.EncodePosition(dep.pos))
+			return
+		}
+	}
+	if err := pa.throttle(ctx); err != nil {
+		txn.err = err
+		return
+	}
+	// Only the time spent applying the transaction counts as busy.
+	start := time.Now()
+	var busy time.Duration
+	defer func() {
+		vp.vr.stats.ParallelApplyTimings.Add(worker.name, busy)
+	}()
+	err := worker.execute(ctx, vp, txn)
+	busy = time.Since(start)
+	if err != nil {
+		txn.err = err
+		worker.dbClient.Rollback()
+		return
+	}
+	if txn.prev != nil {
+		<-txn.prev.done
+		if !txn.prev.committed {
+			txn.err = fmt.Errorf("transaction %v failed", mysql.EncodePosition(txn.prev.pos))
+			worker.dbClient.Rollback()
+			return
+		}
+	}
+	start = time.Now()
+	defer func() {
+		busy += time.Since(start)
+	}()
+	update := binlogplayer.GenerateUpdatePos(vp.vr.id, txn.pos, time.Now().Unix(), txn.timestamp)
+	if _, err := worker.dbClient.Execute(update); err != nil {
+		txn.err = fmt.Errorf("error %v updating position", err)
+		worker.dbClient.Rollback()
+		return
+	}
+	if err := worker.dbClient.Commit(); err != nil {
+		txn.err = err
+		worker.dbClient.Rollback()
+		return
+	}
+	txn.committed = true
+}
+
+// throttle waits until the throttler lets the workers apply transactions.
+func (pa *parallelApplier) throttle(ctx context.Context) error {
+	pa.throttleMu.Lock()
+	defer pa.throttleMu.Unlock()
+	return pa.vp.vr.throttle(ctx, "vplayer")
+}
+
+// execute applies the changes of a transaction without committing it.
+func (worker *parallelWorker) execute(ctx context.Context, vp *vplayer, txn *parallelTxn) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+	if err := worker.dbClient.Begin(); err != nil {
+		return err
+	}
+	for _, pc := range txn.changes {
+		_, err := pc.tplan.applyChange(pc.change, func(sql string) (*sqltypes.Result, error) {
+			stats := NewVrLogStats("ROWCHANGE")
+			start := time.Now()
+			qr, err := worker.dbClient.Execute(sql)
+			vp.vr.stats.QueryCount.Add(vp.phase, 1)
+			vp.vr.stats.QueryTimings.Record(vp.phase, start)
+			stats.Send(sql)
+			return qr, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDependencies sets the transactions each transaction of the
+// batch depends on, and the one it must be committed after.
+func setDependencies(batch []*parallelTxn) {
+	lastWriters := make(map[string]*parallelTxn)
+	for i, txn := range batch {
+		txn.done = make(chan struct{})
+		txn.deps = nil
+		if i > 0 {
+			txn.prev = batch[i-1]
+		}
+		for _, key := range txn.keys {
+			if writer, ok := lastWriters[key]; ok && writer != txn && !containsTxn(txn.deps, writer) {
+				txn.deps = append(txn.deps, writer)
+			}
+			lastWriters[key] = txn
+		}
+	}
+}
+
+func containsTxn(txns []*parallelTxn, txn *parallelTxn) bool {
+	for _, t := range txns {
+		if t == txn {
+			return true
+		}
+	}
+	return false
+}
+
+// rowKeys returns the keys of the target rows affected by a change.
+// A change that moves a row affects both its old and new keys. If the
+// table has no primary key, the key is the table itself.
+// Text values are normalized because the target may compare
+// them without regard to case or trailing spaces.
+func rowKeys(tplan *TablePlan, change *binlogdatapb.RowChange) []string {
+	if len(tplan.PKReferences) == 0 {
+		return []string{tplan.TargetName}
+	}
+	var keys []string
+	for _, row := range []*querypb.Row{change.Before, change.After} {
+		if row == nil {
+			continue
+		}
+		vals := sqltypes.MakeRowTrusted(tplan.Fields, row)
+		buf := &bytes.Buffer{}
+		buf.WriteString(tplan.TargetName)
+		for _, pkref := range tplan.PKReferences {
+			buf.WriteByte(':')
+			for i, field := range tplan.Fields {
+				if field.Name != pkref {
+					continue
+				}
+				if vals[i].IsText() {
+					vals[i] = sqltypes.NewVarChar(strings.TrimRight(strings.ToLower(vals[i].ToString()), " "))
+				}
+				vals[i].EncodeSQL(buf)
+				break
+			}
+		}
+		if key := buf.String(); len(keys) == 0 || keys[0] != key {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
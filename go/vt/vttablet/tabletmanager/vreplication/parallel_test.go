@@ -0,0 +1,150 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestRowKeys(t *testing.T) {
+	tplan := &TablePlan{
+		TargetName: "t1",
+		Fields: []*querypb.Field{
+			{Name: "id", Type: querypb.Type_INT64},
+			{Name: "name", Type: querypb.Type_VARCHAR},
+			{Name: "val", Type: querypb.Type_VARBINARY},
+		},
+		PKReferences: []string{"id", "name"},
+	}
+	row := func(vals ...sqltypes.Value) *querypb.Row {
+		return sqltypes.RowToProto3(vals)
+	}
+	testcases := []struct {
+		change *binlogdatapb.RowChange
+		want   []string
+	}{{
+		change: &binlogdatapb.RowChange{
+			After: row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewVarBinary("aaa")),
+		},
+		want: []string{"t1:1:'a'"},
+	}, {
+		// Update that doesn't change the primary key.
+		change: &binlogdatapb.RowChange{
+			Before: row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewVarBinary("aaa")),
+			After:  row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewVarBinary("bbb")),
+		},
+		want: []string{"t1:1:'a'"},
+	}, {
+		// Row move.
+		change: &binlogdatapb.RowChange{
+			Before: row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a"), sqltypes.NewVarBinary("aaa")),
+			After:  row(sqltypes.NewInt64(2), sqltypes.NewVarChar("a"), sqltypes.NewVarBinary("aaa")),
+		},
+		want: []string{"t1:1:'a'", "t1:2:'a'"},
+	}, {
+		// Text is normalized.
+		change: &binlogdatapb.RowChange{
+			Before: row(sqltypes.NewInt64(1), sqltypes.NewVarChar("A  "), sqltypes.NewVarBinary("aaa")),
+		},
+		want: []string{"t1:1:'a'"},
+	}, {
+		// Nulls and quotes are encoded.
+		change: &binlogdatapb.RowChange{
+			After: row(sqltypes.NULL, sqltypes.NewVarChar("a':'b"), sqltypes.NewVarBinary("aaa")),
+		},
+		want: []string{"t1:null:'a\\':\\'b'"},
+	}}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, rowKeys(tplan, tcase.change))
+	}
+
+	// A table without primary key is a single key.
+	tplan.PKReferences = nil
+	assert.Equal(t, []string{"t1"}, rowKeys(tplan, testcases[0].change))
+}
+
+func TestSetDependencies(t *testing.T) {
+	batch := []*parallelTxn{
+		{keys: []string{"t1:1"}},
+		{keys: []string{"t1:2"}},
+		{keys: []string{"t1:1", "t1:2"}},
+		{keys: []string{"t2"}},
+		{keys: []string{"t1:1", "t1:1", "t2"}},
+	}
+	setDependencies(batch)
+
+	want := [][]int{
+		nil,
+		nil,
+		{0, 1},
+		nil,
+		{2, 3},
+	}
+	for i, txn := range batch {
+		var deps []int
+		for _, dep := range txn.deps {
+			for j := range batch {
+				if batch[j] == dep {
+					deps = append(deps, j)
+				}
+			}
+		}
+		assert.Equal(t, want[i], deps, "deps of transaction %d", i)
+		if i == 0 {
+			assert.Nil(t, txn.prev)
+		} else {
+			assert.Equal(t, batch[i-1], txn.prev, "prev of transaction %d", i)
+		}
+		assert.NotNil(t, txn.done)
+	}
+}
+
+func TestNewParallelApplier(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	vp := &vplayer{
+		vr: &vreplicator{
+			vre: &Engine{
+				dbClientFactory: func() binlogplayer.DBClient { return dbClient },
+			},
+			stats: binlogplayer.NewStats(),
+		},
+	}
+	for i := 0; i < 2; i++ {
+		dbClient.ExpectRequest("set @@session.time_zone = '+00:00'", &sqltypes.Result{}, nil)
+		dbClient.ExpectRequest("set names binary", &sqltypes.Result{}, nil)
+		// Lock waits caused by unique keys must fail fast.
+		dbClient.ExpectRequest("set @@session.innodb_lock_wait_timeout = 1", &sqltypes.Result{}, nil)
+	}
+	pa, err := newParallelApplier(vp, 2)
+	require.NoError(t, err)
+	require.NotNil(t, pa)
+	dbClient.Wait()
+	assert.Len(t, pa.workers, 2)
+	assert.EqualValues(t, 2, vp.vr.stats.ParallelApplyWorkers())
+
+	pa.close()
+	assert.EqualValues(t, 0, vp.vr.stats.ParallelApplyWorkers())
+}
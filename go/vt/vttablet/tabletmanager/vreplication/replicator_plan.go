@@ -41,12 +41,18 @@ import (
 // At that time, buildExecutionPlan is invoked, which will make a copy
 // of the TablePlan from ReplicatorPlan, and fill the rest
 // of the members, leaving the original plan unchanged.
-// The constructor is buildReplicatorPlan in table_plan_builder.go
+// The constructor is buildReplicatorPlan in table_plan_builder.go, or
+// buildSinkReplicatorPlan in sink.go for streams that write to a Sink.
 type ReplicatorPlan struct {
 	VStreamFilter *binlogdatapb.Filter
 	TargetTables  map[string]*TablePlan
 	TablePlans    map[string]*TablePlan
 	PKInfoMap     map[string][]*PrimaryKeyInfo
+	// forSink is set if the changes are written to a Sink
+	// instead of being applied to MySQL.
+	forSink bool
+	// conflicts is set if the stream resolves primary key conflicts.
+	conflicts *conflictResolver
 }
 
 // buildExecution plan uses the field info as input and the partially built
@@ -57,6 +63,12 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		// Unreachable code.
 		return nil, fmt.Errorf("plan not found for %s", fieldEvent.TableName)
 	}
+	// Sinks don't need statements: the fields are all we need.
+	if rp.forSink {
+		tplanv := *prelim
+		tplanv.Fields = fieldEvent.Fields
+		return &tplanv, nil
+	}
 	// If Insert is initialized, then it means that we knew the column
 	// names and have already built most of the plan.
 	if prelim.Insert != nil {
@@ -78,6 +90,7 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		return nil, err
 	}
 	tplan.Fields = fieldEvent.Fields
+	tplan.conflicts = rp.conflicts
 	return tplan, nil
 }
 
@@ -169,10 +182,17 @@ type TablePlan struct {
 	Insert *sqlparser.ParsedQuery
 	Update *sqlparser.ParsedQuery
 	Delete *sqlparser.ParsedQuery
-	Fields []*querypb.Field
+	// Replace overwrites a row that conflicts with an existing one.
+	// It's only built for normal inserts, and is used if the stream
+	// resolves conflicts with the last_writer_wins policy.
+	Replace *sqlparser.ParsedQuery
+	Fields  []*querypb.Field
 	// PKReferences is used to check if an event changed
 	// a primary key column (row move).
 	PKReferences []string
+	// conflicts is set if primary key conflicts must be detected
+	// and resolved instead of failing the stream.
+	conflicts *conflictResolver
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -221,7 +241,11 @@ func (tp *TablePlan) applyBulkInsert(rows *binlogdatapb.VStreamRowsResponse, exe
 	if tp.BulkInsertOnDup != nil {
 		tp.BulkInsertOnDup.Append(&buf, nil, nil)
 	}
-	return executor(buf.String())
+	qr, err := executor(buf.String())
+	if tp.conflicts == nil || !isDupEntry(err) {
+		return qr, err
+	}
+	return tp.applyRowsWithConflicts(rows.Rows, executor)
 }
 
 func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
@@ -244,22 +268,22 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 	}
 	switch {
 	case !before && after:
-		return execParsedQuery(tp.Insert, bindvars, executor)
+		return tp.applyInsert(bindvars, executor)
 	case before && !after:
 		if tp.Delete == nil {
 			return nil, nil
 		}
-		return execParsedQuery(tp.Delete, bindvars, executor)
+		return tp.applyDelete(bindvars, executor)
 	case before && after:
 		if !tp.pkChanged(bindvars) {
-			return execParsedQuery(tp.Update, bindvars, executor)
+			return tp.applyUpdate(bindvars, executor)
 		}
 		if tp.Delete != nil {
-			if _, err := execParsedQuery(tp.Delete, bindvars, executor); err != nil {
+			if _, err := tp.applyDelete(bindvars, executor); err != nil {
 				return nil, err
 			}
 		}
-		return execParsedQuery(tp.Insert, bindvars, executor)
+		return tp.applyInsert(bindvars, executor)
 	}
 	// Unreachable.
 	return nil, nil
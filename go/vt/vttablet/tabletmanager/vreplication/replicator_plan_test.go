@@ -213,6 +213,106 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// Expressions in the where clause are evaluated by the source
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, upper(c2) as c2 from t2 where deleted_at is null and c1 in (1, 2)",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2 from t2 where deleted_at is null and c1 in (1, 2)",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2)",
+					InsertValues: "(:a_c1,upper(:a_c2))",
+					Insert:       "insert into t1(c1,c2) values (:a_c1,upper(:a_c2))",
+					Update:       "update t1 set c2=upper(:a_c2) where c1=:b_c1",
+					Delete:       "delete from t1 where c1=:b_c1",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, pk1, pk2 from t2 where deleted_at is null and c1 in (1, 2)",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2)",
+					InsertValues: "(:a_c1,upper(:a_c2))",
+					Insert:       "insert into t1(c1,c2) select :a_c1, upper(:a_c2) from dual where (:a_pk1,:a_pk2) <= (1,'aaa')",
+					Update:       "update t1 set c2=upper(:a_c2) where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
+	}, {
+		// type conversions and renames are applied by the target
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, cast(doc as json) as c2, concat(first, ' ', last) as c3, json_extract(doc, '$.a') as c4 from t2",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, doc, first, last from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2,c3,c4)",
+					InsertValues: "(:a_c1,convert(convert(:a_doc using utf8mb4), json),concat(:a_first, ' ', :a_last),json_extract(convert(:a_doc using utf8mb4), '$.a'))",
+					Insert:       "insert into t1(c1,c2,c3,c4) values (:a_c1,convert(convert(:a_doc using utf8mb4), json),concat(:a_first, ' ', :a_last),json_extract(convert(:a_doc using utf8mb4), '$.a'))",
+					Update:       "update t1 set c2=convert(convert(:a_doc using utf8mb4), json), c3=concat(:a_first, ' ', :a_last), c4=json_extract(convert(:a_doc using utf8mb4), '$.a') where c1=:b_c1",
+					Delete:       "delete from t1 where c1=:b_c1",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, doc, first, last, pk1, pk2 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2,c3,c4)",
+					InsertValues: "(:a_c1,convert(convert(:a_doc using utf8mb4), json),concat(:a_first, ' ', :a_last),json_extract(convert(:a_doc using utf8mb4), '$.a'))",
+					Insert:       "insert into t1(c1,c2,c3,c4) select :a_c1, convert(convert(:a_doc using utf8mb4), json), concat(:a_first, ' ', :a_last), json_extract(convert(:a_doc using utf8mb4), '$.a') from dual where (:a_pk1,:a_pk2) <= (1,'aaa')",
+					Update:       "update t1 set c2=convert(convert(:a_doc using utf8mb4), json), c3=concat(:a_first, ' ', :a_last), c4=json_extract(convert(:a_doc using utf8mb4), '$.a') where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
 	}, {
 		// partial group by
 		input: &binlogdatapb.Filter{
@@ -601,6 +701,15 @@ func TestBuildPlayerPlan(t *testing.T) {
 			}},
 		},
 		err: "expression needs an alias: hour(c1)",
+	}, {
+		// json operators
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, doc->'$.a' as a from t1",
+			}},
+		},
+		err: "unsupported operator ->, use json_extract or json_unquote instead: doc -> '$.a'",
 	}, {
 		// only count(*)
 		input: &binlogdatapb.Filter{
@@ -0,0 +1,422 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// This file contains the handling of the DDLs of streams that have a
+// schema change policy. The on_schema_change column of _vt.vreplication
+// specifies the policy. If it's empty, DDLs are handled according to the
+// OnDdl setting of the source. With the apply_additive policy, additive
+// changes, like new nullable columns or new indexes, are applied to the
+// target tables that mirror the source tables, and the stream continues
+// with rebuilt table plans. Any other change to a replicated table stops
+// the stream, to let the operator change the target accordingly. Every
+// decision is recorded in _vt.vreplication_log along with the DDL.
+
+// SchemaChangeApplyAdditive is the policy that applies additive
+// changes and stops the stream on any other change.
+const SchemaChangeApplyAdditive = "apply_additive"
+
+// The decisions recorded for a schema change.
+const (
+	schemaChangeApplied = "Applied"
+	schemaChangeIgnored = "Ignored"
+	schemaChangeStopped = "Stopped"
+)
+
+// logTypeSchemaChange is the type of the _vt.vreplication_log
+// records of schema changes.
+const logTypeSchemaChange = "SchemaChange"
+
+const createVReplicationLogTable = `create table if not exists _vt.vreplication_log (
+  id bigint auto_increment,
+  vrepl_id int,
+  type varbinary(64),
+  state varbinary(64),
+  message blob,
+  time_created bigint,
+  primary key (id),
+  key vrepl_id (vrepl_id))`
+
+// ValidateSchemaChangePolicy returns an error if policy is not a valid
+// value for the on_schema_change column of _vt.vreplication.
+func ValidateSchemaChangePolicy(policy string) error {
+	switch policy {
+	case "", SchemaChangeApplyAdditive:
+		return nil
+	}
+	return fmt.Errorf("invalid on_schema_change policy %q, must be %s", policy, SchemaChangeApplyAdditive)
+}
+
+// schemaChange is the analysis of a DDL.
+type schemaChange struct {
+	// tables are the tables changed by the DDL.
+	tables []string
+	// unknown is set if the DDL could not be analyzed.
+	// It may then affect any table.
+	unknown bool
+	// additive is set if the DDL only adds nullable
+	// columns or non-unique indexes.
+	additive bool
+	// reason explains why the DDL is not additive.
+	reason string
+}
+
+// analyzeSchemaChange analyzes the DDL statement sent by a source.
+func analyzeSchemaChange(statement string) *schemaChange {
+	stmt, err := sqlparser.Parse(statement)
+	if err != nil {
+		return &schemaChange{unknown: true, reason: fmt.Sprintf("cannot parse DDL: %v", err)}
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok {
+		// Database DDLs don't change tables.
+		return &schemaChange{reason: "not a table DDL"}
+	}
+	sc := &schemaChange{}
+	switch ddl.Action {
+	case sqlparser.AlterDDLAction:
+		sc.tables = []string{ddl.Table.Name.String()}
+		sc.additive, sc.reason = analyzeAlter(statement)
+	case sqlparser.CreateDDLAction:
+		sc.tables = []string{ddl.Table.Name.String()}
+		sc.reason = "table is created"
+	case sqlparser.TruncateDDLAction:
+		sc.tables = []string{ddl.Table.Name.String()}
+		sc.reason = "table is truncated"
+	case sqlparser.DropDDLAction:
+		for _, table := range ddl.FromTables {
+			sc.tables = append(sc.tables, table.Name.String())
+		}
+		sc.reason = "table is dropped"
+	case sqlparser.RenameDDLAction:
+		for _, table := range ddl.FromTables {
+			sc.tables = append(sc.tables, table.Name.String())
+		}
+		for _, table := range ddl.ToTables {
+			sc.tables = append(sc.tables, table.Name.String())
+		}
+		sc.reason = "table is renamed"
+	default:
+		sc.reason = fmt.Sprintf("%s is not a table DDL", ddl.Action.ToString())
+	}
+	return sc
+}
+
+// ddlToken is a token of a DDL.
+type ddlToken struct {
+	typ int
+	val string
+	// end is the offset of the end of the token in the DDL.
+	end int
+}
+
+// is returns true if the token is one of the words.
+// String literals never match.
+func (tok ddlToken) is(words ...string) bool {
+	if tok.typ == sqlparser.STRING {
+		return false
+	}
+	for _, word := range words {
+		if strings.EqualFold(tok.val, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func tokenizeDDL(statement string) ([]ddlToken, error) {
+	var tokens []ddlToken
+	tkn := sqlparser.NewStringTokenizer(statement)
+	for {
+		typ, val := tkn.Scan()
+		switch typ {
+		case 0:
+			return tokens, nil
+		case sqlparser.LEX_ERROR:
+			return nil, fmt.Errorf("cannot tokenize DDL: %s", statement)
+		}
+		if val == nil {
+			// Punctuation is returned as the type.
+			val = []byte{byte(typ)}
+		}
+		// The tokenizer is one character ahead of the token.
+		tokens = append(tokens, ddlToken{typ: typ, val: string(val), end: tkn.Position - 1})
+	}
+}
+
+// analyzeAlter returns true if the ALTER TABLE or CREATE INDEX statement
+// is additive. If not, the reason is returned.
+func analyzeAlter(statement string) (bool, string) {
+	tokens, err := tokenizeDDL(statement)
+	if err != nil {
+		return false, err.Error()
+	}
+	if len(tokens) > 0 && tokens[0].is("create") {
+		for _, tok := range tokens[1:] {
+			switch {
+			case tok.is("unique"):
+				return false, "unique index is added"
+			case tok.is("index"):
+				return true, ""
+			}
+		}
+		return false, "cannot analyze CREATE statement"
+	}
+
+	// Skip ALTER [ONLINE] [IGNORE] TABLE [db.]name.
+	i := 0
+	for i < len(tokens) && !tokens[i].is("table") {
+		i++
+	}
+	i += 2
+	if i < len(tokens) && tokens[i].typ == '.' {
+		i += 2
+	}
+	if i >= len(tokens) {
+		return false, "cannot analyze ALTER statement"
+	}
+
+	// The specifications of the ALTER are separated by commas
+	// that are not within parenthesis.
+	var clause []ddlToken
+	depth := 0
+	for _, tok := range append(tokens[i:], ddlToken{typ: ',', val: ","}) {
+		switch {
+		case tok.typ == '(':
+			depth++
+		case tok.typ == ')':
+			depth--
+		case tok.typ == ',' && depth == 0:
+			if additive, reason := analyzeAlterClause(clause); !additive {
+				return false, reason
+			}
+			clause = nil
+			continue
+		}
+		clause = append(clause, tok)
+	}
+	return true, ""
+}
+
+// analyzeAlterClause returns true if the specification of an
+// ALTER TABLE is additive. If not, the reason is returned.
+func analyzeAlterClause(clause []ddlToken) (bool, string) {
+	if len(clause) == 0 {
+		return true, ""
+	}
+	vals := make([]string, 0, len(clause))
+	for _, tok := range clause {
+		vals = append(vals, tok.val)
+	}
+	notAdditive := fmt.Sprintf("%s is not an additive change", strings.Join(vals, " "))
+
+	switch {
+	case clause[0].is("algorithm", "lock"):
+		// Options that don't change the table.
+		return true, ""
+	case !clause[0].is("add") || len(clause) < 2:
+		return false, notAdditive
+	}
+	switch {
+	case clause[1].is("index", "key", "fulltext", "spatial"):
+		return true, ""
+	case clause[1].is("column"):
+		clause = clause[2:]
+	case clause[1].typ == '(' || clause[1].is("unique", "primary", "constraint", "foreign", "partition", "check"):
+		return false, notAdditive
+	default:
+		clause = clause[1:]
+	}
+
+	// A column definition: name, type and attributes.
+	if len(clause) < 2 {
+		return false, notAdditive
+	}
+	notNull, hasDefault := false, false
+	for i, tok := range clause[2:] {
+		switch {
+		case tok.is("primary", "unique", "key", "auto_increment", "as", "generated", "references"):
+			return false, notAdditive
+		case tok.is("not") && i+3 < len(clause) && clause[i+3].is("null"):
+			notNull = true
+		case tok.is("default"):
+			hasDefault = true
+		}
+	}
+	if notNull && !hasDefault {
+		return false, fmt.Sprintf("column %s is not nullable and has no default", clause[0].val)
+	}
+	return true, ""
+}
+
+// targetDDL returns the additive DDL to apply to the target. The table
+// of the source DDL may be qualified with the source database, which is
+// removed for the DDL to apply to the table of the same name in the
+// target database.
+func targetDDL(statement string) (string, error) {
+	tokens, err := tokenizeDDL(statement)
+	if err != nil {
+		return "", err
+	}
+	// The table follows ALTER [ONLINE] [IGNORE] TABLE,
+	// or CREATE [UNIQUE] INDEX name ON.
+	keyword := "table"
+	if len(tokens) > 0 && tokens[0].is("create") {
+		keyword = "on"
+	}
+	for i, tok := range tokens {
+		if !tok.is(keyword) {
+			continue
+		}
+		if i+3 < len(tokens) && tokens[i+2].typ == '.' {
+			return statement[:tok.end] + " " + strings.TrimLeft(statement[tokens[i+2].end:], " \t\n"), nil
+		}
+		return statement, nil
+	}
+	return "", fmt.Errorf("cannot find the table of DDL: %s", statement)
+}
+
+// setSchemaChangePolicy makes the vreplicator handle DDLs
+// with the specified policy.
+func (vr *vreplicator) setSchemaChangePolicy(policy string) {
+	vr.schemaChangePolicy = policy
+}
+
+// applySchemaChange handles a DDL according to the schema change policy.
+func (vp *vplayer) applySchemaChange(ctx context.Context, event *binlogdatapb.VEvent) error {
+	sc := analyzeSchemaChange(event.Statement)
+	var tplans []*TablePlan
+	for _, table := range sc.tables {
+		if tplan, ok := vp.replicatorPlan.TablePlans[table]; ok {
+			tplans = append(tplans, tplan)
+		}
+	}
+	if !sc.unknown && len(tplans) == 0 {
+		// The DDL doesn't change the replicated tables.
+		posReached, err := vp.updatePos(event.Timestamp)
+		if err != nil {
+			return err
+		}
+		if posReached {
+			return io.EOF
+		}
+		return nil
+	}
+
+	state, reason := schemaChangeStopped, sc.reason
+	switch {
+	case !sc.additive:
+	case !mirrorsSource(tplans, sc.tables):
+		// The new columns or indexes are irrelevant to the target
+		// tables. The table plans are rebuilt from the next field event.
+		state, reason = schemaChangeIgnored, "target tables don't mirror the source tables"
+	default:
+		ddl, err := targetDDL(event.Statement)
+		if err == nil {
+			err = vp.applyDDL(ctx, ddl)
+		}
+		if err != nil {
+			reason = fmt.Sprintf("failed to apply DDL: %v", err)
+			break
+		}
+		state, reason = schemaChangeApplied, ""
+		if err := vp.replan(ctx); err != nil {
+			return err
+		}
+	}
+	log.Infof("VReplication player id: %v: schema change %s: %s %s", vp.vr.id, state, event.Statement, reason)
+
+	if err := vp.vr.dbClient.Begin(); err != nil {
+		return err
+	}
+	if err := vp.vr.recordSchemaChange(state, event.Statement, reason); err != nil {
+		return err
+	}
+	posReached, err := vp.updatePos(event.Timestamp)
+	if err != nil {
+		return err
+	}
+	if state == schemaChangeStopped {
+		if err := vp.vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stopped at DDL %s: %s", event.Statement, reason)); err != nil {
+			return err
+		}
+	}
+	if err := vp.vr.dbClient.Commit(); err != nil {
+		return err
+	}
+	if state == schemaChangeStopped || posReached {
+		return io.EOF
+	}
+	return nil
+}
+
+// mirrorsSource returns true if all the tables are replicated
+// into target tables of the same name that have all their columns.
+func mirrorsSource(tplans []*TablePlan, tables []string) bool {
+	if len(tplans) != len(tables) {
+		return false
+	}
+	for i, tplan := range tplans {
+		// Plans of 'select *' filters are only built from the fields.
+		if tplan.TargetName != tables[i] || tplan.Insert != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// replan rebuilds the plans of the player after the target schema changed.
+func (vp *vplayer) replan(ctx context.Context) error {
+	pkInfo, err := vp.vr.buildPkInfoMap(ctx)
+	if err != nil {
+		return err
+	}
+	vp.vr.pkInfoMap = pkInfo
+	plan, err := vp.vr.buildReplicatorPlan(vp.copyState)
+	if err != nil {
+		return err
+	}
+	vp.replicatorPlan = plan
+	vp.tablePlans = make(map[string]*TablePlan)
+	return nil
+}
+
+// recordSchemaChange records the decision taken for a DDL.
+func (vr *vreplicator) recordSchemaChange(state, statement, reason string) error {
+	message := statement
+	if reason != "" {
+		message = fmt.Sprintf("%s: %s", reason, statement)
+	}
+	query := fmt.Sprintf("insert into _vt.vreplication_log(vrepl_id, type, state, message, time_created) values (%d, %s, %s, %s, %d)",
+		vr.id, encodeString(logTypeSchemaChange), encodeString(state), encodeString(message), time.Now().Unix())
+	_, err := vr.dbClient.Execute(query)
+	return err
+}
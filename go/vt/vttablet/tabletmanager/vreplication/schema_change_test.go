@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestAnalyzeSchemaChange(t *testing.T) {
+	testcases := []struct {
+		statement string
+		tables    []string
+		additive  bool
+		reason    string
+	}{{
+		statement: "alter table t1 add column c1 int",
+		tables:    []string{"t1"},
+		additive:  true,
+	}, {
+		statement: "alter table db.t1 add c1 varchar(10) default 'a' not null, add c2 int null",
+		tables:    []string{"t1"},
+		additive:  true,
+	}, {
+		statement: "alter table `t1` add index c1(c1, c2), add key (c3), algorithm=inplace, lock=none",
+		tables:    []string{"t1"},
+		additive:  true,
+	}, {
+		statement: "create index c1 on t1(c1)",
+		tables:    []string{"t1"},
+		additive:  true,
+	}, {
+		statement: "alter table t1 add column c1 int comment 'not null'",
+		tables:    []string{"t1"},
+		additive:  true,
+	}, {
+		statement: "alter table t1 add column c1 int not null",
+		tables:    []string{"t1"},
+		reason:    "column c1 is not nullable and has no default",
+	}, {
+		statement: "alter table t1 add column c1 int, drop column c2",
+		tables:    []string{"t1"},
+		reason:    "drop column c2 is not an additive change",
+	}, {
+		statement: "alter table t1 modify c1 bigint",
+		tables:    []string{"t1"},
+		reason:    "modify c1 bigint is not an additive change",
+	}, {
+		statement: "alter table t1 add unique key (c1)",
+		tables:    []string{"t1"},
+		reason:    "add unique key ( c1 ) is not an additive change",
+	}, {
+		statement: "alter table t1 add column c1 int auto_increment",
+		tables:    []string{"t1"},
+		reason:    "add column c1 int auto_increment is not an additive change",
+	}, {
+		statement: "create unique index c1 on t1(c1)",
+		tables:    []string{"t1"},
+		reason:    "unique index is added",
+	}, {
+		statement: "create table t1(id int)",
+		tables:    []string{"t1"},
+		reason:    "table is created",
+	}, {
+		statement: "drop table t1, t2",
+		tables:    []string{"t1", "t2"},
+		reason:    "table is dropped",
+	}, {
+		statement: "rename table t1 to t2",
+		tables:    []string{"t1", "t2"},
+		reason:    "table is renamed",
+	}, {
+		statement: "truncate table t1",
+		tables:    []string{"t1"},
+		reason:    "table is truncated",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.statement, func(t *testing.T) {
+			sc := analyzeSchemaChange(tcase.statement)
+			assert.False(t, sc.unknown)
+			assert.Equal(t, tcase.tables, sc.tables)
+			assert.Equal(t, tcase.additive, sc.additive)
+			assert.Equal(t, tcase.reason, sc.reason)
+		})
+	}
+
+	sc := analyzeSchemaChange("alter tabel t1 add column c1 int")
+	assert.True(t, sc.unknown)
+	assert.False(t, sc.additive)
+}
+
+func TestTargetDDL(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{{
+		in:  "alter table t1 add column c1 int",
+		out: "alter table t1 add column c1 int",
+	}, {
+		in:  "alter table vt_source.t1 add column c1 int",
+		out: "alter table t1 add column c1 int",
+	}, {
+		in:  "ALTER ONLINE TABLE `vt_source` . `t1` ADD INDEX c1 (c1)",
+		out: "ALTER ONLINE TABLE `t1` ADD INDEX c1 (c1)",
+	}, {
+		in:  "create index c1 on vt_source.t1(c1)",
+		out: "create index c1 on t1(c1)",
+	}, {
+		in:  "create index c1 on t1(c1)",
+		out: "create index c1 on t1(c1)",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			out, err := targetDDL(tcase.in)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, out)
+		})
+	}
+
+	_, err := targetDDL("alter")
+	assert.EqualError(t, err, "cannot find the table of DDL: alter")
+}
+
+func TestMirrorsSource(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "t1",
+		}, {
+			Match:  "t2",
+			Filter: "select id, val from t2",
+		}, {
+			Match:  "t3",
+			Filter: "select * from src",
+		}},
+	}
+	pkInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {&PrimaryKeyInfo{Name: "id"}},
+		"t2": {&PrimaryKeyInfo{Name: "id"}},
+		"t3": {&PrimaryKeyInfo{Name: "id"}},
+	}
+	plan, err := buildReplicatorPlan(filter, pkInfos, nil)
+	require.NoError(t, err)
+	tplans := plan.TablePlans
+
+	assert.True(t, mirrorsSource([]*TablePlan{tplans["t1"]}, []string{"t1"}))
+	// Only some columns are replicated.
+	assert.False(t, mirrorsSource([]*TablePlan{tplans["t2"]}, []string{"t2"}))
+	// The target table has a different name.
+	assert.False(t, mirrorsSource([]*TablePlan{tplans["src"]}, []string{"src"}))
+	// One of the tables is not replicated.
+	assert.False(t, mirrorsSource([]*TablePlan{tplans["t1"]}, []string{"t1", "t4"}))
+}
+
+func TestValidateSchemaChangePolicy(t *testing.T) {
+	assert.NoError(t, ValidateSchemaChangePolicy(""))
+	assert.NoError(t, ValidateSchemaChangePolicy(SchemaChangeApplyAdditive))
+	assert.EqualError(t, ValidateSchemaChangePolicy("apply_all"), `invalid on_schema_change policy "apply_all", must be apply_additive`)
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Sink is a target of vreplication that is not a MySQL database.
+// A stream with a sink still goes through the copy, catchup and replay
+// phases, and its position and copy state are still tracked in the _vt
+// tables of the tablet. Instead of being applied to the database, the
+// changes are written to the sink.
+// Changes are written in transaction order. Flush is called before the
+// position of the written changes is committed: once Flush returns, the
+// changes must be durable. If the stream fails, the changes that were
+// not flushed are discarded, and the stream resumes from the last
+// committed position. Consequently, a change can be delivered more than
+// once, but is never lost.
+type Sink interface {
+	// Write buffers changes.
+	Write(changes []*SinkChange) error
+	// Flush makes the changes written so far durable.
+	Flush() error
+	// Close releases the resources of the sink.
+	// Changes that were not flushed are discarded.
+	Close() error
+}
+
+// SinkOp is the type of a SinkChange.
+type SinkOp string
+
+// The operations of a SinkChange. Rows copied from the source are inserts.
+const (
+	SinkInsert = SinkOp("insert")
+	SinkUpdate = SinkOp("update")
+	SinkDelete = SinkOp("delete")
+	SinkDDL    = SinkOp("ddl")
+)
+
+// SinkChange is a change written to a sink.
+type SinkChange struct {
+	Op SinkOp
+	// Table is the name of the target table: the Match of the filter rule.
+	Table string
+	// Position is the source position of the change. It's empty for rows
+	// sent during the copy phase.
+	Position string
+	// Fields describes the values of Before and After.
+	Fields []*querypb.Field
+	// Before is the row before an update or delete.
+	Before []sqltypes.Value
+	// After is the row after an insert or update.
+	After []sqltypes.Value
+	// Statement is the statement of a DDL.
+	Statement string
+}
+
+// MarshalJSON encodes the change as a JSON object. Rows are encoded as
+// objects with one member per column, in the order of the fields.
+// Numbers are encoded as JSON numbers, NULLs as null, and all other
+// values as strings. JSON strings can't hold arbitrary bytes, so the
+// values of BINARY, VARBINARY, BLOB and BIT columns are encoded in
+// standard base64.
+func (sc *SinkChange) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"op":`)
+	writeJSONString(buf, string(sc.Op))
+	if sc.Table != "" {
+		buf.WriteString(`,"table":`)
+		writeJSONString(buf, sc.Table)
+	}
+	if sc.Position != "" {
+		buf.WriteString(`,"position":`)
+		writeJSONString(buf, sc.Position)
+	}
+	if sc.Before != nil {
+		buf.WriteString(`,"before":`)
+		sc.writeJSONRow(buf, sc.Before)
+	}
+	if sc.After != nil {
+		buf.WriteString(`,"after":`)
+		sc.writeJSONRow(buf, sc.After)
+	}
+	if sc.Statement != "" {
+		buf.WriteString(`,"statement":`)
+		writeJSONString(buf, sc.Statement)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (sc *SinkChange) writeJSONRow(buf *bytes.Buffer, row []sqltypes.Value) {
+	buf.WriteByte('{')
+	for i, val := range row {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeJSONString(buf, sc.Fields[i].Name)
+		buf.WriteByte(':')
+		switch {
+		case val.IsNull():
+			buf.WriteString("null")
+		case val.IsIntegral() || val.IsFloat() || val.Type() == sqltypes.Decimal:
+			buf.Write(val.ToBytes())
+		case val.IsBinary() || val.Type() == sqltypes.Bit:
+			writeJSONString(buf, base64.StdEncoding.EncodeToString(val.ToBytes()))
+		default:
+			writeJSONString(buf, val.ToString())
+		}
+	}
+	buf.WriteByte('}')
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	b, _ := json.Marshal(s)
+	buf.Write(b)
+}
+
+// row returns the image that represents the change in formats that
+// have a single row per change: the after image, or the before
+// image for deletes.
+func (sc *SinkChange) row() []sqltypes.Value {
+	if sc.After != nil {
+		return sc.After
+	}
+	return sc.Before
+}
+
+// SinkFactory creates a Sink from its URL.
+type SinkFactory func(u *url.URL) (Sink, error)
+
+var (
+	sinkFactoriesMu sync.Mutex
+	sinkFactories   = make(map[string]SinkFactory)
+)
+
+// RegisterSinkFactory registers the Sink implementation for a URL scheme.
+// The sink column of _vt.vreplication holds the URL of the sink of a stream.
+func RegisterSinkFactory(scheme string, factory SinkFactory) {
+	sinkFactoriesMu.Lock()
+	defer sinkFactoriesMu.Unlock()
+	if _, ok := sinkFactories[scheme]; ok {
+		panic(fmt.Sprintf("sink factory %s is already registered", scheme))
+	}
+	sinkFactories[scheme] = factory
+}
+
+// newSink creates the Sink for the URL in spec.
+func newSink(spec string) (Sink, error) {
+	u, factory, err := lookupSink(spec)
+	if err != nil {
+		return nil, err
+	}
+	return factory(u)
+}
+
+// ValidateSink returns an error if spec is not the URL of a registered
+// sink type. It does not open the sink: sinks are opened by the target
+// tablets when the stream starts.
+func ValidateSink(spec string) error {
+	_, _, err := lookupSink(spec)
+	return err
+}
+
+func lookupSink(spec string) (*url.URL, SinkFactory, error) {
+	u, err := url.Parse(spec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid sink %s: %v", spec, err)
+	}
+	sinkFactoriesMu.Lock()
+	factory, ok := sinkFactories[u.Scheme]
+	sinkFactoriesMu.Unlock()
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported sink type: %s", u.Scheme)
+	}
+	return u, factory, nil
+}
+
+// sinkIntParam returns the integer value of a query parameter of a sink URL.
+func sinkIntParam(u *url.URL, name string, def int) (int, error) {
+	v := u.Query().Get(name)
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid %s for %s sink: %s", name, u.Scheme, v)
+	}
+	return n, nil
+}
+
+// buildSinkReplicatorPlan builds a ReplicatorPlan for a stream that writes
+// to a sink. There is no target schema to match the filter against. So,
+// every rule must name a source table, and the rule filter is sent to the
+// source as is: the source computes the values written to the sink.
+// The rules of the plan have the same meaning as in buildReplicatorPlan,
+// except that aggregations are not supported.
+// The plan is only partially built until the fields are known, like for
+// a "select *" with a MySQL target.
+func buildSinkReplicatorPlan(filter *binlogdatapb.Filter, copyState map[string]*sqltypes.Result) (*ReplicatorPlan, error) {
+	plan := &ReplicatorPlan{
+		VStreamFilter: &binlogdatapb.Filter{FieldEventMode: filter.FieldEventMode},
+		TargetTables:  make(map[string]*TablePlan),
+		TablePlans:    make(map[string]*TablePlan),
+		forSink:       true,
+	}
+	for _, rule := range filter.Rules {
+		if strings.HasPrefix(rule.Match, "/") {
+			return nil, fmt.Errorf("table name patterns are not supported for sinks: %s", rule.Match)
+		}
+		lastpk, ok := copyState[rule.Match]
+		if ok && lastpk == nil {
+			// Don't replicate uncopied tables.
+			continue
+		}
+		query := rule.Filter
+		switch {
+		case rule.Filter == ExcludeStr:
+			continue
+		case rule.Filter == "":
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(rule.Match))
+			query = buf.String()
+		case key.IsKeyRange(rule.Filter):
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(rule.Match), sqlparser.NewStrLiteral([]byte(rule.Filter)))
+			query = buf.String()
+		}
+		sel, fromTable, err := analyzeSelectFrom(query)
+		if err != nil {
+			return nil, err
+		}
+		if len(sel.GroupBy) != 0 {
+			return nil, fmt.Errorf("group by is not supported for sinks: %v", sqlparser.String(sel))
+		}
+		tablePlan := &TablePlan{
+			TargetName: rule.Match,
+			SendRule: &binlogdatapb.Rule{
+				Match:  fromTable,
+				Filter: query,
+			},
+			Lastpk: lastpk,
+		}
+		if dup, ok := plan.TablePlans[fromTable]; ok {
+			return nil, fmt.Errorf("more than one target for source table %s: %s and %s", fromTable, dup.TargetName, rule.Match)
+		}
+		plan.VStreamFilter.Rules = append(plan.VStreamFilter.Rules, tablePlan.SendRule)
+		plan.TargetTables[rule.Match] = tablePlan
+		plan.TablePlans[fromTable] = tablePlan
+	}
+	return plan, nil
+}
+
+// sinkChange converts a row change into the change written to a sink.
+// Like the statements generated for MySQL targets, the images of rows
+// that are past the lastpk of a table being copied are dropped.
+// It returns nil if there's nothing to write.
+func (tp *TablePlan) sinkChange(rowChange *binlogdatapb.RowChange, position string) (*SinkChange, error) {
+	change := &SinkChange{
+		Table:    tp.TargetName,
+		Position: position,
+		Fields:   tp.Fields,
+	}
+	if rowChange.Before != nil {
+		before := sqltypes.MakeRowTrusted(tp.Fields, rowChange.Before)
+		copied, err := tp.isCopied(before)
+		if err != nil {
+			return nil, err
+		}
+		if copied {
+			change.Before = before
+		}
+	}
+	if rowChange.After != nil {
+		after := sqltypes.MakeRowTrusted(tp.Fields, rowChange.After)
+		copied, err := tp.isCopied(after)
+		if err != nil {
+			return nil, err
+		}
+		if copied {
+			change.After = after
+		}
+	}
+	switch {
+	case change.Before == nil && change.After == nil:
+		return nil, nil
+	case change.Before == nil:
+		change.Op = SinkInsert
+	case change.After == nil:
+		change.Op = SinkDelete
+	default:
+		change.Op = SinkUpdate
+	}
+	return change, nil
+}
+
+// isCopied returns true if the row is at or before the lastpk of the table.
+func (tp *TablePlan) isCopied(row []sqltypes.Value) (bool, error) {
+	if tp.Lastpk == nil || len(tp.Lastpk.Rows) == 0 {
+		return true, nil
+	}
+	for i, pkField := range tp.Lastpk.Fields {
+		col := -1
+		for j, field := range tp.Fields {
+			if strings.EqualFold(field.Name, pkField.Name) {
+				col = j
+				break
+			}
+		}
+		if col == -1 {
+			return false, fmt.Errorf("primary key column %s of %s must be selected to replicate to a sink", pkField.Name, tp.TargetName)
+		}
+		cmp, err := compareSinkValues(row[col], tp.Lastpk.Rows[0][i])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return cmp < 0, nil
+		}
+	}
+	return true, nil
+}
+
+// compareSinkValues compares numbers numerically and other values
+// byte by byte, like the binary comparisons of the generated statements.
+func compareSinkValues(v1, v2 sqltypes.Value) (int, error) {
+	if v1.IsNull() || v2.IsNull() || sqltypes.IsNumber(v1.Type()) || sqltypes.IsNumber(v2.Type()) {
+		return evalengine.NullsafeCompare(v1, v2)
+	}
+	return bytes.Compare(v1.ToBytes(), v2.ToBytes()), nil
+}
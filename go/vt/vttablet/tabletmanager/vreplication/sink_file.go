@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+func init() {
+	RegisterSinkFactory("file", newFileSink)
+}
+
+// fileSink appends changes to a local file, one change per line.
+// The URL is file:///path/to/file?format=json|csv.
+// The json format writes one JSON object per change, as encoded by
+// SinkChange.MarshalJSON. The csv format writes the operation, the
+// table name, and the values of the row. For updates, only the after
+// image is written. For DDLs, the statement is written instead of values.
+// A file should be written by a single stream.
+type fileSink struct {
+	file   *os.File
+	format string
+	buf    bytes.Buffer
+}
+
+func newFileSink(u *url.URL) (Sink, error) {
+	if u.Path == "" {
+		return nil, fmt.Errorf("missing path for file sink: %s", u.String())
+	}
+	format := u.Query().Get("format")
+	switch format {
+	case "":
+		format = "json"
+	case "json", "csv":
+	default:
+		return nil, fmt.Errorf("unsupported format for file sink: %s", format)
+	}
+	file, err := os.OpenFile(u.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{
+		file:   file,
+		format: format,
+	}, nil
+}
+
+// Write implements the Sink interface.
+func (fs *fileSink) Write(changes []*SinkChange) error {
+	if fs.format == "json" {
+		for _, change := range changes {
+			b, err := json.Marshal(change)
+			if err != nil {
+				return err
+			}
+			fs.buf.Write(b)
+			fs.buf.WriteByte('\n')
+		}
+		return nil
+	}
+	w := csv.NewWriter(&fs.buf)
+	for _, change := range changes {
+		record := []string{string(change.Op), change.Table}
+		if change.Op == SinkDDL {
+			record = append(record, change.Statement)
+		}
+		for _, val := range change.row() {
+			record = append(record, val.ToString())
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// Flush implements the Sink interface.
+func (fs *fileSink) Flush() error {
+	if fs.buf.Len() == 0 {
+		return nil
+	}
+	if _, err := fs.file.Write(fs.buf.Bytes()); err != nil {
+		return err
+	}
+	fs.buf.Reset()
+	return fs.file.Sync()
+}
+
+// Close implements the Sink interface.
+func (fs *fileSink) Close() error {
+	fs.buf.Reset()
+	return fs.file.Close()
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+var httpSinkTimeout = flag.Duration("vreplication_http_sink_timeout", 30*time.Second, "timeout of the requests sent to http sinks by vreplication")
+
+func init() {
+	RegisterSinkFactory("http", newHTTPSink)
+	RegisterSinkFactory("https", newHTTPSink)
+}
+
+// httpSink posts changes to a webhook. The URL is the URL of the webhook,
+// with an optional batch_size parameter, which is not sent to the webhook.
+// Changes are posted in batches of up to batch_size changes (100 by default).
+// The body of a request is newline-delimited JSON, with one change
+// per line, as encoded by SinkChange.MarshalJSON. Any response status other
+// than 2xx is an error, in which case the stream is retried from its last
+// committed position.
+type httpSink struct {
+	url       string
+	batchSize int
+	client    *http.Client
+	pending   []*SinkChange
+}
+
+func newHTTPSink(u *url.URL) (Sink, error) {
+	batchSize, err := sinkIntParam(u, "batch_size", 100)
+	if err != nil {
+		return nil, err
+	}
+	target := *u
+	query := target.Query()
+	query.Del("batch_size")
+	target.RawQuery = query.Encode()
+	return &httpSink{
+		url:       target.String(),
+		batchSize: batchSize,
+		client:    &http.Client{Timeout: *httpSinkTimeout},
+	}, nil
+}
+
+// Write implements the Sink interface.
+func (hs *httpSink) Write(changes []*SinkChange) error {
+	hs.pending = append(hs.pending, changes...)
+	return nil
+}
+
+// Flush implements the Sink interface.
+func (hs *httpSink) Flush() error {
+	for len(hs.pending) > 0 {
+		n := hs.batchSize
+		if n > len(hs.pending) {
+			n = len(hs.pending)
+		}
+		if err := hs.post(hs.pending[:n]); err != nil {
+			return err
+		}
+		hs.pending = hs.pending[n:]
+	}
+	hs.pending = nil
+	return nil
+}
+
+func (hs *httpSink) post(changes []*SinkChange) error {
+	var body bytes.Buffer
+	for _, change := range changes {
+		b, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+		body.Write(b)
+		body.WriteByte('\n')
+	}
+	resp, err := hs.client.Post(hs.url, "application/x-ndjson", &body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain the body to allow the connection to be reused.
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("http sink returned status %s", resp.Status)
+	}
+	return nil
+}
+
+// Close implements the Sink interface.
+func (hs *httpSink) Close() error {
+	hs.pending = nil
+	hs.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var sinkTestFields = sqltypes.MakeTestFields("id|name|price", "int64|varchar|float64")
+
+func sinkTestChanges() []*SinkChange {
+	return []*SinkChange{{
+		Op:     SinkInsert,
+		Table:  "t1",
+		Fields: sinkTestFields,
+		After:  sqltypes.MakeTestResult(sinkTestFields, "1|a\"b|1.5").Rows[0],
+	}, {
+		Op:       SinkUpdate,
+		Table:    "t1",
+		Position: "MySQL56/uuid:1-2",
+		Fields:   sinkTestFields,
+		Before:   sqltypes.MakeTestResult(sinkTestFields, "1|a\"b|1.5").Rows[0],
+		After:    sqltypes.MakeTestResult(sinkTestFields, "1|c|null").Rows[0],
+	}, {
+		Op:       SinkDelete,
+		Table:    "t1",
+		Position: "MySQL56/uuid:1-3",
+		Fields:   sinkTestFields,
+		Before:   sqltypes.MakeTestResult(sinkTestFields, "1|c|null").Rows[0],
+	}, {
+		Op:        SinkDDL,
+		Position:  "MySQL56/uuid:1-4",
+		Statement: "alter table t1 add column x int",
+	}}
+}
+
+var sinkTestJSON = []string{
+	`{"op":"insert","table":"t1","after":{"id":1,"name":"a\"b","price":1.5}}`,
+	`{"op":"update","table":"t1","position":"MySQL56/uuid:1-2","before":{"id":1,"name":"a\"b","price":1.5},"after":{"id":1,"name":"c","price":null}}`,
+	`{"op":"delete","table":"t1","position":"MySQL56/uuid:1-3","before":{"id":1,"name":"c","price":null}}`,
+	`{"op":"ddl","position":"MySQL56/uuid:1-4","statement":"alter table t1 add column x int"}`,
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	testcases := []struct {
+		format string
+		want   string
+	}{{
+		format: "json",
+		want:   strings.Join(sinkTestJSON, "\n") + "\n",
+	}, {
+		format: "csv",
+		want: "insert,t1,1,\"a\"\"b\",1.5\n" +
+			"update,t1,1,c,\n" +
+			"delete,t1,1,c,\n" +
+			"ddl,,alter table t1 add column x int\n",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.format, func(t *testing.T) {
+			filename := path.Join(dir, "out."+tcase.format)
+			sink, err := newSink("file://" + filename + "?format=" + tcase.format)
+			require.NoError(t, err)
+
+			require.NoError(t, sink.Write(sinkTestChanges()))
+			got, err := ioutil.ReadFile(filename)
+			require.NoError(t, err)
+			assert.Empty(t, string(got), "changes must not be written before a flush")
+
+			require.NoError(t, sink.Flush())
+			got, err = ioutil.ReadFile(filename)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, string(got))
+
+			// Unflushed changes are discarded on close.
+			require.NoError(t, sink.Write(sinkTestChanges()))
+			require.NoError(t, sink.Close())
+			got, err = ioutil.ReadFile(filename)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, string(got))
+		})
+	}
+
+	_, err = newSink("file://" + path.Join(dir, "out") + "?format=xml")
+	assert.EqualError(t, err, "unsupported format for file sink: xml")
+}
+
+func TestSinkChangeMarshalJSONBinary(t *testing.T) {
+	blob := []byte{0x00, 0xff, 0xfe, 'a', 0x80}
+	change := &SinkChange{
+		Op:     SinkInsert,
+		Table:  "t1",
+		Fields: sqltypes.MakeTestFields("id|data|hash|flags|name", "int64|blob|varbinary|bit|varchar"),
+		After: []sqltypes.Value{
+			sqltypes.NewInt64(1),
+			sqltypes.MakeTrusted(sqltypes.Blob, blob),
+			sqltypes.MakeTrusted(sqltypes.VarBinary, []byte("\xc3\x28")),
+			sqltypes.MakeTrusted(sqltypes.Bit, []byte{0x05}),
+			sqltypes.NewVarChar("é"),
+		},
+	}
+	got, err := json.Marshal(change)
+	require.NoError(t, err)
+	assert.Equal(t, `{"op":"insert","table":"t1","after":{"id":1,"data":"AP/+YYA=","hash":"wyg=","flags":"BQ==","name":"é"}}`, string(got))
+
+	// The binary values are decoded without losing bytes.
+	var decoded struct {
+		After map[string]interface{}
+	}
+	require.NoError(t, json.Unmarshal(got, &decoded))
+	data, err := base64.StdEncoding.DecodeString(decoded.After["data"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, blob, data)
+}
+
+func TestHTTPSink(t *testing.T) {
+	var mu sync.Mutex
+	var bodies []string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		assert.Equal(t, "k=v", r.URL.RawQuery)
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink, err := newSink(server.URL + "/hook?k=v&batch_size=3")
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Write(sinkTestChanges()))
+	assert.Empty(t, bodies)
+	require.NoError(t, sink.Flush())
+	want := []string{
+		strings.Join(sinkTestJSON[:3], "\n") + "\n",
+		sinkTestJSON[3] + "\n",
+	}
+	assert.Equal(t, want, bodies)
+
+	// A failed post is an error.
+	mu.Lock()
+	status = http.StatusInternalServerError
+	bodies = nil
+	mu.Unlock()
+	require.NoError(t, sink.Write(sinkTestChanges()[:1]))
+	err = sink.Flush()
+	assert.EqualError(t, err, "http sink returned status 500 Internal Server Error")
+
+	_, err = newSink(server.URL + "?batch_size=0")
+	assert.EqualError(t, err, "invalid batch_size for http sink: 0")
+	_, err = newSink("kafka://localhost")
+	assert.EqualError(t, err, "unsupported sink type: kafka")
+}
+
+func TestBuildSinkReplicatorPlan(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "t1",
+		}, {
+			Match:  "t2",
+			Filter: "-80",
+		}, {
+			Match:  "upper_t3",
+			Filter: "select id, upper(name) as name from t3 where id > 10",
+		}, {
+			Match:  "t4",
+			Filter: ExcludeStr,
+		}},
+	}
+	plan, err := buildSinkReplicatorPlan(filter, nil)
+	require.NoError(t, err)
+	wantRules := []*binlogdatapb.Rule{{
+		Match:  "t1",
+		Filter: "select * from t1",
+	}, {
+		Match:  "t2",
+		Filter: "select * from t2 where in_keyrange('-80')",
+	}, {
+		Match:  "t3",
+		Filter: "select id, upper(name) as name from t3 where id > 10",
+	}}
+	assert.Equal(t, wantRules, plan.VStreamFilter.Rules)
+	assert.Equal(t, "upper_t3", plan.TablePlans["t3"].TargetName)
+	assert.Len(t, plan.TargetTables, 3)
+
+	// Uncopied tables are skipped.
+	plan, err = buildSinkReplicatorPlan(filter, map[string]*sqltypes.Result{"t1": nil})
+	require.NoError(t, err)
+	assert.Len(t, plan.TargetTables, 2)
+
+	_, err = buildSinkReplicatorPlan(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "/.*"}}}, nil)
+	assert.EqualError(t, err, "table name patterns are not supported for sinks: /.*")
+	_, err = buildSinkReplicatorPlan(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{
+		Match:  "t1",
+		Filter: "select id, count(*) from t1 group by id",
+	}}}, nil)
+	assert.EqualError(t, err, "group by is not supported for sinks: select id, count(*) from t1 group by id")
+}
+
+func TestSinkChangeLastpk(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|name", "int64|varchar")
+	tplan := &TablePlan{
+		TargetName: "t1",
+		Fields:     fields,
+		Lastpk:     sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "5"),
+	}
+	row := func(id, name string) *querypb.Row {
+		return sqltypes.RowToProto3(sqltypes.MakeTestResult(fields, id+"|"+name).Rows[0])
+	}
+
+	testcases := []struct {
+		before, after *querypb.Row
+		wantOp        SinkOp
+	}{{
+		after:  row("1", "a"),
+		wantOp: SinkInsert,
+	}, {
+		after: row("10", "a"),
+	}, {
+		before: row("5", "a"),
+		after:  row("5", "b"),
+		wantOp: SinkUpdate,
+	}, {
+		// A row moving past lastpk is a delete.
+		before: row("3", "a"),
+		after:  row("30", "a"),
+		wantOp: SinkDelete,
+	}, {
+		before: row("30", "a"),
+		after:  row("3", "a"),
+		wantOp: SinkInsert,
+	}}
+	for _, tcase := range testcases {
+		change, err := tplan.sinkChange(&binlogdatapb.RowChange{Before: tcase.before, After: tcase.after}, "pos")
+		require.NoError(t, err)
+		if tcase.wantOp == "" {
+			assert.Nil(t, change)
+			continue
+		}
+		require.NotNil(t, change)
+		assert.Equal(t, tcase.wantOp, change.Op)
+		assert.Equal(t, "pos", change.Position)
+	}
+
+	tplan.Lastpk = sqltypes.MakeTestResult(sqltypes.MakeTestFields("other", "int64"), "5")
+	_, err := tplan.sinkChange(&binlogdatapb.RowChange{After: row("1", "a")}, "")
+	assert.EqualError(t, err, "primary key column other of t1 must be selected to replicate to a sink")
+}
@@ -151,6 +151,75 @@ func (st *vrStats) register() {
 			}
 			return result
 		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationThrottledTime",
+		"vreplication time spent throttled per stream and component",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts", "component"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				for component, t := range ct.blpStats.ThrottledTimings.Histograms() {
+					result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)+"."+component] = t.Total()
+				}
+			}
+			return result
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationParallelApplyWorkers",
+		"vreplication number of workers applying transactions in parallel per stream",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)] = ct.blpStats.ParallelApplyWorkers()
+			}
+			return result
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationParallelApplyUtilization",
+		"vreplication percentage of time the parallel apply workers are busy per stream",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)] = ct.blpStats.ParallelApplyUtilization()
+			}
+			return result
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationParallelApplyTimings",
+		"vreplication time spent applying transactions per stream and parallel apply worker",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts", "worker"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				for worker, t := range ct.blpStats.ParallelApplyTimings.Histograms() {
+					result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)+"."+worker] = t.Total()
+				}
+			}
+			return result
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationParallelApplyFallbacks",
+		"vreplication batches of transactions that could not be applied in parallel per stream",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)] = ct.blpStats.ParallelApplyFallbacks.Get()
+			}
+			return result
+		})
 	stats.NewCounterFunc(
 		"VReplicationPhaseTimingsTotal",
 		"vreplication per phase timings aggregated across all phases and streams",
@@ -344,6 +413,9 @@ func (st *vrStats) status() *EngineStatus {
 			PhaseTimings:        ct.blpStats.PhaseTimings.Counts(),
 			CopyRowCount:        ct.blpStats.CopyRowCount.Get(),
 			CopyLoopCount:       ct.blpStats.CopyLoopCount.Get(),
+
+			ParallelApplyWorkers:     ct.blpStats.ParallelApplyWorkers(),
+			ParallelApplyUtilization: ct.blpStats.ParallelApplyUtilization(),
 		}
 		i++
 	}
@@ -375,6 +447,9 @@ type ControllerStatus struct {
 	PhaseTimings        map[string]int64
 	CopyRowCount        int64
 	CopyLoopCount       int64
+
+	ParallelApplyWorkers     int64
+	ParallelApplyUtilization int64
 }
 
 var vreplicationTemplate = `
@@ -296,6 +296,7 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		BulkInsertValues: tpb.generateValuesPart(sqlparser.NewTrackedBuffer(bvf.formatter), bvf),
 		BulkInsertOnDup:  tpb.generateOnDupPart(sqlparser.NewTrackedBuffer(bvf.formatter)),
 		Insert:           tpb.generateInsertStatement(),
+		Replace:          tpb.generateReplaceStatement(),
 		Update:           tpb.generateUpdateStatement(),
 		Delete:           tpb.generateDeleteStatement(),
 		PKReferences:     pkrefs,
@@ -414,16 +415,71 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			if node.IsAggregate() {
 				return false, fmt.Errorf("unexpected: %v", sqlparser.String(node))
 			}
+		case *sqlparser.BinaryExpr:
+			// The left side of these operators must be a column name,
+			// but the generated statements use bind variables instead.
+			if node.Operator == sqlparser.JSONExtractOp || node.Operator == sqlparser.JSONUnquoteExtractOp {
+				return false, fmt.Errorf("unsupported operator %s, use json_extract or json_unquote instead: %v", node.Operator.ToString(), sqlparser.String(node))
+			}
 		}
 		return true, nil
 	}, aliased.Expr)
 	if err != nil {
 		return nil, err
 	}
-	cexpr.expr = aliased.Expr
+	cexpr.expr = convertJSONArgs(aliased.Expr)
 	return cexpr, nil
 }
 
+// jsonDocFuncs are the JSON functions whose first argument is a JSON document.
+var jsonDocFuncs = map[string]bool{
+	"json_contains":       true,
+	"json_contains_path":  true,
+	"json_depth":          true,
+	"json_extract":        true,
+	"json_insert":         true,
+	"json_keys":           true,
+	"json_length":         true,
+	"json_merge_patch":    true,
+	"json_merge_preserve": true,
+	"json_pretty":         true,
+	"json_remove":         true,
+	"json_replace":        true,
+	"json_search":         true,
+	"json_set":            true,
+	"json_type":           true,
+	"json_unquote":        true,
+	"json_valid":          true,
+}
+
+// convertJSONArgs converts the columns that are used as JSON documents
+// to utf8mb4. The values of the columns are sent as binary strings, which
+// MySQL does not accept as JSON text.
+func convertJSONArgs(expr sqlparser.Expr) sqlparser.Expr {
+	toText := func(expr sqlparser.Expr) sqlparser.Expr {
+		if _, ok := expr.(*sqlparser.ColName); !ok {
+			return expr
+		}
+		return &sqlparser.ConvertUsingExpr{Expr: expr, Type: "utf8mb4"}
+	}
+	return sqlparser.Rewrite(expr, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.ConvertExpr:
+			if strings.EqualFold(node.Type.Type, "json") {
+				node.Expr = toText(node.Expr)
+			}
+		case *sqlparser.FuncExpr:
+			if !jsonDocFuncs[node.Name.Lowered()] || len(node.Exprs) == 0 {
+				break
+			}
+			if arg, ok := node.Exprs[0].(*sqlparser.AliasedExpr); ok {
+				arg.Expr = toText(arg.Expr)
+			}
+		}
+		return true
+	}, nil).(sqlparser.Expr)
+}
+
 // addCol adds the specified column to the send query
 // if it's not already present.
 func (tpb *tablePlanBuilder) addCol(ident sqlparser.ColIdent) {
@@ -503,6 +559,30 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
 
 	tpb.generateInsertPart(buf)
+	tpb.generateRowSourcePart(buf, bvf)
+	tpb.generateOnDupPart(buf)
+
+	return buf.ParsedQuery()
+}
+
+// generateReplaceStatement generates the statement used to overwrite
+// a row that conflicts with an existing one. Only normal inserts can
+// conflict: the other types already handle duplicates.
+func (tpb *tablePlanBuilder) generateReplaceStatement() *sqlparser.ParsedQuery {
+	if tpb.onInsert != insertNormal {
+		return nil
+	}
+	bvf := &bindvarFormatter{}
+	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+
+	buf.Myprintf("replace into %v(", tpb.name)
+	tpb.generateColumnsPart(buf)
+	tpb.generateRowSourcePart(buf, bvf)
+
+	return buf.ParsedQuery()
+}
+
+func (tpb *tablePlanBuilder) generateRowSourcePart(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
 	if tpb.lastpk == nil {
 		// If there's no lastpk, generate straight values.
 		buf.Myprintf(" values ", tpb.name)
@@ -512,9 +592,6 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 		// where the pks < lastpk
 		tpb.generateSelectPart(buf, bvf)
 	}
-	tpb.generateOnDupPart(buf)
-
-	return buf.ParsedQuery()
 }
 
 func (tpb *tablePlanBuilder) generateInsertPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
@@ -523,13 +600,17 @@ func (tpb *tablePlanBuilder) generateInsertPart(buf *sqlparser.TrackedBuffer) *s
 	} else {
 		buf.Myprintf("insert into %v(", tpb.name)
 	}
+	tpb.generateColumnsPart(buf)
+	return buf.ParsedQuery()
+}
+
+func (tpb *tablePlanBuilder) generateColumnsPart(buf *sqlparser.TrackedBuffer) {
 	separator := ""
 	for _, cexpr := range tpb.colExprs {
 		buf.Myprintf("%s%v", separator, cexpr.colName)
 		separator = ","
 	}
-	buf.Myprintf(")", tpb.name)
-	return buf.ParsedQuery()
+	buf.Myprintf(")")
 }
 
 func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) *sqlparser.ParsedQuery {
@@ -55,7 +55,7 @@ func newVCopier(vr *vreplicator) *vcopier {
 func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 	defer vc.vr.dbClient.Rollback()
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.pkInfoMap, nil)
+	plan, err := vc.vr.buildReplicatorPlan(nil)
 	if err != nil {
 		return err
 	}
@@ -198,7 +198,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 
 	log.Infof("Copying table %s, lastpk: %v", tableName, copyState[tableName])
 
-	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.pkInfoMap, nil)
+	plan, err := vc.vr.buildReplicatorPlan(nil)
 	if err != nil {
 		return err
 	}
@@ -248,6 +248,9 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		if len(rows.Rows) == 0 {
 			return nil
 		}
+		if err := vc.vr.throttle(ctx, "vcopier"); err != nil {
+			return err
+		}
 		// The number of rows we receive depends on the packet size set
 		// for the row streamer. Since the packet size is roughly equivalent
 		// to data size, this should map to a uniform amount of pages affected
@@ -256,19 +259,23 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		if err := vc.vr.dbClient.Begin(); err != nil {
 			return err
 		}
-		_, err = vc.tablePlan.applyBulkInsert(rows, func(sql string) (*sqltypes.Result, error) {
-			start := time.Now()
-			qr, err := vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
-			if err != nil {
-				return nil, err
-			}
-			vc.vr.stats.QueryTimings.Record("copy", start)
+		if vc.vr.sink != nil {
+			err = vc.writeRows(rows)
+		} else {
+			_, err = vc.tablePlan.applyBulkInsert(rows, func(sql string) (*sqltypes.Result, error) {
+				start := time.Now()
+				qr, err := vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
+				if err != nil {
+					return nil, err
+				}
+				vc.vr.stats.QueryTimings.Record("copy", start)
 
-			vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
-			vc.vr.stats.QueryCount.Add("copy", 1)
+				vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
+				vc.vr.stats.QueryCount.Add("copy", 1)
 
-			return qr, err
-		})
+				return qr, err
+			})
+		}
 		if err != nil {
 			return err
 		}
@@ -319,6 +326,24 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	return nil
 }
 
+// writeRows writes the copied rows to the sink of the stream as inserts.
+func (vc *vcopier) writeRows(rows *binlogdatapb.VStreamRowsResponse) error {
+	changes := make([]*SinkChange, 0, len(rows.Rows))
+	for _, row := range rows.Rows {
+		changes = append(changes, &SinkChange{
+			Op:     SinkInsert,
+			Table:  vc.tablePlan.TargetName,
+			Fields: vc.tablePlan.Fields,
+			After:  sqltypes.MakeRowTrusted(vc.tablePlan.Fields, row),
+		})
+	}
+	if err := vc.vr.sink.Write(changes); err != nil {
+		return err
+	}
+	vc.vr.stats.CopyRowCount.Add(int64(len(changes)))
+	return nil
+}
+
 func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltypes.Result, gtid string) error {
 	defer func() {
 		vc.vr.stats.PhaseTimings.Record("fastforward", time.Now())
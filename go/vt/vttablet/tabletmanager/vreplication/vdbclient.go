@@ -35,6 +35,9 @@ type vdbClient struct {
 	InTransaction bool
 	startTime     time.Time
 	queries       []string
+	// sink, if set, is flushed before every commit. This way,
+	// the position is saved only after the changes are durable.
+	sink Sink
 }
 
 func newVDBClient(dbclient binlogplayer.DBClient, stats *binlogplayer.Stats) *vdbClient {
@@ -58,6 +61,11 @@ func (vc *vdbClient) Begin() error {
 }
 
 func (vc *vdbClient) Commit() error {
+	if vc.sink != nil {
+		if err := vc.sink.Flush(); err != nil {
+			return err
+		}
+	}
 	if err := vc.DBClient.Commit(); err != nil {
 		return err
 	}
@@ -87,7 +95,14 @@ func (vc *vdbClient) ExecuteFetch(query string, maxrows int) (*sqltypes.Result,
 	} else {
 		vc.queries = append(vc.queries, query)
 	}
-	return vc.DBClient.ExecuteFetch(query, maxrows)
+	qr, err := vc.DBClient.ExecuteFetch(query, maxrows)
+	if vc.InTransaction && isDupEntry(err) {
+		// The statement failed without affecting the transaction, and its
+		// conflict may be resolved by other statements: it must not be
+		// replayed by Retry.
+		vc.queries = vc.queries[:len(vc.queries)-1]
+	}
+	return qr, err
 }
 
 // Execute is ExecuteFetch without the maxrows.
@@ -59,6 +59,8 @@ type vplayer struct {
 	timeOffsetNs int64
 	// canAcceptStmtEvents is set to true if the current player can accept events in statement mode. Only true for filters that are match all.
 	canAcceptStmtEvents bool
+	// parallel, if set, applies transactions in parallel.
+	parallel *parallelApplier
 
 	phase string
 }
@@ -101,7 +103,7 @@ func (vp *vplayer) play(ctx context.Context) error {
 		return nil
 	}
 
-	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.pkInfoMap, vp.copyState)
+	plan, err := vp.vr.buildReplicatorPlan(vp.copyState)
 	if err != nil {
 		vp.vr.stats.ErrorCounts.Add([]string{"Plan"}, 1)
 		return err
@@ -117,6 +119,14 @@ func (vp *vplayer) play(ctx context.Context) error {
 		}
 	}
 
+	vp.parallel, err = newParallelApplier(vp, *parallelApplyWorkers)
+	if err != nil {
+		return err
+	}
+	if vp.parallel != nil {
+		defer vp.parallel.close()
+	}
+
 	return vp.fetchAndApply(ctx)
 }
 
@@ -192,6 +202,13 @@ func (vp *vplayer) applyStmtEvent(ctx context.Context, event *binlogdatapb.VEven
 	if sql == "" {
 		sql = event.Dml
 	}
+	if vp.vr.sink != nil {
+		if event.Type == binlogdatapb.VEventType_SAVEPOINT {
+			// Savepoints are meaningless for sinks.
+			return nil
+		}
+		return fmt.Errorf("statement based replication is not supported for sinks: %s", sql)
+	}
 	if event.Type == binlogdatapb.VEventType_SAVEPOINT || vp.canAcceptStmtEvents {
 		start := time.Now()
 		_, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
@@ -207,6 +224,9 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 	if tplan == nil {
 		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
 	}
+	if vp.vr.sink != nil {
+		return vp.writeRowEvent(tplan, rowEvent)
+	}
 	for _, change := range rowEvent.RowChanges {
 		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
 			stats := NewVrLogStats("ROWCHANGE")
@@ -224,6 +244,44 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 	return nil
 }
 
+// writeRowEvent writes the changes of a row event to the sink of the stream.
+func (vp *vplayer) writeRowEvent(tplan *TablePlan, rowEvent *binlogdatapb.RowEvent) error {
+	position := mysql.EncodePosition(vp.pos)
+	changes := make([]*SinkChange, 0, len(rowEvent.RowChanges))
+	for _, rowChange := range rowEvent.RowChanges {
+		change, err := tplan.sinkChange(rowChange, position)
+		if err != nil {
+			return err
+		}
+		if change != nil {
+			changes = append(changes, change)
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	vp.vr.stats.QueryCount.Add(vp.phase, 1)
+	return vp.vr.sink.Write(changes)
+}
+
+// applyDDL applies a DDL to the target. For sinks, the DDL is written
+// and flushed, because the position is saved right after.
+func (vp *vplayer) applyDDL(ctx context.Context, statement string) error {
+	if vp.vr.sink != nil {
+		change := &SinkChange{
+			Op:        SinkDDL,
+			Position:  mysql.EncodePosition(vp.pos),
+			Statement: statement,
+		}
+		if err := vp.vr.sink.Write([]*SinkChange{change}); err != nil {
+			return err
+		}
+		return vp.vr.sink.Flush()
+	}
+	_, err := vp.vr.dbClient.ExecuteWithRetry(ctx, statement)
+	return err
+}
+
 func (vp *vplayer) updatePos(ts int64) (posReached bool, err error) {
 	update := binlogplayer.GenerateUpdatePos(vp.vr.id, vp.pos, time.Now().Unix(), ts)
 	if _, err := vp.vr.dbClient.Execute(update); err != nil {
@@ -286,6 +344,9 @@ func (vp *vplayer) recordHeartbeat() (err error) {
 //   of transactions come in, with the last one being partial. In this case, all transactions
 //   up to the last one have to be committed, and the final one must be partially applied.
 //
+// If the player has a parallelApplier, the events are handed over to it instead.
+// Its transactions are not combined, because each of them is committed separately.
+//
 // Of the above events, the saveable ones are COMMIT, DDL, and OTHER. Eventhough
 // A GTID comes as a separate event, it's not saveable until a subsequent saveable
 // event occurs. VStreamer currently sequences the GTID to be sent just before
@@ -325,6 +386,8 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 		if len(items) == 0 {
 			behind := time.Now().UnixNano() - vp.lastTimestampNs - vp.timeOffsetNs
 			vp.vr.stats.SecondsBehindMaster.Set(behind / 1e9)
+		} else if err := vp.vr.throttle(ctx, "vplayer"); err != nil {
+			return err
 		}
 		// Empty transactions are saved at most once every idleTimeout.
 		// This covers two situations:
@@ -342,6 +405,28 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 				return nil
 			}
 		}
+		if vp.parallel != nil {
+			for _, events := range items {
+				for _, event := range events {
+					if event.Timestamp != 0 {
+						vp.lastTimestampNs = event.Timestamp * 1e9
+						vp.timeOffsetNs = time.Now().UnixNano() - event.CurrentTime
+						sbm = event.CurrentTime/1e9 - event.Timestamp
+					}
+				}
+			}
+			if err := vp.parallel.applyItems(ctx, items); err != nil {
+				if err != io.EOF {
+					vp.vr.stats.ErrorCounts.Add([]string{"Apply"}, 1)
+					log.Errorf("Error applying events: %s", err.Error())
+				}
+				return err
+			}
+			if sbm >= 0 {
+				vp.vr.stats.SecondsBehindMaster.Set(sbm)
+			}
+			continue
+		}
 		for i, events := range items {
 			for j, event := range events {
 				if event.Timestamp != 0 {
@@ -499,6 +584,9 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			log.Errorf("internal error: vplayer is in a transaction on event: %v", event)
 			return fmt.Errorf("internal error: vplayer is in a transaction on event: %v", event)
 		}
+		if vp.vr.schemaChangePolicy != "" {
+			return vp.applySchemaChange(ctx, event)
+		}
 		switch vp.vr.source.OnDdl {
 		case binlogdatapb.OnDDLAction_IGNORE:
 			// We still have to update the position.
@@ -528,7 +616,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			// So, we apply the DDL first, and then save the position.
 			// Manual intervention may be needed if there is a partial
 			// failure here.
-			if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, event.Statement); err != nil {
+			if err := vp.applyDDL(ctx, event.Statement); err != nil {
 				return err
 			}
 			stats.Send(fmt.Sprintf("%v", event.Statement))
@@ -540,7 +628,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return io.EOF
 			}
 		case binlogdatapb.OnDDLAction_EXEC_IGNORE:
-			if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, event.Statement); err != nil {
+			if err := vp.applyDDL(ctx, event.Statement); err != nil {
 				log.Infof("Ignoring error: %v for DDL: %s", err, event.Statement)
 			}
 			stats.Send(fmt.Sprintf("%v", event.Statement))
@@ -1547,6 +1547,84 @@ func TestPlayerDDL(t *testing.T) {
 	cancel()
 }
 
+func TestPlayerSchemaChangePolicy(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+	execStatements(t, []string{
+		"create table t1(id int, primary key(id))",
+		fmt.Sprintf("create table %s.t1(id int, primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		fmt.Sprintf("drop table %s.t1", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	cancel, id := startVReplication(t, bls, "")
+	defer cancel()
+	execStatements(t, []string{"insert into t1 values(1)"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id) values (1)",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+
+	if _, err := playerEngine.Exec(fmt.Sprintf("update _vt.vreplication set on_schema_change='apply_additive' where id=%d", id)); err != nil {
+		t.Fatal(err)
+	}
+	expectDBClientQueries(t, []string{
+		"/update _vt.vreplication set on_schema_change='apply_additive'",
+		"/update _vt.vreplication set message='Picked source tablet.*",
+		"/create table if not exists _vt.vreplication_log",
+		"/update _vt.vreplication set state='Running'",
+	})
+
+	// An additive change is applied to the target table, without the
+	// qualifier of the source database, and the plan is rebuilt.
+	execStatements(t, []string{fmt.Sprintf("alter table %s.t1 add column val varchar(128)", env.KeyspaceName)})
+	expectDBClientQueries(t, []string{
+		"alter table t1 add column val varchar(128)",
+		"begin",
+		"/insert into _vt.vreplication_log.*'SchemaChange', 'Applied'",
+		"/update _vt.vreplication set pos=",
+		"commit",
+		// The apply of the DDL on target generates an "other" event.
+		"/update _vt.vreplication set pos=",
+	})
+	execStatements(t, []string{"insert into t1 values(2, 'aaa')"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id,val) values (2,'aaa')",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+
+	// Any other change stops the stream.
+	execStatements(t, []string{"alter table t1 drop column val"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"/insert into _vt.vreplication_log.*'SchemaChange', 'Stopped', 'drop column val is not an additive change: alter table t1 drop column val'",
+		"/update _vt.vreplication set pos=",
+		"/update _vt.vreplication set state='Stopped'",
+		"commit",
+	})
+
+	qr, err := env.Mysqld.FetchSuperQuery(context.Background(), fmt.Sprintf("select state from _vt.vreplication_log where vrepl_id=%d and type='SchemaChange' order by id", id))
+	require.NoError(t, err)
+	require.Equal(t, "[[VARBINARY(\"Applied\")] [VARBINARY(\"Stopped\")]]", fmt.Sprintf("%v", qr.Rows))
+}
+
 func TestPlayerStopPos(t *testing.T) {
 	defer deleteTablet(addTablet(100))
 
@@ -1855,6 +1933,61 @@ func TestPlayerSplitTransaction(t *testing.T) {
 	})
 }
 
+func TestPlayerParallelApply(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+	flag.Set("vreplication_parallel_apply_workers", "4")
+	defer flag.Set("vreplication_parallel_apply_workers", "0")
+
+	execStatements(t, []string{
+		"create table t1(id int, val varbinary(128), primary key(id))",
+		fmt.Sprintf("create table %s.t1(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		fmt.Sprintf("drop table %s.t1", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	cancel, _ := startVReplication(t, bls, "")
+	defer cancel()
+
+	// The transactions touch the same row. So, they must
+	// be applied in order even if they're applied in parallel.
+	execStatements(t, []string{
+		"insert into t1 values(1, 'aaa')",
+		"update t1 set val='bbb' where id=1",
+		"update t1 set id=2 where id=1",
+		"update t1 set val='ccc' where id=2",
+	})
+	expectNontxQueries(t, []string{
+		"insert into t1(id,val) values (1,'aaa')",
+		"update t1 set val='bbb' where id=1",
+		"delete from t1 where id=1",
+		"insert into t1(id,val) values (2,'bbb')",
+		"update t1 set val='ccc' where id=2",
+	})
+	expectData(t, "t1", [][]string{
+		{"2", "ccc"},
+	})
+
+	var workers int64
+	for _, ct := range globalStats.status().Controllers {
+		workers += ct.ParallelApplyWorkers
+	}
+	require.Equal(t, int64(4), workers)
+}
+
 func TestPlayerLockErrors(t *testing.T) {
 	defer deleteTablet(addTablet(100))
 
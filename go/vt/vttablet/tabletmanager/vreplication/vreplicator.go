@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -46,23 +47,38 @@ var (
 	relayLogMaxItems    = 1000
 	copyTimeout         = 1 * time.Hour
 	replicaLagTolerance = 10 * time.Second
+	// throttledUpdateInterval is the minimum interval between
+	// two updates of the throttled state of a stream.
+	throttledUpdateInterval = 1 * time.Second
 )
 
 // vreplicator provides the core logic to start vreplication streams
 type vreplicator struct {
 	vre      *Engine
 	id       uint32
+	workflow string
 	dbClient *vdbClient
 	// source
 	source          *binlogdatapb.BinlogSource
 	sourceVStreamer VStreamerClient
 
 	stats *binlogplayer.Stats
+	// sink, if set, receives the changes instead of the database.
+	// The database is still used to track the state of the stream.
+	sink Sink
+	// conflicts, if set, resolves primary key conflicts.
+	conflicts *conflictResolver
+	// schemaChangePolicy, if set, overrides the OnDdl setting of the source.
+	schemaChangePolicy string
 	// mysqld is used to fetch the local schema.
 	mysqld    mysqlctl.MysqlDaemon
 	pkInfoMap map[string][]*PrimaryKeyInfo
 
 	originalFKCheckSetting int64
+
+	// throttlerClient checks the throttler as the app "vreplication:<workflow>".
+	throttlerClient    *throttle.Client
+	lastThrottleUpdate time.Time
 }
 
 // newVReplicator creates a new vreplicator. The valid fields from the source are:
@@ -85,16 +101,26 @@ type vreplicator struct {
 //   alias like "a+b as targetcol" must be used.
 //   More advanced constructs can be used. Please see the table plan builder
 //   documentation for more info.
-func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
-	return &vreplicator{
+func newVReplicator(id uint32, workflow string, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
+	vr := &vreplicator{
 		vre:             vre,
 		id:              id,
+		workflow:        workflow,
 		source:          source,
 		sourceVStreamer: sourceVStreamer,
 		stats:           stats,
 		dbClient:        newVDBClient(dbClient, stats),
 		mysqld:          mysqld,
 	}
+	if vre != nil {
+		vr.throttlerClient = throttle.NewBackgroundClient(vre.lagThrottler, throttlerAppName(workflow))
+	}
+	return vr
+}
+
+// throttlerAppName returns the app name a workflow uses when checking the throttler.
+func throttlerAppName(workflow string) string {
+	return "vreplication:" + workflow
 }
 
 // Replicate starts a vreplication stream. It can be in one of three phases:
@@ -120,6 +146,9 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 // code.
 func (vr *vreplicator) Replicate(ctx context.Context) error {
 	err := vr.replicate(ctx)
+	if err != nil && vr.conflicts != nil && vr.conflicts.failed != nil {
+		err = vr.stopOnConflict(vr.conflicts.failed)
+	}
 	if err != nil {
 		log.Errorf("Replicate error: %s", err.Error())
 		if err := vr.setMessage(fmt.Sprintf("Error: %s", err.Error())); err != nil {
@@ -130,11 +159,27 @@ func (vr *vreplicator) Replicate(ctx context.Context) error {
 }
 
 func (vr *vreplicator) replicate(ctx context.Context) error {
-	pkInfo, err := vr.buildPkInfoMap(ctx)
-	if err != nil {
-		return err
+	// The target schema is irrelevant for sinks.
+	if vr.sink == nil {
+		pkInfo, err := vr.buildPkInfoMap(ctx)
+		if err != nil {
+			return err
+		}
+		vr.pkInfoMap = pkInfo
+	}
+	if vr.conflicts != nil {
+		if _, err := vr.dbClient.Execute(createConflictsTable); err != nil {
+			return err
+		}
+		if _, err := vr.dbClient.Execute(createConflictKeysTable); err != nil {
+			return err
+		}
+	}
+	if vr.schemaChangePolicy != "" {
+		if _, err := vr.dbClient.Execute(createVReplicationLogTable); err != nil {
+			return err
+		}
 	}
-	vr.pkInfoMap = pkInfo
 	if err := vr.getSettingFKCheck(); err != nil {
 		return err
 	}
@@ -191,6 +236,27 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 	}
 }
 
+// setSink makes the vreplicator write changes to the sink.
+func (vr *vreplicator) setSink(sink Sink) {
+	vr.sink = sink
+	vr.dbClient.sink = sink
+}
+
+// buildReplicatorPlan builds the plan for the target of the stream.
+func (vr *vreplicator) buildReplicatorPlan(copyState map[string]*sqltypes.Result) (*ReplicatorPlan, error) {
+	if vr.sink != nil {
+		return buildSinkReplicatorPlan(vr.source.Filter, copyState)
+	}
+	plan, err := buildReplicatorPlan(vr.source.Filter, vr.pkInfoMap, copyState)
+	if err != nil {
+		return nil, err
+	}
+	if vr.conflicts != nil {
+		plan.setConflictResolver(vr.conflicts)
+	}
+	return plan, nil
+}
+
 // PrimaryKeyInfo is used to store charset and collation for primary keys where applicable
 type PrimaryKeyInfo struct {
 	Name       string
@@ -300,6 +366,33 @@ func (vr *vreplicator) setMessage(message string) error {
 	return nil
 }
 
+// throttle blocks while the throttler holds back the stream. The time spent
+// throttled is recorded for the component, and the throttled state is
+// reflected in the vreplication table.
+func (vr *vreplicator) throttle(ctx context.Context, component string) error {
+	if vr.throttlerClient.ThrottleCheckOK(ctx) {
+		return nil
+	}
+	if err := vr.updateTimeThrottled(component); err != nil {
+		return err
+	}
+	throttled, err := vr.throttlerClient.Throttle(ctx)
+	vr.stats.ThrottledTimings.Add(component, throttled)
+	return err
+}
+
+func (vr *vreplicator) updateTimeThrottled(component string) error {
+	if time.Since(vr.lastThrottleUpdate) < throttledUpdateInterval {
+		return nil
+	}
+	vr.lastThrottleUpdate = time.Now()
+	query := fmt.Sprintf("update _vt.vreplication set time_throttled=%v, component_throttled=%v where id=%v", vr.lastThrottleUpdate.Unix(), encodeString(component), vr.id)
+	if _, err := vr.dbClient.ExecuteFetch(query, 1); err != nil {
+		return fmt.Errorf("could not set throttled state: %v: %v", query, err)
+	}
+	return nil
+}
+
 func (vr *vreplicator) setState(state, message string) error {
 	if message != "" {
 		vr.stats.History.Add(&binlogplayer.StatsHistoryRecord{
@@ -23,6 +23,7 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
@@ -57,6 +58,15 @@ type Pool struct {
 	waiterCount        sync2.AtomicInt64
 	dbaPool            *dbconnpool.ConnectionPool
 	appDebugParams     dbconfigs.Connector
+
+	// minSize and maxSize are set if the pool is sized by a
+	// pools.CapacityController while it's open.
+	minSize    int
+	maxSize    int
+	resize     tabletenv.PoolResizeConfig
+	load       pools.LoadFunc
+	controller *pools.CapacityController
+	resizes    *stats.CountersWithMultiLabels
 }
 
 // NewPool creates a new Pool. The name is used
@@ -72,10 +82,18 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 		idleTimeout:        idleTimeout,
 		waiterCap:          int64(cfg.MaxWaiters),
 		dbaPool:            dbconnpool.NewConnectionPool("", 1, idleTimeout, 0),
+		minSize:            cfg.MinSize,
+		maxSize:            cfg.MaxSize,
+	}
+	if cp.maxSize > 0 {
+		cp.resize = env.Config().PoolResize
 	}
 	if name == "" {
 		return cp
 	}
+	if cp.maxSize > 0 {
+		cp.resizes = env.Exporter().NewCountersWithMultiLabels(name+"Resizes", "Tablet server conn pool resizes", []string{"Direction", "Reason"})
+	}
 	env.Exporter().NewGaugeFunc(name+"Capacity", "Tablet server conn pool capacity", cp.Capacity)
 	env.Exporter().NewGaugeFunc(name+"Available", "Tablet server conn pool available", cp.Available)
 	env.Exporter().NewGaugeFunc(name+"Active", "Tablet server conn pool active", cp.Active)
@@ -109,10 +127,58 @@ func (cp *Pool) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector) {
 	f := func(ctx context.Context) (pools.Resource, error) {
 		return NewDBConn(ctx, cp, appParams)
 	}
-	cp.connections = pools.NewResourcePool(f, cp.capacity, cp.capacity, cp.idleTimeout, cp.prefillParallelism, cp.getLogWaitCallback())
+	maxCap := cp.capacity
+	if cp.maxSize > maxCap {
+		maxCap = cp.maxSize
+	}
+	cp.connections = pools.NewResourcePool(f, cp.capacity, maxCap, cp.idleTimeout, cp.prefillParallelism, cp.getLogWaitCallback())
 	cp.appDebugParams = appDebugParams
 
 	cp.dbaPool.Open(dbaParams)
+
+	if cp.maxSize > 0 {
+		cp.openController()
+	}
+}
+
+// openController starts resizing the pool between its min and max sizes.
+func (cp *Pool) openController() {
+	controller, err := pools.NewCapacityController(cp.connections, pools.CapacityControllerConfig{
+		MinCapacity:      cp.minSize,
+		MaxCapacity:      cp.maxSize,
+		Interval:         cp.resize.IntervalSeconds.Get(),
+		Step:             cp.resize.Step,
+		Hysteresis:       cp.resize.Hysteresis,
+		WaitThreshold:    cp.resize.WaitThresholdSeconds.Get(),
+		WaitersThreshold: cp.resize.WaitersThreshold,
+		MaxLoad:          float64(cp.resize.MaxThreadsRunning),
+	}, cp.load, cp.recordResize)
+	if err != nil {
+		log.Errorf("Pool %s will not be resized: %v", cp.name, err)
+		return
+	}
+	cp.controller = controller
+	cp.controller.Open()
+}
+
+func (cp *Pool) recordResize(oldCapacity, newCapacity int, reason string) {
+	direction := "Grow"
+	if newCapacity < oldCapacity {
+		direction = "Shrink"
+	}
+	log.Infof("Pool %s resized from %d to %d: %s", cp.name, oldCapacity, newCapacity, reason)
+	if cp.resizes != nil {
+		cp.resizes.Add([]string{direction, reason}, 1)
+	}
+}
+
+// SetLoadFunc sets the function that reports the load of MySQL.
+// An adaptive pool shrinks while the load exceeds the configured maximum.
+// It must be called before Open.
+func (cp *Pool) SetLoadFunc(load pools.LoadFunc) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.load = load
 }
 
 func (cp *Pool) getLogWaitCallback() func(time.Time) {
@@ -131,6 +197,13 @@ func (cp *Pool) Close() {
 	if p == nil {
 		return
 	}
+	cp.mu.Lock()
+	controller := cp.controller
+	cp.controller = nil
+	cp.mu.Unlock()
+	if controller != nil {
+		controller.Close()
+	}
 	// We should not hold the lock while calling Close
 	// because it waits for connections to be returned.
 	p.Close()
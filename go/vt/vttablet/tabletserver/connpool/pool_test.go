@@ -287,3 +287,35 @@ func newPool() *Pool {
 		IdleTimeoutSeconds: 10,
 	})
 }
+
+func TestConnPoolAdaptiveSize(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	config := tabletenv.NewDefaultConfig()
+	config.PoolResize.IntervalSeconds = 0.01
+	config.PoolResize.Hysteresis = 1
+	connPool := NewPool(tabletenv.NewEnv(config, "PoolTest"), "TestAdaptivePool", tabletenv.ConnPoolConfig{
+		Size:    1,
+		MinSize: 1,
+		MaxSize: 2,
+	})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	assert.EqualValues(t, 2, connPool.MaxCap())
+
+	// A waiter makes the pool grow, which lets the waiter through.
+	dbConn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	dbConn2, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, connPool.Capacity())
+	assert.EqualValues(t, 1, connPool.resizes.Counts()["Grow.Waiters"])
+
+	// Idle connections make it shrink again.
+	dbConn.Recycle()
+	dbConn2.Recycle()
+	for connPool.Capacity() != 1 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.EqualValues(t, 1, connPool.resizes.Counts()["Shrink.Idle"])
+}
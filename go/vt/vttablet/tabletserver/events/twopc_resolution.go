@@ -0,0 +1,38 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events contains event structs used by the tabletserver package.
+package events
+
+import (
+	base "vitess.io/vitess/go/vt/events"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// TwoPCResolution is an event that describes a single step in the
+// resolution of an abandoned distributed transaction by the
+// metadata manager.
+type TwoPCResolution struct {
+	base.StatusUpdater
+
+	Dtid string
+	// State is the state of the transaction when it was found abandoned.
+	State querypb.TransactionState
+	// Decision is the outcome the transaction is driven to: COMMIT or ROLLBACK.
+	Decision     querypb.TransactionState
+	Participants []*querypb.Target
+}
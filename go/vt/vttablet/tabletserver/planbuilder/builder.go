@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"strings"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
@@ -75,6 +76,7 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 		buf.Myprintf("%v", upd.Where)
 		plan.WhereClause = buf.ParsedQuery()
 	}
+	plan.PKValues = analyzePKWhere(plan.Table, upd.Where)
 
 	// Situations when we pass-through:
 	// PassthroughDMLs flag is set.
@@ -82,12 +84,14 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 	// If there's an explicit Limit.
 	if PassthroughDMLs || plan.Table == nil || upd.Limit != nil {
 		plan.FullQuery = GenerateFullQuery(upd)
+		plan.PKSelectQuery = GeneratePKSelectQuery(plan.Table, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit)
 		return plan, nil
 	}
 
 	plan.PlanID = PlanUpdateLimit
 	upd.Limit = execLimit
 	plan.FullQuery = GenerateFullQuery(upd)
+	plan.PKSelectQuery = GeneratePKSelectQuery(plan.Table, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit)
 	upd.Limit = nil
 	return plan, nil
 }
@@ -104,14 +108,24 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 		buf.Myprintf("%v", del.Where)
 		plan.WhereClause = buf.ParsedQuery()
 	}
+	singleTable := len(del.Targets) == 0
+	if singleTable {
+		plan.PKValues = analyzePKWhere(plan.Table, del.Where)
+	}
 
 	if PassthroughDMLs || plan.Table == nil || del.Limit != nil {
 		plan.FullQuery = GenerateFullQuery(del)
+		if singleTable {
+			plan.PKSelectQuery = GeneratePKSelectQuery(plan.Table, del.TableExprs, del.Where, del.OrderBy, del.Limit)
+		}
 		return plan, nil
 	}
 	plan.PlanID = PlanDeleteLimit
 	del.Limit = execLimit
 	plan.FullQuery = GenerateFullQuery(del)
+	if singleTable {
+		plan.PKSelectQuery = GeneratePKSelectQuery(plan.Table, del.TableExprs, del.Where, del.OrderBy, del.Limit)
+	}
 	del.Limit = nil
 	return plan, nil
 }
@@ -124,9 +138,103 @@ func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan
 
 	tableName := sqlparser.GetTableName(ins.Table)
 	plan.Table = tables[tableName.String()]
+	plan.InsertPKValues = analyzePKInsert(plan.Table, ins)
+	if len(ins.OnDup) != 0 {
+		plan.PKValues = plan.InsertPKValues
+	}
 	return plan, nil
 }
 
+// analyzePKWhere returns the primary key values of the rows targeted by
+// the WHERE clause if it only consists of equalities on all the primary
+// key columns, or of an IN-list on a single-column primary key.
+// It returns nil otherwise.
+func analyzePKWhere(table *schema.Table, where *sqlparser.Where) []sqltypes.PlanValue {
+	if table == nil || !table.HasPrimary() || where == nil {
+		return nil
+	}
+	pkValues := make([]sqltypes.PlanValue, len(table.PKColumns))
+	for _, expr := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		cmp, ok := expr.(*sqlparser.ComparisonExpr)
+		if !ok {
+			return nil
+		}
+		col, ok := cmp.Left.(*sqlparser.ColName)
+		if !ok {
+			return nil
+		}
+		index := pkIndex(table, col.Name)
+		if index == -1 || pkValues[index].IsList() {
+			return nil
+		}
+		pv, err := sqlparser.NewPlanValue(cmp.Right)
+		if err != nil {
+			return nil
+		}
+		switch {
+		case cmp.Operator == sqlparser.EqualOp && !pv.IsList() && !pv.IsNull():
+			pkValues[index] = sqltypes.PlanValue{Values: []sqltypes.PlanValue{pv}}
+		case cmp.Operator == sqlparser.InOp && pv.IsList() && len(table.PKColumns) == 1:
+			pkValues[index] = pv
+		default:
+			return nil
+		}
+	}
+	for _, pv := range pkValues {
+		if !pv.IsList() {
+			return nil
+		}
+	}
+	return pkValues
+}
+
+// analyzePKInsert returns the primary key values of the rows of an
+// INSERT if all primary key columns are supplied as values.
+// It returns nil otherwise.
+func analyzePKInsert(table *schema.Table, ins *sqlparser.Insert) []sqltypes.PlanValue {
+	rows, ok := ins.Rows.(sqlparser.Values)
+	if table == nil || !table.HasPrimary() || !ok {
+		return nil
+	}
+	pkValues := make([]sqltypes.PlanValue, len(table.PKColumns))
+	for i := range pkValues {
+		pkValues[i].Values = make([]sqltypes.PlanValue, 0, len(rows))
+	}
+	found := 0
+	for colIndex, col := range ins.Columns {
+		index := pkIndex(table, col)
+		if index == -1 {
+			continue
+		}
+		found++
+		for _, row := range rows {
+			if colIndex >= len(row) {
+				return nil
+			}
+			pv, err := sqlparser.NewPlanValue(row[colIndex])
+			if err != nil || pv.IsList() || pv.IsNull() {
+				return nil
+			}
+			pkValues[index].Values = append(pkValues[index].Values, pv)
+		}
+	}
+	if found != len(table.PKColumns) {
+		return nil
+	}
+	return pkValues
+}
+
+// pkIndex returns the position of the column in the primary key,
+// or -1 if the column is not part of it.
+func pkIndex(table *schema.Table, col sqlparser.ColIdent) int {
+	for i, pkCol := range table.PKColumns {
+		if pkCol < len(table.Fields) && col.EqualString(table.Fields[pkCol].Name) {
+			return i
+		}
+	}
+	return -1
+}
+
 func analyzeShow(show *sqlparser.Show, dbName string) (plan *Plan, err error) {
 	switch showInternal := show.Internal.(type) {
 	case *sqlparser.ShowLegacy:
@@ -144,6 +144,25 @@ type Plan struct {
 	// WhereClause is set for DMLs. It is used by the hot row protection
 	// to serialize e.g. UPDATEs going to the same row.
 	WhereClause *sqlparser.ParsedQuery
+
+	// PKValues is set for DMLs which only change rows identified by their
+	// full primary key: UPDATEs and DELETEs with equalities on the primary
+	// key columns or an IN-list on a single-column primary key, and
+	// INSERT ... ON DUPLICATE KEY UPDATE. It holds one list per primary key
+	// column, with a value per row. It is used by the hot row protection.
+	PKValues []sqltypes.PlanValue
+
+	// PKSelectQuery is set for single-table UPDATEs and DELETEs on tables
+	// with a primary key. It is used by the audit log to capture the
+	// primary keys of the rows the statement is about to change.
+	// It carries the same LIMIT as the statement.
+	PKSelectQuery *sqlparser.ParsedQuery
+
+	// InsertPKValues is set for INSERTs that supply all primary key
+	// columns as values. It holds one list per primary key column, with
+	// a value per row, and is used by the audit log to record the
+	// primary keys of the inserted rows.
+	InsertPKValues []sqltypes.PlanValue
 }
 
 // TableName returns the table name for the plan.
@@ -33,6 +33,8 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // MarshalJSON returns a JSON of the given Plan.
@@ -267,6 +269,79 @@ func TestMessageStreamingPlan(t *testing.T) {
 	}
 }
 
+func TestPKValues(t *testing.T) {
+	tables := map[string]*schema.Table{
+		"t1": {
+			Name:      sqlparser.NewTableIdent("t1"),
+			Fields:    []*querypb.Field{{Name: "id"}, {Name: "val"}},
+			PKColumns: []int{0},
+		},
+		"t2": {
+			Name:      sqlparser.NewTableIdent("t2"),
+			Fields:    []*querypb.Field{{Name: "a"}, {Name: "b"}, {Name: "val"}},
+			PKColumns: []int{0, 1},
+		},
+	}
+	testcases := []struct {
+		query string
+		want  string
+	}{{
+		query: "update t1 set val = 1 where id = 1",
+		want:  "[[1]]",
+	}, {
+		query: "update t1 set val = 1 where id in (1, :id, 3)",
+		want:  `[[1,":id",3]]`,
+	}, {
+		query: "delete from t1 where id in ::ids",
+		want:  `["::ids"]`,
+	}, {
+		query: "update t2 set val = 1 where b = 2 and a = 1",
+		want:  "[[1],[2]]",
+	}, {
+		query: "insert into t2(val, b, a) values (1, 2, 3), (4, 5, :a) on duplicate key update val = val + 1",
+		want:  `[[3,":a"],[2,5]]`,
+	}, {
+		// Not all primary key columns.
+		query: "update t2 set val = 1 where a = 1",
+		want:  "null",
+	}, {
+		// Other conditions.
+		query: "update t1 set val = 1 where id = 1 and val = 2",
+		want:  "null",
+	}, {
+		query: "update t1 set val = 1 where id = 1 or id = 2",
+		want:  "null",
+	}, {
+		// IN-lists are only supported on single-column primary keys.
+		query: "update t2 set val = 1 where a in (1, 2) and b = 1",
+		want:  "null",
+	}, {
+		query: "update t1 set val = 1 where id > 1",
+		want:  "null",
+	}, {
+		// Plain inserts are not serialized.
+		query: "insert into t1(id, val) values (1, 2)",
+		want:  "null",
+	}, {
+		query: "insert into t2(a, val) values (1, 2) on duplicate key update val = val + 1",
+		want:  "null",
+	}, {
+		query: "insert into t1(id, val) select id, val from t2 on duplicate key update val = val + 1",
+		want:  "null",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			statement, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			plan, err := Build(statement, tables, false, "dbName")
+			require.NoError(t, err)
+			got, err := json.Marshal(plan.PKValues)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
 func loadSchema(name string) map[string]*schema.Table {
 	b, err := ioutil.ReadFile(locateFile(name))
 	if err != nil {
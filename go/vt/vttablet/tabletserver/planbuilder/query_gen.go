@@ -18,6 +18,7 @@ package planbuilder
 
 import (
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 )
 
 // GenerateFullQuery generates the full query from the ast.
@@ -64,3 +65,26 @@ func GenerateLimitQuery(selStmt sqlparser.SelectStatement) *sqlparser.ParsedQuer
 	buf.Myprintf("%v", selStmt)
 	return buf.ParsedQuery()
 }
+
+// GeneratePKSelectQuery generates a locking select of the primary key
+// columns of the rows that a single-table DML would change.
+// It returns nil if the table is unknown or its primary key is not known.
+func GeneratePKSelectQuery(table *schema.Table, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit) *sqlparser.ParsedQuery {
+	if table == nil || !table.HasPrimary() {
+		return nil
+	}
+	sel := &sqlparser.Select{
+		From:    tableExprs,
+		Where:   where,
+		OrderBy: orderBy,
+		Limit:   limit,
+		Lock:    sqlparser.ForUpdateLock,
+	}
+	for _, col := range table.PKColumns {
+		if col >= len(table.Fields) {
+			return nil
+		}
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: sqlparser.NewColName(table.Fields[col].Name)})
+	}
+	return GenerateFullQuery(sel)
+}
@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/sync2"
@@ -135,6 +136,9 @@ type QueryEngine struct {
 	// that we start more than one transaction per hot row (range).
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
+	// slowQueries captures queries that exceed the slow query
+	// threshold, along with their EXPLAIN.
+	slowQueries *SlowQueryLog
 
 	// Vars
 	maxResultSize    sync2.AtomicInt64
@@ -153,6 +157,13 @@ type QueryEngine struct {
 	consolidatorMode            string
 	enableQueryPlanFieldCaching bool
 
+	// enableAuditLog, auditTables and auditExcludedTables decide which
+	// statements are sent to the audit log. An empty auditTables means
+	// that all tables are audited.
+	enableAuditLog      bool
+	auditTables         map[string]bool
+	auditExcludedTables map[string]bool
+
 	// stats
 	queryCounts, queryTimes, queryRowCounts, queryErrorCounts *stats.CountersWithMultiLabels
 
@@ -179,12 +190,23 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
 	qe.txSerializer = txserializer.New(env)
+	qe.slowQueries = NewSlowQueryLog(env, qe.explain)
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
 
 	qe.strictTransTables = config.EnforceStrictTransTables
 
+	qe.enableAuditLog = config.EnableAuditLog
+	qe.auditTables = make(map[string]bool)
+	for _, table := range config.AuditLogTables {
+		qe.auditTables[table] = true
+	}
+	qe.auditExcludedTables = make(map[string]bool)
+	for _, table := range config.AuditLogExcludedTables {
+		qe.auditExcludedTables[table] = true
+	}
+
 	if config.TableACLExemptACL != "" {
 		if f, err := tableacl.GetCurrentACLFactory(); err == nil {
 			if exemptACL, err := f.New([]string{config.TableACLExemptACL}); err == nil {
@@ -229,10 +251,25 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	env.Exporter().HandleFunc("/debug/query_rules", qe.handleHTTPQueryRules)
 	env.Exporter().HandleFunc("/debug/consolidations", qe.handleHTTPConsolidations)
 	env.Exporter().HandleFunc("/debug/acl", qe.handleHTTPAclJSON)
+	env.Exporter().HandleFunc("/debug/slowqueryz", func(w http.ResponseWriter, r *http.Request) {
+		slowqueryzHandler(qe.slowQueries, w, r)
+	})
+	env.Exporter().HandleFunc("/debug/slowqueryz/json", func(w http.ResponseWriter, r *http.Request) {
+		slowqueryzJSONHandler(qe.slowQueries, w, r)
+	})
 
 	return qe
 }
 
+// shouldAudit returns true if changes to the table must be sent to
+// the audit log.
+func (qe *QueryEngine) shouldAudit(table string) bool {
+	if !qe.enableAuditLog || qe.auditExcludedTables[table] {
+		return false
+	}
+	return len(qe.auditTables) == 0 || qe.auditTables[table]
+}
+
 // Open must be called before sending requests to QueryEngine.
 func (qe *QueryEngine) Open() error {
 	if qe.isOpen {
@@ -280,6 +317,17 @@ func (qe *QueryEngine) Close() {
 	log.Info("Query Engine: closed")
 }
 
+// explain executes an EXPLAIN statement on a connection from the
+// regular pool. It is used for explaining slow queries.
+func (qe *QueryEngine) explain(ctx context.Context, sql string) (*sqltypes.Result, error) {
+	conn, err := qe.conns.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	return conn.Exec(ctx, sql, 1, false)
+}
+
 // GetPlan returns the TabletPlan that for the query. Plans are cached in a cache.LRUCache.
 func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats, sql string, skipQueryPlanCache bool, isReservedConn bool) (*TabletPlan, error) {
 	span, ctx := trace.NewSpan(ctx, "QueryEngine.GetPlan")
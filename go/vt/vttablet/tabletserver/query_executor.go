@@ -39,6 +39,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -81,6 +82,8 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			tableName = "Join"
 		}
 
+		qre.tsv.qe.slowQueries.Record(qre.query, tableName, qre.logStats, duration, reply, err)
+
 		if reply == nil {
 			qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, 0, 1)
 			qre.plan.AddStats(1, duration, mysqlTime, 0, 1)
@@ -142,6 +145,13 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	case planbuilder.PlanSavepoint, planbuilder.PlanRelease, planbuilder.PlanSRollback:
 		return qre.execOther()
 	case planbuilder.PlanInsert, planbuilder.PlanUpdate, planbuilder.PlanDelete, planbuilder.PlanInsertMessage, planbuilder.PlanDDL, planbuilder.PlanLoad:
+		if _, audit := qre.auditTable(); audit && qre.plan.PKSelectQuery != nil {
+			// The primary keys must be captured in the same transaction
+			// as the change itself. This turns an autocommit UPDATE or
+			// DELETE on an audited table into an explicit transaction,
+			// which holds the row locks of the select until the commit.
+			return qre.execAsTransaction(qre.txConnExec)
+		}
 		return qre.execAutocommit(qre.txConnExec)
 	case planbuilder.PlanUpdateLimit, planbuilder.PlanDeleteLimit:
 		return qre.execAsTransaction(qre.txConnExec)
@@ -194,6 +204,13 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *StatefulConnection) (*s
 }
 
 func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result, error) {
+	if table, audit := qre.auditTable(); audit {
+		return qre.execAudited(conn, table)
+	}
+	return qre.txConnExecPlan(conn)
+}
+
+func (qre *QueryExecutor) txConnExecPlan(conn *StatefulConnection) (*sqltypes.Result, error) {
 	switch qre.plan.PlanID {
 	case planbuilder.PlanInsert, planbuilder.PlanUpdate, planbuilder.PlanDelete:
 		return qre.txFetch(conn, true)
@@ -228,13 +245,131 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "%s unexpected plan type", qre.plan.PlanID.String())
 }
 
+// auditTable returns the table changed by the statement, and whether
+// the statement must be sent to the audit log.
+func (qre *QueryExecutor) auditTable() (string, bool) {
+	switch qre.plan.PlanID {
+	case planbuilder.PlanInsert, planbuilder.PlanInsertMessage, planbuilder.PlanUpdate, planbuilder.PlanUpdateLimit,
+		planbuilder.PlanDelete, planbuilder.PlanDeleteLimit, planbuilder.PlanDDL, planbuilder.PlanLoad:
+	default:
+		return "", false
+	}
+	table := qre.plan.TableName().String()
+	if table == "" && len(qre.plan.Permissions) > 0 {
+		table = qre.plan.Permissions[0].TableName
+	}
+	return table, qre.tsv.qe.shouldAudit(table)
+}
+
+// execAudited executes a DML or DDL and records the change in the audit log.
+// Records of statements executed in a transaction are held back until the
+// transaction concludes. DDLs are not transactional and are sent right away.
+func (qre *QueryExecutor) execAudited(conn *StatefulConnection, table string) (*sqltypes.Result, error) {
+	sql := qre.query
+	if qre.plan.FullQuery != nil {
+		sql = qre.plan.FullQuery.Query
+	}
+	record := tx.NewAuditRecord(qre.ctx, qre.plan.PlanID.String(), table, sql, conn.ID())
+	if qre.plan.PKSelectQuery != nil {
+		if err := qre.fetchAuditPKs(conn, record); err != nil {
+			return nil, err
+		}
+	}
+	qr, err := qre.txConnExecPlan(conn)
+	if err != nil {
+		return nil, err
+	}
+	if qre.plan.InsertPKValues != nil {
+		if err := qre.addAuditInsertPKs(record); err != nil {
+			return nil, err
+		}
+	}
+	record.RowsAffected = qr.RowsAffected
+	record.InsertID = qr.InsertID
+	if qre.plan.PlanID == planbuilder.PlanDDL || !conn.IsInTransaction() {
+		tabletenv.AuditLogger.Send(record)
+	} else {
+		conn.TxProperties().RecordAudit(record)
+	}
+	return qr, nil
+}
+
+// fetchAuditPKs captures the primary keys of the rows that the DML
+// is about to change. The select locks the rows, so the keys cannot
+// change before the DML executes.
+func (qre *QueryExecutor) fetchAuditPKs(conn *StatefulConnection, record *tx.AuditRecord) error {
+	maxrows := qre.tsv.qe.maxResultSize.Get()
+	if qre.plan.PlanID == planbuilder.PlanUpdateLimit || qre.plan.PlanID == planbuilder.PlanDeleteLimit {
+		// The select shares the row limit of the DML.
+		qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
+	}
+	sql, _, err := qre.generateFinalSQL(qre.plan.PKSelectQuery, qre.bindVars)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	qr, err := conn.Exec(qre.ctx, sql, int(maxrows), false)
+	qre.logStats.AddRewrittenSQL(sql, start)
+	if err != nil {
+		if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERVitessMaxRowsExceeded {
+			record.PrimaryKeysTruncated = true
+			return nil
+		}
+		return err
+	}
+	record.AddPrimaryKeys(qre.auditPKColumns(), qr.Rows)
+	return nil
+}
+
+// addAuditInsertPKs records the primary keys of the rows supplied
+// by an INSERT.
+func (qre *QueryExecutor) addAuditInsertPKs(record *tx.AuditRecord) error {
+	var rows [][]sqltypes.Value
+	for i, pv := range qre.plan.InsertPKValues {
+		list, err := pv.ResolveList(qre.bindVars)
+		if err != nil {
+			return err
+		}
+		if rows == nil {
+			rows = make([][]sqltypes.Value, len(list))
+			for j := range rows {
+				rows[j] = make([]sqltypes.Value, len(qre.plan.InsertPKValues))
+			}
+		}
+		if len(list) != len(rows) {
+			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "mismatched number of primary key values: %d vs %d", len(list), len(rows))
+		}
+		for j, val := range list {
+			rows[j][i] = val
+		}
+	}
+	record.AddPrimaryKeys(qre.auditPKColumns(), rows)
+	return nil
+}
+
+// auditPKColumns returns the names of the primary key columns of the table.
+func (qre *QueryExecutor) auditPKColumns() []string {
+	pkColumns := make([]string, len(qre.plan.Table.PKColumns))
+	for i, col := range qre.plan.Table.PKColumns {
+		pkColumns[i] = qre.plan.Table.Fields[col].Name
+	}
+	return pkColumns
+}
+
 // Stream performs a streaming query execution.
-func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
+func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) (err error) {
 	qre.logStats.PlanType = qre.plan.PlanID.String()
 
 	defer func(start time.Time) {
-		qre.tsv.stats.QueryTimings.Record(qre.plan.PlanID.String(), start)
-		qre.recordUserQuery("Stream", int64(time.Since(start)))
+		duration := time.Since(start)
+		qre.tsv.stats.QueryTimings.Add(qre.plan.PlanID.String(), duration)
+		qre.recordUserQuery("Stream", int64(duration))
+
+		tableName := qre.plan.TableName().String()
+		if tableName == "" {
+			tableName = "Join"
+		}
+		qre.tsv.qe.slowQueries.Record(qre.query, tableName, qre.logStats, duration, nil, err)
 	}(time.Now())
 
 	if err := qre.checkPermissions(); err != nil {
@@ -283,6 +283,72 @@ func TestQueryExecutorPlans(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorAuditLog(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("select pk from test_table where pk in (1, 2) limit 10001 for update", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("pk", "int32"),
+		"1",
+		"2",
+	))
+	db.AddQuery("update test_table set addr = 3 where pk in (1, 2) limit 10001", &sqltypes.Result{RowsAffected: 2})
+	db.AddQuery("alter table test_table add zipcode int", &sqltypes.Result{})
+	db.AddQuery("insert into test_table(pk, name) values (3, 'a'), (4, 'b')", &sqltypes.Result{RowsAffected: 2})
+	ctx := callerid.NewContext(
+		context.Background(),
+		callerid.NewEffectiveCallerID("eff", "", ""),
+		callerid.NewImmediateCallerID("imm"),
+	)
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.enableAuditLog = true
+
+	ch := tabletenv.AuditLogger.Subscribe("test")
+	defer tabletenv.AuditLogger.Unsubscribe(ch)
+
+	qre := newTestQueryExecutor(ctx, tsv, "update test_table set addr = 3 where pk in (1, 2)", 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "begin; select pk from test_table where pk in (1, 2) limit 10001 for update; update test_table set addr = 3 where pk in (1, 2) limit 10001; commit", qre.logStats.RewrittenSQL())
+
+	record := (<-ch).(*tx.AuditRecord)
+	assert.Equal(t, "eff", record.EffectiveCaller)
+	assert.Equal(t, "imm", record.ImmediateCaller)
+	assert.Equal(t, "UpdateLimit", record.PlanType)
+	assert.Equal(t, "test_table", record.Table)
+	assert.Equal(t, uint64(2), record.RowsAffected)
+	assert.Equal(t, []map[string]string{{"pk": "1"}, {"pk": "2"}}, record.PrimaryKeys)
+	assert.Equal(t, "commit", record.Conclusion)
+
+	qre = newTestQueryExecutor(ctx, tsv, "insert into test_table(pk, name) values (3, 'a'), (4, 'b')", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	record = (<-ch).(*tx.AuditRecord)
+	assert.Equal(t, "Insert", record.PlanType)
+	assert.Equal(t, uint64(2), record.RowsAffected)
+	assert.Equal(t, []map[string]string{{"pk": "3"}, {"pk": "4"}}, record.PrimaryKeys)
+
+	qre = newTestQueryExecutor(ctx, tsv, "alter table test_table add zipcode int", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	record = (<-ch).(*tx.AuditRecord)
+	assert.Equal(t, "DDL", record.PlanType)
+	assert.Equal(t, "test_table", record.Table)
+	assert.Equal(t, "alter table test_table add zipcode int", record.SQL)
+
+	// Excluded tables are not audited.
+	tsv.qe.auditExcludedTables["test_table"] = true
+	qre = newTestQueryExecutor(ctx, tsv, "update test_table set addr = 3 where pk in (1, 2)", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "begin; update test_table set addr = 3 where pk in (1, 2) limit 10001; commit", qre.logStats.RewrittenSQL())
+	select {
+	case record := <-ch:
+		t.Errorf("unexpected audit record: %v", record)
+	default:
+	}
+}
+
 // TestQueryExecutorSelectImpossible is separate because it's a special case
 // because the "in transaction" case is a no-op.
 func TestQueryExecutorSelectImpossible(t *testing.T) {
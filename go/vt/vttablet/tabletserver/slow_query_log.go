@@ -0,0 +1,250 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+const (
+	// explainTimeout bounds the time spent explaining a slow query.
+	explainTimeout = 5 * time.Second
+
+	// maxSampledQueries bounds the number of normalized queries for
+	// which the time of the last capture is remembered.
+	maxSampledQueries = 10000
+)
+
+// SlowQuery is a query that exceeded the slow query threshold,
+// along with the plan that MySQL reported for it.
+type SlowQuery struct {
+	Time            time.Time
+	Method          string
+	PlanType        string
+	Table           string
+	OriginalSQL     string
+	ExplainedSQL    string `json:",omitempty"`
+	EffectiveCaller string
+	ImmediateCaller string
+	TotalTime       time.Duration
+	MysqlTime       time.Duration
+	ConnWaitTime    time.Duration
+	RowsAffected    int
+	RowsReturned    int
+	TransactionID   int64
+	ReservedID      int64
+	Error           string          `json:",omitempty"`
+	Explain         json.RawMessage `json:",omitempty"`
+	ExplainError    string          `json:",omitempty"`
+}
+
+// explainFunc executes an EXPLAIN statement against MySQL.
+type explainFunc func(ctx context.Context, sql string) (*sqltypes.Result, error)
+
+// SlowQueryLog keeps the most recent slow queries in a bounded ring.
+// Every captured query is explained in the background with
+// EXPLAIN FORMAT=JSON. To limit the load on MySQL, a normalized query is
+// captured at most once per sample interval, and only one EXPLAIN
+// runs at a time.
+type SlowQueryLog struct {
+	threshold      time.Duration
+	sampleInterval time.Duration
+	explain        explainFunc
+
+	slowQueries *stats.CountersWithMultiLabels
+	explains    *stats.CountersWithSingleLabel
+
+	explaining sync2.AtomicBool
+
+	mu      sync.Mutex
+	entries []*SlowQuery
+	next    int
+	sampled map[string]time.Time
+}
+
+// NewSlowQueryLog creates a new SlowQueryLog. The log is disabled if the
+// threshold or the size is not positive.
+func NewSlowQueryLog(env tabletenv.Env, explain explainFunc) *SlowQueryLog {
+	config := env.Config()
+	sl := &SlowQueryLog{
+		threshold:      config.SlowQueryThresholdSeconds.Get(),
+		sampleInterval: config.SlowQuerySampleIntervalSeconds.Get(),
+		explain:        explain,
+		sampled:        make(map[string]time.Time),
+	}
+	if config.SlowQueryLogSize > 0 {
+		sl.entries = make([]*SlowQuery, config.SlowQueryLogSize)
+	}
+	sl.slowQueries = env.Exporter().NewCountersWithMultiLabels("SlowQueries", "Queries that exceeded the slow query threshold", []string{"Table", "Plan"})
+	sl.explains = env.Exporter().NewCountersWithSingleLabel("SlowQueryExplains", "Outcome of explaining captured slow queries", "Result")
+	return sl
+}
+
+// Enabled returns true if slow queries are being captured.
+func (sl *SlowQueryLog) Enabled() bool {
+	return sl.threshold > 0 && len(sl.entries) > 0
+}
+
+// Record captures the query if it took longer than the threshold and
+// it was not captured within the last sample interval. The query is the
+// normalized query used for sampling.
+func (sl *SlowQueryLog) Record(query, table string, logStats *tabletenv.LogStats, duration time.Duration, reply *sqltypes.Result, err error) {
+	if !sl.Enabled() || duration < sl.threshold {
+		return
+	}
+	sl.slowQueries.Add([]string{table, logStats.PlanType}, 1)
+
+	now := time.Now()
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	if last, ok := sl.sampled[query]; ok && now.Sub(last) < sl.sampleInterval {
+		return
+	}
+	sl.markSampled(query, now)
+
+	entry := &SlowQuery{
+		Time:            now,
+		Method:          logStats.Method,
+		PlanType:        logStats.PlanType,
+		Table:           table,
+		OriginalSQL:     logStats.OriginalSQL,
+		EffectiveCaller: logStats.EffectiveCaller(),
+		ImmediateCaller: logStats.ImmediateCaller(),
+		TotalTime:       duration,
+		MysqlTime:       logStats.MysqlResponseTime,
+		ConnWaitTime:    logStats.WaitingForConnection,
+		TransactionID:   logStats.TransactionID,
+		ReservedID:      logStats.ReservedID,
+	}
+	if reply != nil {
+		entry.RowsAffected = int(reply.RowsAffected)
+		entry.RowsReturned = len(reply.Rows)
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if *streamlog.RedactDebugUIQueries {
+		entry.OriginalSQL, _ = sqlparser.RedactSQLQuery(entry.OriginalSQL)
+	}
+	sl.entries[sl.next] = entry
+	sl.next = (sl.next + 1) % len(sl.entries)
+
+	explained := explainableSQL(logStats.RewrittenSQLs())
+	if explained == "" {
+		return
+	}
+	if *streamlog.RedactDebugUIQueries {
+		entry.ExplainedSQL, _ = sqlparser.RedactSQLQuery(explained)
+	} else {
+		entry.ExplainedSQL = explained
+	}
+	if !sl.explaining.CompareAndSwap(false, true) {
+		entry.ExplainError = "skipped: another explain was in progress"
+		sl.explains.Add("Skipped", 1)
+		return
+	}
+	go sl.runExplain(entry, explained)
+}
+
+// markSampled records that the query was captured. It must be called
+// with the lock held.
+func (sl *SlowQueryLog) markSampled(query string, now time.Time) {
+	if len(sl.sampled) >= maxSampledQueries {
+		for k, last := range sl.sampled {
+			if now.Sub(last) >= sl.sampleInterval {
+				delete(sl.sampled, k)
+			}
+		}
+		if len(sl.sampled) >= maxSampledQueries {
+			sl.sampled = make(map[string]time.Time)
+		}
+	}
+	sl.sampled[query] = now
+}
+
+func (sl *SlowQueryLog) runExplain(entry *SlowQuery, explained string) {
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), explainTimeout)
+	defer cancel()
+	plan, err := sl.explainPlan(ctx, explained)
+
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	defer sl.explaining.Set(false)
+	if err != nil {
+		log.Warningf("Could not explain slow query: %v", err)
+		entry.ExplainError = err.Error()
+		sl.explains.Add("Error", 1)
+		return
+	}
+	entry.Explain = plan
+	sl.explains.Add("Success", 1)
+}
+
+func (sl *SlowQueryLog) explainPlan(ctx context.Context, explained string) (json.RawMessage, error) {
+	qr, err := sl.explain(ctx, "explain format=json "+explained)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return nil, fmt.Errorf("unexpected explain result: %v", qr.Rows)
+	}
+	plan := qr.Rows[0][0].ToBytes()
+	if !json.Valid(plan) {
+		return nil, fmt.Errorf("explain returned invalid json: %s", plan)
+	}
+	return json.RawMessage(plan), nil
+}
+
+// Entries returns a copy of the captured slow queries, most recent first.
+func (sl *SlowQueryLog) Entries() []SlowQuery {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	var entries []SlowQuery
+	for i := 1; i <= len(sl.entries); i++ {
+		entry := sl.entries[(sl.next-i+len(sl.entries))%len(sl.entries)]
+		if entry == nil {
+			break
+		}
+		entries = append(entries, *entry)
+	}
+	return entries
+}
+
+// explainableSQL returns the last statement MySQL can explain among the
+// ones executed for a query. Statements like begin and commit are skipped.
+func explainableSQL(sqls []string) string {
+	for i := len(sqls) - 1; i >= 0; i-- {
+		switch sqlparser.Preview(sqls[i]) {
+		case sqlparser.StmtSelect, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+			return sqls[i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const testExplain = `{"query_block": {"select_id": 1, "table": {"table_name": "t1", "access_type": "ALL"}}}`
+
+func newTestSlowQueryLog(size int, explain explainFunc) *SlowQueryLog {
+	config := tabletenv.NewDefaultConfig()
+	config.SlowQueryThresholdSeconds.Set(100 * time.Millisecond)
+	config.SlowQuerySampleIntervalSeconds.Set(time.Minute)
+	config.SlowQueryLogSize = size
+	return NewSlowQueryLog(tabletenv.NewEnv(config, "SlowQueryLogTest"), explain)
+}
+
+func newSlowLogStats(sql string, rewritten ...string) *tabletenv.LogStats {
+	logStats := tabletenv.NewLogStats(context.Background(), "Execute")
+	logStats.PlanType = "Select"
+	logStats.OriginalSQL = sql
+	for _, r := range rewritten {
+		logStats.AddRewrittenSQL(r, time.Now())
+	}
+	return logStats
+}
+
+// waitForExplain waits until the most recent entry is done being explained.
+func waitForExplain(t *testing.T, sl *SlowQueryLog) SlowQuery {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(time.Millisecond) {
+		entries := sl.Entries()
+		require.NotEmpty(t, entries)
+		if entries[0].Explain != nil || entries[0].ExplainError != "" {
+			return entries[0]
+		}
+	}
+	t.Fatal("timed out waiting for explain")
+	return SlowQuery{}
+}
+
+func TestSlowQueryLogRecord(t *testing.T) {
+	var explained []string
+	sl := newTestSlowQueryLog(2, func(ctx context.Context, sql string) (*sqltypes.Result, error) {
+		explained = append(explained, sql)
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("EXPLAIN", "varchar"), testExplain), nil
+	})
+	require.True(t, sl.Enabled())
+
+	// Fast queries are not captured.
+	sl.Record("select * from t1", "t1", newSlowLogStats("select * from t1", "select * from t1 limit 10001"), 10*time.Millisecond, nil, nil)
+	assert.Empty(t, sl.Entries())
+
+	reply := &sqltypes.Result{RowsAffected: 3, Rows: [][]sqltypes.Value{{}, {}, {}}}
+	sl.Record("select * from t1", "t1", newSlowLogStats("select * from t1", "select * from t1 limit 10001"), time.Second, reply, nil)
+	entry := waitForExplain(t, sl)
+	assert.Equal(t, "select * from t1", entry.OriginalSQL)
+	assert.Equal(t, "select * from t1 limit 10001", entry.ExplainedSQL)
+	assert.Equal(t, "t1", entry.Table)
+	assert.Equal(t, time.Second, entry.TotalTime)
+	assert.Equal(t, 3, entry.RowsAffected)
+	assert.Equal(t, 3, entry.RowsReturned)
+	assert.JSONEq(t, testExplain, string(entry.Explain))
+	assert.Equal(t, []string{"explain format=json select * from t1 limit 10001"}, explained)
+
+	// The same query is sampled only once per interval.
+	sl.Record("select * from t1", "t1", newSlowLogStats("select * from t1", "select * from t1 limit 10001"), time.Second, nil, nil)
+	assert.Len(t, sl.Entries(), 1)
+
+	// Transaction statements are not explained, and the ring is bounded.
+	sl.Record("update t1 set a = 1", "t1", newSlowLogStats("update t1 set a = 1", "begin", "update t1 set a = 1", "commit"), time.Second, nil, errors.New("deadlock"))
+	entry = waitForExplain(t, sl)
+	assert.Equal(t, "update t1 set a = 1", entry.ExplainedSQL)
+	assert.Equal(t, "deadlock", entry.Error)
+	sl.Record("show tables", "", newSlowLogStats("show tables", "show tables"), time.Second, nil, nil)
+	entries := sl.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "show tables", entries[0].OriginalSQL)
+	assert.Equal(t, "", entries[0].ExplainedSQL)
+	assert.Nil(t, entries[0].Explain)
+	assert.Equal(t, "update t1 set a = 1", entries[1].OriginalSQL)
+}
+
+func TestSlowQueryLogExplainError(t *testing.T) {
+	sl := newTestSlowQueryLog(10, func(ctx context.Context, sql string) (*sqltypes.Result, error) {
+		return nil, fmt.Errorf("explain failed")
+	})
+	sl.Record("select * from t1", "t1", newSlowLogStats("select * from t1", "select * from t1"), time.Second, nil, nil)
+	entry := waitForExplain(t, sl)
+	assert.Nil(t, entry.Explain)
+	assert.Equal(t, "explain failed", entry.ExplainError)
+}
+
+func TestSlowQueryLogDisabled(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	sl := NewSlowQueryLog(tabletenv.NewEnv(config, "SlowQueryLogTest"), nil)
+	assert.False(t, sl.Enabled())
+	sl.Record("select * from t1", "t1", newSlowLogStats("select * from t1", "select * from t1"), time.Hour, nil, nil)
+	assert.Empty(t, sl.Entries())
+}
+
+func TestSlowQueryLogStreamExecute(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	tsv.qe.slowQueries = newTestSlowQueryLog(10, func(ctx context.Context, sql string) (*sqltypes.Result, error) {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("EXPLAIN", "varchar"), testExplain), nil
+	})
+	tsv.qe.slowQueries.threshold = time.Nanosecond
+
+	// Streaming queries are captured like the non-streaming ones.
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary("row01")}},
+	})
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	err := tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, nil, func(*sqltypes.Result) error { return nil })
+	require.NoError(t, err)
+	entry := waitForExplain(t, tsv.qe.slowQueries)
+	assert.Equal(t, "StreamExecute", entry.Method)
+	assert.Equal(t, "SelectStream", entry.PlanType)
+	assert.Equal(t, "test_table", entry.Table)
+	assert.Equal(t, executeSQL, entry.OriginalSQL)
+	assert.Equal(t, executeSQL, entry.ExplainedSQL)
+	assert.JSONEq(t, testExplain, string(entry.Explain))
+
+	// Failed streams are captured with their error.
+	failedSQL := "select * from test_table where pk = 1"
+	db.AddRejectedQuery(failedSQL, errors.New("stream failed"))
+	err = tsv.StreamExecute(ctx, &target, failedSQL, nil, 0, nil, func(*sqltypes.Result) error { return nil })
+	require.Error(t, err)
+	entries := tsv.qe.slowQueries.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, failedSQL, entries[0].OriginalSQL)
+	assert.Contains(t, entries[0].Error, "stream failed")
+}
+
+func TestSlowqueryzHandler(t *testing.T) {
+	sl := newTestSlowQueryLog(10, func(ctx context.Context, sql string) (*sqltypes.Result, error) {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("EXPLAIN", "varchar"), testExplain), nil
+	})
+	sl.Record("select * from t1", "t1", newSlowLogStats("select * from t1", "select * from t1"), 2*time.Second, nil, nil)
+	waitForExplain(t, sl)
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/debug/slowqueryz", nil)
+	slowqueryzHandler(sl, resp, req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Contains(t, string(body), `<tr class="high">`)
+	assert.Contains(t, string(body), "<td>select * from t1</td>")
+	assert.Contains(t, string(body), "access_type")
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/debug/slowqueryz/json", nil)
+	slowqueryzJSONHandler(sl, resp, req)
+	var entries []SlowQuery
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&entries))
+	require.Len(t, entries, 1)
+	assert.Equal(t, "select * from t1", entries[0].OriginalSQL)
+	assert.True(t, strings.Contains(string(entries[0].Explain), "query_block"))
+}
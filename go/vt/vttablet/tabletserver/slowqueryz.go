@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logz"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	slowqueryzHeader = []byte(`<thead>
+		<tr>
+			<th>Time</th>
+			<th>Method</th>
+			<th>Effective Caller</th>
+			<th>Immediate Caller</th>
+			<th>Plan</th>
+			<th>Table</th>
+			<th>Duration</th>
+			<th>MySQL time</th>
+			<th>Conn wait</th>
+			<th>RowsAffected</th>
+			<th>Rows</th>
+			<th>SQL</th>
+			<th>Explained SQL</th>
+			<th>Error</th>
+			<th>Explain</th>
+		</tr>
+        </thead>
+	`)
+	slowqueryzFuncMap = template.FuncMap{
+		"stampMicro":    func(t time.Time) string { return t.Format(time.StampMicro) },
+		"truncateQuery": sqlparser.TruncateForUI,
+		"indentJSON":    indentJSON,
+	}
+	slowqueryzTmpl = template.Must(template.New("example").Funcs(slowqueryzFuncMap).Parse(`
+		<tr class="{{.Color}}">
+			<td>{{.Time | stampMicro}}</td>
+			<td>{{.Method}}</td>
+			<td>{{.EffectiveCaller}}</td>
+			<td>{{.ImmediateCaller}}</td>
+			<td>{{.PlanType}}</td>
+			<td>{{.Table}}</td>
+			<td>{{.TotalTime.Seconds}}</td>
+			<td>{{.MysqlTime.Seconds}}</td>
+			<td>{{.ConnWaitTime.Seconds}}</td>
+			<td>{{.RowsAffected}}</td>
+			<td>{{.RowsReturned}}</td>
+			<td>{{.OriginalSQL | truncateQuery}}</td>
+			<td>{{.ExplainedSQL | truncateQuery}}</td>
+			<td>{{.Error}}</td>
+			<td>{{if .Explain}}<pre>{{.Explain | indentJSON}}</pre>{{else}}{{.ExplainError}}{{end}}</td>
+		</tr>
+	`))
+)
+
+// slowqueryzRow is used for rendering a slow query
+// using go's template.
+type slowqueryzRow struct {
+	SlowQuery
+	Color string
+}
+
+// slowqueryzHandler serves the captured slow queries as an HTML table.
+func slowqueryzHandler(sl *SlowQueryLog, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	logz.StartHTMLTable(w)
+	defer logz.EndHTMLTable(w)
+	w.Write(slowqueryzHeader)
+
+	for _, entry := range sl.Entries() {
+		row := slowqueryzRow{SlowQuery: entry}
+		switch {
+		case entry.TotalTime < 100*time.Millisecond:
+			row.Color = "low"
+		case entry.TotalTime < time.Second:
+			row.Color = "medium"
+		default:
+			row.Color = "high"
+		}
+		if err := slowqueryzTmpl.Execute(w, row); err != nil {
+			log.Errorf("slowqueryz: couldn't execute template: %v", err)
+		}
+	}
+}
+
+// slowqueryzJSONHandler serves the captured slow queries as JSON.
+func slowqueryzJSONHandler(sl *SlowQueryLog, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	entries := sl.Entries()
+	if entries == nil {
+		entries = []SlowQuery{}
+	}
+	b, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		w.Write([]byte(err.Error()))
+		return
+	}
+	w.Write(b)
+}
+
+func indentJSON(in json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, in, "", "  "); err != nil {
+		return string(in)
+	}
+	return buf.String()
+}
@@ -262,6 +262,10 @@ func (sc *StatefulConnection) LogTransaction(reason tx.ReleaseReason) {
 	if sc.txProps.LogToFile {
 		log.Infof("Logged transaction: %s", sc.String())
 	}
+	for _, record := range sc.txProps.AuditRecords {
+		record.Conclusion = reason.Name()
+		tabletenv.AuditLogger.Send(record)
+	}
 	tabletenv.TxLogger.Send(sc)
 }
 
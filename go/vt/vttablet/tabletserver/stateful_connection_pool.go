@@ -73,6 +73,13 @@ func NewStatefulConnPool(env tabletenv.Env) *StatefulConnectionPool {
 	}
 }
 
+// SetLoadFunc sets the function that reports the load of MySQL to the
+// underlying connection pools. It must be called before Open.
+func (sf *StatefulConnectionPool) SetLoadFunc(load pools.LoadFunc) {
+	sf.conns.SetLoadFunc(load)
+	sf.foundRowsPool.SetLoadFunc(load)
+}
+
 // Open makes the TxPool operational. This also starts the transaction killer
 // that will kill long-running transactions.
 func (sf *StatefulConnectionPool) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector) {
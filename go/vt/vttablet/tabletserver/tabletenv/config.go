@@ -48,6 +48,7 @@ var (
 
 	queryLogHandler = flag.String("query-log-stream-handler", "/debug/querylog", "URL handler for streaming queries log")
 	txLogHandler    = flag.String("transaction-log-stream-handler", "/debug/txlog", "URL handler for streaming transactions log")
+	auditLogHandler = flag.String("audit-log-stream-handler", "/debug/auditlog", "URL handler for streaming the audit log of data changes")
 
 	// TxLogger can be used to enable logging of transactions.
 	// Call TxLogger.ServeLogs in your main program to enable logging.
@@ -57,6 +58,11 @@ var (
 	// StatsLogger is the main stream logger object
 	StatsLogger = streamlog.New("TabletServer", 50)
 
+	// AuditLogger receives an AuditRecord for every audited DML or DDL.
+	// The buffer is larger than for the other loggers because records
+	// are dropped if a subscriber falls behind.
+	AuditLogger = streamlog.New("AuditLog", 1000)
+
 	// Placeholder for deprecated variable.
 	// TODO(sougou): deprecate the flags after release 7.0.
 	deprecatedMessagePoolPrefillParallelism int
@@ -84,12 +90,22 @@ var (
 func init() {
 	flag.IntVar(&currentConfig.OltpReadPool.Size, "queryserver-config-pool-size", defaultConfig.OltpReadPool.Size, "query server read pool size, connection pool is used by regular queries (non streaming, not in a transaction)")
 	flag.IntVar(&currentConfig.OltpReadPool.PrefillParallelism, "queryserver-config-pool-prefill-parallelism", defaultConfig.OltpReadPool.PrefillParallelism, "query server read pool prefill parallelism, a non-zero value will prefill the pool using the specified parallism.")
+	flag.IntVar(&currentConfig.OltpReadPool.MinSize, "queryserver-config-pool-min-size", defaultConfig.OltpReadPool.MinSize, "query server read pool min size. If set together with -queryserver-config-pool-max-size, the pool is resized between the two values based on wait times, waiters and MySQL load.")
+	flag.IntVar(&currentConfig.OltpReadPool.MaxSize, "queryserver-config-pool-max-size", defaultConfig.OltpReadPool.MaxSize, "query server read pool max size. See -queryserver-config-pool-min-size.")
 	flag.IntVar(&currentConfig.OlapReadPool.Size, "queryserver-config-stream-pool-size", defaultConfig.OlapReadPool.Size, "query server stream connection pool size, stream pool is used by stream queries: queries that return results to client in a streaming fashion")
 	flag.IntVar(&currentConfig.OlapReadPool.PrefillParallelism, "queryserver-config-stream-pool-prefill-parallelism", defaultConfig.OlapReadPool.PrefillParallelism, "query server stream pool prefill parallelism, a non-zero value will prefill the pool using the specified parallelism")
 	flag.IntVar(&deprecatedMessagePoolSize, "queryserver-config-message-conn-pool-size", 0, "DEPRECATED")
 	flag.IntVar(&deprecatedMessagePoolPrefillParallelism, "queryserver-config-message-conn-pool-prefill-parallelism", 0, "DEPRECATED: Unused.")
 	flag.IntVar(&currentConfig.TxPool.Size, "queryserver-config-transaction-cap", defaultConfig.TxPool.Size, "query server transaction cap is the maximum number of transactions allowed to happen at any given point of a time for a single vttablet. E.g. by setting transaction cap to 100, there are at most 100 transactions will be processed by a vttablet and the 101th transaction will be blocked (and fail if it cannot get connection within specified timeout)")
 	flag.IntVar(&currentConfig.TxPool.PrefillParallelism, "queryserver-config-transaction-prefill-parallelism", defaultConfig.TxPool.PrefillParallelism, "query server transaction prefill parallelism, a non-zero value will prefill the pool using the specified parallism.")
+	flag.IntVar(&currentConfig.TxPool.MinSize, "queryserver-config-transaction-min-cap", defaultConfig.TxPool.MinSize, "query server transaction pool min size. If set together with -queryserver-config-transaction-max-cap, the pool is resized between the two values based on wait times, waiters and MySQL load.")
+	flag.IntVar(&currentConfig.TxPool.MaxSize, "queryserver-config-transaction-max-cap", defaultConfig.TxPool.MaxSize, "query server transaction pool max size. See -queryserver-config-transaction-min-cap.")
+	SecondsVar(&currentConfig.PoolResize.IntervalSeconds, "queryserver-config-pool-resize-interval", defaultConfig.PoolResize.IntervalSeconds, "how often (in seconds) adaptive connection pools are evaluated for resizing")
+	flag.IntVar(&currentConfig.PoolResize.Step, "queryserver-config-pool-resize-step", defaultConfig.PoolResize.Step, "number of connections added to or removed from an adaptive connection pool by a single resize")
+	flag.IntVar(&currentConfig.PoolResize.Hysteresis, "queryserver-config-pool-resize-hysteresis", defaultConfig.PoolResize.Hysteresis, "number of consecutive evaluations that must agree before an adaptive connection pool is resized")
+	SecondsVar(&currentConfig.PoolResize.WaitThresholdSeconds, "queryserver-config-pool-resize-wait-threshold", defaultConfig.PoolResize.WaitThresholdSeconds, "average wait (in seconds) for a connection above which an adaptive connection pool grows")
+	flag.IntVar(&currentConfig.PoolResize.WaitersThreshold, "queryserver-config-pool-resize-waiters-threshold", defaultConfig.PoolResize.WaitersThreshold, "number of waiters for a connection at which an adaptive connection pool grows. 0 disables the check.")
+	flag.IntVar(&currentConfig.PoolResize.MaxThreadsRunning, "queryserver-config-pool-resize-max-threads-running", defaultConfig.PoolResize.MaxThreadsRunning, "MySQL Threads_running at which adaptive connection pools shrink instead of growing. Requires -enable-lag-throttler, which samples Threads_running. 0 disables the check.")
 	flag.IntVar(&currentConfig.MessagePostponeParallelism, "queryserver-config-message-postpone-cap", defaultConfig.MessagePostponeParallelism, "query server message postpone cap is the maximum number of messages that can be postponed at any given time. Set this number to substantially lower than transaction cap, so that the transaction pool isn't exhausted by the message subsystem.")
 	flag.IntVar(&deprecatedFoundRowsPoolSize, "client-found-rows-pool-size", 0, "DEPRECATED: queryserver-config-transaction-cap will be used instead.")
 	SecondsVar(&currentConfig.Oltp.TxTimeoutSeconds, "queryserver-config-transaction-timeout", defaultConfig.Oltp.TxTimeoutSeconds, "query server transaction timeout (in seconds), a transaction will be killed if it takes longer than this value")
@@ -123,6 +139,7 @@ func init() {
 	flag.BoolVar(&currentConfig.TwoPCEnable, "twopc_enable", defaultConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&currentConfig.TwoPCCoordinatorAddress, "twopc_coordinator_address", defaultConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
 	SecondsVar(&currentConfig.TwoPCAbandonAge, "twopc_abandon_age", defaultConfig.TwoPCAbandonAge, "time in seconds. Any unresolved transaction older than this time will be sent to the coordinator to be resolved.")
+	flag.BoolVar(&currentConfig.TwoPCAutoResolve, "twopc_auto_resolve", defaultConfig.TwoPCAutoResolve, "if the flag is on, abandoned transactions are resolved by the metadata manager itself, by calling CommitPrepared or RollbackPrepared on the participants, instead of being sent to the coordinator.")
 	flag.BoolVar(&currentConfig.EnableTxThrottler, "enable-tx-throttler", defaultConfig.EnableTxThrottler, "If true replication-lag-based throttling on transactions will be enabled.")
 	flag.StringVar(&currentConfig.TxThrottlerConfig, "tx-throttler-config", defaultConfig.TxThrottlerConfig, "The configuration of the transaction throttler as a text formatted throttlerdata.Configuration protocol buffer message")
 	flagutil.StringListVar(&currentConfig.TxThrottlerHealthCheckCells, "tx-throttler-healthcheck-cells", defaultConfig.TxThrottlerHealthCheckCells, "A comma-separated list of cells. Only tabletservers running in these cells will be monitored for replication lag by the transaction throttler.")
@@ -156,6 +173,15 @@ func init() {
 	flag.DurationVar(&transitionGracePeriod, "serving_state_grace_period", 0, "how long to pause after broadcasting health to vtgate, before enforcing a new serving state")
 
 	flag.BoolVar(&enableReplicationReporter, "enable_replication_reporter", false, "Use polling to track replication lag.")
+
+	flag.BoolVar(&currentConfig.EnableAuditLog, "enable_audit_log", defaultConfig.EnableAuditLog, "If true, vttablet sends a structured record of every DML and DDL, including the caller and the affected primary keys, to the audit log. Autocommit UPDATEs and DELETEs on audited tables run in an explicit transaction, so that the affected primary keys can be locked and captured first.")
+	flag.StringVar(&currentConfig.AuditLogFile, "audit_log_file", defaultConfig.AuditLogFile, "If set, the audit log is written to this file as JSON, one record per line. The file is reopened on SIGUSR2 to allow rotation.")
+	flagutil.StringListVar(&currentConfig.AuditLogTables, "audit_log_tables", defaultConfig.AuditLogTables, "A comma-separated list of tables to audit. If empty, all tables are audited.")
+	flagutil.StringListVar(&currentConfig.AuditLogExcludedTables, "audit_log_excluded_tables", defaultConfig.AuditLogExcludedTables, "A comma-separated list of tables that are never audited.")
+
+	SecondsVar(&currentConfig.SlowQueryThresholdSeconds, "slow_query_threshold", defaultConfig.SlowQueryThresholdSeconds, "Queries that take longer than this many seconds are captured, along with their MySQL EXPLAIN, on /debug/slowqueryz. 0 disables the capture.")
+	flag.IntVar(&currentConfig.SlowQueryLogSize, "slow_query_log_size", defaultConfig.SlowQueryLogSize, "Number of slow queries kept in memory for /debug/slowqueryz.")
+	SecondsVar(&currentConfig.SlowQuerySampleIntervalSeconds, "slow_query_sample_interval", defaultConfig.SlowQuerySampleIntervalSeconds, "A normalized query is captured as slow at most once per this many seconds.")
 }
 
 // Init must be called after flag.Parse, and before doing any other operations.
@@ -220,6 +246,17 @@ func Init() {
 	if *txLogHandler != "" {
 		TxLogger.ServeLogs(*txLogHandler, streamlog.GetFormatter(TxLogger))
 	}
+
+	if currentConfig.EnableAuditLog {
+		if *auditLogHandler != "" {
+			AuditLogger.ServeLogs(*auditLogHandler, streamlog.GetFormatter(AuditLogger))
+		}
+		if currentConfig.AuditLogFile != "" {
+			if _, err := AuditLogger.LogToFile(currentConfig.AuditLogFile, streamlog.GetFormatter(AuditLogger)); err != nil {
+				log.Exitf("Cannot open audit log file %v: %v", currentConfig.AuditLogFile, err)
+			}
+		}
+	}
 }
 
 // TabletConfig contains all the configuration for query service
@@ -238,6 +275,8 @@ type TabletConfig struct {
 
 	ReplicationTracker ReplicationTrackerConfig `json:"replicationTracker,omitempty"`
 
+	PoolResize PoolResizeConfig `json:"poolResize,omitempty"`
+
 	// Consolidator can be enable, disable, or notOnMaster. Default is enable.
 	Consolidator                string  `json:"consolidator,omitempty"`
 	PassthroughDML              bool    `json:"passthroughDML,omitempty"`
@@ -258,6 +297,7 @@ type TabletConfig struct {
 	TwoPCEnable             bool    `json:"-"`
 	TwoPCCoordinatorAddress string  `json:"-"`
 	TwoPCAbandonAge         Seconds `json:"-"`
+	TwoPCAutoResolve        bool    `json:"-"`
 
 	EnableTxThrottler           bool     `json:"-"`
 	TxThrottlerConfig           string   `json:"-"`
@@ -268,6 +308,10 @@ type TabletConfig struct {
 	TransactionLimitConfig `json:"-"`
 
 	EnforceStrictTransTables bool `json:"-"`
+
+	AuditLogConfig `json:"-"`
+
+	SlowQueryConfig `json:"-"`
 }
 
 // ConnPoolConfig contains the config for a conn pool.
@@ -277,6 +321,20 @@ type ConnPoolConfig struct {
 	IdleTimeoutSeconds Seconds `json:"idleTimeoutSeconds,omitempty"`
 	PrefillParallelism int     `json:"prefillParallelism,omitempty"`
 	MaxWaiters         int     `json:"maxWaiters,omitempty"`
+	// MinSize and MaxSize enable adaptive sizing of the pool between
+	// the two values, starting at Size. Both are 0 for a fixed size pool.
+	MinSize int `json:"minSize,omitempty"`
+	MaxSize int `json:"maxSize,omitempty"`
+}
+
+// PoolResizeConfig contains the config for adaptive sizing of conn pools.
+type PoolResizeConfig struct {
+	IntervalSeconds      Seconds `json:"intervalSeconds,omitempty"`
+	Step                 int     `json:"step,omitempty"`
+	Hysteresis           int     `json:"hysteresis,omitempty"`
+	WaitThresholdSeconds Seconds `json:"waitThresholdSeconds,omitempty"`
+	WaitersThreshold     int     `json:"waitersThreshold,omitempty"`
+	MaxThreadsRunning    int     `json:"maxThreadsRunning,omitempty"`
 }
 
 // OltpConfig contains the config for oltp settings.
@@ -329,6 +387,21 @@ type TransactionLimitConfig struct {
 	TransactionLimitBySubcomponent bool
 }
 
+// AuditLogConfig captures configuration of the audit log of data changes.
+type AuditLogConfig struct {
+	EnableAuditLog         bool
+	AuditLogFile           string
+	AuditLogTables         []string
+	AuditLogExcludedTables []string
+}
+
+// SlowQueryConfig captures configuration of the slow query capture.
+type SlowQueryConfig struct {
+	SlowQueryThresholdSeconds      Seconds
+	SlowQueryLogSize               int
+	SlowQuerySampleIntervalSeconds Seconds
+}
+
 // NewCurrentConfig returns a copy of the current config.
 func NewCurrentConfig() *TabletConfig {
 	return currentConfig.Clone()
@@ -365,6 +438,24 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if err := c.OltpReadPool.verifyAdaptiveSize("-queryserver-config-pool"); err != nil {
+		return err
+	}
+	if err := c.TxPool.verifyAdaptiveSize("-queryserver-config-transaction"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// verifyAdaptiveSize checks that the min and max sizes of the pool are
+// either both unset, or bound its size.
+func (c *ConnPoolConfig) verifyAdaptiveSize(name string) error {
+	if c.MinSize == 0 && c.MaxSize == 0 {
+		return nil
+	}
+	if c.MinSize <= 0 || c.MinSize > c.Size || c.Size > c.MaxSize {
+		return fmt.Errorf("%s min and max sizes must satisfy 0 < min <= size <= max (specified values: %v, %v, %v)", name, c.MinSize, c.Size, c.MaxSize)
+	}
 	return nil
 }
 
@@ -430,6 +521,13 @@ var defaultConfig = TabletConfig{
 		Mode:                     Disable,
 		HeartbeatIntervalSeconds: 0.25,
 	},
+	PoolResize: PoolResizeConfig{
+		IntervalSeconds:      1,
+		Step:                 1,
+		Hysteresis:           3,
+		WaitThresholdSeconds: 0.01,
+		WaitersThreshold:     1,
+	},
 	HotRowProtection: HotRowProtectionConfig{
 		Mode: Disable,
 		// Default value is the same as TxPool.Size.
@@ -461,6 +559,11 @@ var defaultConfig = TabletConfig{
 	TransactionLimitConfig: defaultTransactionLimitConfig(),
 
 	EnforceStrictTransTables: true,
+
+	SlowQueryConfig: SlowQueryConfig{
+		SlowQueryLogSize:               100,
+		SlowQuerySampleIntervalSeconds: 60,
+	},
 }
 
 // defaultTxThrottlerConfig formats the default throttlerdata.Configuration
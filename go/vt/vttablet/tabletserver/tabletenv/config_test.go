@@ -74,6 +74,7 @@ oltpReadPool:
   prefillParallelism: 30
   size: 16
   timeoutSeconds: 10
+poolResize: {}
 replicationTracker: {}
 txPool: {}
 `
@@ -129,6 +130,12 @@ oltpReadPool:
   idleTimeoutSeconds: 1800
   maxWaiters: 5000
   size: 16
+poolResize:
+  hysteresis: 3
+  intervalSeconds: 1
+  step: 1
+  waitThresholdSeconds: 0.01
+  waitersThreshold: 1
 queryCacheSize: 5000
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
@@ -188,6 +195,13 @@ func TestFlags(t *testing.T) {
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
 		},
+		PoolResize: PoolResizeConfig{
+			IntervalSeconds:      1,
+			Step:                 1,
+			Hysteresis:           3,
+			WaitThresholdSeconds: 0.01,
+			WaitersThreshold:     1,
+		},
 		StreamBufferSize:            32768,
 		QueryCacheSize:              5000,
 		SchemaReloadIntervalSeconds: 1800,
@@ -202,7 +216,11 @@ func TestFlags(t *testing.T) {
 			TransactionLimitByPrincipal: true,
 		},
 		EnforceStrictTransTables: true,
-		DB:                       &dbconfigs.DBConfigs{},
+		SlowQueryConfig: SlowQueryConfig{
+			SlowQueryLogSize:               100,
+			SlowQuerySampleIntervalSeconds: 60,
+		},
+		DB: &dbconfigs.DBConfigs{},
 	}
 	assert.Equal(t, want.DB, currentConfig.DB)
 	assert.Equal(t, want, currentConfig)
@@ -118,6 +118,11 @@ func (stats *LogStats) RewrittenSQL() string {
 	return strings.Join(stats.rewrittenSqls, "; ")
 }
 
+// RewrittenSQLs returns the list of SQL statements that were executed.
+func (stats *LogStats) RewrittenSQLs() []string {
+	return stats.rewrittenSqls
+}
+
 // SizeOfResponse returns the approximate size of the response in
 // bytes (this does not take in account protocol encoding). It will return
 // 0 for streaming requests.
@@ -168,6 +168,9 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
 	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
 	tsv.te = NewTxEngine(tsv)
+	if config.TwoPCAutoResolve {
+		tsv.te.resolver = NewTxResolver(tsv, tsv.te, topoServer)
+	}
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
 	tabletTypeFunc := func() topodatapb.TabletType {
@@ -178,6 +181,9 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	}
 	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc)
 	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tabletTypeFunc)
+	// Adaptive connection pools shrink when the throttler sees MySQL overloaded.
+	tsv.qe.conns.SetLoadFunc(tsv.lagThrottler.ThreadsRunning)
+	tsv.te.txPool.scp.SetLoadFunc(tsv.lagThrottler.ThreadsRunning)
 	tsv.tableGC = gc.NewTableGC(tsv, topoServer, tabletTypeFunc, tsv.lagThrottler)
 
 	tsv.sm = &stateManager{
@@ -921,14 +927,14 @@ func (tsv *TabletServer) beginWaitForSameRangeTransactions(ctx context.Context,
 		"", "waitForSameRangeTransactions", nil,
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
-			k, table := tsv.computeTxSerializerKey(ctx, logStats, sql, bindVariables)
-			if k == "" {
+			keys, table, kind := tsv.computeTxSerializerKeys(ctx, logStats, sql, bindVariables)
+			if len(keys) == 0 {
 				// Query is not subject to tx serialization/hot row protection.
 				return nil
 			}
 
 			startTime := time.Now()
-			done, waited, waitErr := tsv.qe.txSerializer.Wait(ctx, k, table)
+			done, waited, waitErr := tsv.qe.txSerializer.WaitForKeys(ctx, keys, table, kind)
 			txDone = done
 			if waited {
 				tsv.stats.WaitTimings.Record("TxSerializer", startTime)
@@ -939,43 +945,118 @@ func (tsv *TabletServer) beginWaitForSameRangeTransactions(ctx context.Context,
 	return txDone, err
 }
 
-// computeTxSerializerKey returns a unique string ("key") used to determine
-// whether two queries would update the same row (range).
-// Additionally, it returns the table name (needed for updating stats vars).
-// It returns an empty string as key if the row (range) cannot be parsed from
-// the query and bind variables or the table name is empty.
-func (tsv *TabletServer) computeTxSerializerKey(ctx context.Context, logStats *tabletenv.LogStats, sql string, bindVariables map[string]*querypb.BindVariable) (string, string) {
+// maxTxSerializerKeys is the maximum number of rows of a single query
+// for which hot row protection acquires one slot per row. Larger queries
+// fall back to a single slot for their WHERE clause.
+const maxTxSerializerKeys = 100
+
+// computeTxSerializerKeys returns the unique strings ("keys") used to
+// determine whether two queries would update the same rows (range).
+// If the rows are targeted by their primary key, there is one key per row.
+// Additionally, it returns the table name and the kind of the query
+// (needed for updating stats vars).
+// It returns no keys if the rows (range) cannot be parsed from the query
+// and bind variables or the table name is empty.
+func (tsv *TabletServer) computeTxSerializerKeys(ctx context.Context, logStats *tabletenv.LogStats, sql string, bindVariables map[string]*querypb.BindVariable) ([]string, string, string) {
 	// Strip trailing comments so we don't pollute the query cache.
 	sql, _ = sqlparser.SplitMarginComments(sql)
 	plan, err := tsv.qe.GetPlan(ctx, logStats, sql, false /* skipQueryPlanCache */, false /* isReservedConn */)
 	if err != nil {
 		logComputeRowSerializerKey.Errorf("failed to get plan for query: %v err: %v", sql, err)
-		return "", ""
+		return nil, "", ""
 	}
 
 	switch plan.PlanID {
-	// Serialize only UPDATE or DELETE queries.
+	// Serialize only UPDATE or DELETE queries, and upserts.
 	case planbuilder.PlanUpdate, planbuilder.PlanUpdateLimit,
 		planbuilder.PlanDelete, planbuilder.PlanDeleteLimit:
+	case planbuilder.PlanInsert:
+		if plan.PKValues == nil {
+			return nil, "", ""
+		}
 	default:
-		return "", ""
+		return nil, "", ""
 	}
 
 	tableName := plan.TableName()
-	if tableName.IsEmpty() || plan.WhereClause == nil {
-		// Do not serialize any queries without table name or where clause
-		return "", ""
+	if tableName.IsEmpty() {
+		return nil, "", ""
+	}
+
+	if plan.PKValues != nil {
+		keys, err := txSerializerPKKeys(plan, bindVariables)
+		if err != nil {
+			logComputeRowSerializerKey.Errorf("failed to resolve primary key values: %v query: %v bind vars: %v", err, sql, bindVariables)
+		}
+		if keys != nil {
+			kind := txserializer.KindWhere
+			switch {
+			case plan.PlanID == planbuilder.PlanInsert:
+				kind = txserializer.KindUpsert
+			case len(keys) > 1 || plan.PKValues[0].ListKey != "":
+				kind = txserializer.KindPKList
+			}
+			return keys, tableName.String(), kind
+		}
+		if plan.PlanID == planbuilder.PlanInsert {
+			return nil, "", ""
+		}
+	}
+
+	if plan.WhereClause == nil {
+		// Do not serialize any queries without where clause
+		return nil, "", ""
 	}
 
 	where, err := plan.WhereClause.GenerateQuery(bindVariables, nil)
 	if err != nil {
 		logComputeRowSerializerKey.Errorf("failed to substitute bind vars in where clause: %v query: %v bind vars: %v", err, sql, bindVariables)
-		return "", ""
+		return nil, "", ""
 	}
 
 	// Example: table1 where id = 1 and sub_id = 2
 	key := fmt.Sprintf("%s%s", tableName, where)
-	return key, tableName.String()
+	return []string{key}, tableName.String(), txserializer.KindWhere
+}
+
+// txSerializerPKKeys returns one key per row targeted by the primary key
+// values of the plan. The keys have the same format as the WHERE clause
+// based ones, e.g. "table1 where id = 1 and sub_id = 2".
+// It returns nil if there are too many rows.
+func txSerializerPKKeys(plan *TabletPlan, bindVariables map[string]*querypb.BindVariable) ([]string, error) {
+	rows := -1
+	values := make([][]sqltypes.Value, len(plan.PKValues))
+	for i, pv := range plan.PKValues {
+		list, err := pv.ResolveList(bindVariables)
+		if err != nil {
+			return nil, err
+		}
+		if rows != -1 && len(list) != rows {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "mismatched number of primary key values: %d vs %d", len(list), rows)
+		}
+		rows = len(list)
+		values[i] = list
+	}
+	if rows <= 0 || rows > maxTxSerializerKeys {
+		return nil, nil
+	}
+
+	keys := make([]string, rows)
+	buf := &strings.Builder{}
+	for row := range keys {
+		buf.Reset()
+		fmt.Fprintf(buf, "%s where ", plan.TableName())
+		for i := range values {
+			if i != 0 {
+				buf.WriteString(" and ")
+			}
+			buf.WriteString(plan.Table.GetPKColumn(i).Name)
+			buf.WriteString(" = ")
+			values[i][row].EncodeSQL(buf)
+		}
+		keys[row] = buf.String()
+	}
+	return keys, nil
 }
 
 // BeginExecuteBatch combines Begin and ExecuteBatch.
@@ -49,6 +49,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -1252,6 +1253,58 @@ func TestSerializeTransactionsSameRow_ConcurrentTransactions(t *testing.T) {
 	}
 }
 
+func TestComputeTxSerializerKeys(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Enable
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	testcases := []struct {
+		sql  string
+		bv   map[string]*querypb.BindVariable
+		keys []string
+		kind string
+	}{{
+		sql:  "update test_table set name_string = 'a' where pk = :pk and name = :name",
+		bv:   map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1), "name": sqltypes.Int64BindVariable(1)},
+		keys: []string{"test_table where pk = 1 and name = 1"},
+		kind: txserializer.KindWhere,
+	}, {
+		sql:  "update test_table set name_string = 'a' where pk = :pk",
+		bv:   map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1)},
+		keys: []string{"test_table where pk = 1"},
+		kind: txserializer.KindWhere,
+	}, {
+		sql:  "delete from test_table where pk in ::pks",
+		bv:   map[string]*querypb.BindVariable{"pks": sqltypes.TestBindVariable([]interface{}{3, 1})},
+		keys: []string{"test_table where pk = 3", "test_table where pk = 1"},
+		kind: txserializer.KindPKList,
+	}, {
+		sql:  "insert into test_table(pk, name) values (1, 2), (:pk, 3) on duplicate key update name = name + 1",
+		bv:   map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(5)},
+		keys: []string{"test_table where pk = 1", "test_table where pk = 5"},
+		kind: txserializer.KindUpsert,
+	}, {
+		sql: "insert into test_table(pk, name) values (1, 2)",
+	}, {
+		sql: "insert into test_table(name) values (2) on duplicate key update name = name + 1",
+	}, {
+		sql: "select * from test_table where pk = 1",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.sql, func(t *testing.T) {
+			logStats := tabletenv.NewLogStats(ctx, "TxSerializerKeys")
+			keys, table, kind := tsv.computeTxSerializerKeys(ctx, logStats, tc.sql, tc.bv)
+			assert.Equal(t, tc.keys, keys)
+			assert.Equal(t, tc.kind, kind)
+			if tc.keys != nil {
+				assert.Equal(t, "test_table", table)
+			}
+		})
+	}
+}
+
 func waitForTxSerializationPendingQueries(tsv *TabletServer, key string, i int) error {
 	start := time.Now()
 	for {
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	// throttleCheckDuration controls both how frequently the throttler is checked, as well as
+	// how long to sleep if throttler blocks us
+	throttleCheckDuration = 250 * time.Millisecond
+)
+
+// Client is used by in-process apps which wish to consult with the throttler.
+// It encapsulates the check and backoff logic. A nil Client never throttles.
+// A Client is not safe for concurrent use.
+type Client struct {
+	throttler *Throttler
+	appName   string
+	flags     CheckFlags
+
+	lastSuccessfulCheck time.Time
+}
+
+// NewBackgroundClient creates a client for a background app, such as vreplication.
+// Background apps have low priority, and back off when normal apps are being throttled.
+func NewBackgroundClient(throttler *Throttler, appName string) *Client {
+	if throttler == nil {
+		return nil
+	}
+	return &Client{
+		throttler: throttler,
+		appName:   appName,
+		flags: CheckFlags{
+			LowPriority: true,
+		},
+	}
+}
+
+// ThrottleCheckOK checks the throttler, and returns 'true' when the app may proceed.
+// A successful check is reused for throttleCheckDuration.
+func (c *Client) ThrottleCheckOK(ctx context.Context) bool {
+	if c == nil {
+		return true
+	}
+	if time.Since(c.lastSuccessfulCheck) < throttleCheckDuration {
+		return true
+	}
+	checkResult := c.throttler.Check(ctx, c.appName, "", &c.flags)
+	if checkResult.StatusCode != http.StatusOK {
+		return false
+	}
+	c.lastSuccessfulCheck = time.Now()
+	return true
+}
+
+// Throttle blocks until the throttler allows the app to proceed, or until the
+// context is done. It returns the time spent throttled.
+func (c *Client) Throttle(ctx context.Context) (throttled time.Duration, err error) {
+	if c.ThrottleCheckOK(ctx) {
+		return 0, nil
+	}
+	start := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return time.Since(start), ctx.Err()
+		case <-time.After(throttleCheckDuration):
+		}
+		if c.ThrottleCheckOK(ctx) {
+			return time.Since(start), nil
+		}
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newTestThrottler(lag float64) *Throttler {
+	config := tabletenv.NewDefaultConfig()
+	config.EnableLagThrottler = true
+	throttler := NewThrottler(tabletenv.NewEnv(config, "ThrottlerTest"), nil, func() topodatapb.TabletType {
+		return topodatapb.TabletType_REPLICA
+	})
+	throttler.mysqlClusterThresholds.Set(localStoreName, 1.0, cache.DefaultExpiration)
+	throttler.aggregatedMetrics.Set("mysql/"+localStoreName, base.NewSimpleMetricResult(lag), cache.DefaultExpiration)
+	return throttler
+}
+
+func TestClientThrottleCheckOK(t *testing.T) {
+	ctx := context.Background()
+
+	var nilClient *Client
+	assert.True(t, nilClient.ThrottleCheckOK(ctx))
+	assert.Nil(t, NewBackgroundClient(nil, "test"))
+
+	assert.True(t, NewBackgroundClient(newTestThrottler(0.5), "test").ThrottleCheckOK(ctx))
+	assert.False(t, NewBackgroundClient(newTestThrottler(5), "test").ThrottleCheckOK(ctx))
+
+	// Throttled apps are rejected regardless of the lag.
+	throttler := newTestThrottler(0.5)
+	throttler.ThrottleApp("vreplication:wf", time.Now().Add(time.Hour), defaultThrottleRatio)
+	assert.False(t, NewBackgroundClient(throttler, "vreplication:wf").ThrottleCheckOK(ctx))
+	assert.True(t, NewBackgroundClient(throttler, "vreplication:other").ThrottleCheckOK(ctx))
+}
+
+func TestClientThrottle(t *testing.T) {
+	throttler := newTestThrottler(5)
+	client := NewBackgroundClient(throttler, "test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*throttleCheckDuration)
+	defer cancel()
+	throttled, err := client.Throttle(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, throttled > 0)
+
+	// Once the lag recovers, the client proceeds.
+	go func() {
+		time.Sleep(throttleCheckDuration)
+		throttler.aggregatedMetrics.Set("mysql/"+localStoreName, base.NewSimpleMetricResult(0), cache.DefaultExpiration)
+	}()
+	throttled, err = client.Throttle(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, throttled >= throttleCheckDuration)
+
+	// The successful check is reused.
+	throttled, err = client.Throttle(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, throttled)
+}
@@ -24,6 +24,7 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
@@ -43,6 +44,7 @@ const (
 	aggregatedMetricsExpiration   = 5 * time.Second
 	aggregatedMetricsCleanup      = 1 * time.Second
 	throttledAppsSnapshotInterval = 5 * time.Second
+	threadsRunningCollectInterval = 1 * time.Second
 	recentAppsExpiration          = time.Hour * 24
 
 	nonDeprioritizedAppMapExpiration = time.Second
@@ -55,6 +57,8 @@ const (
 	maxPasswordLength = 32
 
 	localStoreName = "local"
+
+	threadsRunningMetricName = "local/threads_running"
 )
 
 var throttleThreshold = flag.Duration("throttle_threshold", 1*time.Second, "Replication lag threshold for throttling")
@@ -72,6 +76,7 @@ var (
 		`GRANT SELECT ON _vt.heartbeat TO %s`,
 	}
 	replicationLagQuery = `select unix_timestamp(now(6))-max(ts/1000000000) from _vt.heartbeat`
+	threadsRunningQuery = `show global status like 'threads_running'`
 )
 
 func init() {
@@ -109,6 +114,8 @@ type Throttler struct {
 
 	lastCheckTimeNano int64
 
+	threadsRunningInProgress int64
+
 	initMutex          sync.Mutex
 	throttledAppsMutex sync.Mutex
 	tickers            [](*timer.SuspendableTicker)
@@ -338,6 +345,7 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 	mysqlRefreshTicker := addTicker(mysqlRefreshInterval)
 	mysqlAggregateTicker := addTicker(mysqlAggregateInterval)
 	throttledAppsTicker := addTicker(throttledAppsSnapshotInterval)
+	threadsRunningTicker := addTicker(threadsRunningCollectInterval)
 
 	shouldCreateThrottlerUser := false
 	for {
@@ -424,6 +432,13 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 					go throttler.expireThrottledApps()
 				}
 			}
+		case <-threadsRunningTicker.C:
+			{
+				// all tablet types: the local server's load is used to size its own connection pools
+				if atomic.LoadInt64(&throttler.isOpen) > 0 {
+					go throttler.collectThreadsRunning(ctx)
+				}
+			}
 		}
 	}
 }
@@ -454,6 +469,36 @@ func (throttler *Throttler) collectMySQLMetrics(ctx context.Context) error {
 	return nil
 }
 
+// collectThreadsRunning reads Threads_running from the local MySQL server
+func (throttler *Throttler) collectThreadsRunning(ctx context.Context) {
+	if !atomic.CompareAndSwapInt64(&throttler.threadsRunningInProgress, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt64(&throttler.threadsRunningInProgress, 0)
+
+	conn, err := throttler.pool.Get(ctx)
+	if err != nil {
+		return
+	}
+	defer conn.Recycle()
+	qr, err := conn.Exec(ctx, threadsRunningQuery, 1, false)
+	if err != nil || len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return
+	}
+	value, err := evalengine.ToFloat64(qr.Rows[0][1])
+	if err != nil {
+		return
+	}
+	throttler.aggregatedMetrics.Set(threadsRunningMetricName, base.NewSimpleMetricResult(value), cache.DefaultExpiration)
+}
+
+// ThreadsRunning returns the number of running threads on the local MySQL server,
+// as last sampled by the throttler. ok is false if there is no recent sample.
+func (throttler *Throttler) ThreadsRunning() (value float64, ok bool) {
+	value, err := throttler.getNamedMetric(threadsRunningMetricName).Get()
+	return value, err == nil
+}
+
 // refreshMySQLInventory will re-structure the inventory based on reading config settings, and potentially
 // re-querying dynamic data such as HAProxy list of hosts
 func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
@@ -57,6 +57,10 @@ type (
 		Conclusion      string
 		LogToFile       bool
 
+		// AuditRecords are sent to the audit log once the transaction
+		// concludes, so that they can record its outcome.
+		AuditRecords []*AuditRecord
+
 		Stats *servenv.TimingsWrapper
 	}
 )
@@ -121,6 +125,14 @@ func (p *Properties) RecordQuery(query string) {
 	p.Queries = append(p.Queries, query)
 }
 
+// RecordAudit holds back the audit record until the transaction concludes.
+func (p *Properties) RecordAudit(record *AuditRecord) {
+	if p == nil {
+		return
+	}
+	p.AuditRecords = append(p.AuditRecords, record)
+}
+
 // InTransaction returns true as soon as this struct is not nil
 func (p *Properties) InTransaction() bool { return p != nil }
 
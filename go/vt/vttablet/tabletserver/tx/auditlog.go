@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tx
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+)
+
+// AuditRecord is a structured record of a single data or schema change
+// applied by the tabletserver. Records are sent to tabletenv.AuditLogger.
+type AuditRecord struct {
+	Time            time.Time
+	EffectiveCaller string
+	ImmediateCaller string
+	PlanType        string
+	Table           string
+	SQL             string
+	TransactionID   int64
+	RowsAffected    uint64
+	InsertID        uint64              `json:",omitempty"`
+	PrimaryKeys     []map[string]string `json:",omitempty"`
+	// PrimaryKeysTruncated is set if the statement matched more rows
+	// than the max result size and not all primary keys were captured.
+	PrimaryKeysTruncated bool `json:",omitempty"`
+	// Conclusion is the outcome of the enclosing transaction. It is
+	// empty for statements that were not part of a transaction.
+	Conclusion string `json:",omitempty"`
+}
+
+// NewAuditRecord returns an AuditRecord for the callers found in ctx.
+func NewAuditRecord(ctx context.Context, planType, table, sql string, transactionID int64) *AuditRecord {
+	return &AuditRecord{
+		Time:            time.Now(),
+		EffectiveCaller: callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)),
+		ImmediateCaller: callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx)),
+		PlanType:        planType,
+		Table:           table,
+		SQL:             sql,
+		TransactionID:   transactionID,
+	}
+}
+
+// AddPrimaryKeys records the primary key values of the affected rows.
+// The fields must be the primary key columns, in the order of the row values.
+func (record *AuditRecord) AddPrimaryKeys(fields []string, rows [][]sqltypes.Value) {
+	for _, row := range rows {
+		pk := make(map[string]string, len(fields))
+		for i, field := range fields {
+			pk[field] = row[i].ToString()
+		}
+		record.PrimaryKeys = append(record.PrimaryKeys, pk)
+	}
+}
+
+// EventTime returns the time the change was applied.
+func (record *AuditRecord) EventTime() time.Time {
+	return record.Time
+}
+
+// Logf formats the record as a single line of JSON. Unlike the query log,
+// the audit log is never redacted or filtered.
+func (record *AuditRecord) Logf(w io.Writer, params url.Values) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	_, err = w.Write(b)
+	return err
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tx
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+)
+
+func TestAuditRecordFormat(t *testing.T) {
+	ctx := callerid.NewContext(
+		context.Background(),
+		callerid.NewEffectiveCallerID("eff", "", ""),
+		callerid.NewImmediateCallerID("imm"),
+	)
+	record := NewAuditRecord(ctx, "Update", "t1", "update t1 set a = :a where id in ::ids", 5)
+	record.Time = time.Date(2017, time.January, 1, 1, 2, 3, 0, time.UTC)
+	record.RowsAffected = 2
+	record.AddPrimaryKeys([]string{"id"}, [][]sqltypes.Value{
+		{sqltypes.NewInt64(1)},
+		{sqltypes.NewInt64(2)},
+	})
+	record.Conclusion = "commit"
+
+	var b bytes.Buffer
+	require.NoError(t, record.Logf(&b, nil))
+	want := `{"Time":"2017-01-01T01:02:03Z","EffectiveCaller":"eff","ImmediateCaller":"imm","PlanType":"Update","Table":"t1","SQL":"update t1 set a = :a where id in ::ids","TransactionID":5,"RowsAffected":2,"PrimaryKeys":[{"id":"1"},{"id":"2"}],"Conclusion":"commit"}` + "\n"
+	assert.Equal(t, want, b.String())
+}
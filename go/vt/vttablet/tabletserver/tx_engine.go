@@ -83,6 +83,9 @@ type TxEngine struct {
 	coordinatorAddress  string
 	abandonAge          time.Duration
 	ticks               *timer.Timer
+	// resolver, if set, resolves abandoned transactions in place
+	// of the coordinator.
+	resolver *TxResolver
 
 	// reservedConnStats keeps statistics about reserved connections
 	reservedConnStats *servenv.TimingsWrapper
@@ -104,7 +107,7 @@ func NewTxEngine(env tabletenv.Env) *TxEngine {
 	te.txPool = NewTxPool(env, limiter)
 	te.twopcEnabled = config.TwoPCEnable
 	if te.twopcEnabled {
-		if config.TwoPCCoordinatorAddress == "" {
+		if config.TwoPCCoordinatorAddress == "" && !config.TwoPCAutoResolve {
 			log.Error("Coordinator address not specified: Disabling 2PC")
 			te.twopcEnabled = false
 		}
@@ -425,7 +428,8 @@ func (te *TxEngine) rollbackPrepared() {
 }
 
 // startWatchdog starts the watchdog goroutine, which looks for abandoned
-// transactions and calls the notifier on them.
+// transactions and calls the notifier on them. If a resolver is set,
+// the transactions are resolved directly instead.
 func (te *TxEngine) startWatchdog() {
 	te.ticks.Start(func() {
 		ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), te.abandonAge/4)
@@ -447,10 +451,16 @@ func (te *TxEngine) startWatchdog() {
 			log.Errorf("Error reading transactions for 2pc watchdog: %v", err)
 			return
 		}
+		te.env.Stats().Unresolved.Set("Abandoned", int64(len(txs)))
 		if len(txs) == 0 {
 			return
 		}
 
+		if te.resolver != nil {
+			te.resolver.ResolveAll(ctx, txs)
+			return
+		}
+
 		coordConn, err := vtgateconn.Dial(ctx, te.coordinatorAddress)
 		if err != nil {
 			te.env.Stats().InternalErrors.Add("WatchdogFail", 1)
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/events"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// participantDialer returns a connection to the master of a participant.
+type participantDialer func(ctx context.Context, target *querypb.Target) (queryservice.QueryService, error)
+
+// TxResolver resolves abandoned distributed transactions from the
+// metadata manager, without the help of a coordinator. It follows the
+// same protocol as the vtgate coordinator: a transaction in the PREPARE
+// state is rolled back, and transactions in the COMMIT or ROLLBACK state
+// are driven to completion on all participants before the metadata
+// is deleted.
+type TxResolver struct {
+	te   *TxEngine
+	dial participantDialer
+
+	resolutions *stats.CountersWithMultiLabels
+	timings     *servenv.TimingsWrapper
+}
+
+// NewTxResolver creates a TxResolver that finds the participants
+// through the topo server.
+func NewTxResolver(env tabletenv.Env, te *TxEngine, ts *topo.Server) *TxResolver {
+	return &TxResolver{
+		te:          te,
+		dial:        topoParticipantDialer(ts),
+		resolutions: env.Exporter().NewCountersWithMultiLabels("TwopcResolutions", "Abandoned distributed transactions resolved by the watchdog", []string{"Decision", "Result"}),
+		timings:     env.Exporter().NewTimings("TwopcResolutionTimings", "Time spent resolving abandoned distributed transactions", "Decision"),
+	}
+}
+
+// ResolveAll resolves the abandoned transactions concurrently.
+func (txr *TxResolver) ResolveAll(ctx context.Context, dtids map[string]time.Time) {
+	var wg sync.WaitGroup
+	for dtid := range dtids {
+		wg.Add(1)
+		go func(dtid string) {
+			defer wg.Done()
+			if err := txr.Resolve(ctx, dtid); err != nil {
+				txr.te.env.Stats().InternalErrors.Add("WatchdogFail", 1)
+				log.Errorf("Error resolving dtid %s: %v", dtid, err)
+			}
+		}(dtid)
+	}
+	wg.Wait()
+}
+
+// Resolve drives the distributed transaction to its conclusion.
+func (txr *TxResolver) Resolve(ctx context.Context, dtid string) error {
+	txe := &TxExecutor{
+		ctx:      ctx,
+		logStats: tabletenv.NewLogStats(ctx, "TwopcResolve"),
+		te:       txr.te,
+	}
+	transaction, err := txe.ReadTransaction(dtid)
+	if err != nil {
+		return err
+	}
+	if transaction.Dtid == "" {
+		// It was already resolved.
+		return nil
+	}
+
+	ev := &events.TwoPCResolution{
+		Dtid:         dtid,
+		State:        transaction.State,
+		Decision:     transaction.State,
+		Participants: transaction.Participants,
+	}
+	if ev.Decision == querypb.TransactionState_PREPARE {
+		// The commit decision was never recorded: roll back.
+		ev.Decision = querypb.TransactionState_ROLLBACK
+	}
+	decision := ev.Decision.String()
+	defer txr.timings.Record(decision, time.Now())
+	event.DispatchUpdate(ev, "resolving")
+
+	if err := txr.resolve(ctx, txe, transaction); err != nil {
+		txr.resolutions.Add([]string{decision, "Error"}, 1)
+		event.DispatchUpdate(ev, "failed: "+err.Error())
+		return err
+	}
+	txr.resolutions.Add([]string{decision, "Success"}, 1)
+	event.DispatchUpdate(ev, "resolved")
+	log.Infof("Resolved abandoned distributed transaction %s: %v", dtid, decision)
+	return nil
+}
+
+func (txr *TxResolver) resolve(ctx context.Context, txe *TxExecutor, transaction *querypb.TransactionMetadata) error {
+	dtid := transaction.Dtid
+	var action func(qs queryservice.QueryService, target *querypb.Target) error
+	switch transaction.State {
+	case querypb.TransactionState_PREPARE:
+		// Like vtgate, release the MM transaction if it's still
+		// open on this tablet before recording the decision.
+		mmShard, err := dtids.ShardSession(dtid)
+		if err != nil {
+			return err
+		}
+		if err := txe.SetRollback(dtid, mmShard.TransactionId); err != nil {
+			return err
+		}
+		fallthrough
+	case querypb.TransactionState_ROLLBACK:
+		action = func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.RollbackPrepared(ctx, target, dtid, 0)
+		}
+	case querypb.TransactionState_COMMIT:
+		action = func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.CommitPrepared(ctx, target, dtid)
+		}
+	default:
+		// Should never happen.
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid state: %v", transaction.State)
+	}
+	if err := txr.runParticipants(ctx, transaction.Participants, action); err != nil {
+		return err
+	}
+	return txe.ConcludeTransaction(dtid)
+}
+
+// runParticipants executes the action on all participants in parallel
+// and returns a consolidated error.
+func (txr *TxResolver) runParticipants(ctx context.Context, participants []*querypb.Target, action func(queryservice.QueryService, *querypb.Target) error) error {
+	allErrors := new(concurrency.AllErrorRecorder)
+	var wg sync.WaitGroup
+	for _, target := range participants {
+		wg.Add(1)
+		go func(target *querypb.Target) {
+			defer wg.Done()
+			qs, err := txr.dial(ctx, target)
+			if err != nil {
+				allErrors.RecordError(err)
+				return
+			}
+			defer qs.Close(ctx)
+			if err := action(qs, target); err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "%s/%s", target.Keyspace, target.Shard))
+			}
+		}(target)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+// topoParticipantDialer returns a participantDialer that connects
+// to the master of the participant shard, as recorded in the topo.
+func topoParticipantDialer(ts *topo.Server) participantDialer {
+	return func(ctx context.Context, target *querypb.Target) (queryservice.QueryService, error) {
+		si, err := ts.GetShard(ctx, target.Keyspace, target.Shard)
+		if err != nil {
+			return nil, err
+		}
+		if !si.HasMaster() {
+			return nil, fmt.Errorf("shard %s/%s has no master", target.Keyspace, target.Shard)
+		}
+		ti, err := ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "cannot read master %s", topoproto.TabletAliasString(si.MasterAlias))
+		}
+		return tabletconn.GetDialer()(ti.Tablet, grpcclient.FailFast(false))
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// fakeParticipant records the 2PC calls it receives.
+type fakeParticipant struct {
+	queryservice.QueryService
+
+	mu    sync.Mutex
+	calls []string
+	err   error
+}
+
+func (fp *fakeParticipant) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) error {
+	return fp.record("CommitPrepared", target, dtid)
+}
+
+func (fp *fakeParticipant) RollbackPrepared(ctx context.Context, target *querypb.Target, dtid string, originalID int64) error {
+	return fp.record("RollbackPrepared", target, dtid)
+}
+
+func (fp *fakeParticipant) Close(ctx context.Context) error {
+	return nil
+}
+
+func (fp *fakeParticipant) record(method string, target *querypb.Target, dtid string) error {
+	fp.mu.Lock()
+	defer fp.mu.Unlock()
+	fp.calls = append(fp.calls, fmt.Sprintf("%s %s/%s %s", method, target.Keyspace, target.Shard, dtid))
+	sort.Strings(fp.calls)
+	return fp.err
+}
+
+func newTestTxResolver(t *testing.T) (*TxResolver, *fakeParticipant, *TabletServer, *fakesqldb.DB) {
+	_, tsv, db := newTestTxExecutor(t)
+	fp := &fakeParticipant{}
+	txr := NewTxResolver(tsv, tsv.te, nil)
+	txr.dial = func(ctx context.Context, target *querypb.Target) (queryservice.QueryService, error) {
+		return fp, nil
+	}
+	return txr, fp, tsv, db
+}
+
+func addTransactionQueries(db *fakesqldb.DB, dtid string, state querypb.TransactionState) {
+	db.AddQuery(fmt.Sprintf("select dtid, state, time_created from _vt.dt_state where dtid = '%s'", dtid), &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary(dtid),
+			sqltypes.NewInt64(int64(state)),
+			sqltypes.NewVarBinary("1"),
+		}},
+	})
+	db.AddQuery(fmt.Sprintf("select keyspace, shard from _vt.dt_participant where dtid = '%s'", dtid), &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("test1"),
+			sqltypes.NewVarBinary("0"),
+		}, {
+			sqltypes.NewVarBinary("test2"),
+			sqltypes.NewVarBinary("1"),
+		}},
+	})
+	db.AddQuery(fmt.Sprintf("delete from _vt.dt_state where dtid = '%s'", dtid), &sqltypes.Result{})
+	db.AddQuery(fmt.Sprintf("delete from _vt.dt_participant where dtid = '%s'", dtid), &sqltypes.Result{})
+}
+
+func TestTxResolverCommit(t *testing.T) {
+	txr, fp, tsv, db := newTestTxResolver(t)
+	defer db.Close()
+	defer tsv.StopService()
+
+	addTransactionQueries(db, "test1:0:1", querypb.TransactionState_COMMIT)
+	require.NoError(t, txr.Resolve(ctx, "test1:0:1"))
+	assert.Equal(t, []string{"CommitPrepared test1/0 test1:0:1", "CommitPrepared test2/1 test1:0:1"}, fp.calls)
+	assert.Equal(t, int64(1), txr.resolutions.Counts()["COMMIT.Success"])
+}
+
+func TestTxResolverRollback(t *testing.T) {
+	txr, fp, tsv, db := newTestTxResolver(t)
+	defer db.Close()
+	defer tsv.StopService()
+
+	// An abandoned transaction that was never committed is rolled back,
+	// and the MM transaction still open on this tablet is released.
+	txid := newTxForPrep(tsv)
+	dtid := fmt.Sprintf("test1:0:%d", txid)
+	addTransactionQueries(db, dtid, querypb.TransactionState_PREPARE)
+	rollbackTransition := fmt.Sprintf("update _vt.dt_state set state = %d where dtid = '%s' and state = %d", int(querypb.TransactionState_ROLLBACK), dtid, int(querypb.TransactionState_PREPARE))
+	db.AddQuery(rollbackTransition, &sqltypes.Result{RowsAffected: 1})
+	require.NoError(t, txr.Resolve(ctx, dtid))
+	assert.Equal(t, []string{"RollbackPrepared test1/0 " + dtid, "RollbackPrepared test2/1 " + dtid}, fp.calls)
+	assert.Equal(t, int64(1), txr.resolutions.Counts()["ROLLBACK.Success"])
+	_, err := tsv.te.txPool.GetAndLock(txid, "for test")
+	require.Error(t, err)
+
+	// A malformed dtid is not rolled back.
+	addTransactionQueries(db, "aa", querypb.TransactionState_PREPARE)
+	err = txr.Resolve(ctx, "aa")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid parts in dtid: aa")
+}
+
+func TestTxResolverErrors(t *testing.T) {
+	txr, fp, tsv, db := newTestTxResolver(t)
+	defer db.Close()
+	defer tsv.StopService()
+
+	// Already resolved.
+	db.AddQuery("select dtid, state, time_created from _vt.dt_state where dtid = 'aa'", &sqltypes.Result{})
+	require.NoError(t, txr.Resolve(ctx, "aa"))
+	assert.Empty(t, fp.calls)
+
+	// The metadata is not concluded if a participant fails.
+	addTransactionQueries(db, "test1:0:1", querypb.TransactionState_COMMIT)
+	db.AddRejectedQuery("delete from _vt.dt_state where dtid = 'test1:0:1'", errors.New("must not conclude"))
+	fp.err = errors.New("participant unreachable")
+	err := txr.Resolve(ctx, "test1:0:1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "participant unreachable")
+	assert.Equal(t, int64(1), txr.resolutions.Counts()["COMMIT.Error"])
+}
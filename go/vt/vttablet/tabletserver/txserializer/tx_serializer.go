@@ -21,6 +21,7 @@ package txserializer
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -37,6 +38,17 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
+// Kinds of statements which are serialized. They are used as a label
+// for the stats.
+const (
+	// KindWhere is an UPDATE or DELETE keyed by its table and WHERE clause.
+	KindWhere = "Where"
+	// KindPKList is an UPDATE or DELETE on a list of primary keys.
+	KindPKList = "PKList"
+	// KindUpsert is an INSERT ... ON DUPLICATE KEY UPDATE.
+	KindUpsert = "Upsert"
+)
+
 // TxSerializer serializes incoming transactions which target the same row range
 // i.e. table name and WHERE clause are identical.
 // Additional transactions are queued and woken up in arrival order.
@@ -80,6 +92,10 @@ type TxSerializer struct {
 	waits, waitsDryRun, queueExceeded, queueExceededDryRun *stats.CountersWithSingleLabel
 	globalQueueExceeded, globalQueueExceededDryRun         *stats.Counter
 
+	// waitsByKind and waitsByKindDryRun are the same as waits and waitsDryRun,
+	// but also broken down by the kind of the statement.
+	waitsByKind, waitsByKindDryRun *stats.CountersWithMultiLabels
+
 	log                          *logutil.ThrottledLogger
 	logDryRun                    *logutil.ThrottledLogger
 	logWaitsDryRun               *logutil.ThrottledLogger
@@ -123,6 +139,14 @@ func New(env tabletenv.Env) *TxSerializer {
 		globalQueueExceededDryRun: env.Exporter().NewCounter(
 			"TxSerializerGlobalQueueExceededDryRun",
 			"Dry-run stats for TxSerializerGlobalQueueExceeded"),
+		waitsByKind: env.Exporter().NewCountersWithMultiLabels(
+			"TxSerializerWaitsByKind",
+			"Number of times a transaction was queued, by table and kind of statement",
+			[]string{"table_name", "kind"}),
+		waitsByKindDryRun: env.Exporter().NewCountersWithMultiLabels(
+			"TxSerializerWaitsByKindDryRun",
+			"Dry run number of transactions that would've been queued, by table and kind of statement",
+			[]string{"table_name", "kind"}),
 		log:                          logutil.NewThrottledLogger("HotRowProtection", 5*time.Second),
 		logDryRun:                    logutil.NewThrottledLogger("HotRowProtection DryRun", 5*time.Second),
 		logWaitsDryRun:               logutil.NewThrottledLogger("HotRowProtection Waits DryRun", 5*time.Second),
@@ -143,10 +167,45 @@ type DoneFunc func()
 // "waited" is true if Wait() had to wait for other transactions.
 // "err" is not nil if a) the context is done or b) a queue limit was reached.
 func (txs *TxSerializer) Wait(ctx context.Context, key, table string) (done DoneFunc, waited bool, err error) {
+	return txs.wait(ctx, key, table, KindWhere)
+}
+
+// WaitForKeys is the same as Wait, but for a transaction which targets
+// several rows (ranges). It acquires a slot for each key, one at a time.
+// The keys are acquired in sorted order, which avoids deadlocks between
+// transactions with overlapping keys. If a slot cannot be acquired, the
+// ones acquired so far are released.
+func (txs *TxSerializer) WaitForKeys(ctx context.Context, keys []string, table, kind string) (done DoneFunc, waited bool, err error) {
+	sorted := make([]string, len(keys))
+	copy(sorted, keys)
+	sort.Strings(sorted)
+
+	var dones []DoneFunc
+	doneAll := func() {
+		for i := len(dones) - 1; i >= 0; i-- {
+			dones[i]()
+		}
+	}
+	for i, key := range sorted {
+		if i > 0 && key == sorted[i-1] {
+			continue
+		}
+		done, keyWaited, err := txs.wait(ctx, key, table, kind)
+		waited = waited || keyWaited
+		if err != nil {
+			doneAll()
+			return nil, waited, err
+		}
+		dones = append(dones, done)
+	}
+	return doneAll, waited, nil
+}
+
+func (txs *TxSerializer) wait(ctx context.Context, key, table, kind string) (done DoneFunc, waited bool, err error) {
 	txs.mu.Lock()
 	defer txs.mu.Unlock()
 
-	waited, err = txs.lockLocked(ctx, key, table)
+	waited, err = txs.lockLocked(ctx, key, table, kind)
 	if err != nil {
 		if waited {
 			// Waiting failed early e.g. due a canceled context and we did NOT get the
@@ -161,7 +220,7 @@ func (txs *TxSerializer) Wait(ctx context.Context, key, table string) (done Done
 // lockLocked queues this transaction. It will unblock immediately if this
 // transaction is the first in the queue or when it acquired a slot.
 // The method has the suffix "Locked" to clarify that "txs.mu" must be locked.
-func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (bool, error) {
+func (txs *TxSerializer) lockLocked(ctx context.Context, key, table, kind string) (bool, error) {
 	q, ok := txs.queues[key]
 	if !ok {
 		// First transaction in the queue i.e. we don't wait and return immediately.
@@ -216,6 +275,7 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 
 	if txs.dryRun {
 		txs.waitsDryRun.Add(table, 1)
+		txs.waitsByKindDryRun.Add([]string{table, kind}, 1)
 		txs.logWaitsDryRun.Warningf("Would have queued BeginExecute RPC for row (range): '%v' because another transaction to the same range is already in progress.", key)
 		return false, nil
 	}
@@ -235,6 +295,7 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 
 	// Blocking wait for the next available slot.
 	txs.waits.Add(table, 1)
+	txs.waitsByKind.Add([]string{table, kind}, 1)
 	select {
 	case q.availableSlots <- struct{}{}:
 		return true, nil
@@ -41,6 +41,8 @@ func resetVariables(txs *TxSerializer) {
 	txs.queueExceededDryRun.ResetAll()
 	txs.globalQueueExceeded.Reset()
 	txs.globalQueueExceededDryRun.Reset()
+	txs.waitsByKind.ResetAll()
+	txs.waitsByKindDryRun.ResetAll()
 }
 
 func TestTxSerializer_NoHotRow(t *testing.T) {
@@ -463,6 +465,104 @@ func TestTxSerializerDryRun(t *testing.T) {
 	}
 }
 
+func TestTxSerializerWaitForKeys(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 2
+	config.HotRowProtection.MaxGlobalQueueSize = 10
+	config.HotRowProtection.MaxConcurrency = 1
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	// tx1 holds the row in the middle.
+	done1, _, err1 := txs.Wait(context.Background(), "t1 where2", "t1")
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+
+	// tx2 acquires its rows in order and waits for tx1.
+	tx2Done := make(chan struct{})
+	go func() {
+		defer close(tx2Done)
+		done2, waited2, err2 := txs.WaitForKeys(context.Background(), []string{"t1 where3", "t1 where1", "t1 where2", "t1 where1"}, "t1", KindPKList)
+		if err2 != nil {
+			t.Error(err2)
+			return
+		}
+		if !waited2 {
+			t.Error("tx2 must have waited for tx1")
+		}
+		done2()
+	}()
+	if err := waitForPending(txs, "t1 where2", 2); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := txs.Pending("t1 where1"), 1; got != want {
+		t.Errorf("tx2 must hold the first row: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.Pending("t1 where3"), 0; got != want {
+		t.Errorf("tx2 must not hold the last row yet: got = %v, want = %v", got, want)
+	}
+
+	done1()
+	<-tx2Done
+	if len(txs.queues) != 0 {
+		t.Errorf("all queues must be released: %v", txs.queues)
+	}
+	if got, want := txs.waitsByKind.Counts()["t1.PKList"], int64(1); got != want {
+		t.Errorf("wrong WaitsByKind variable: got = %v, want = %v", got, want)
+	}
+}
+
+func TestTxSerializerWaitForKeysRelease(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 1
+	config.HotRowProtection.MaxGlobalQueueSize = 10
+	config.HotRowProtection.MaxConcurrency = 1
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	done1, _, err1 := txs.Wait(context.Background(), "t1 where2", "t1")
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	// The second row exceeds the queue size: the first one must be released.
+	_, _, err2 := txs.WaitForKeys(context.Background(), []string{"t1 where2", "t1 where1"}, "t1", KindUpsert)
+	if err2 == nil {
+		t.Fatal("tx2 must be rejected")
+	}
+	if got, want := txs.Pending("t1 where1"), 0; got != want {
+		t.Errorf("first row of tx2 was not released: got = %v, want = %v", got, want)
+	}
+	done1()
+}
+
+func TestTxSerializerWaitForKeysDryRun(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Dryrun
+	config.HotRowProtection.MaxQueueSize = 10
+	config.HotRowProtection.MaxGlobalQueueSize = 10
+	config.HotRowProtection.MaxConcurrency = 1
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	done1, _, err1 := txs.WaitForKeys(context.Background(), []string{"t1 where1", "t1 where2"}, "t1", KindUpsert)
+	if err1 != nil {
+		t.Fatal(err1)
+	}
+	done2, waited2, err2 := txs.WaitForKeys(context.Background(), []string{"t1 where2", "t1 where1"}, "t1", KindUpsert)
+	if err2 != nil {
+		t.Fatal(err2)
+	}
+	if waited2 {
+		t.Error("transactions must never wait in dry-run mode")
+	}
+	if got, want := txs.waitsByKindDryRun.Counts()["t1.Upsert"], int64(2); got != want {
+		t.Errorf("wrong WaitsByKindDryRun variable: got = %v, want = %v", got, want)
+	}
+	done1()
+	done2()
+}
+
 // TestTxSerializerGlobalQueueOverflow shows that the global queue can exceed
 // its limit without rejecting errors. This is the case when all transactions
 // are the first one for their row range.
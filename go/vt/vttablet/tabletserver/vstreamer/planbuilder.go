@@ -58,6 +58,9 @@ const (
 	Equal = Opcode(iota)
 	// VindexMatch is used for an in_keyrange() construct
 	VindexMatch
+	// ExprMatch is used for any other expression, evaluated
+	// against the row by the evalengine
+	ExprMatch
 )
 
 // Filter contains opcodes for filtering.
@@ -74,6 +77,10 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the expression evaluated for ExprMatch. The row
+	// matches if the result is true.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -91,6 +98,10 @@ type ColExpr struct {
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
+
+	// Expr, if set, is evaluated against the row to generate
+	// the value. If so, ColNum is ignored.
+	Expr evalengine.Expr
 }
 
 // Table contains the metadata for a table.
@@ -129,11 +140,30 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil, nil
 			}
+		case ExprMatch:
+			result, err := filter.Expr.Evaluate(evalengine.ExpressionEnv{Row: values, Filter: true})
+			if err != nil {
+				return false, nil, err
+			}
+			if !result.IsTruthy() {
+				return false, nil, nil
+			}
 		}
 	}
 
 	result := make([]sqltypes.Value, len(plan.ColExprs))
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			val, err := colExpr.Expr.Evaluate(evalengine.ExpressionEnv{Row: values, Filter: true})
+			if err != nil {
+				return false, nil, err
+			}
+			// The value must match the type advertised in the field.
+			if result[i], err = evalengine.Cast(val.Value(), colExpr.Field.Type); err != nil {
+				return false, nil, err
+			}
+			continue
+		}
 		if colExpr.ColNum == -1 {
 			result[i] = colExpr.FixedValue
 			continue
@@ -367,8 +397,14 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
 			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			val, isLiteral := expr.Right.(*sqlparser.Literal)
+			// Equality of a column to an integer or a string has its own opcode.
+			// StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+			if !ok || !isLiteral || expr.Operator != sqlparser.EqualOp || (val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal) {
+				if err := plan.analyzeWhereExpr(expr); err != nil {
+					return err
+				}
+				continue
 			}
 			if !qualifiedName.Qualifier.IsEmpty() {
 				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
@@ -377,14 +413,6 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
 			pv, err := sqlparser.NewPlanValue(val)
 			if err != nil {
 				return err
@@ -400,18 +428,79 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 			})
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+				if err := plan.analyzeWhereExpr(expr); err != nil {
+					return err
+				}
+				continue
 			}
 			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
 				return err
 			}
 		default:
-			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			if err := plan.analyzeWhereExpr(expr); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// analyzeWhereExpr adds a filter for a constraint that's evaluated by the evalengine.
+func (plan *Plan) analyzeWhereExpr(expr sqlparser.Expr) error {
+	if err := plan.checkBinaryComparisons(expr); err != nil {
+		return err
+	}
+	evalExpr, err := sqlparser.ConvertVReplicationExpr(expr, plan.findColumnExpr)
+	if err == sqlparser.ErrExprNotSupported {
+		return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+	}
+	if err != nil {
+		return err
+	}
+	plan.Filters = append(plan.Filters, Filter{
+		Opcode: ExprMatch,
+		Expr:   evalExpr,
+	})
+	return nil
+}
+
+// checkBinaryComparisons returns an error if the expression compares
+// a non-binary string column. The evalengine compares strings byte-wise,
+// and we do not support varchar since we would have to implement all
+// collation types.
+func (plan *Plan) checkBinaryComparisons(expr sqlparser.Expr) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node.(type) {
+		case *sqlparser.ComparisonExpr, *sqlparser.RangeCond:
+		default:
+			return true, nil
+		}
+		return false, sqlparser.Walk(func(inner sqlparser.SQLNode) (bool, error) {
+			col, ok := inner.(*sqlparser.ColName)
+			if !ok {
+				return true, nil
+			}
+			colnum, err := plan.findColumnExpr(col)
+			if err != nil {
+				return false, err
+			}
+			switch typ := plan.Table.Fields[colnum].Type; {
+			case sqltypes.IsText(typ), typ == sqltypes.Enum, typ == sqltypes.Set:
+				return false, fmt.Errorf("unsupported comparison of non-binary column %v: %v", sqlparser.String(col), sqlparser.String(expr))
+			}
+			return true, nil
+		}, node)
+	}, expr)
+}
+
+// findColumnExpr returns the column number of a column referenced by an expression.
+func (plan *Plan) findColumnExpr(col *sqlparser.ColName) (int, error) {
+	if !col.Qualifier.IsEmpty() {
+		return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+	}
+	return findColumn(plan.Table, col.Name)
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -473,6 +562,9 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
+			if evalengine.IsBuiltinFunc(inner.Name.String()) {
+				return plan.analyzeEvalExpr(aliased)
+			}
 			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
 		}
 		if len(inner.Exprs) != 0 {
@@ -515,9 +607,42 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			FixedValue: sqltypes.NewInt64(num),
 		}, nil
 	default:
-		log.Infof("Unsupported expression: %v", inner)
+		return plan.analyzeEvalExpr(aliased)
+	}
+}
+
+// analyzeEvalExpr builds a column expression that's evaluated by the evalengine.
+// The field is named after the alias, or the expression itself if there's none.
+func (plan *Plan) analyzeEvalExpr(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	evalExpr, err := sqlparser.ConvertVReplicationExpr(aliased.Expr, plan.findColumnExpr)
+	if err == sqlparser.ErrExprNotSupported {
+		log.Infof("Unsupported expression: %v", aliased.Expr)
 		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
 	}
+	if err != nil {
+		return ColExpr{}, err
+	}
+	// The type of the result is computed from the types of the columns.
+	typeRow := make([]sqltypes.Value, len(plan.Table.Fields))
+	for i, field := range plan.Table.Fields {
+		typeRow[i] = sqltypes.MakeTrusted(field.Type, nil)
+	}
+	typ, err := evalExpr.Type(evalengine.ExpressionEnv{Row: typeRow, Filter: true})
+	if err != nil {
+		return ColExpr{}, err
+	}
+	name := aliased.As.String()
+	if name == "" {
+		name = sqlparser.String(aliased.Expr)
+	}
+	return ColExpr{
+		ColNum: -1,
+		Field: &querypb.Field{
+			Name: name,
+			Type: typ,
+		},
+		Expr: evalExpr,
+	}, nil
 }
 
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
@@ -21,9 +21,13 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -533,7 +537,38 @@ func TestPlanbuilder(t *testing.T) {
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: -1,
+				Field: &querypb.Field{
+					Name: "id + 1",
+					Type: sqltypes.Int64,
+				},
+				Expr: &evalengine.BinaryOp{
+					Expr:  &evalengine.Addition{},
+					Left:  evalengine.NewColumn(0),
+					Right: evalengine.NewLiteralInt(1),
+				},
+			}, {
+				ColNum: 1,
+				Field: &querypb.Field{
+					Name: "val",
+					Type: sqltypes.VarBinary,
+				},
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = rand()"},
+		outErr:  `unsupported constraint: id = rand()`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where none is null"},
+		outErr:  `column none not found in table t1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, now() from t1"},
+		outErr:  `unsupported function: now()`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -577,3 +612,73 @@ func TestPlanbuilder(t *testing.T) {
 		}
 	}
 }
+
+func TestPlanbuilderFilterExprs(t *testing.T) {
+	t1 := &Table{
+		Name:   "t1",
+		Fields: sqltypes.MakeTestFields("id|name|deleted_at|title", "int64|varbinary|datetime|varchar"),
+	}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarBinary("alice"), sqltypes.NULL, sqltypes.NewVarChar("a")},
+		{sqltypes.NewInt64(2), sqltypes.NewVarBinary("Bob"), sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-01-02 03:04:05")), sqltypes.NewVarChar("b")},
+		{sqltypes.NewInt64(3), sqltypes.NewVarBinary("carol"), sqltypes.NULL, sqltypes.NewVarChar("c")},
+	}
+
+	testcases := []struct {
+		filter    string
+		outFields []*querypb.Field
+		outRows   [][]sqltypes.Value
+	}{{
+		filter:    "select id from t1 where deleted_at is null",
+		outFields: sqltypes.MakeTestFields("id", "int64"),
+		outRows:   [][]sqltypes.Value{{sqltypes.NewInt64(1)}, {sqltypes.NewInt64(3)}},
+	}, {
+		filter:    "select id, upper(name) as uname from t1 where id in (2, 3) and (name != 'carol' or id > 2)",
+		outFields: sqltypes.MakeTestFields("id|uname", "int64|varbinary"),
+		outRows: [][]sqltypes.Value{
+			{sqltypes.NewInt64(2), sqltypes.NewVarBinary("BOB")},
+			{sqltypes.NewInt64(3), sqltypes.NewVarBinary("CAROL")},
+		},
+	}, {
+		filter:    "select id * 10 as id10, date(deleted_at) as d from t1 where deleted_at is not null",
+		outFields: sqltypes.MakeTestFields("id10|d", "int64|date"),
+		outRows:   [][]sqltypes.Value{{sqltypes.NewInt64(20), sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-01-02"))}},
+	}, {
+		filter:    "select id, ifnull(deleted_at, '0000-00-00 00:00:00') as deleted from t1 where id between 2 and 3",
+		outFields: sqltypes.MakeTestFields("id|deleted", "int64|datetime"),
+		outRows: [][]sqltypes.Value{
+			{sqltypes.NewInt64(2), sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-01-02 03:04:05"))},
+			{sqltypes.NewInt64(3), sqltypes.MakeTrusted(sqltypes.Datetime, []byte("0000-00-00 00:00:00"))},
+		},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.filter, func(t *testing.T) {
+			plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tcase.outFields, plan.fields())
+			var got [][]sqltypes.Value
+			for _, row := range rows {
+				ok, values, err := plan.filter(row)
+				require.NoError(t, err)
+				if ok {
+					got = append(got, values)
+				}
+			}
+			assert.Equal(t, tcase.outRows, got)
+		})
+	}
+
+	// Non-binary string columns would need collations to be compared.
+	for _, filter := range []string{
+		"select id from t1 where title != 'a'",
+		"select id from t1 where lower(title) in ('a', 'b')",
+		"select id from t1 where title between 'a' and 'b'",
+	} {
+		_, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: filter}},
+		})
+		assert.Contains(t, fmt.Sprint(err), "unsupported comparison of non-binary column title", filter)
+	}
+}
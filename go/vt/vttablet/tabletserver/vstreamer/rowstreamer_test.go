@@ -385,6 +385,43 @@ func TestStreamRowsCancel(t *testing.T) {
 	}
 }
 
+func TestStreamRowsFilePos(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table t1(id int, val varbinary(128), primary key(id))",
+		"insert into t1 values (1, 'aaa'), (2, 'bbb')",
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+	})
+
+	savedEngine := engine
+	defer func() { engine = savedEngine }()
+	engine = customEngine(t, func(in mysql.ConnParams) mysql.ConnParams {
+		in.Flavor = "FilePos"
+		return in
+	})
+	defer engine.Close()
+	engine.se.Reload(context.Background())
+
+	// The snapshot position must be in the FilePos format
+	// for the copy to be followed by a catchup.
+	var gtid string
+	err := engine.StreamRows(context.Background(), "select * from t1", nil, func(rows *binlogdatapb.VStreamRowsResponse) error {
+		if gtid == "" {
+			gtid = rows.Gtid
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	pos, err := mysql.DecodePosition(gtid)
+	require.NoError(t, err)
+	require.True(t, pos.MatchesFlavor(mysql.FilePosFlavorID), gtid)
+}
+
 func checkStream(t *testing.T, query string, lastpk []sqltypes.Value, wantQuery string, wantStream []string) {
 	t.Helper()
 
@@ -762,6 +762,70 @@ func TestStatements(t *testing.T) {
 	runCases(t, nil, testcases, "current", nil)
 }
 
+// TestFilePosResume tests that a stream of the FilePos flavor
+// can be resumed from a position it sent.
+func TestFilePosResume(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table stream1(id int, val varbinary(128), primary key(id))",
+	})
+	defer execStatements(t, []string{
+		"drop table stream1",
+	})
+
+	savedEngine := engine
+	defer func() { engine = savedEngine }()
+	engine = customEngine(t, func(in mysql.ConnParams) mysql.ConnParams {
+		in.Flavor = "FilePos"
+		return in
+	})
+	defer engine.Close()
+	engine.se.Reload(context.Background())
+
+	// Stream the first transaction and remember its position.
+	ctx, cancel := context.WithCancel(context.Background())
+	wg, ch := startStream(ctx, t, nil, "", nil)
+	execStatements(t, []string{
+		"begin",
+		"insert into stream1 values (1, 'aaa')",
+		"commit",
+	})
+	var resumePos string
+	for resumePos == "" {
+		evs, ok := <-ch
+		require.True(t, ok, "stream ended before the commit")
+		for _, ev := range evs {
+			if ev.Type == binlogdatapb.VEventType_GTID {
+				resumePos = ev.Gtid
+			}
+		}
+	}
+	cancel()
+	wg.Wait()
+	require.True(t, strings.HasPrefix(resumePos, "FilePos/"), resumePos)
+
+	// Resuming from that position must only stream the next transaction.
+	execStatements(t, []string{
+		"begin",
+		"insert into stream1 values (2, 'bbb')",
+		"commit",
+	})
+	testcases := []testcase{{
+		input: []string{},
+		output: [][]string{{
+			`begin`,
+			`type:FIELD field_event:<table_name:"stream1" fields:<name:"id" type:INT32 table:"stream1" org_table:"stream1" database:"vttest" org_name:"id" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"stream1" org_table:"stream1" database:"vttest" org_name:"val" column_length:128 charset:63 > > `,
+			`type:ROW row_event:<table_name:"stream1" row_changes:<after:<lengths:1 lengths:3 values:"2bbb" > > > `,
+			`gtid`,
+			`commit`,
+		}},
+	}}
+	runCases(t, nil, testcases, resumePos, nil)
+}
+
 // TestOther tests "other" and "priv" statements. These statements can
 // produce very different events depending on the version of mysql or
 // mariadb. So, we just show that vreplication transmits "OTHER" events
@@ -49,6 +49,11 @@ type TabletManagerClient interface {
 	// GetSchema asks the remote tablet for its database schema
 	GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error)
 
+	// GetSchemaHistory asks the remote tablet for the schema versions recorded
+	// by its schema tracker, oldest first. If tables is not empty, only these
+	// tables are returned, and only the versions in which any of them changed.
+	GetSchemaHistory(ctx context.Context, tablet *topodatapb.Tablet, request *tabletmanagerdatapb.GetSchemaHistoryRequest) ([]*tabletmanagerdatapb.SchemaVersion, error)
+
 	// GetPermissions asks the remote tablet for its permissions list
 	GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error)
 
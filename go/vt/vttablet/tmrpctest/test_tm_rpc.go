@@ -292,6 +292,47 @@ func tmRPCTestGetSchemaPanic(ctx context.Context, t *testing.T, client tmclient.
 	expectHandleRPCPanic(t, "GetSchema", false /*verbose*/, err)
 }
 
+var testGetSchemaHistoryRequest = &tabletmanagerdatapb.GetSchemaHistoryRequest{
+	Tables:         []string{"table1"},
+	MinId:          2,
+	MaxId:          5,
+	MaxTimeUpdated: 1600000000,
+	Limit:          1,
+}
+var testGetSchemaHistoryReply = []*tabletmanagerdatapb.SchemaVersion{
+	{
+		Id:          1,
+		Position:    "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5",
+		Ddl:         "create table table1 (col1 int, primary key (col1))",
+		TimeUpdated: 1600000000,
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:              "table1",
+				Columns:           []string{"col1"},
+				PrimaryKeyColumns: []string{"col1"},
+			},
+		},
+	},
+}
+
+func (fra *fakeRPCTM) GetSchemaHistory(ctx context.Context, request *tabletmanagerdatapb.GetSchemaHistoryRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetSchemaHistory request", request, testGetSchemaHistoryRequest)
+	return testGetSchemaHistoryReply, nil
+}
+
+func tmRPCTestGetSchemaHistory(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	result, err := client.GetSchemaHistory(ctx, tablet, testGetSchemaHistoryRequest)
+	compareError(t, "GetSchemaHistory", err, result, testGetSchemaHistoryReply)
+}
+
+func tmRPCTestGetSchemaHistoryPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetSchemaHistory(ctx, tablet, testGetSchemaHistoryRequest)
+	expectHandleRPCPanic(t, "GetSchemaHistory", false /*verbose*/, err)
+}
+
 var testGetPermissionsReply = &tabletmanagerdatapb.Permissions{
 	UserPermissions: []*tabletmanagerdatapb.UserPermission{
 		{
@@ -1427,6 +1468,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-only methods
 	tmRPCTestPing(ctx, t, client, tablet)
 	tmRPCTestGetSchema(ctx, t, client, tablet)
+	tmRPCTestGetSchemaHistory(ctx, t, client, tablet)
 	tmRPCTestGetPermissions(ctx, t, client, tablet)
 
 	// Various read-write methods
@@ -1492,6 +1534,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-only methods
 	tmRPCTestPingPanic(ctx, t, client, tablet)
 	tmRPCTestGetSchemaPanic(ctx, t, client, tablet)
+	tmRPCTestGetSchemaHistoryPanic(ctx, t, client, tablet)
 	tmRPCTestGetPermissionsPanic(ctx, t, client, tablet)
 
 	// Various read-write methods
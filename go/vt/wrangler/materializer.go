@@ -54,6 +54,9 @@ type materializer struct {
 	targetVSchema *vindexes.KeyspaceSchema
 	sourceShards  []*topo.ShardInfo
 	targetShards  []*topo.ShardInfo
+	// externalMysql is the name of the external mysql the tables
+	// are copied from. sourceShards is empty if it's set.
+	externalMysql string
 }
 
 const (
@@ -61,8 +64,13 @@ const (
 	createDDLAsCopyDropConstraint = "copy:drop_constraint"
 )
 
-// MoveTables initiates moving table(s) over to another keyspace
-func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs, cell, tabletTypes string) error {
+// MoveTables initiates moving table(s) over to another keyspace.
+// If externalMysql is set, the tables are moved from that external mysql,
+// which must be configured in the external connections of the target tablets.
+// sourceKeyspace is then only used to identify the source of the streams:
+// the routing rules are not changed, and the tables must already exist
+// in the target keyspace.
+func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs, cell, tabletTypes, externalMysql string) error {
 	var tables []string
 	var err error
 
@@ -98,16 +106,19 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 
 	// Save routing rules before vschema. If we save vschema first, and routing rules
 	// fails to save, we may generate duplicate table errors.
-	rules, err := wr.getRoutingRules(ctx)
-	if err != nil {
-		return err
-	}
-	for _, table := range tables {
-		rules[table] = []string{sourceKeyspace + "." + table}
-		rules[targetKeyspace+"."+table] = []string{sourceKeyspace + "." + table}
-	}
-	if err := wr.saveRoutingRules(ctx, rules); err != nil {
-		return err
+	// An external source is not served by vitess: there is nothing to route to.
+	if externalMysql == "" {
+		rules, err := wr.getRoutingRules(ctx)
+		if err != nil {
+			return err
+		}
+		for _, table := range tables {
+			rules[table] = []string{sourceKeyspace + "." + table}
+			rules[targetKeyspace+"."+table] = []string{sourceKeyspace + "." + table}
+		}
+		if err := wr.saveRoutingRules(ctx, rules); err != nil {
+			return err
+		}
 	}
 	if vschema != nil {
 		// We added to the vschema.
@@ -129,13 +140,18 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 	for _, table := range tables {
 		buf := sqlparser.NewTrackedBuffer(nil)
 		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+		createDDL := createDDLAsCopy
+		if externalMysql != "" {
+			// The schema of an external source can't be copied.
+			createDDL = ""
+		}
 		ms.TableSettings = append(ms.TableSettings, &vtctldatapb.TableMaterializeSettings{
 			TargetTable:      table,
 			SourceExpression: buf.String(),
-			CreateDdl:        createDDLAsCopy,
+			CreateDdl:        createDDL,
 		})
 	}
-	mz, err := wr.prepareMaterializerStreams(ctx, ms)
+	mz, err := wr.prepareMaterializerStreams(ctx, ms, externalMysql)
 	if err != nil {
 		return err
 	}
@@ -164,6 +180,100 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 	return mz.startStreams(ctx)
 }
 
+// Merge initiates the consolidation of tables from multiple source keyspaces
+// into the target keyspace. The tables are copied from every source, and
+// rows with the same primary key in different sources are resolved with
+// onConflict, which can be "fail", "skip" or "last_writer_wins".
+// The conflicts are recorded in the _vt.vreplication_conflicts table
+// of the target tablets.
+// Unlike MoveTables, routing rules are not created because the tables
+// are served by more than one keyspace during the merge.
+func (wr *Wrangler) Merge(ctx context.Context, workflow string, sourceKeyspaces []string, targetKeyspace, tableSpecs, cell, tabletTypes, onConflict string) error {
+	if onConflict == "" {
+		return fmt.Errorf("a conflict policy must be specified")
+	}
+	if err := vreplication.ValidateConflictPolicy(onConflict); err != nil {
+		return err
+	}
+	if len(sourceKeyspaces) < 2 {
+		return fmt.Errorf("at least two source keyspaces must be specified, use MoveTables to move tables from a single keyspace")
+	}
+	seen := make(map[string]bool)
+	for _, sourceKeyspace := range sourceKeyspaces {
+		if sourceKeyspace == targetKeyspace {
+			return fmt.Errorf("source keyspace %s can't be the target keyspace", sourceKeyspace)
+		}
+		if seen[sourceKeyspace] {
+			return fmt.Errorf("source keyspace %s specified more than once", sourceKeyspace)
+		}
+		seen[sourceKeyspace] = true
+	}
+	if tableSpecs == "" {
+		return fmt.Errorf("no tables specified")
+	}
+	tables := strings.Split(tableSpecs, ",")
+
+	if err := wr.validateNewWorkflow(ctx, targetKeyspace, workflow); err != nil {
+		return err
+	}
+	vschema, err := wr.ts.GetVSchema(ctx, targetKeyspace)
+	if err != nil {
+		return err
+	}
+	if !vschema.Sharded {
+		if vschema.Tables == nil {
+			vschema.Tables = make(map[string]*vschemapb.Table)
+		}
+		for _, table := range tables {
+			vschema.Tables[table] = &vschemapb.Table{}
+		}
+		if err := wr.ts.SaveVSchema(ctx, targetKeyspace, vschema); err != nil {
+			return err
+		}
+		if err := wr.ts.RebuildSrvVSchema(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	var mz *materializer
+	for _, sourceKeyspace := range sourceKeyspaces {
+		ms := &vtctldatapb.MaterializeSettings{
+			Workflow:       workflow,
+			SourceKeyspace: sourceKeyspace,
+			TargetKeyspace: targetKeyspace,
+			Cell:           cell,
+			TabletTypes:    tabletTypes,
+		}
+		for _, table := range tables {
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+			ms.TableSettings = append(ms.TableSettings, &vtctldatapb.TableMaterializeSettings{
+				TargetTable:      table,
+				SourceExpression: buf.String(),
+				CreateDdl:        createDDLAsCopy,
+			})
+		}
+		// The tables are created from the schema of the first source
+		// keyspace. They already exist for the others.
+		created, err := wr.createMaterializerStreams(ctx, ms, "")
+		if err != nil {
+			err = vterrors.Wrapf(err, "source keyspace %s", sourceKeyspace)
+			if mz != nil {
+				// Roll back the streams of the previous source keyspaces.
+				if derr := mz.deleteStreams(ctx); derr != nil {
+					return vterrors.Wrapf(err, "and failed to delete the streams already created: %v", derr)
+				}
+			}
+			return err
+		}
+		mz = created
+	}
+	if err := mz.setStreamOption(ctx, "on_conflict", onConflict); err != nil {
+		return err
+	}
+	return mz.startStreams(ctx)
+}
+
 func (wr *Wrangler) checkIfPreviousJournalExists(ctx context.Context, mz *materializer, migrationID int64) (bool, []string, error) {
 	forAllSources := func(f func(*topo.ShardInfo) error) error {
 		var wg sync.WaitGroup
@@ -661,17 +771,27 @@ func getMigrationID(targetKeyspace string, shardTablets []string) (int64, error)
 	return int64(hasher.Sum64() & math.MaxInt64), nil
 }
 
-func (wr *Wrangler) prepareMaterializerStreams(ctx context.Context, ms *vtctldatapb.MaterializeSettings) (*materializer, error) {
+func (wr *Wrangler) prepareMaterializerStreams(ctx context.Context, ms *vtctldatapb.MaterializeSettings, externalMysql string) (*materializer, error) {
 	if err := wr.validateNewWorkflow(ctx, ms.TargetKeyspace, ms.Workflow); err != nil {
 		return nil, err
 	}
-	mz, err := wr.buildMaterializer(ctx, ms)
+	return wr.createMaterializerStreams(ctx, ms, externalMysql)
+}
+
+// createMaterializerStreams creates the streams of ms without checking
+// that the workflow is new. This allows a workflow to have streams from
+// multiple source keyspaces.
+func (wr *Wrangler) createMaterializerStreams(ctx context.Context, ms *vtctldatapb.MaterializeSettings, externalMysql string) (*materializer, error) {
+	mz, err := wr.buildMaterializer(ctx, ms, externalMysql)
 	if err != nil {
 		return nil, err
 	}
 	if err := mz.deploySchema(ctx); err != nil {
 		return nil, err
 	}
+	if err := mz.checkSourceExpressions(ctx); err != nil {
+		return nil, err
+	}
 	inserts, err := mz.generateInserts(ctx)
 	if err != nil {
 		return nil, err
@@ -684,14 +804,44 @@ func (wr *Wrangler) prepareMaterializerStreams(ctx context.Context, ms *vtctldat
 
 // Materialize performs the steps needed to materialize a list of tables based on the materialization specs.
 func (wr *Wrangler) Materialize(ctx context.Context, ms *vtctldatapb.MaterializeSettings) error {
-	mz, err := wr.prepareMaterializerStreams(ctx, ms)
+	mz, err := wr.prepareMaterializerStreams(ctx, ms, "")
+	if err != nil {
+		return err
+	}
+	return mz.startStreams(ctx)
+}
+
+// MaterializeToSink is like Materialize, but the streams write the changes
+// to sink instead of applying them to the target tables, which are not
+// created. sink is a URL whose scheme selects the type of the sink, for
+// example file:///data/changes.json or https://host/path. The sink is
+// opened by every master tablet of the target keyspace, which still
+// tracks the position and the copy state of its streams.
+func (wr *Wrangler) MaterializeToSink(ctx context.Context, ms *vtctldatapb.MaterializeSettings, sink string) error {
+	if err := vreplication.ValidateSink(sink); err != nil {
+		return err
+	}
+	if err := wr.validateNewWorkflow(ctx, ms.TargetKeyspace, ms.Workflow); err != nil {
+		return err
+	}
+	mz, err := wr.buildMaterializer(ctx, ms, "")
 	if err != nil {
 		return err
 	}
+	inserts, err := mz.generateInserts(ctx)
+	if err != nil {
+		return err
+	}
+	if err := mz.createStreams(ctx, inserts); err != nil {
+		return err
+	}
+	if err := mz.setStreamOption(ctx, "sink", sink); err != nil {
+		return err
+	}
 	return mz.startStreams(ctx)
 }
 
-func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.MaterializeSettings) (*materializer, error) {
+func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.MaterializeSettings, externalMysql string) (*materializer, error) {
 	vschema, err := wr.ts.GetVSchema(ctx, ms.TargetKeyspace)
 	if err != nil {
 		return nil, err
@@ -708,9 +858,12 @@ func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.Mater
 		}
 	}
 
-	sourceShards, err := wr.ts.GetServingShards(ctx, ms.SourceKeyspace)
-	if err != nil {
-		return nil, err
+	var sourceShards []*topo.ShardInfo
+	if externalMysql == "" {
+		sourceShards, err = wr.ts.GetServingShards(ctx, ms.SourceKeyspace)
+		if err != nil {
+			return nil, err
+		}
 	}
 	targetShards, err := wr.ts.GetServingShards(ctx, ms.TargetKeyspace)
 	if err != nil {
@@ -722,6 +875,7 @@ func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.Mater
 		targetVSchema: targetVSchema,
 		sourceShards:  sourceShards,
 		targetShards:  targetShards,
+		externalMysql: externalMysql,
 	}, nil
 }
 
@@ -729,6 +883,9 @@ func (mz *materializer) getSourceTableDDLs(ctx context.Context) (map[string]stri
 	sourceDDLs := make(map[string]string)
 	allTables := []string{"/.*/"}
 
+	if len(mz.sourceShards) == 0 {
+		return nil, fmt.Errorf("schema can't be copied from external mysql %v", mz.externalMysql)
+	}
 	sourceMaster := mz.sourceShards[0].MasterAlias
 	if sourceMaster == nil {
 		return nil, fmt.Errorf("source shard must have a master for copying schema: %v", mz.sourceShards[0].ShardName())
@@ -842,6 +999,74 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 	})
 }
 
+// checkSourceExpressions verifies that the values computed by the source
+// expressions can be stored in the target tables, so that type errors are
+// reported when the workflow is created instead of when rows are applied.
+// Expressions that select all the columns of a table are not checked: the
+// target table is usually a copy of the source table.
+func (mz *materializer) checkSourceExpressions(ctx context.Context) error {
+	var sourceTables, targetTables []string
+	var checked []*vtctldatapb.TableMaterializeSettings
+	for _, ts := range mz.ms.TableSettings {
+		if ts.SourceExpression == "" {
+			continue
+		}
+		// Invalid expressions are reported by generateInserts.
+		stmt, err := sqlparser.Parse(ts.SourceExpression)
+		if err != nil {
+			continue
+		}
+		sel, ok := stmt.(*sqlparser.Select)
+		if !ok {
+			continue
+		}
+		if _, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); ok {
+			continue
+		}
+		sourceTable, err := sqlparser.TableFromStatement(ts.SourceExpression)
+		if err != nil {
+			continue
+		}
+		sourceTables = append(sourceTables, sourceTable.Name.String())
+		targetTables = append(targetTables, ts.TargetTable)
+		checked = append(checked, ts)
+	}
+	if len(checked) == 0 || len(mz.sourceShards) == 0 {
+		return nil
+	}
+	sourceMaster := mz.sourceShards[0].MasterAlias
+	if sourceMaster == nil {
+		log.Warningf("Not checking source expressions: source shard %v has no master", mz.sourceShards[0].ShardName())
+		return nil
+	}
+	sourceSchema, err := mz.wr.GetSchema(ctx, sourceMaster, sourceTables, nil, false)
+	if err != nil {
+		return err
+	}
+	targetSchema, err := mz.wr.GetSchema(ctx, mz.targetShards[0].MasterAlias, targetTables, nil, false)
+	if err != nil {
+		return err
+	}
+	sourceFields := make(map[string][]*querypb.Field)
+	for _, td := range sourceSchema.TableDefinitions {
+		sourceFields[td.Name] = td.Fields
+	}
+	targetFields := make(map[string][]*querypb.Field)
+	for _, td := range targetSchema.TableDefinitions {
+		targetFields[td.Name] = td.Fields
+	}
+	for i, ts := range checked {
+		// Tables whose fields are unknown can't be checked.
+		if len(sourceFields[sourceTables[i]]) == 0 || len(targetFields[ts.TargetTable]) == 0 {
+			continue
+		}
+		if err := vreplication.CheckFilterTypes(ts.TargetTable, ts.SourceExpression, sourceFields[sourceTables[i]], targetFields[ts.TargetTable]); err != nil {
+			return vterrors.Wrapf(err, "invalid source expression for table %s", ts.TargetTable)
+		}
+	}
+	return nil
+}
+
 func stripTableConstraints(ddl string) (string, error) {
 	ast, err := sqlparser.ParseStrictDDL(ddl)
 	if err != nil {
@@ -867,13 +1092,22 @@ func stripTableConstraints(ddl string) (string, error) {
 func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 	ig := vreplication.NewInsertGenerator(binlogplayer.BlpStopped, "{{.dbname}}")
 
-	for _, source := range mz.sourceShards {
-		bls := &binlogdatapb.BinlogSource{
+	var sources []*binlogdatapb.BinlogSource
+	if mz.externalMysql != "" {
+		sources = append(sources, &binlogdatapb.BinlogSource{
 			Keyspace:      mz.ms.SourceKeyspace,
-			Shard:         source.ShardName(),
-			Filter:        &binlogdatapb.Filter{},
-			StopAfterCopy: mz.ms.StopAfterCopy,
-		}
+			ExternalMysql: mz.externalMysql,
+		})
+	}
+	for _, source := range mz.sourceShards {
+		sources = append(sources, &binlogdatapb.BinlogSource{
+			Keyspace: mz.ms.SourceKeyspace,
+			Shard:    source.ShardName(),
+		})
+	}
+	for _, bls := range sources {
+		bls.Filter = &binlogdatapb.Filter{}
+		bls.StopAfterCopy = mz.ms.StopAfterCopy
 		for _, ts := range mz.ms.TableSettings {
 			rule := &binlogdatapb.Rule{
 				Match: ts.TargetTable,
@@ -1000,6 +1234,38 @@ func (mz *materializer) startStreams(ctx context.Context) error {
 	})
 }
 
+// deleteStreams deletes all the streams of the workflow.
+func (mz *materializer) deleteStreams(ctx context.Context) error {
+	return mz.forAllTargets(func(target *topo.ShardInfo) error {
+		targetMaster, err := mz.wr.ts.GetTablet(ctx, target.MasterAlias)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetTablet(%v) failed", target.MasterAlias)
+		}
+		query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(targetMaster.DbName()), encodeString(mz.ms.Workflow))
+		if _, err := mz.wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, query); err != nil {
+			return vterrors.Wrapf(err, "VReplicationExec(%v, %s)", targetMaster.Tablet, query)
+		}
+		return nil
+	})
+}
+
+// setStreamOption sets a column of _vt.vreplication, like on_conflict
+// or sink, for all the streams of the workflow. The streams must not
+// have been started yet.
+func (mz *materializer) setStreamOption(ctx context.Context, column, value string) error {
+	return mz.forAllTargets(func(target *topo.ShardInfo) error {
+		targetMaster, err := mz.wr.ts.GetTablet(ctx, target.MasterAlias)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetTablet(%v) failed", target.MasterAlias)
+		}
+		query := fmt.Sprintf("update _vt.vreplication set %s=%s where db_name=%s and workflow=%s", column, encodeString(value), encodeString(targetMaster.DbName()), encodeString(mz.ms.Workflow))
+		if _, err := mz.wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, query); err != nil {
+			return vterrors.Wrapf(err, "VReplicationExec(%v, %s)", targetMaster.Tablet, query)
+		}
+		return nil
+	})
+}
+
 func (mz *materializer) forAllTargets(f func(*topo.ShardInfo) error) error {
 	var wg sync.WaitGroup
 	allErrors := &concurrency.AllErrorRecorder{}
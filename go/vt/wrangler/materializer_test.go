@@ -60,7 +60,7 @@ func TestMigrateTables(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", "")
 	require.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	require.NoError(t, err)
@@ -75,6 +75,143 @@ func TestMigrateTables(t *testing.T) {
 	}
 }
 
+func TestMigrateTablesFromExternal(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix+`.*keyspace:\\"sourceks\\" filter:.* external_mysql:\\"legacy\\".*`, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzSelectIDQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	ctx := context.Background()
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", "legacy")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
+	require.NoError(t, err)
+	got := fmt.Sprintf("%v", vschema)
+	require.Contains(t, got, `keyspaces:<key:"targetks" value:<tables:<key:"t1" value:<> > > >`)
+	require.NotContains(t, got, "from_table")
+}
+
+func TestMerge(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+	env.addTablet(110, "sourceks2", "0", topodatapb.TabletType_MASTER)
+	env.tmc.schema["sourceks2.t1"] = env.tmc.schema["sourceks.t1"]
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix+`.*sourceks\\".*`, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix+`.*sourceks2\\".*`, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "update _vt.vreplication set on_conflict='skip' where db_name='vt_targetks' and workflow='workflow'", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	ctx := context.Background()
+	err := env.wr.Merge(ctx, "workflow", []string{"sourceks", "sourceks2"}, "targetks", "t1", "", "", "skip")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
+	require.NoError(t, err)
+	got := fmt.Sprintf("%v", vschema)
+	require.Contains(t, got, `keyspaces:<key:"targetks" value:<tables:<key:"t1" value:<> > > >`)
+	require.NotContains(t, got, "from_table")
+}
+
+// TestMergeRollback checks that the streams of the first source keyspaces
+// are deleted if the streams of a later one can't be created.
+func TestMergeRollback(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix+`.*sourceks\\".*`, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "delete from _vt.vreplication where db_name='vt_targetks' and workflow='workflow'", &sqltypes.Result{})
+
+	err := env.wr.Merge(context.Background(), "workflow", []string{"sourceks", "sourceks2"}, "targetks", "t1", "", "", "skip")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "source keyspace sourceks2")
+	env.tmc.verifyQueries(t)
+}
+
+func TestMergeFailures(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	testcases := []struct {
+		sources    []string
+		tables     string
+		onConflict string
+		err        string
+	}{{
+		sources: []string{"sourceks", "sourceks2"},
+		tables:  "t1",
+		err:     "a conflict policy must be specified",
+	}, {
+		sources:    []string{"sourceks", "sourceks2"},
+		tables:     "t1",
+		onConflict: "first_writer_wins",
+		err:        `invalid on_conflict policy "first_writer_wins", must be one of fail, skip or last_writer_wins`,
+	}, {
+		sources:    []string{"sourceks"},
+		tables:     "t1",
+		onConflict: "fail",
+		err:        "at least two source keyspaces must be specified, use MoveTables to move tables from a single keyspace",
+	}, {
+		sources:    []string{"sourceks", "targetks"},
+		tables:     "t1",
+		onConflict: "fail",
+		err:        "source keyspace targetks can't be the target keyspace",
+	}, {
+		sources:    []string{"sourceks", "sourceks"},
+		tables:     "t1",
+		onConflict: "fail",
+		err:        "source keyspace sourceks specified more than once",
+	}, {
+		sources:    []string{"sourceks", "sourceks2"},
+		onConflict: "fail",
+		err:        "no tables specified",
+	}}
+	for _, tcase := range testcases {
+		err := env.wr.Merge(context.Background(), "workflow", tcase.sources, "targetks", tcase.tables, "", "", tcase.onConflict)
+		require.EqualError(t, err, tcase.err)
+	}
+}
+
 func TestMigrateVSchema(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -95,7 +232,7 @@ func TestMigrateVSchema(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "", "")
 	require.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	require.NoError(t, err)
@@ -1909,6 +2046,32 @@ func TestMaterializerStopAfterCopy(t *testing.T) {
 	env.tmc.verifyQueries(t)
 }
 
+func TestMaterializerToSink(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select id, val from t1",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix+`.*match:\\"t1\\" filter:\\"select id, val from t1\\".*'Stopped'`, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, "update _vt.vreplication set sink='file:///data/t1.json' where db_name='vt_targetks' and workflow='workflow'", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.MaterializeToSink(context.Background(), ms, "file:///data/t1.json")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+
+	err = env.wr.MaterializeToSink(context.Background(), ms, "kafka://broker/topic")
+	require.EqualError(t, err, "unsupported sink type: kafka")
+}
+
 func TestMaterializerNoTargetVSchema(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -2109,6 +2272,28 @@ func TestMaterializerNotASelect(t *testing.T) {
 	require.EqualError(t, err, "unrecognized statement: update t1 set val=1")
 }
 
+func TestMaterializerTypeMismatch(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select id, doc as data from t2",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.schema["sourceks.t2"].TableDefinitions[0].Fields = sqltypes.MakeTestFields("id|doc", "int64|varchar")
+	env.tmc.schema["targetks.t1"].TableDefinitions[0].Fields = sqltypes.MakeTestFields("id|data", "int64|json")
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	err := env.wr.Materialize(context.Background(), ms)
+	require.EqualError(t, err, "invalid source expression for table t1: doc of type VARCHAR can't be stored in column data of type JSON without an explicit cast, like cast(doc as json)")
+}
+
 func TestMaterializerNoGoodVindex(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -26,6 +26,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -53,6 +54,80 @@ func (wr *Wrangler) GetSchema(ctx context.Context, tabletAlias *topodatapb.Table
 	return wr.tmc.GetSchema(ctx, ti.Tablet, tables, excludeTables, includeViews)
 }
 
+// GetSchemaHistory uses an RPC to get the schema versions recorded by the
+// schema tracker of the master of a shard that match the request, oldest
+// first.
+func (wr *Wrangler) GetSchemaHistory(ctx context.Context, keyspace, shard string, request *tabletmanagerdatapb.GetSchemaHistoryRequest) ([]*tabletmanagerdatapb.SchemaVersion, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err)
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("no master in shard %v/%v", keyspace, shard)
+	}
+	ti, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, fmt.Errorf("GetTablet(%v) failed: %v", si.MasterAlias, err)
+	}
+	return wr.tmc.GetSchemaHistory(ctx, ti.Tablet, request)
+}
+
+// GetSchemaAsOf reconstructs the schema of a remote tablet as of a
+// replication position or an RFC 3339 time, from the schema versions
+// recorded by its schema tracker. Only the schema of the version in effect
+// is read from the tablet. The reconstructed tables only hold their columns,
+// fields and primary key columns.
+func (wr *Wrangler) GetSchemaAsOf(ctx context.Context, tabletAlias *topodatapb.TabletAlias, asOf string, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return nil, fmt.Errorf("GetTablet(%v) failed: %v", tabletAlias, err)
+	}
+	var version *tabletmanagerdatapb.SchemaVersion
+	if t, err := time.Parse(time.RFC3339, asOf); err == nil {
+		versions, err := wr.tmc.GetSchemaHistory(ctx, ti.Tablet, &tabletmanagerdatapb.GetSchemaHistoryRequest{
+			MaxTimeUpdated: t.Unix(),
+			Limit:          1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		version = tmutils.SchemaVersionAtTime(versions, t)
+	} else {
+		pos, err := mysql.DecodePosition(asOf)
+		if err != nil || pos.IsZero() {
+			return nil, fmt.Errorf("%q is neither a replication position nor an RFC 3339 time", asOf)
+		}
+		// Positions can't be compared by MySQL, so find the version
+		// from the positions alone, then read its schema.
+		versions, err := wr.tmc.GetSchemaHistory(ctx, ti.Tablet, &tabletmanagerdatapb.GetSchemaHistoryRequest{
+			ExcludeTableDefinitions: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if version, err = tmutils.SchemaVersionAtPosition(versions, pos); err != nil {
+			return nil, err
+		}
+		if version != nil {
+			versions, err := wr.tmc.GetSchemaHistory(ctx, ti.Tablet, &tabletmanagerdatapb.GetSchemaHistoryRequest{
+				MinId: version.Id,
+				MaxId: version.Id,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if len(versions) != 1 {
+				return nil, fmt.Errorf("schema version %d is no longer recorded on tablet %v", version.Id, topoproto.TabletAliasString(tabletAlias))
+			}
+			version = versions[0]
+		}
+	}
+	if version == nil {
+		return nil, fmt.Errorf("no schema version recorded on tablet %v as of %v", topoproto.TabletAliasString(tabletAlias), asOf)
+	}
+	return tmutils.FilterTables(tmutils.SchemaVersionToSchemaDefinition(version), tables, excludeTables, includeViews)
+}
+
 // ReloadSchema forces the remote tablet to reload its schema.
 func (wr *Wrangler) ReloadSchema(ctx context.Context, tabletAlias *topodatapb.TabletAlias) error {
 	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
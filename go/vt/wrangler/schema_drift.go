@@ -0,0 +1,261 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ShardSchemaDrift describes how the schema of a shard differs from the
+// schema of the reference shard of its keyspace.
+type ShardSchemaDrift struct {
+	Keyspace    string
+	Shard       string
+	MasterAlias *topodatapb.TabletAlias
+	// Differences lists the differences, as reported by ValidateSchemaKeyspace.
+	Differences []string
+	// RepairSQL lists the statements which converge the shard to the
+	// reference shard. It may be empty even though there are differences,
+	// when these are not significant, e.g. the order of columns.
+	RepairSQL []string
+}
+
+// DiffSchemaKeyspace compares the schema of the master of each shard of
+// the keyspace with the schema of the master of the reference shard, and
+// returns the shards which differ. If referenceShard is empty, the first
+// shard of the keyspace is the reference. Views and internal tables, such
+// as online DDL and table GC artifacts, are ignored. Shards without a
+// master are skipped.
+func (wr *Wrangler) DiffSchemaKeyspace(ctx context.Context, keyspace, referenceShard string, excludeTables []string) ([]*ShardSchemaDrift, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+	}
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("no shards in keyspace %v", keyspace)
+	}
+	sort.Strings(shards)
+	if referenceShard == "" {
+		referenceShard = shards[0]
+	}
+
+	referenceSchema, referenceAlias, err := wr.getShardMasterSchema(ctx, keyspace, referenceShard, excludeTables)
+	if err != nil {
+		return nil, err
+	}
+	if referenceAlias == nil {
+		return nil, fmt.Errorf("no master in reference shard %v/%v", keyspace, referenceShard)
+	}
+	referenceName := fmt.Sprintf("reference shard %v/%v master %v", keyspace, referenceShard, topoproto.TabletAliasString(referenceAlias))
+	referenceSQLs := schemaDefinitionTableSQLs(referenceSchema)
+
+	var drifts []*ShardSchemaDrift
+	for _, shard := range shards {
+		if shard == referenceShard {
+			continue
+		}
+		sd, alias, err := wr.getShardMasterSchema(ctx, keyspace, shard, excludeTables)
+		if err != nil {
+			return nil, err
+		}
+		if alias == nil {
+			log.Warningf("DiffSchemaKeyspace: no master in shard %v/%v, skipping", keyspace, shard)
+			continue
+		}
+		name := fmt.Sprintf("shard %v/%v master %v", keyspace, shard, topoproto.TabletAliasString(alias))
+		differences := tmutils.DiffSchemaToArray(referenceName, referenceSchema, name, sd)
+		if len(differences) == 0 {
+			continue
+		}
+		repairSQL, err := schemadiff.DiffSchemas(schemaDefinitionTableSQLs(sd), referenceSQLs)
+		if err != nil {
+			return nil, fmt.Errorf("cannot diff schema of shard %v/%v: %v", keyspace, shard, err)
+		}
+		drifts = append(drifts, &ShardSchemaDrift{
+			Keyspace:    keyspace,
+			Shard:       shard,
+			MasterAlias: alias,
+			Differences: differences,
+			RepairSQL:   repairSQL,
+		})
+	}
+	return drifts, nil
+}
+
+// getShardMasterSchema returns the schema of the master of the shard,
+// without views and internal tables. It returns a nil alias if the shard
+// has no master.
+func (wr *Wrangler) getShardMasterSchema(ctx context.Context, keyspace, shard string, excludeTables []string) (*tabletmanagerdatapb.SchemaDefinition, *topodatapb.TabletAlias, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err)
+	}
+	if !si.HasMaster() {
+		return nil, nil, nil
+	}
+	sd, err := wr.GetSchema(ctx, si.MasterAlias, nil, excludeTables, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("GetSchema(%v, nil, %v, false) failed: %v", si.MasterAlias, excludeTables, err)
+	}
+	filtered := &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: sd.DatabaseSchema,
+	}
+	for _, td := range sd.TableDefinitions {
+		if schema.IsOnlineDDLTableName(td.Name) || schema.IsGCTableName(td.Name) {
+			continue
+		}
+		filtered.TableDefinitions = append(filtered.TableDefinitions, td)
+	}
+	tmutils.GenerateSchemaVersion(filtered)
+	return filtered, si.MasterAlias, nil
+}
+
+func schemaDefinitionTableSQLs(sd *tabletmanagerdatapb.SchemaDefinition) []string {
+	sqls := make([]string, 0, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		sqls = append(sqls, td.Schema)
+	}
+	return sqls
+}
+
+// RepairSchemaKeyspace converges the schema of the shards of the keyspace
+// to the schema of the reference shard, see DiffSchemaKeyspace. Unless apply
+// is set, the statements are only printed. CREATE TABLE statements are applied
+// directly on the shard masters. ALTER TABLE and DROP TABLE statements are
+// submitted to the drifted shards as online DDL migrations with the given
+// strategy, unless it is schema.DDLStrategyNormal, in which case they are
+// applied directly too. Nothing is applied if any shard would drop a table,
+// unless allowDrops is set.
+func (wr *Wrangler) RepairSchemaKeyspace(ctx context.Context, keyspace, referenceShard string, excludeTables []string, ddlStrategy sqlparser.DDLStrategy, apply, allowDrops bool) error {
+	if referenceShard == "" {
+		return fmt.Errorf("a reference shard is required to repair the schema of keyspace %v", keyspace)
+	}
+	drifts, err := wr.DiffSchemaKeyspace(ctx, keyspace, referenceShard, excludeTables)
+	if err != nil {
+		return err
+	}
+	if len(drifts) == 0 {
+		wr.Logger().Printf("All shards of keyspace %v have the schema of the reference shard\n", keyspace)
+		return nil
+	}
+	var drops []string
+	for _, drift := range drifts {
+		if len(drift.RepairSQL) == 0 {
+			wr.Logger().Warningf("Shard %v/%v differs from the reference shard, but no DDL is needed to converge it: %v", keyspace, drift.Shard, strings.Join(drift.Differences, "; "))
+			continue
+		}
+		for _, sql := range drift.RepairSQL {
+			wr.Logger().Printf("%v/%v: %v\n", keyspace, drift.Shard, sql)
+			ddl, err := parseRepairDDL(sql)
+			if err != nil {
+				return err
+			}
+			if ddl.Action == sqlparser.DropDDLAction {
+				drops = append(drops, fmt.Sprintf("%v/%v: %v", keyspace, drift.Shard, sql))
+			}
+		}
+	}
+	if !apply {
+		return nil
+	}
+	if len(drops) > 0 && !allowDrops {
+		return fmt.Errorf("repairing the schema of keyspace %v drops tables, which requires -allow_drops: %v", keyspace, strings.Join(drops, "; "))
+	}
+	for _, drift := range drifts {
+		for _, sql := range drift.RepairSQL {
+			if err := wr.repairShardSchema(ctx, drift, sql, ddlStrategy); err != nil {
+				return fmt.Errorf("cannot repair shard %v/%v with %v: %v", keyspace, drift.Shard, sql, err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseRepairDDL parses a statement which repairs the schema of a shard.
+func parseRepairDDL(sql string) (*sqlparser.DDL, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok {
+		return nil, fmt.Errorf("not a DDL statement: %v", sql)
+	}
+	return ddl, nil
+}
+
+// repairShardSchema applies a repair statement to the master of a drifted shard.
+func (wr *Wrangler) repairShardSchema(ctx context.Context, drift *ShardSchemaDrift, sql string, ddlStrategy sqlparser.DDLStrategy) error {
+	ti, err := wr.ts.GetTablet(ctx, drift.MasterAlias)
+	if err != nil {
+		return fmt.Errorf("GetTablet(%v) failed: %v", drift.MasterAlias, err)
+	}
+	ddl, err := parseRepairDDL(sql)
+	if err != nil {
+		return err
+	}
+
+	var table string
+	switch ddl.Action {
+	case sqlparser.AlterDDLAction:
+		table = ddl.Table.Name.String()
+	case sqlparser.DropDDLAction:
+		table = ddl.FromTables[0].Name.String()
+	}
+	if ddlStrategy == schema.DDLStrategyNormal || table == "" {
+		if _, err := wr.tmc.ExecuteFetchAsDba(ctx, ti.Tablet, false, []byte(sql), 0, false, true /* reloadSchema */); err != nil {
+			return err
+		}
+		wr.Logger().Printf("%v/%v: applied %v\n", drift.Keyspace, drift.Shard, sql)
+		return nil
+	}
+	onlineSQL, err := schema.AddOnlineDDLHint(sql, ddlStrategy)
+	if err != nil {
+		return err
+	}
+
+	// Only the drifted shard gets the migration, so it is submitted to its
+	// master, rather than through the keyspace-wide migration requests.
+	onlineDDL, err := schema.NewOnlineDDL(drift.Keyspace, table, onlineSQL, ddlStrategy, "")
+	if err != nil {
+		return err
+	}
+	onlineDDL.Status = schema.OnlineDDLStatusQueued
+	query, err := onlineDDL.InsertQuery()
+	if err != nil {
+		return err
+	}
+	if _, err := wr.tmc.VExec(ctx, ti.Tablet, query, onlineDDL.UUID, drift.Keyspace); err != nil {
+		return err
+	}
+	wr.Logger().Printf("%v/%v: submitted migration %v: %v\n", drift.Keyspace, drift.Shard, onlineDDL.UUID, onlineSQL)
+	return nil
+}
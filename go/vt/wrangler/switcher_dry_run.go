@@ -91,14 +91,15 @@ func (dr *switcherDryRun) changeRouting(ctx context.Context) error {
 	addLogs := make([]string, 0)
 	if dr.ts.migrationType == binlogdatapb.MigrationType_TABLES {
 		for _, table := range dr.ts.tables {
+			sourceTable := dr.ts.sourceTables[table]
 			for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
 				tt := strings.ToLower(tabletType.String())
-				deleteLogs = append(deleteLogs, fmt.Sprintf("\t%s => %s", table+"@"+tt, strings.Trim(rules[table+"@"+tt][0], "[]")))
+				deleteLogs = append(deleteLogs, fmt.Sprintf("\t%s => %s", sourceTable+"@"+tt, strings.Trim(rules[sourceTable+"@"+tt][0], "[]")))
 				deleteLogs = append(deleteLogs, fmt.Sprintf("\t%s => %s", dr.ts.targetKeyspace+"."+table+"@"+tt, strings.Trim(rules[dr.ts.targetKeyspace+"."+table+"@"+tt][0], "[]")))
-				deleteLogs = append(deleteLogs, fmt.Sprintf("\t%s => %s", dr.ts.sourceKeyspace+"."+table+"@"+tt, strings.Trim(rules[dr.ts.sourceKeyspace+"."+table+"@"+tt][0], "[]")))
+				deleteLogs = append(deleteLogs, fmt.Sprintf("\t%s => %s", dr.ts.sourceKeyspace+"."+sourceTable+"@"+tt, strings.Trim(rules[dr.ts.sourceKeyspace+"."+sourceTable+"@"+tt][0], "[]")))
 			}
-			addLogs = append(addLogs, fmt.Sprintf("\t%s => %s", table, dr.ts.targetKeyspace+"."+table))
-			addLogs = append(addLogs, fmt.Sprintf("\t%s => %s", dr.ts.sourceKeyspace+"."+table, dr.ts.targetKeyspace+"."+table))
+			addLogs = append(addLogs, fmt.Sprintf("\t%s => %s", sourceTable, dr.ts.targetKeyspace+"."+table))
+			addLogs = append(addLogs, fmt.Sprintf("\t%s => %s", dr.ts.sourceKeyspace+"."+sourceTable, dr.ts.targetKeyspace+"."+table))
 		}
 		if len(deleteLogs) > 0 {
 			dr.drLog.Log("Following rules will be deleted:")
@@ -193,7 +194,7 @@ func (dr *switcherDryRun) stopSourceWrites(ctx context.Context) error {
 		logs = append(logs, fmt.Sprintf("\tKeyspace %s, Shard %s at Position %s", dr.ts.sourceKeyspace, source.si.ShardName(), position))
 	}
 	if len(logs) > 0 {
-		dr.drLog.Log(fmt.Sprintf("Stop writes on keyspace %s, tables %s:", dr.ts.sourceKeyspace, strings.Join(dr.ts.tables, ",")))
+		dr.drLog.Log(fmt.Sprintf("Stop writes on keyspace %s, tables %s:", dr.ts.sourceKeyspace, strings.Join(dr.ts.sourceTableNames(), ",")))
 		dr.drLog.LogSlice(logs)
 	}
 	return nil
@@ -228,7 +229,7 @@ func (dr *switcherDryRun) lockKeyspace(ctx context.Context, keyspace, _ string)
 func (dr *switcherDryRun) removeSourceTables(ctx context.Context, removalType TableRemovalType) error {
 	logs := make([]string, 0)
 	for _, source := range dr.ts.sources {
-		for _, tableName := range dr.ts.tables {
+		for _, tableName := range dr.ts.sourceTableNames() {
 			logs = append(logs, fmt.Sprintf("\tKeyspace %s Shard %s DbName %s Tablet %d Table %s RemovalType %s",
 				source.master.Keyspace, source.master.Shard, source.master.DbName(), source.master.Alias.Uid, tableName, TableRemovalType(removalType)))
 		}
@@ -309,7 +310,7 @@ func (dr *switcherDryRun) dropSourceBlacklistedTables(ctx context.Context) error
 		logs = append(logs, fmt.Sprintf("\tKeyspace %s Shard %s Tablet %d", si.Keyspace(), si.ShardName(), si.MasterAlias.Uid))
 	}
 	if len(logs) > 0 {
-		dr.drLog.Log(fmt.Sprintf("Blacklisted tables %s will be removed from:", strings.Join(dr.ts.tables, ",")))
+		dr.drLog.Log(fmt.Sprintf("Blacklisted tables %s will be removed from:", strings.Join(dr.ts.sourceTableNames(), ",")))
 		dr.drLog.LogSlice(logs)
 	}
 	return nil
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testlib
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestRepairSchemaKeyspace(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+
+	referenceDb := fakesqldb.New(t).SetName("referenceDb")
+	defer referenceDb.Close()
+	reference := NewFakeTablet(t, wr, "cell1", 0,
+		topodatapb.TabletType_MASTER, referenceDb, TabletKeyspaceShard(t, "ks", "-80"))
+
+	driftedDb := fakesqldb.New(t).SetName("driftedDb")
+	defer driftedDb.Close()
+	drifted := NewFakeTablet(t, wr, "cell1", 10,
+		topodatapb.TabletType_MASTER, driftedDb, TabletKeyspaceShard(t, "ks", "80-"))
+
+	for _, ft := range []*FakeTablet{reference, drifted} {
+		ft.StartActionLoop(t, wr)
+		defer ft.StopActionLoop(t)
+	}
+
+	table1 := &tabletmanagerdatapb.TableDefinition{
+		Name:   "table1",
+		Schema: "CREATE TABLE `table1` (\n  `id` bigint(20) NOT NULL,\n  `msg` varchar(64) DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+		Type:   tmutils.TableBaseTable,
+	}
+	table2 := &tabletmanagerdatapb.TableDefinition{
+		Name:   "table2",
+		Schema: "CREATE TABLE `table2` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+		Type:   tmutils.TableBaseTable,
+	}
+	driftedTable1 := &tabletmanagerdatapb.TableDefinition{
+		Name:   "table1",
+		Schema: "CREATE TABLE `table1` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+		Type:   tmutils.TableBaseTable,
+	}
+	// A user table, which is part of the schema despite its name.
+	auditTable := &tabletmanagerdatapb.TableDefinition{
+		Name:   "_audit",
+		Schema: "CREATE TABLE `_audit` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+		Type:   tmutils.TableBaseTable,
+	}
+	// An artifact of an online DDL migration, which is not part of the schema.
+	ghostTable := &tabletmanagerdatapb.TableDefinition{
+		Name:   "_table1_gho",
+		Schema: "CREATE TABLE `_table1_gho` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+		Type:   tmutils.TableBaseTable,
+	}
+	reference.FakeMysqlDaemon.Schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{auditTable, table1, table2},
+	}
+	repairedSchema := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{auditTable, table1, table2},
+	}
+	driftedSchema := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{auditTable, ghostTable, driftedTable1},
+	}
+
+	createTable := table2.Schema
+	alterTable := "alter table table1 add column msg varchar(64) default null"
+	driftedDb.AddQuery("USE `vt_ks`", &sqltypes.Result{})
+	driftedDb.AddQuery(createTable, &sqltypes.Result{})
+	driftedDb.AddQuery(alterTable, &sqltypes.Result{})
+	drifted.FakeMysqlDaemon.SchemaFunc = func() (*tabletmanagerdatapb.SchemaDefinition, error) {
+		if driftedDb.GetQueryCalledNum(alterTable) == 1 {
+			return repairedSchema, nil
+		}
+		return driftedSchema, nil
+	}
+
+	drifts, err := wr.DiffSchemaKeyspace(ctx, "ks", "", nil)
+	if err != nil {
+		t.Fatalf("DiffSchemaKeyspace failed: %v", err)
+	}
+	if len(drifts) != 1 || drifts[0].Shard != "80-" {
+		t.Fatalf("DiffSchemaKeyspace: got %v, want a single drift of shard 80-", drifts)
+	}
+	if want := []string{createTable, alterTable}; !reflect.DeepEqual(drifts[0].RepairSQL, want) {
+		t.Errorf("DiffSchemaKeyspace: got repair SQL %v, want %v", drifts[0].RepairSQL, want)
+	}
+
+	// Using the drifted shard as the reference would drop table2 from shard -80.
+	drifts, err = wr.DiffSchemaKeyspace(ctx, "ks", "80-", nil)
+	if err != nil {
+		t.Fatalf("DiffSchemaKeyspace failed: %v", err)
+	}
+	if len(drifts) != 1 || drifts[0].Shard != "-80" || len(drifts[0].RepairSQL) != 2 || drifts[0].RepairSQL[1] != "drop table table2" {
+		t.Errorf("DiffSchemaKeyspace with reference shard 80-: got %v, want shard -80 to drop table2", drifts)
+	}
+
+	if err := vp.Run([]string{"RepairSchemaKeyspace", "ks"}); err == nil || !strings.Contains(err.Error(), "-reference_shard flag is required") {
+		t.Errorf("RepairSchemaKeyspace without -reference_shard: got %v, want an error", err)
+	}
+
+	// Statements are only printed unless -apply is set.
+	if err := vp.Run([]string{"RepairSchemaKeyspace", "-reference_shard=-80", "ks"}); err != nil {
+		t.Fatalf("RepairSchemaKeyspace failed: %v", err)
+	}
+	if count := driftedDb.GetQueryCalledNum(alterTable); count != 0 {
+		t.Errorf("RepairSchemaKeyspace without -apply altered the table %v times", count)
+	}
+
+	// Converging to shard 80- drops table2, which requires -allow_drops.
+	if err := vp.Run([]string{"RepairSchemaKeyspace", "-reference_shard=80-", "-apply", "ks"}); err == nil || !strings.Contains(err.Error(), "requires -allow_drops") {
+		t.Errorf("RepairSchemaKeyspace dropping a table without -allow_drops: got %v, want an error", err)
+	}
+	if queries := referenceDb.QueryLog(); queries != "" {
+		t.Errorf("RepairSchemaKeyspace without -allow_drops ran queries on shard -80: %v", queries)
+	}
+
+	if err := vp.Run([]string{"RepairSchemaKeyspace", "-reference_shard=-80", "-apply", "ks"}); err != nil {
+		t.Fatalf("RepairSchemaKeyspace -apply failed: %v", err)
+	}
+	if count := driftedDb.GetQueryCalledNum(createTable); count != 1 {
+		t.Errorf("RepairSchemaKeyspace did not create the table exactly once. Query count: %v", count)
+	}
+	if count := driftedDb.GetQueryCalledNum(alterTable); count != 1 {
+		t.Errorf("RepairSchemaKeyspace did not alter the table exactly once. Query count: %v", count)
+	}
+	if count := referenceDb.GetQueryCalledNum(alterTable); count != 0 {
+		t.Errorf("RepairSchemaKeyspace altered the reference shard %v times", count)
+	}
+
+	drifts, err = wr.DiffSchemaKeyspace(ctx, "ks", "", nil)
+	if err != nil {
+		t.Fatalf("DiffSchemaKeyspace failed: %v", err)
+	}
+	if len(drifts) != 0 {
+		t.Errorf("DiffSchemaKeyspace after repair: got %v, want no drift", drifts)
+	}
+}
@@ -104,9 +104,12 @@ type trafficSwitcher struct {
 	sourceKeyspace  string
 	targetKeyspace  string
 	tables          []string
-	sourceKSSchema  *vindexes.KeyspaceSchema
-	optCells        string //cells option passed to MoveTables/Reshard
-	optTabletTypes  string //tabletTypes option passed to MoveTables/Reshard
+	// sourceTables maps the tables of the workflow to the tables they're
+	// replicated from. A Materialize workflow can rename a table.
+	sourceTables   map[string]string
+	sourceKSSchema *vindexes.KeyspaceSchema
+	optCells       string //cells option passed to MoveTables/Reshard
+	optTabletTypes string //tabletTypes option passed to MoveTables/Reshard
 
 }
 
@@ -186,6 +189,9 @@ func (wr *Wrangler) SwitchReads(ctx context.Context, targetKeyspace, workflow st
 }
 
 // SwitchWrites is a generic way of migrating write traffic for a resharding workflow.
+// For a Materialize workflow, the reverse streams replicate the materialized
+// tables back to their source tables, which requires the source expressions
+// to only select and rename columns.
 func (wr *Wrangler) SwitchWrites(ctx context.Context, targetKeyspace, workflow string, filteredReplicationWaitTime time.Duration, cancelMigrate, reverseReplication bool, dryRun bool) (journalID int64, dryRunResults *[]string, err error) {
 	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
 	if err != nil {
@@ -332,7 +338,7 @@ func (wr *Wrangler) SwitchWrites(ctx context.Context, targetKeyspace, workflow s
 	return ts.id, sw.logs(), nil
 }
 
-// DropSources cleans up source tables, shards and blacklisted tables after a MoveTables/Reshard is completed
+// DropSources cleans up source tables, shards and blacklisted tables after a MoveTables/Materialize/Reshard is completed
 func (wr *Wrangler) DropSources(ctx context.Context, targetKeyspace, workflow string, removalType TableRemovalType, dryRun bool) (*[]string, error) {
 	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
 	if err != nil {
@@ -402,6 +408,7 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 		id:              hashStreams(targetKeyspace, targets),
 		targets:         targets,
 		sources:         make(map[string]*tsSource),
+		sourceTables:    make(map[string]string),
 		targetKeyspace:  targetKeyspace,
 		frozen:          frozen,
 		optCells:        optCells,
@@ -421,6 +428,7 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 			if ts.tables == nil {
 				for _, rule := range bls.Filter.Rules {
 					ts.tables = append(ts.tables, rule.Match)
+					ts.sourceTables[rule.Match] = ruleSourceTable(rule)
 				}
 				sort.Strings(ts.tables)
 			} else {
@@ -572,7 +580,30 @@ func (ts *trafficSwitcher) validate(ctx context.Context, isWrite bool) error {
 				return fmt.Errorf("cannot migrate streams with wild card table names: %v", table)
 			}
 		}
+		// Tables are routed by the name of their source table.
+		targetTables := make(map[string]string)
+		for _, table := range ts.tables {
+			sourceTable := ts.sourceTables[table]
+			if other, ok := targetTables[sourceTable]; ok {
+				return fmt.Errorf("cannot migrate table %s: it's materialized into both %s and %s", sourceTable, other, table)
+			}
+			targetTables[sourceTable] = table
+		}
 		if isWrite {
+			// The reverse streams are created when writes are switched.
+			// Check that they can be built before anything is changed.
+			for _, target := range ts.targets {
+				for _, bls := range target.sources {
+					for _, rule := range bls.Filter.Rules {
+						if rule.Filter == vreplication.ExcludeStr {
+							continue
+						}
+						if _, err := buildReverseRule(rule); err != nil {
+							return err
+						}
+					}
+				}
+			}
 			return ts.validateTableForWrite(ctx)
 		}
 	} else { // binlogdatapb.MigrationType_SHARDS
@@ -589,9 +620,10 @@ func (ts *trafficSwitcher) validateTableForWrite(ctx context.Context) error {
 		return err
 	}
 	for _, table := range ts.tables {
+		sourceTable := ts.sourceTables[table]
 		for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
 			tt := strings.ToLower(tabletType.String())
-			if rules[table+"@"+tt] == nil || rules[ts.targetKeyspace+"."+table+"@"+tt] == nil {
+			if rules[sourceTable+"@"+tt] == nil || rules[ts.targetKeyspace+"."+table+"@"+tt] == nil {
 				return fmt.Errorf("missing tablet type specific routing, read-only traffic must be switched before switching writes: %v", table)
 			}
 		}
@@ -658,16 +690,19 @@ func (ts *trafficSwitcher) switchTableReads(ctx context.Context, cells []string,
 	// targetKeyspace.table -> sourceKeyspace.table
 	// For forward migration, we add tablet type specific rules to redirect traffic to the target.
 	// For backward, we delete them.
+	// If a Materialize workflow renamed the table, the rules for the name of
+	// the source table redirect traffic to the target table.
 	tt := strings.ToLower(servedType.String())
 	for _, table := range ts.tables {
+		sourceTable := ts.sourceTables[table]
 		if direction == DirectionForward {
-			rules[table+"@"+tt] = []string{ts.targetKeyspace + "." + table}
+			rules[sourceTable+"@"+tt] = []string{ts.targetKeyspace + "." + table}
 			rules[ts.targetKeyspace+"."+table+"@"+tt] = []string{ts.targetKeyspace + "." + table}
-			rules[ts.sourceKeyspace+"."+table+"@"+tt] = []string{ts.targetKeyspace + "." + table}
+			rules[ts.sourceKeyspace+"."+sourceTable+"@"+tt] = []string{ts.targetKeyspace + "." + table}
 		} else {
-			delete(rules, table+"@"+tt)
+			delete(rules, sourceTable+"@"+tt)
 			delete(rules, ts.targetKeyspace+"."+table+"@"+tt)
-			delete(rules, ts.sourceKeyspace+"."+table+"@"+tt)
+			delete(rules, ts.sourceKeyspace+"."+sourceTable+"@"+tt)
 		}
 	}
 	if err := ts.wr.saveRoutingRules(ctx, rules); err != nil {
@@ -758,7 +793,7 @@ func (ts *trafficSwitcher) stopSourceWrites(ctx context.Context) error {
 func (ts *trafficSwitcher) changeTableSourceWrites(ctx context.Context, access accessType) error {
 	return ts.forAllSources(func(source *tsSource) error {
 		if _, err := ts.wr.ts.UpdateShardFields(ctx, ts.sourceKeyspace, source.si.ShardName(), func(si *topo.ShardInfo) error {
-			return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, access == allowWrites /* remove */, ts.tables)
+			return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, access == allowWrites /* remove */, ts.sourceTableNames())
 		}); err != nil {
 			return err
 		}
@@ -871,27 +906,38 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 				reverseBls.Filter.Rules = append(reverseBls.Filter.Rules, rule)
 				continue
 			}
-			var filter string
 			if strings.HasPrefix(rule.Match, "/") {
+				var filter string
 				if ts.sourceKSSchema.Keyspace.Sharded {
 					filter = key.KeyRangeString(source.si.KeyRange)
 				}
-			} else {
-				var inKeyrange string
-				if ts.sourceKSSchema.Keyspace.Sharded {
-					vtable, ok := ts.sourceKSSchema.Tables[rule.Match]
-					if !ok {
-						return fmt.Errorf("table %s not found in vschema1", rule.Match)
-					}
-					// TODO(sougou): handle degenerate cases like sequence, etc.
-					// We currently assume the primary vindex is the best way to filter, which may not be true.
-					inKeyrange = fmt.Sprintf(" where in_keyrange(%s, '%s', '%s')", sqlparser.String(vtable.ColumnVindexes[0].Columns[0]), vtable.ColumnVindexes[0].Type, key.KeyRangeString(source.si.KeyRange))
+				reverseBls.Filter.Rules = append(reverseBls.Filter.Rules, &binlogdatapb.Rule{
+					Match:  rule.Match,
+					Filter: filter,
+				})
+				continue
+			}
+			rr, err := buildReverseRule(rule)
+			if err != nil {
+				return err
+			}
+			var inKeyrange string
+			if ts.sourceKSSchema.Keyspace.Sharded {
+				vtable, ok := ts.sourceKSSchema.Tables[rr.sourceTable]
+				if !ok {
+					return fmt.Errorf("table %s not found in vschema1", rr.sourceTable)
+				}
+				// TODO(sougou): handle degenerate cases like sequence, etc.
+				// We currently assume the primary vindex is the best way to filter, which may not be true.
+				column, err := rr.targetColumn(vtable.ColumnVindexes[0].Columns[0])
+				if err != nil {
+					return err
 				}
-				filter = fmt.Sprintf("select * from %s%s", rule.Match, inKeyrange)
+				inKeyrange = fmt.Sprintf(" where in_keyrange(%s, '%s', '%s')", sqlparser.String(column), vtable.ColumnVindexes[0].Type, key.KeyRangeString(source.si.KeyRange))
 			}
 			reverseBls.Filter.Rules = append(reverseBls.Filter.Rules, &binlogdatapb.Rule{
-				Match:  rule.Match,
-				Filter: filter,
+				Match:  rr.sourceTable,
+				Filter: fmt.Sprintf("select %s from %s%s", rr.columns, rr.table, inKeyrange),
 			})
 		}
 
@@ -1025,19 +1071,27 @@ func (ts *trafficSwitcher) changeTableRouting(ctx context.Context) error {
 	// After this step, only the following rules will be left:
 	// table -> targetKeyspace.table
 	// sourceKeyspace.table -> targetKeyspace.table
+	// If a Materialize workflow renamed the table, the rules are for the name
+	// of the source table, and rules for the name of the target table are
+	// deleted: they're left behind by a previous migration in the other direction.
 	for _, table := range ts.tables {
+		sourceTable := ts.sourceTables[table]
 		for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
 			tt := strings.ToLower(tabletType.String())
-			delete(rules, table+"@"+tt)
+			delete(rules, sourceTable+"@"+tt)
 			delete(rules, ts.targetKeyspace+"."+table+"@"+tt)
-			delete(rules, ts.sourceKeyspace+"."+table+"@"+tt)
-			ts.wr.Logger().Infof("Delete routing: %v %v %v", table+"@"+tt, ts.targetKeyspace+"."+table+"@"+tt, ts.sourceKeyspace+"."+table+"@"+tt)
+			delete(rules, ts.sourceKeyspace+"."+sourceTable+"@"+tt)
+			ts.wr.Logger().Infof("Delete routing: %v %v %v", sourceTable+"@"+tt, ts.targetKeyspace+"."+table+"@"+tt, ts.sourceKeyspace+"."+sourceTable+"@"+tt)
 		}
 		delete(rules, ts.targetKeyspace+"."+table)
 		ts.wr.Logger().Infof("Delete routing: %v", ts.targetKeyspace+"."+table)
-		rules[table] = []string{ts.targetKeyspace + "." + table}
-		rules[ts.sourceKeyspace+"."+table] = []string{ts.targetKeyspace + "." + table}
-		ts.wr.Logger().Infof("Add routing: %v %v", table, ts.sourceKeyspace+"."+table)
+		if sourceTable != table {
+			delete(rules, table)
+			ts.wr.Logger().Infof("Delete routing: %v", table)
+		}
+		rules[sourceTable] = []string{ts.targetKeyspace + "." + table}
+		rules[ts.sourceKeyspace+"."+sourceTable] = []string{ts.targetKeyspace + "." + table}
+		ts.wr.Logger().Infof("Add routing: %v %v", sourceTable, ts.sourceKeyspace+"."+sourceTable)
 	}
 	if err := ts.wr.saveRoutingRules(ctx, rules); err != nil {
 		return err
@@ -1137,6 +1191,119 @@ func (ts *trafficSwitcher) forAllUids(f func(target *tsTarget, uid uint32) error
 	return allErrors.AggrError(vterrors.Aggregate)
 }
 
+// sourceTableNames returns the names of the source tables of the workflow.
+func (ts *trafficSwitcher) sourceTableNames() []string {
+	tables := make([]string, 0, len(ts.tables))
+	for _, table := range ts.tables {
+		tables = append(tables, ts.sourceTables[table])
+	}
+	return tables
+}
+
+// ruleSourceTable returns the name of the table a rule replicates from.
+func ruleSourceTable(rule *binlogdatapb.Rule) string {
+	if rule.Filter == "" || rule.Filter == vreplication.ExcludeStr || key.IsKeyRange(rule.Filter) {
+		return rule.Match
+	}
+	table, err := sqlparser.TableFromStatement(rule.Filter)
+	if err != nil {
+		return rule.Match
+	}
+	return table.Name.String()
+}
+
+// reverseRule describes how a table of a workflow is replicated back
+// to its source table.
+type reverseRule struct {
+	// table is the table of the workflow, and sourceTable the table
+	// it's replicated from.
+	table       string
+	sourceTable string
+	// columns is the select list of the reverse filter.
+	columns string
+	// renames maps the columns of the source table to the columns of
+	// the table. It's nil if all columns are replicated as is.
+	renames map[string]sqlparser.ColIdent
+}
+
+// buildReverseRule builds the reverseRule of a rule. A rule can be reversed
+// if it selects all the columns of its source table, or columns that may be
+// renamed. Its where clause can only be an in_keyrange.
+func buildReverseRule(rule *binlogdatapb.Rule) (*reverseRule, error) {
+	rr := &reverseRule{
+		table:       rule.Match,
+		sourceTable: rule.Match,
+		columns:     "*",
+	}
+	if rule.Filter == "" || key.IsKeyRange(rule.Filter) {
+		return rr, nil
+	}
+	stmt, err := sqlparser.Parse(rule.Filter)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.From) != 1 {
+		return nil, fmt.Errorf("cannot reverse the replication of table %s: unexpected filter: %s", rule.Match, rule.Filter)
+	}
+	rr.sourceTable = ruleSourceTable(rule)
+	unsupported := func(what sqlparser.SQLNode) error {
+		return fmt.Errorf("cannot reverse the replication of table %s: %s is not supported: %s", rule.Match, sqlparser.String(what), rule.Filter)
+	}
+	if sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return nil, fmt.Errorf("cannot reverse the replication of table %s: only columns can be selected: %s", rule.Match, rule.Filter)
+	}
+	if sel.Where != nil {
+		funcExpr, ok := sel.Where.Expr.(*sqlparser.FuncExpr)
+		if !ok || !funcExpr.Name.EqualString("in_keyrange") {
+			return nil, unsupported(sel.Where.Expr)
+		}
+	}
+	if _, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); ok && len(sel.SelectExprs) == 1 {
+		return rr, nil
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	rr.renames = make(map[string]sqlparser.ColIdent)
+	for i, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, unsupported(selExpr)
+		}
+		col, ok := aliased.Expr.(*sqlparser.ColName)
+		if !ok || !col.Qualifier.IsEmpty() {
+			return nil, unsupported(aliased.Expr)
+		}
+		as := aliased.As
+		if as.IsEmpty() {
+			as = col.Name
+		}
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if as.Equal(col.Name) {
+			buf.Myprintf("%v", as)
+		} else {
+			buf.Myprintf("%v as %v", as, col.Name)
+		}
+		rr.renames[col.Name.Lowered()] = as
+	}
+	rr.columns = buf.String()
+	return rr, nil
+}
+
+// targetColumn returns the column of the table that a column of the
+// source table is replicated to.
+func (rr *reverseRule) targetColumn(col sqlparser.ColIdent) (sqlparser.ColIdent, error) {
+	if rr.renames == nil {
+		return col, nil
+	}
+	as, ok := rr.renames[col.Lowered()]
+	if !ok {
+		return sqlparser.ColIdent{}, fmt.Errorf("cannot reverse the replication of table %s: column %s of %s is not replicated", rr.table, col.String(), rr.sourceTable)
+	}
+	return as, nil
+}
+
 func (ts *trafficSwitcher) sourceShards() []*topo.ShardInfo {
 	shards := make([]*topo.ShardInfo, 0, len(ts.sources))
 	for _, source := range ts.sources {
@@ -1156,7 +1323,7 @@ func (ts *trafficSwitcher) targetShards() []*topo.ShardInfo {
 func (ts *trafficSwitcher) dropSourceBlacklistedTables(ctx context.Context) error {
 	return ts.forAllSources(func(source *tsSource) error {
 		if _, err := ts.wr.ts.UpdateShardFields(ctx, ts.sourceKeyspace, source.si.ShardName(), func(si *topo.ShardInfo) error {
-			return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, true, ts.tables)
+			return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, true, ts.sourceTableNames())
 		}); err != nil {
 			return err
 		}
@@ -1202,7 +1369,7 @@ func doValidateWorkflowHasCompleted(ctx context.Context, ts *trafficSwitcher) er
 		}
 		for fromTable, toTables := range rules {
 			for _, toTable := range toTables {
-				for _, table := range ts.tables {
+				for _, table := range ts.sourceTableNames() {
 					if toTable == fmt.Sprintf("%s.%s", ts.sourceKeyspace, table) {
 						rec.RecordError(fmt.Errorf("routing still exists from keyspace %s table %s to %s", ts.sourceKeyspace, table, fromTable))
 					}
@@ -1219,7 +1386,7 @@ func doValidateWorkflowHasCompleted(ctx context.Context, ts *trafficSwitcher) er
 
 func (ts *trafficSwitcher) removeSourceTables(ctx context.Context, removalType TableRemovalType) error {
 	return ts.forAllSources(func(source *tsSource) error {
-		for _, tableName := range ts.tables {
+		for _, tableName := range ts.sourceTableNames() {
 			query := fmt.Sprintf("drop table %s.%s", source.master.DbName(), tableName)
 			if removalType == DropTable {
 				ts.wr.Logger().Infof("Dropping table %s.%s\n", source.master.DbName(), tableName)
@@ -26,12 +26,14 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 )
 
@@ -1662,6 +1664,139 @@ func TestMigrateNoTableWildcards(t *testing.T) {
 	}
 }
 
+// TestMaterializeMigrate tests the migration of a Materialize workflow that
+// renames a table and its columns.
+func TestMaterializeMigrate(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+
+	setFilter := func(filter string) {
+		for i, targetShard := range tme.targetShards {
+			var rows []string
+			for j, sourceShard := range tme.sourceShards {
+				bls := &binlogdatapb.BinlogSource{
+					Keyspace: "ks1",
+					Shard:    sourceShard,
+					Filter: &binlogdatapb.Filter{
+						Rules: []*binlogdatapb.Rule{{
+							Match:  "t3",
+							Filter: fmt.Sprintf(filter, targetShard),
+						}},
+					},
+				}
+				rows = append(rows, fmt.Sprintf("%d|%v|||", j+1, bls))
+			}
+			tme.dbTargetClients[i].addInvariant(vreplQueryks2, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+				"id|source|message|cell|tablet_types",
+				"int64|varchar|varchar|varchar|varchar"),
+				rows...),
+			)
+		}
+	}
+	setFilter("select c1, val as value from t1 where in_keyrange('%s')")
+
+	tme.expectNoPreviousJournals()
+	_, err := tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_RDONLY, nil, DirectionForward, false)
+	require.NoError(t, err)
+	checkRouting(t, tme.wr, map[string][]string{
+		"t1":            {"ks1.t1"},
+		"ks2.t1":        {"ks1.t1"},
+		"t2":            {"ks1.t2"},
+		"ks2.t2":        {"ks1.t2"},
+		"t1@rdonly":     {"ks2.t3"},
+		"ks2.t3@rdonly": {"ks2.t3"},
+		"ks1.t1@rdonly": {"ks2.t3"},
+	})
+	tme.expectNoPreviousJournals()
+	_, err = tme.wr.SwitchReads(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_REPLICA, nil, DirectionForward, false)
+	require.NoError(t, err)
+
+	ts, err := tme.wr.buildTrafficSwitcher(ctx, tme.targetKeyspace, "test")
+	require.NoError(t, err)
+	require.NoError(t, ts.validate(ctx, true /* isWrite */))
+	assert.Equal(t, []string{"t1"}, ts.sourceTableNames())
+
+	// The reverse streams undo the renames.
+	for i, dbclient := range tme.dbSourceClients {
+		dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+		for j, targetShard := range tme.targetShards {
+			dbclient.addQueryRE(fmt.Sprintf("insert into _vt.vreplication.*test_reverse.*ks2.*%s.*match.*t1.*select c1, value as val from t3 where in_keyrange\\(c1, .*hash.*, .*%s.*Stopped", targetShard, tme.sourceShards[i]), &sqltypes.Result{InsertID: uint64(j + 1)}, nil)
+			dbclient.addQuery(fmt.Sprintf("select * from _vt.vreplication where id = %d", j+1), stoppedResult(j+1), nil)
+		}
+	}
+	require.NoError(t, ts.createReverseVReplication(ctx))
+	verifyQueries(t, tme.allDBClients)
+
+	require.NoError(t, ts.changeTableRouting(ctx))
+	checkRouting(t, tme.wr, map[string][]string{
+		"t1":     {"ks2.t3"},
+		"ks1.t1": {"ks2.t3"},
+		"ks2.t1": {"ks1.t1"},
+		"t2":     {"ks1.t2"},
+		"ks2.t2": {"ks1.t2"},
+	})
+
+	// Transformations can't be reversed.
+	setFilter("select c1, upper(val) as value from t1 where in_keyrange('%s')")
+	tme.expectNoPreviousJournals()
+	_, _, err = tme.wr.SwitchWrites(ctx, tme.targetKeyspace, "test", 1*time.Second, false, true, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot reverse the replication of table t3: upper(val) is not supported")
+}
+
+func TestBuildReverseRule(t *testing.T) {
+	testcases := []struct {
+		rule                 *binlogdatapb.Rule
+		sourceTable, columns string
+		err                  string
+	}{{
+		rule:        &binlogdatapb.Rule{Match: "t1"},
+		sourceTable: "t1",
+		columns:     "*",
+	}, {
+		rule:        &binlogdatapb.Rule{Match: "t1", Filter: "-80"},
+		sourceTable: "t1",
+		columns:     "*",
+	}, {
+		rule:        &binlogdatapb.Rule{Match: "t1", Filter: "select * from t1 where in_keyrange(c1, 'hash', '-80')"},
+		sourceTable: "t1",
+		columns:     "*",
+	}, {
+		rule:        &binlogdatapb.Rule{Match: "t1_new", Filter: "select * from t1"},
+		sourceTable: "t1",
+		columns:     "*",
+	}, {
+		rule:        &binlogdatapb.Rule{Match: "t2", Filter: "select c1, c2 as c3, `order` as ord from t1"},
+		sourceTable: "t1",
+		columns:     "c1, c3 as c2, ord as `order`",
+	}, {
+		rule: &binlogdatapb.Rule{Match: "t2", Filter: "select c1, c2 + 1 as c3 from t1"},
+		err:  "cannot reverse the replication of table t2: c2 + 1 is not supported: select c1, c2 + 1 as c3 from t1",
+	}, {
+		rule: &binlogdatapb.Rule{Match: "t2", Filter: "select c1, count(*) as c from t1 group by c1"},
+		err:  "cannot reverse the replication of table t2: only columns can be selected: select c1, count(*) as c from t1 group by c1",
+	}, {
+		rule: &binlogdatapb.Rule{Match: "t2", Filter: "select * from t1 where c1 > 10"},
+		err:  "cannot reverse the replication of table t2: c1 > 10 is not supported: select * from t1 where c1 > 10",
+	}}
+	for _, tcase := range testcases {
+		rr, err := buildReverseRule(tcase.rule)
+		if tcase.err != "" {
+			assert.EqualError(t, err, tcase.err, tcase.rule.Filter)
+			continue
+		}
+		require.NoError(t, err, tcase.rule.Filter)
+		assert.Equal(t, tcase.sourceTable, rr.sourceTable, tcase.rule.Filter)
+		assert.Equal(t, tcase.columns, rr.columns, tcase.rule.Filter)
+	}
+
+	rr, err := buildReverseRule(&binlogdatapb.Rule{Match: "t2", Filter: "select c2 as c3 from t1"})
+	require.NoError(t, err)
+	_, err = rr.targetColumn(sqlparser.NewColIdent("c1"))
+	assert.EqualError(t, err, "cannot reverse the replication of table t2: column c1 of t1 is not replicated")
+}
+
 func TestReverseName(t *testing.T) {
 	tests := []struct {
 		in, out string
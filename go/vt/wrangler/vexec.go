@@ -36,6 +36,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
@@ -43,6 +44,8 @@ const (
 	vexecTableQualifier       = "_vt"
 	vreplicationTableName     = "vreplication"
 	schemaMigrationsTableName = "schema_migrations"
+	vdiffTableName            = "vdiff"
+	vdiffTableTableName       = "vdiff_table"
 )
 
 // vexec is the construct by which we run a query against backend shards. vexec is created by user-facing
@@ -326,6 +329,23 @@ func (wr *Wrangler) execWorkflowAction(ctx context.Context, workflow, keyspace,
 	return wr.runVexec(ctx, workflow, keyspace, query, dryRun)
 }
 
+// SetSchemaChangePolicy sets the policy of the streams of a workflow for
+// the DDLs of the source tables. The streams are restarted with it.
+// An empty policy handles DDLs according to the OnDdl setting of
+// the streams.
+func (wr *Wrangler) SetSchemaChangePolicy(ctx context.Context, workflow, keyspace, policy string, dryRun bool) (map[*topo.TabletInfo]*sqltypes.Result, error) {
+	if err := vreplication.ValidateSchemaChangePolicy(policy); err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf("update _vt.vreplication set on_schema_change = %s", encodeString(policy))
+	results, err := wr.runVexec(ctx, workflow, keyspace, query, dryRun)
+	retResults := make(map[*topo.TabletInfo]*sqltypes.Result)
+	for tablet, result := range results {
+		retResults[tablet] = sqltypes.Proto3ToResult(result)
+	}
+	return retResults, err
+}
+
 // ReplicationStatusResult represents the result of trying to get the replication status for a given workflow.
 type ReplicationStatusResult struct {
 	// Workflow represents the name of the workflow relevant to the related replication statuses.
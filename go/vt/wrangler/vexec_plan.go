@@ -154,9 +154,39 @@ func (p schemaMigrationsPlanner) exec(ctx context.Context, masterAlias *topodata
 }
 func (p schemaMigrationsPlanner) dryRun(ctx context.Context) error { return nil }
 
+// vdiffPlanner is a vexecPlanner implementation, specific to _vt.vdiff and _vt.vdiff_table tables
+type vdiffPlanner struct {
+	vx *vexec
+	d  *vexecPlannerParams
+}
+
+func newVDiffPlanner(vx *vexec) vexecPlanner {
+	return &vdiffPlanner{
+		vx: vx,
+		d: &vexecPlannerParams{
+			dbNameColumn:         "db_name",
+			workflowColumn:       "workflow",
+			updatableColumnNames: []string{"state"},
+			updateTemplates: []string{
+				`update _vt.vdiff set state='val1'`,
+				`update _vt.vdiff set state='val1' where vdiff_uuid='val2'`,
+			},
+			insertTemplates: []string{
+				`insert into _vt.vdiff (vdiff_uuid, workflow, options) values ('val', 'val', 'val')`,
+			},
+		},
+	}
+}
+func (p vdiffPlanner) params() *vexecPlannerParams { return p.d }
+func (p vdiffPlanner) exec(ctx context.Context, masterAlias *topodatapb.TabletAlias, query string) (*querypb.QueryResult, error) {
+	return p.vx.wr.GenericVExec(ctx, masterAlias, query, p.vx.workflow, p.vx.keyspace)
+}
+func (p vdiffPlanner) dryRun(ctx context.Context) error { return nil }
+
 // make sure these planners implement vexecPlanner interface
 var _ vexecPlanner = vreplicationPlanner{}
 var _ vexecPlanner = schemaMigrationsPlanner{}
+var _ vexecPlanner = vdiffPlanner{}
 
 const (
 	updateQuery = iota
@@ -192,6 +222,8 @@ func (vx *vexec) getPlanner(ctx context.Context) error {
 		vx.planner = newSchemaMigrationsPlanner(vx)
 	case qualifiedTableName(vreplicationTableName):
 		vx.planner = newVReplicationPlanner(vx)
+	case qualifiedTableName(vdiffTableName), qualifiedTableName(vdiffTableTableName):
+		vx.planner = newVDiffPlanner(vx)
 	default:
 		return fmt.Errorf("table not supported by vexec: %v", vx.tableName)
 	}
@@ -161,6 +161,23 @@ func TestVExec(t *testing.T) {
 	require.Equal(t, strings.Join(dryRunResults, "\n")+"\n\n\n\n\n", logger.String())
 }
 
+func TestSetSchemaChangePolicy(t *testing.T) {
+	ctx := context.Background()
+	env := newWranglerTestEnv([]string{"0"}, []string{"-80", "80-"}, "", nil)
+	defer env.close()
+	wr := New(logutil.NewMemoryLogger(), env.topoServ, env.tmc)
+
+	results, err := wr.SetSchemaChangePolicy(ctx, "wrWorkflow", "target", "apply_additive", false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.EqualValues(t, 1, result.RowsAffected)
+	}
+
+	_, err = wr.SetSchemaChangePolicy(ctx, "wrWorkflow", "target", "apply_all", false)
+	require.EqualError(t, err, `invalid on_schema_change policy "apply_all", must be apply_additive`)
+}
+
 func TestWorkflowStatusUpdate(t *testing.T) {
 	require.Equal(t, "Running", updateState("for vdiff", "Running", nil, int64(time.Now().Second())))
 	require.Equal(t, "Running", updateState("", "Running", nil, int64(time.Now().Second())))
@@ -423,3 +440,44 @@ func TestVExecValidations(t *testing.T) {
 		})
 	}
 }
+
+func TestVExecVDiffPlan(t *testing.T) {
+	ctx := context.Background()
+	env := newWranglerTestEnv([]string{"0"}, []string{"-80", "80-"}, "", nil)
+	defer env.close()
+	wr := New(logutil.NewConsoleLogger(), env.topoServ, env.tmc)
+
+	testCases := []struct {
+		query       string
+		want        string
+		errorString string
+	}{{
+		query: `insert into _vt.vdiff (vdiff_uuid, workflow, options) values ('u1', 'wrWorkflow', '{}')`,
+		want:  `insert into _vt.vdiff(vdiff_uuid, workflow, options) values ('u1', 'wrWorkflow', '{}')`,
+	}, {
+		query: "update _vt.vdiff set state = 'stopped' where vdiff_uuid = 'u1'",
+		want:  "update _vt.vdiff set state = 'stopped' where vdiff_uuid = 'u1' and db_name = 'vt_target' and workflow = 'wrWorkflow'",
+	}, {
+		query: "select table_name, state, report from _vt.vdiff_table where vdiff_uuid = 'u1'",
+		want:  "select table_name, state, report from _vt.vdiff_table where vdiff_uuid = 'u1' and db_name = 'vt_target' and workflow = 'wrWorkflow'",
+	}, {
+		query:       "update _vt.vdiff set options = '{}'",
+		errorString: "options cannot be changed: options = '{}'",
+	}, {
+		query:       `insert into _vt.vdiff (vdiff_uuid, workflow, options, state) values ('u1', 'wrWorkflow', '{}', 'completed')`,
+		errorString: "Query must match one of these templates: insert into _vt.vdiff (vdiff_uuid, workflow, options) values ('val', 'val', 'val')",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			vx := newVExec(ctx, "wrWorkflow", "target", tc.query, wr)
+			require.NoError(t, vx.getMasters())
+			plan, err := vx.parseAndPlan(ctx)
+			if tc.errorString != "" {
+				require.EqualError(t, err, tc.errorString)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, plan.parsedQuery.Query)
+		})
+	}
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// sequenceColumns are the columns of a sequence backing table.
+var sequenceColumns = []string{"id", "next_id", "cache"}
+
+// ValidateVSchema cross-checks the proposed VSchema of the keyspace against
+// the schema of the tablets: the tables, the vindex columns, the backing
+// tables of lookup vindexes and of sequences, and their unique keys. The
+// schema of a keyspace is read from the master of its first shard. It
+// returns the problems which break routing as errors, and the suspicious
+// configurations as warnings.
+func (wr *Wrangler) ValidateVSchema(ctx context.Context, keyspace string, vs *vschemapb.Keyspace) (errors, warnings []string) {
+	validator := newVSchemaValidator(keyspace, vs,
+		func(keyspace string) (map[string]*tabletmanagerdatapb.TableDefinition, error) {
+			return wr.getKeyspaceTables(ctx, keyspace)
+		},
+		func(keyspace string) (*vschemapb.Keyspace, error) {
+			return wr.ts.GetVSchema(ctx, keyspace)
+		})
+	validator.validate()
+	return validator.errors, validator.warnings
+}
+
+// getKeyspaceTables returns the table definitions of the master of the
+// first shard of the keyspace, by table name.
+func (wr *Wrangler) getKeyspaceTables(ctx context.Context, keyspace string) (map[string]*tabletmanagerdatapb.TableDefinition, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+	}
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("no shards in keyspace %v", keyspace)
+	}
+	sort.Strings(shards)
+	si, err := wr.ts.GetShard(ctx, keyspace, shards[0])
+	if err != nil {
+		return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shards[0], err)
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("no master in shard %v/%v", keyspace, shards[0])
+	}
+	sd, err := wr.GetSchema(ctx, si.MasterAlias, nil, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("GetSchema(%v, nil, nil, true) failed: %v", si.MasterAlias, err)
+	}
+	tables := make(map[string]*tabletmanagerdatapb.TableDefinition, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		tables[td.Name] = td
+	}
+	return tables, nil
+}
+
+// vschemaValidator validates a keyspace VSchema. The tablet schemas and the
+// VSchemas of the other keyspaces are read through getTables and getVSchema,
+// at most once per keyspace.
+type vschemaValidator struct {
+	keyspace   string
+	vs         *vschemapb.Keyspace
+	getTables  func(keyspace string) (map[string]*tabletmanagerdatapb.TableDefinition, error)
+	getVSchema func(keyspace string) (*vschemapb.Keyspace, error)
+
+	tables   map[string]map[string]*tabletmanagerdatapb.TableDefinition
+	vschemas map[string]*vschemapb.Keyspace
+	errors   []string
+	warnings []string
+}
+
+func newVSchemaValidator(keyspace string, vs *vschemapb.Keyspace,
+	getTables func(keyspace string) (map[string]*tabletmanagerdatapb.TableDefinition, error),
+	getVSchema func(keyspace string) (*vschemapb.Keyspace, error)) *vschemaValidator {
+	return &vschemaValidator{
+		keyspace:   keyspace,
+		vs:         vs,
+		getTables:  getTables,
+		getVSchema: getVSchema,
+		tables:     make(map[string]map[string]*tabletmanagerdatapb.TableDefinition),
+		vschemas:   map[string]*vschemapb.Keyspace{keyspace: vs},
+	}
+}
+
+func (v *vschemaValidator) errorf(format string, args ...interface{}) {
+	v.errors = append(v.errors, fmt.Sprintf(format, args...))
+}
+
+func (v *vschemaValidator) warningf(format string, args ...interface{}) {
+	v.warnings = append(v.warnings, fmt.Sprintf(format, args...))
+}
+
+func (v *vschemaValidator) validate() {
+	if _, err := vindexes.BuildKeyspaceSchema(v.vs, v.keyspace); err != nil {
+		v.errorf("%v", err)
+		return
+	}
+	for _, name := range sortedTableNames(v.vs.Tables) {
+		v.validateTable(name, v.vs.Tables[name])
+	}
+	vindexNames := make([]string, 0, len(v.vs.Vindexes))
+	for name := range v.vs.Vindexes {
+		vindexNames = append(vindexNames, name)
+	}
+	sort.Strings(vindexNames)
+	for _, name := range vindexNames {
+		v.validateVindex(name, v.vs.Vindexes[name])
+	}
+}
+
+// keyspaceTables returns the tables of the keyspace, or nil if they cannot
+// be read, in which case the tables of the keyspace are not validated.
+func (v *vschemaValidator) keyspaceTables(keyspace string) map[string]*tabletmanagerdatapb.TableDefinition {
+	if tables, ok := v.tables[keyspace]; ok {
+		return tables
+	}
+	tables, err := v.getTables(keyspace)
+	if err != nil {
+		v.warningf("cannot validate against the schema of keyspace %s: %v", keyspace, err)
+	}
+	v.tables[keyspace] = tables
+	return tables
+}
+
+func (v *vschemaValidator) validateTable(name string, table *vschemapb.Table) {
+	tables := v.keyspaceTables(v.keyspace)
+	if tables == nil {
+		return
+	}
+	td, ok := tables[name]
+	if !ok {
+		v.errorf("table %s does not exist in keyspace %s", name, v.keyspace)
+		return
+	}
+	for _, cv := range table.ColumnVindexes {
+		columns := cv.Columns
+		if len(columns) == 0 {
+			columns = []string{cv.Column}
+		}
+		for _, column := range columns {
+			if !hasColumn(td, column) {
+				v.errorf("column %s of vindex %s does not exist in table %s", column, cv.Name, name)
+			}
+		}
+	}
+	for _, column := range table.Columns {
+		if hasColumn(td, column.Name) {
+			continue
+		}
+		if table.ColumnListAuthoritative {
+			v.errorf("column %s of the authoritative column list does not exist in table %s", column.Name, name)
+		} else {
+			v.warningf("column %s of the column list does not exist in table %s", column.Name, name)
+		}
+	}
+	if table.AutoIncrement != nil {
+		if !hasColumn(td, table.AutoIncrement.Column) {
+			v.errorf("auto increment column %s does not exist in table %s", table.AutoIncrement.Column, name)
+		}
+		v.validateSequence(name, table.AutoIncrement.Sequence)
+	}
+}
+
+// validateSequence checks that the sequence of the table is a sequence table
+// in the VSchema, backed by a table with the sequence columns.
+func (v *vschemaValidator) validateSequence(tableName, sequence string) {
+	keyspace, name := v.keyspace, sequence
+	if i := strings.Index(sequence, "."); i >= 0 {
+		keyspace, name = sequence[:i], sequence[i+1:]
+	}
+	vs, ok := v.vschemas[keyspace]
+	if !ok {
+		var err error
+		if vs, err = v.getVSchema(keyspace); err != nil {
+			v.errorf("cannot read the VSchema of keyspace %s, of sequence %s of table %s: %v", keyspace, sequence, tableName, err)
+			return
+		}
+		v.vschemas[keyspace] = vs
+	}
+	seq, ok := vs.Tables[name]
+	if !ok {
+		if keyspace == v.keyspace && !strings.Contains(sequence, ".") {
+			v.warningf("sequence %s of table %s is not in keyspace %s, it must be unique across all keyspaces", sequence, tableName, keyspace)
+		} else {
+			v.errorf("sequence %s of table %s does not exist in the VSchema of keyspace %s", sequence, tableName, keyspace)
+		}
+		return
+	}
+	if seq.Type != vindexes.TypeSequence {
+		v.errorf("sequence %s of table %s is not a table of type %s", sequence, tableName, vindexes.TypeSequence)
+		return
+	}
+	tables := v.keyspaceTables(keyspace)
+	if tables == nil {
+		return
+	}
+	td, ok := tables[name]
+	if !ok {
+		v.errorf("backing table %s of sequence %s does not exist in keyspace %s", name, sequence, keyspace)
+		return
+	}
+	for _, column := range sequenceColumns {
+		if !hasColumn(td, column) {
+			v.errorf("backing table %s of sequence %s has no column %s", name, sequence, column)
+		}
+	}
+}
+
+// validateVindex checks that the backing table of a lookup vindex exists,
+// and has a unique key on its from columns, or on its from and to columns.
+func (v *vschemaValidator) validateVindex(name string, vindex *vschemapb.Vindex) {
+	vdx, err := vindexes.CreateVindex(vindex.Type, name, vindex.Params)
+	if err != nil {
+		v.errorf("%v", err)
+		return
+	}
+	if _, isLookup := vdx.(vindexes.Lookup); !isLookup || vindex.Params["table"] == "" {
+		return
+	}
+	keyspace, tableName := v.keyspace, vindex.Params["table"]
+	if i := strings.Index(tableName, "."); i >= 0 {
+		keyspace, tableName = tableName[:i], tableName[i+1:]
+	}
+	tables := v.keyspaceTables(keyspace)
+	if tables == nil {
+		return
+	}
+	td, ok := tables[tableName]
+	if !ok {
+		v.errorf("backing table %s of lookup vindex %s does not exist in keyspace %s", tableName, name, keyspace)
+		return
+	}
+	var fromColumns []string
+	for _, column := range strings.Split(vindex.Params["from"], ",") {
+		fromColumns = append(fromColumns, strings.TrimSpace(column))
+	}
+	lookupColumns := append(append([]string{}, fromColumns...), strings.TrimSpace(vindex.Params["to"]))
+	missing := false
+	for _, column := range lookupColumns {
+		if !hasColumn(td, column) {
+			v.errorf("column %s of lookup vindex %s does not exist in backing table %s", column, name, tableName)
+			missing = true
+		}
+	}
+	if missing {
+		return
+	}
+	uniqueKeys := tableUniqueKeys(td)
+	if vdx.IsUnique() {
+		if !hasUniqueKey(uniqueKeys, fromColumns) {
+			v.errorf("backing table %s of unique lookup vindex %s has no unique key on (%s)", tableName, name, strings.Join(fromColumns, ", "))
+		}
+		return
+	}
+	if !hasUniqueKey(uniqueKeys, lookupColumns) {
+		v.warningf("backing table %s of lookup vindex %s has no unique key on (%s)", tableName, name, strings.Join(lookupColumns, ", "))
+	}
+}
+
+func hasColumn(td *tabletmanagerdatapb.TableDefinition, column string) bool {
+	for _, c := range td.Columns {
+		if strings.EqualFold(c, column) {
+			return true
+		}
+	}
+	return false
+}
+
+// tableUniqueKeys returns the columns of the primary key and of the unique
+// keys of the table. If the CREATE TABLE statement cannot be parsed, only the
+// primary key is returned.
+func tableUniqueKeys(td *tabletmanagerdatapb.TableDefinition) [][]string {
+	var keys [][]string
+	if len(td.PrimaryKeyColumns) > 0 {
+		keys = append(keys, td.PrimaryKeyColumns)
+	}
+	stmt, err := sqlparser.Parse(td.Schema)
+	if err != nil {
+		return keys
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.TableSpec == nil {
+		return keys
+	}
+	for _, index := range ddl.TableSpec.Indexes {
+		if !index.Info.Primary && !index.Info.Unique {
+			continue
+		}
+		var columns []string
+		for _, column := range index.Columns {
+			columns = append(columns, column.Column.String())
+		}
+		keys = append(keys, columns)
+	}
+	return keys
+}
+
+// hasUniqueKey returns true if one of the keys consists of exactly the given
+// columns, in any order.
+func hasUniqueKey(keys [][]string, columns []string) bool {
+	for _, key := range keys {
+		if len(key) != len(columns) {
+			continue
+		}
+		matches := true
+		for _, column := range columns {
+			found := false
+			for _, keyColumn := range key {
+				found = found || strings.EqualFold(keyColumn, column)
+			}
+			matches = matches && found
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedTableNames(tables map[string]*vschemapb.Table) []string {
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
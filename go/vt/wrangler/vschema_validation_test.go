@@ -0,0 +1,224 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func validationTestTables() map[string]map[string]*tabletmanagerdatapb.TableDefinition {
+	return map[string]map[string]*tabletmanagerdatapb.TableDefinition{
+		"ks": {
+			"user": {
+				Name:              "user",
+				Schema:            "CREATE TABLE `user` (\n  `id` bigint NOT NULL,\n  `name` varchar(64) DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+				Columns:           []string{"id", "name"},
+				PrimaryKeyColumns: []string{"id"},
+			},
+			"name_user_idx": {
+				Name:              "name_user_idx",
+				Schema:            "CREATE TABLE `name_user_idx` (\n  `name` varchar(64) NOT NULL,\n  `user_id` bigint NOT NULL,\n  PRIMARY KEY (`name`, `user_id`)\n) ENGINE=InnoDB",
+				Columns:           []string{"name", "user_id"},
+				PrimaryKeyColumns: []string{"name", "user_id"},
+			},
+		},
+		"lookup": {
+			"name_keyspace_idx": {
+				Name:              "name_keyspace_idx",
+				Schema:            "CREATE TABLE `name_keyspace_idx` (\n  `id` bigint NOT NULL,\n  `name` varchar(64) NOT NULL,\n  `keyspace_id` varbinary(128) DEFAULT NULL,\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `name_idx` (`name`)\n) ENGINE=InnoDB",
+				Columns:           []string{"id", "name", "keyspace_id"},
+				PrimaryKeyColumns: []string{"id"},
+			},
+			"user_seq": {
+				Name:              "user_seq",
+				Schema:            "CREATE TABLE `user_seq` (\n  `id` int NOT NULL,\n  `next_id` bigint DEFAULT NULL,\n  `cache` bigint DEFAULT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+				Columns:           []string{"id", "next_id", "cache"},
+				PrimaryKeyColumns: []string{"id"},
+			},
+			"order_seq": {
+				Name:              "order_seq",
+				Schema:            "CREATE TABLE `order_seq` (\n  `id` int NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+				Columns:           []string{"id"},
+				PrimaryKeyColumns: []string{"id"},
+			},
+		},
+	}
+}
+
+func validateTestVSchema(vs *vschemapb.Keyspace) ([]string, []string) {
+	tables := validationTestTables()
+	vschemas := map[string]*vschemapb.Keyspace{
+		"lookup": {
+			Tables: map[string]*vschemapb.Table{
+				"name_keyspace_idx": {},
+				"user_seq":          {Type: "sequence"},
+				"order_seq":         {Type: "sequence"},
+			},
+		},
+	}
+	validator := newVSchemaValidator("ks", vs,
+		func(keyspace string) (map[string]*tabletmanagerdatapb.TableDefinition, error) {
+			if tables, ok := tables[keyspace]; ok {
+				return tables, nil
+			}
+			return nil, fmt.Errorf("no shards in keyspace %v", keyspace)
+		},
+		func(keyspace string) (*vschemapb.Keyspace, error) {
+			if vs, ok := vschemas[keyspace]; ok {
+				return vs, nil
+			}
+			return nil, fmt.Errorf("node doesn't exist: keyspaces/%s/VSchema", keyspace)
+		})
+	validator.validate()
+	return validator.errors, validator.warnings
+}
+
+func TestValidateVSchema(t *testing.T) {
+	hash := &vschemapb.Vindex{Type: "hash"}
+	testcases := []struct {
+		name     string
+		vs       *vschemapb.Keyspace
+		errors   []string
+		warnings []string
+	}{{
+		name: "valid",
+		vs: &vschemapb.Keyspace{
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"hash": hash,
+				"name_keyspace_idx": {
+					Type:   "lookup_unique",
+					Params: map[string]string{"table": "lookup.name_keyspace_idx", "from": "name", "to": "keyspace_id"},
+					Owner:  "user",
+				},
+				"name_user_idx": {
+					Type:   "lookup_hash",
+					Params: map[string]string{"table": "name_user_idx", "from": "name", "to": "user_id"},
+				},
+			},
+			Tables: map[string]*vschemapb.Table{
+				"user": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{
+						{Name: "hash", Column: "id"},
+						{Name: "name_keyspace_idx", Columns: []string{"name"}},
+					},
+					AutoIncrement: &vschemapb.AutoIncrement{Column: "id", Sequence: "lookup.user_seq"},
+				},
+				"name_user_idx": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Column: "user_id"}},
+				},
+			},
+		},
+	}, {
+		name: "unparsable",
+		vs: &vschemapb.Keyspace{
+			Sharded: true,
+			Tables: map[string]*vschemapb.Table{
+				"user": {ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Column: "id"}}},
+			},
+		},
+		errors: []string{"vindex hash not found for table user"},
+	}, {
+		name: "typos",
+		vs: &vschemapb.Keyspace{
+			Sharded:  true,
+			Vindexes: map[string]*vschemapb.Vindex{"hash": hash},
+			Tables: map[string]*vschemapb.Table{
+				"usr": {ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Column: "id"}}},
+				"user": {
+					ColumnVindexes:          []*vschemapb.ColumnVindex{{Name: "hash", Column: "uid"}},
+					Columns:                 []*vschemapb.Column{{Name: "id"}, {Name: "nme"}},
+					ColumnListAuthoritative: true,
+				},
+				"name_user_idx": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{{Name: "hash", Column: "user_id"}},
+					Columns:        []*vschemapb.Column{{Name: "usr_id"}},
+				},
+			},
+		},
+		errors: []string{
+			"column uid of vindex hash does not exist in table user",
+			"column nme of the authoritative column list does not exist in table user",
+			"table usr does not exist in keyspace ks",
+		},
+		warnings: []string{"column usr_id of the column list does not exist in table name_user_idx"},
+	}, {
+		name: "lookup backing tables",
+		vs: &vschemapb.Keyspace{
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"missing_idx": {
+					Type:   "lookup_unique",
+					Params: map[string]string{"table": "lookup.missing_idx", "from": "name", "to": "keyspace_id"},
+				},
+				"name_keyspace_idx": {
+					Type:   "lookup_hash",
+					Params: map[string]string{"table": "lookup.name_keyspace_idx", "from": "name", "to": "keyspace_id"},
+				},
+				"name_user_idx": {
+					Type:   "lookup_hash_unique",
+					Params: map[string]string{"table": "name_user_idx", "from": "name", "to": "user"},
+				},
+				"user_idx": {
+					Type:   "lookup_hash_unique",
+					Params: map[string]string{"table": "name_user_idx", "from": "user_id", "to": "name"},
+				},
+			},
+		},
+		errors: []string{
+			"backing table missing_idx of lookup vindex missing_idx does not exist in keyspace lookup",
+			"column user of lookup vindex name_user_idx does not exist in backing table name_user_idx",
+			"backing table name_user_idx of unique lookup vindex user_idx has no unique key on (user_id)",
+		},
+		warnings: []string{"backing table name_keyspace_idx of lookup vindex name_keyspace_idx has no unique key on (name, keyspace_id)"},
+	}, {
+		name: "sequences",
+		vs: &vschemapb.Keyspace{
+			Tables: map[string]*vschemapb.Table{
+				"name_user_idx": {AutoIncrement: &vschemapb.AutoIncrement{Column: "user_id", Sequence: "lookup.order_seq"}},
+				"user":          {AutoIncrement: &vschemapb.AutoIncrement{Column: "uid", Sequence: "user_seq"}},
+			},
+		},
+		errors: []string{
+			"backing table order_seq of sequence lookup.order_seq has no column next_id",
+			"backing table order_seq of sequence lookup.order_seq has no column cache",
+			"auto increment column uid does not exist in table user",
+		},
+		warnings: []string{"sequence user_seq of table user is not in keyspace ks, it must be unique across all keyspaces"},
+	}, {
+		name: "unknown keyspace",
+		vs: &vschemapb.Keyspace{
+			Tables: map[string]*vschemapb.Table{
+				"user": {AutoIncrement: &vschemapb.AutoIncrement{Column: "id", Sequence: "other.user_seq"}},
+			},
+		},
+		errors: []string{"cannot read the VSchema of keyspace other, of sequence other.user_seq of table user: node doesn't exist: keyspaces/other/VSchema"},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			errors, warnings := validateTestVSchema(tc.vs)
+			assert.Equal(t, tc.errors, errors)
+			assert.Equal(t, tc.warnings, warnings)
+		})
+	}
+}